@@ -202,16 +202,19 @@ var specs = []tableSpec{
 	{
 		name:     "unofficial_events",
 		category: categoryLeak,
+		// 参加コードで共有し、他のユーザの記録がまだ付いているものは、その記録のために残すので数えない。
 		query: `SELECT t.user_id, COUNT(*) FROM unofficial_events t
 		        JOIN users u ON u.id = t.user_id
 		        WHERE u.deleted_at IS NOT NULL AND t.deleted_at IS NULL
+		          AND NOT EXISTS (SELECT 1 FROM records r WHERE r.unofficial_event_id = t.id AND r.deleted_at IS NULL)
 		        GROUP BY t.user_id`,
 		// Record.Delete は records.unofficial_event_id をたどって削除するため、どの record からも
 		// 参照されていない自由形式イベントは退会処理では消えない。ここでは user_id から直接消す。
 		deleteQuery: `UPDATE unofficial_events t SET deleted_at = now() FROM users u
-		              WHERE u.id = t.user_id AND u.deleted_at IS NOT NULL AND t.deleted_at IS NULL`,
+		              WHERE u.id = t.user_id AND u.deleted_at IS NOT NULL AND t.deleted_at IS NULL
+		                AND NOT EXISTS (SELECT 1 FROM records r WHERE r.unofficial_event_id = t.id AND r.deleted_at IS NULL)`,
 		ownerColumn: "t.user_id",
-		note:        "record 経由でのみ削除される。どの record からも参照されていないものは残る",
+		note:        "record 経由でのみ削除される。どの record からも参照されていないものは残る(他のユーザが参加中のものは残すのが正しい)",
 	},
 
 	// --- 退会処理が削除対象にしていないもの(残っていても実装どおり) ---
@@ -264,6 +267,20 @@ var specs = []tableSpec{
 		ownerColumn: "t.user_id",
 		note:        "退会処理の対象外。論理削除を持たないため行ごと残る",
 	},
+	{
+		name:     "user_relationships",
+		category: categoryUnhandled,
+		// 退会したユーザが送った申請・ブロックだけを数える。退会したユーザ宛ての行は
+		// 相手のデータのため対象にしない(フレンドの一覧では退会したユーザを除いて返す)。
+		query: `SELECT t.user_id, COUNT(*) FROM user_relationships t
+		        JOIN users u ON u.id = t.user_id
+		        WHERE u.deleted_at IS NOT NULL
+		        GROUP BY t.user_id`,
+		deleteQuery: `DELETE FROM user_relationships t USING users u
+		              WHERE u.id = t.user_id AND u.deleted_at IS NOT NULL`,
+		ownerColumn: "t.user_id",
+		note:        "退会処理の対象外。論理削除を持たないため行ごと残る",
+	},
 	{
 		name:     "match_pokemon_sprites",
 		category: categoryUnhandled,
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/vsrecorder/core-apiserver/internal"
 	"github.com/vsrecorder/core-apiserver/internal/controller"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
 	"github.com/vsrecorder/core-apiserver/internal/infrastructure"
 	"github.com/vsrecorder/core-apiserver/internal/infrastructure/postgres"
 	"github.com/vsrecorder/core-apiserver/internal/logging"
@@ -60,6 +63,161 @@ func validateJWTSecret(secret string) error {
 	return nil
 }
 
+// デッキのリソース(結果HTML・画像)の配置先。DECK_ASSET_STORAGE で選ぶ。
+const (
+	deckAssetStorageS3     = "s3"
+	deckAssetStorageLocal  = "local"
+	deckAssetStorageMemory = "memory"
+
+	// deckAssetLocalPath はローカルのファイルシステムに置いたリソースを配信するパス。
+	// S3互換ストレージのオブジェクトキーと同じ構成で配信する(例: /deck-assets/images/decks/<デッキコード>.jpg)。
+	deckAssetLocalPath = "/deck-assets"
+)
+
+// newDeckAssetStorage は環境変数からデッキのリソースの配置先と、ユーザのエクスポート・写真の配置先を作る。
+//
+// 未指定は本番と同じS3互換ストレージ。開発・CIでは MinIO 等を DECK_ASSET_S3_ENDPOINT で指すか、
+// local(DECK_ASSET_LOCAL_DIR に置いて r から配信する)・memory(再起動で消える)を使う。
+// エクスポートと写真はデッキのリソースと同じストレージに非公開で置く。local では配信するディレクトリの
+// 外(USER_EXPORT_LOCAL_DIR・ATTACHMENT_LOCAL_DIR、未指定なら DECK_ASSET_LOCAL_DIR と同じ階層の
+// user-exports・attachments)に置く。
+func newDeckAssetStorage(r *gin.Engine) (deckAssets infrastructure.DeckAssetStorage, userExports infrastructure.DeckAssetStorage, attachments infrastructure.DeckAssetStorage, err error) {
+	switch storage := os.Getenv("DECK_ASSET_STORAGE"); storage {
+	case "", deckAssetStorageS3:
+		if _, err := config.LoadDefaultConfig(context.Background()); err != nil {
+			return nil, nil, nil, err
+		}
+
+		endpoint := os.Getenv("DECK_ASSET_S3_ENDPOINT")
+		if endpoint == "" {
+			endpoint = infrastructure.DefaultDeckAssetS3Endpoint
+		}
+
+		bucket := os.Getenv("DECK_ASSET_S3_BUCKET")
+		if bucket == "" {
+			bucket = infrastructure.DefaultDeckAssetS3Bucket
+		}
+
+		usePathStyle := false
+		if v := os.Getenv("DECK_ASSET_S3_USE_PATH_STYLE"); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("DECK_ASSET_S3_USE_PATH_STYLE must be a boolean: %w", err)
+			}
+			usePathStyle = b
+		}
+
+		return infrastructure.NewS3DeckAssetStorage(endpoint, bucket, usePathStyle),
+			infrastructure.NewPrivateS3DeckAssetStorage(endpoint, bucket, usePathStyle),
+			infrastructure.NewPrivateS3DeckAssetStorage(endpoint, bucket, usePathStyle),
+			nil
+
+	case deckAssetStorageLocal:
+		dir := os.Getenv("DECK_ASSET_LOCAL_DIR")
+		if dir == "" {
+			return nil, nil, nil, errors.New("DECK_ASSET_LOCAL_DIR is not set")
+		}
+
+		exportDir := os.Getenv("USER_EXPORT_LOCAL_DIR")
+		if exportDir == "" {
+			exportDir = filepath.Join(filepath.Dir(filepath.Clean(dir)), "user-exports")
+		}
+
+		attachmentDir := os.Getenv("ATTACHMENT_LOCAL_DIR")
+		if attachmentDir == "" {
+			attachmentDir = filepath.Join(filepath.Dir(filepath.Clean(dir)), "attachments")
+		}
+
+		for _, d := range []string{dir, exportDir, attachmentDir} {
+			if err := os.MkdirAll(d, 0o755); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		r.Static(deckAssetLocalPath, dir)
+
+		return infrastructure.NewLocalDeckAssetStorage(dir),
+			infrastructure.NewLocalDeckAssetStorage(exportDir),
+			infrastructure.NewLocalDeckAssetStorage(attachmentDir),
+			nil
+
+	case deckAssetStorageMemory:
+		return infrastructure.NewInMemoryDeckAssetStorage(),
+			infrastructure.NewInMemoryDeckAssetStorage(),
+			infrastructure.NewInMemoryDeckAssetStorage(),
+			nil
+
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown DECK_ASSET_STORAGE: %q", storage)
+	}
+}
+
+// デッキのリソースの取得ジョブを処理するワーカーの設定。
+const (
+	// deckAssetWorkerInterval は実行できるジョブが無いときに次に見に行くまでの間隔。
+	deckAssetWorkerInterval = 5 * time.Second
+	// deckAssetWorkerBatchSize は1回に取り出すジョブの数。取り出した数がこれに満たなければ
+	// キューが空いたとみなして間隔を空け、満ちていれば続けて取り出す。
+	deckAssetWorkerBatchSize = 10
+)
+
+// runDeckAssetWorker は ctx が終わるまでデッキのリソースの取得ジョブを処理し続ける。
+//
+// 処理中に停止した場合、実行中のジョブは一定時間後に取り出し直される
+// (usecase.DeckAssetJob.Process 参照)ため、停止時に処理の完了は待たない。
+func runDeckAssetWorker(ctx context.Context, deckAssetJob usecase.DeckAssetJobInterface) {
+	ticker := time.NewTicker(deckAssetWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := deckAssetJob.Process(ctx, deckAssetWorkerBatchSize)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to process deck asset jobs", logging.Err(err))
+		}
+
+		if err == nil && n == deckAssetWorkerBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ユーザのエクスポートを処理するワーカーの設定。アーカイブの作成はユーザの全データを
+// 読むため、1回に1件ずつ処理する。
+const (
+	userExportWorkerInterval  = 10 * time.Second
+	userExportWorkerBatchSize = 1
+)
+
+// runUserExportWorker は ctx が終わるまでユーザのエクスポートを処理し続ける。
+// 停止時の扱いは runDeckAssetWorker と同じ(実行中のものは一定時間後に取り出し直される)。
+func runUserExportWorker(ctx context.Context, userExport usecase.UserExportInterface) {
+	ticker := time.NewTicker(userExportWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := userExport.Process(ctx, userExportWorkerBatchSize)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to process user exports", logging.Err(err))
+		}
+
+		if err == nil && n == userExportWorkerBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 type APIServer struct {
 	httpServer *http.Server
 	db         *gorm.DB
@@ -161,11 +319,6 @@ func main() {
 		os.Exit(ExitCodeNG)
 	}
 
-	if _, err := config.LoadDefaultConfig(context.Background()); err != nil {
-		slog.Error("failed to load default config", logging.Err(err))
-		os.Exit(ExitCodeNG)
-	}
-
 	dbHostname := os.Getenv("DB_HOSTNAME")
 	dbPort := os.Getenv("DB_PORT")
 	userName := os.Getenv("DB_USER_NAME")
@@ -184,7 +337,14 @@ func main() {
 	r.Use(
 		internal.RequestIDMiddleware(),
 		internal.AccessLogMiddleware(logger),
-		internal.BodySizeLimitMiddleware(internal.MaxRequestBodyBytes),
+		internal.BodySizeLimitMiddleware(
+			internal.MaxRequestBodyBytes,
+			// 写真の添付だけは写真1枚分まで受け付ける。
+			map[string]int64{
+				relativePath + controller.RecordsPath + "/:id" + controller.AttachmentsPath: controller.MaxAttachmentRequestBodyBytes,
+				relativePath + controller.MatchesPath + "/:id" + controller.AttachmentsPath: controller.MaxAttachmentRequestBodyBytes,
+			},
+		),
 		internal.RecoveryMiddleware(logger),
 	)
 
@@ -193,6 +353,12 @@ func main() {
 		AllowHeaders: []string{
 			"Authorization",
 			"Content-Type",
+			internal.IdempotencyKeyHeader,
+			helper.IfMatchHeader,
+		},
+		// 更新・削除の If-Match に使うため、ブラウザの JS から ETag を読めるようにする。
+		ExposeHeaders: []string{
+			helper.ETagHeader,
 		},
 		AllowMethods: []string{
 			"GET",
@@ -211,6 +377,33 @@ func main() {
 		MaxAge:           1 * time.Hour,
 	}))
 
+	// リトライによる二重作成を防ぐ作成系のエンドポイント。CORSのプリフライトを先に通すため、CORSの後に置く。
+	// 新たに作成系(POSTで行を作る)のエンドポイントを足したらここにも加える。
+	r.Use(internal.IdempotencyMiddleware(
+		logger,
+		infrastructure.NewIdempotencyKey(db),
+		relativePath+controller.RecordsPath,
+		relativePath+controller.RecordsPath+"/import",
+		relativePath+controller.RecordsPath+"/:id"+controller.TonamelMatchesPath,
+		relativePath+controller.RecordsPath+"/:id"+controller.AttachmentsPath,
+		relativePath+controller.MatchesPath,
+		relativePath+controller.MatchesPath+"/:id"+controller.AttachmentsPath,
+		relativePath+controller.DecksPath,
+		relativePath+controller.DeckCodesPath,
+		relativePath+controller.TagsPath,
+		relativePath+controller.UnofficialEventsPath,
+		relativePath+controller.UnofficialEventsPath+"/:id"+controller.TournamentPath,
+		relativePath+controller.UnofficialEventsPath+"/:id"+controller.TournamentPath+"/participants",
+		relativePath+controller.UnofficialEventsPath+"/:id"+controller.TournamentPath+"/rounds",
+		relativePath+controller.UnofficialEventsPath+"/:id"+controller.TournamentPath+"/top_cut",
+	))
+
+	deckAssetStorage, userExportStorage, attachmentStorage, err := newDeckAssetStorage(r)
+	if err != nil {
+		slog.Error("failed to set up deck asset storage", logging.Err(err))
+		os.Exit(ExitCodeNG)
+	}
+
 	badgeEvaluation := usecase.NewBadgeEvaluation(
 		infrastructure.NewBadgeDefinition(db),
 		infrastructure.NewUserBadge(db),
@@ -228,6 +421,22 @@ func main() {
 		infrastructure.NewUserPlayer(db),
 	)
 
+	// デッキコードの使用可否の判定は GET /deckcodes/:id/legality と、記録の作成・更新時の警告で共有する
+	deckLegality := usecase.NewDeckLegality(
+		infrastructure.NewDeckCode(db),
+		infrastructure.NewDeckCodeCard(db),
+		infrastructure.NewDeckAsset(logger, deckAssetStorage),
+		infrastructure.NewStandardRegulation(db),
+		infrastructure.NewBannedCard(db),
+	)
+
+	// デッキコードのリソース(結果HTML・画像)の取得ジョブ。登録時に積み、このプロセス内のワーカーが処理する。
+	deckAssetJob := usecase.NewDeckAssetJob(
+		infrastructure.NewDeckAssetJob(db),
+		infrastructure.NewDeckAsset(logger, deckAssetStorage),
+		infrastructure.NewDeckCodeCard(db),
+	)
+
 	environmentBadgeEvaluation := usecase.NewEnvironmentBadgeEvaluation(
 		infrastructure.NewEnvironment(db),
 		infrastructure.NewUserEnvironmentBadge(db),
@@ -235,6 +444,21 @@ func main() {
 		infrastructure.NewTransactionManager(db),
 	)
 
+	// 対戦結果の確認依頼は、承認後に対戦結果(Match)が直されたときにも状態を改める
+	matchConfirmation := usecase.NewMatchConfirmation(
+		infrastructure.NewMatchConfirmation(db),
+		infrastructure.NewMatch(db),
+		infrastructure.NewRecord(db, logger),
+		infrastructure.NewUser(db),
+		infrastructure.NewUserRelationship(db),
+		infrastructure.NewNotification(db),
+		badgeEvaluation,
+		designationEvaluation,
+		environmentBadgeEvaluation,
+		infrastructure.NewTransactionManager(db),
+		infrastructure.NewEntityRevision(db),
+	)
+
 	controller.NewUser(
 		logger,
 		r,
@@ -272,14 +496,29 @@ func main() {
 		),
 	).RegisterRoute(relativePath)
 
+	controller.NewUnofficialEventShare(
+		r,
+		infrastructure.NewUnofficialEvent(db),
+		usecase.NewUnofficialEventShare(
+			infrastructure.NewUnofficialEvent(db),
+			infrastructure.NewUnofficialEventSummary(db),
+			infrastructure.NewRecord(db, logger),
+			infrastructure.NewTransactionManager(db),
+			infrastructure.NewEntityRevision(db),
+		),
+	).RegisterRoute(relativePath)
+
 	controller.NewDeck(
 		logger,
 		r,
 		infrastructure.NewDeck(db),
 		infrastructure.NewRecord(db, logger),
+		infrastructure.NewUserRelationship(db),
 		usecase.NewDeck(
 			infrastructure.NewDeck(db),
-			infrastructure.NewDeckAsset(logger),
+			infrastructure.NewDeckAssetJob(db),
+			infrastructure.NewDeckCodeCard(db),
+			infrastructure.NewCard(db),
 			infrastructure.NewUserFavoriteDeck(db),
 			infrastructure.NewTag(db),
 			infrastructure.NewTransactionManager(db),
@@ -294,10 +533,17 @@ func main() {
 		infrastructure.NewRecord(db, logger),
 		usecase.NewDeckCode(
 			infrastructure.NewDeckCode(db),
-			infrastructure.NewDeckAsset(logger),
+			infrastructure.NewDeckAsset(logger, deckAssetStorage),
+			infrastructure.NewDeckAssetJob(db),
+			infrastructure.NewDeckCodeCard(db),
+			infrastructure.NewDeckCodeStat(db),
+			infrastructure.NewCard(db),
 			infrastructure.NewTag(db),
+			infrastructure.NewTransactionManager(db),
 			badgeEvaluation,
 		),
+		deckLegality,
+		deckAssetJob,
 	).RegisterRoute(relativePath)
 
 	controller.NewTag(
@@ -324,6 +570,8 @@ func main() {
 	controller.NewRecord(
 		r,
 		infrastructure.NewRecord(db, logger),
+		infrastructure.NewUserRelationship(db),
+		infrastructure.NewTrash(db),
 		usecase.NewRecord(
 			logger,
 			infrastructure.NewRecord(db, logger),
@@ -331,6 +579,25 @@ func main() {
 			designationEvaluation,
 			infrastructure.NewTonamelEvent(logger),
 			infrastructure.NewTonamelEventStore(db),
+			infrastructure.NewMatch(db),
+			infrastructure.NewTag(db),
+			infrastructure.NewTransactionManager(db),
+			environmentBadgeEvaluation,
+			infrastructure.NewEntityRevision(db),
+			infrastructure.NewUnofficialEvent(db),
+		),
+		deckLegality,
+		usecase.NewRecordImport(
+			logger,
+			infrastructure.NewRecord(db, logger),
+			infrastructure.NewMatch(db),
+			infrastructure.NewTransactionManager(db),
+			badgeEvaluation,
+			designationEvaluation,
+			environmentBadgeEvaluation,
+			infrastructure.NewTonamelEvent(logger),
+			infrastructure.NewTonamelEventStore(db),
+			infrastructure.NewUnofficialEvent(db),
 		),
 	).RegisterRoute(relativePath)
 
@@ -338,6 +605,7 @@ func main() {
 		r,
 		infrastructure.NewMatch(db),
 		infrastructure.NewRecord(db, logger),
+		infrastructure.NewTrash(db),
 		usecase.NewMatch(
 			infrastructure.NewMatch(db),
 			infrastructure.NewRecord(db, logger),
@@ -345,6 +613,66 @@ func main() {
 			badgeEvaluation,
 			designationEvaluation,
 			environmentBadgeEvaluation,
+			infrastructure.NewTransactionManager(db),
+			infrastructure.NewEntityRevision(db),
+			matchConfirmation,
+		),
+	).RegisterRoute(relativePath)
+
+	controller.NewUserRelationship(
+		r,
+		usecase.NewUserRelationship(
+			infrastructure.NewUserRelationship(db),
+			infrastructure.NewUser(db),
+			infrastructure.NewNotification(db),
+			infrastructure.NewTransactionManager(db),
+		),
+	).RegisterRoute(relativePath)
+
+	controller.NewMatchConfirmation(
+		r,
+		infrastructure.NewMatch(db),
+		matchConfirmation,
+	).RegisterRoute(relativePath)
+
+	controller.NewTonamelMatchImport(
+		r,
+		infrastructure.NewRecord(db, logger),
+		usecase.NewTonamelMatchImport(
+			infrastructure.NewRecord(db, logger),
+			infrastructure.NewMatch(db),
+			infrastructure.NewTonamelBracket(logger),
+			badgeEvaluation,
+			designationEvaluation,
+			environmentBadgeEvaluation,
+			infrastructure.NewTransactionManager(db),
+		),
+	).RegisterRoute(relativePath)
+
+	controller.NewUnofficialEventTournament(
+		r,
+		infrastructure.NewUnofficialEvent(db),
+		usecase.NewUnofficialEventTournament(
+			infrastructure.NewUnofficialEventTournament(db),
+			infrastructure.NewUnofficialEvent(db),
+			infrastructure.NewUser(db),
+			infrastructure.NewRecord(db, logger),
+			infrastructure.NewMatch(db),
+			usecase.NewMatch(
+				infrastructure.NewMatch(db),
+				infrastructure.NewRecord(db, logger),
+				infrastructure.NewTag(db),
+				badgeEvaluation,
+				designationEvaluation,
+				environmentBadgeEvaluation,
+				infrastructure.NewTransactionManager(db),
+				infrastructure.NewEntityRevision(db),
+				matchConfirmation,
+			),
+			badgeEvaluation,
+			designationEvaluation,
+			environmentBadgeEvaluation,
+			infrastructure.NewTransactionManager(db),
 		),
 	).RegisterRoute(relativePath)
 
@@ -449,6 +777,19 @@ func main() {
 		),
 	).RegisterRoute(relativePath)
 
+	controller.NewCardStat(
+		r,
+		usecase.NewCardStat(
+			infrastructure.NewCardStat(db),
+			infrastructure.NewDeckCode(db),
+			infrastructure.NewDeckCodeCard(db),
+			infrastructure.NewDeckAsset(logger, deckAssetStorage),
+			infrastructure.NewEnvironment(db),
+			infrastructure.NewStandardRegulation(db),
+			infrastructure.NewChampionshipSeries(db),
+		),
+	).RegisterRoute(relativePath)
+
 	controller.NewDeckUsageStat(
 		r,
 		usecase.NewDeckUsageStat(
@@ -468,6 +809,7 @@ func main() {
 
 	controller.NewOpponentDeckUsageStat(
 		r,
+		infrastructure.NewUserRelationship(db),
 		usecase.NewOpponentDeckUsageStat(
 			infrastructure.NewOpponentDeckUsageStat(db),
 			infrastructure.NewEnvironment(db),
@@ -494,6 +836,56 @@ func main() {
 		),
 	).RegisterRoute(relativePath)
 
+	// ユーザの全データのエクスポート(テイクアウト)。アーカイブはワーカーが非同期に作り、
+	// デッキのリソースと同じストレージへ非公開で置く。
+	userExport := usecase.NewUserExport(
+		infrastructure.NewUserExport(db),
+		infrastructure.NewUserExportArchive(userExportStorage),
+		infrastructure.NewCalendar(db),
+		infrastructure.NewTag(db),
+		infrastructure.NewUnofficialEvent(db),
+		infrastructure.NewUserBadge(db),
+		infrastructure.NewUserEnvironmentBadge(db),
+		infrastructure.NewNotification(db),
+	)
+
+	controller.NewUserExport(
+		r,
+		userExport,
+	).RegisterRoute(relativePath)
+
+	// 記録・対戦結果・対局のメモの検索(本人のみ)。
+	controller.NewMemoSearch(
+		r,
+		usecase.NewMemoSearch(
+			infrastructure.NewMemoSearch(db),
+		),
+	).RegisterRoute(relativePath)
+
+	// 記録・対戦結果に添付する写真(組み合わせ表・スコアシート等)。デッキのリソースと同じ
+	// ストレージへ非公開で置き、APIを通して配信する。
+	controller.NewAttachment(
+		r,
+		usecase.NewAttachment(
+			infrastructure.NewAttachment(db),
+			infrastructure.NewAttachmentStorage(attachmentStorage),
+		),
+		infrastructure.NewRecord(db, logger),
+		infrastructure.NewMatch(db),
+	).RegisterRoute(relativePath)
+
+	// 削除した記録・対戦結果・デッキ・デッキコードのゴミ箱。保持期間を過ぎたものは
+	// cmd/purge-trash が添付した写真ごと物理削除する。
+	controller.NewTrash(
+		r,
+		usecase.NewTrash(
+			infrastructure.NewTrash(db),
+			badgeEvaluation,
+			infrastructure.NewAttachment(db),
+			infrastructure.NewAttachmentStorage(attachmentStorage),
+		),
+	).RegisterRoute(relativePath)
+
 	// プラットフォーム全体の週次デッキ使用率（公開・非会員閲覧可）。
 	controller.NewWeeklyDeckUsageStat(
 		r,
@@ -510,6 +902,9 @@ func main() {
 		)
 		defer stop()
 
+		go runDeckAssetWorker(ctx, deckAssetJob)
+		go runUserExportWorker(ctx, userExport)
+
 		server := NewAPIServer(":8914", r, db)
 
 		if err := server.Start(ctx); err != nil {
@@ -0,0 +1,63 @@
+// purge-idempotency-keys は、保持期間(entity.IdempotencyKeyTTL、24時間)を過ぎた
+// Idempotency-Key(idempotency_keys)を物理削除する定期バッチ。
+//
+// 期限切れのキーはAPIサーバ側で新しいリクエストとして扱う(同じキーなら上書きする)ため、
+// 行を残しておく理由が無い。何度実行しても期限切れのものを消すだけのため、cronの多重起動でも安全。
+//
+// 想定運用: OSのcronから毎日深夜に起動する。
+//
+// 使い方:
+//
+//	go run ./cmd/purge-idempotency-keys
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/postgres"
+)
+
+const (
+	ExitCodeOK = iota
+	ExitCodeNG
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Printf("failed to load .env file: %v", err)
+	}
+
+	db, err := postgres.NewDB(
+		os.Getenv("DB_HOSTNAME"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER_NAME"),
+		os.Getenv("DB_USER_PASSWORD"),
+		os.Getenv("DB_NAME"),
+	)
+	if err != nil {
+		log.Printf("failed to connect database: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	log.Printf("purging idempotency keys created more than %s ago\n", entity.IdempotencyKeyTTL)
+
+	n, err := infrastructure.NewIdempotencyKey(db).DeleteExpired(
+		context.Background(),
+		entity.IdempotencyKeyExpiredBefore(time.Now().Local()),
+	)
+	if err != nil {
+		log.Printf("failed to purge idempotency keys: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	log.Printf("completed: idempotency_keys=%d\n", n)
+
+	os.Exit(ExitCodeOK)
+}
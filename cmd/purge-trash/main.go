@@ -0,0 +1,162 @@
+// purge-trash は、ゴミ箱の保持期間(entity.TrashRetention、30日)を過ぎた記録・対戦結果・対局・
+// 自由形式イベント・デッキ・デッキコードを物理削除する定期バッチ。
+//
+// API の削除は論理削除(deleted_at)で、保持期間内は GET /users/:id/trash から復元できる。
+// 期間を過ぎたものは一覧にも出さず復元もできないため、行を残しておく理由が無い。
+// 中間テーブル(タグ・ポケモンのスプライト・カード構成・お気に入り等)とリソース取得ジョブは、
+// 外部キーで参照する側から順に1トランザクションで消す。
+// 記録・対戦結果に添付した写真は、行を消した後にストレージ(core-apiserver と同じ
+// DECK_ASSET_STORAGE 等の環境変数で選ぶ)からも消す。ストレージから消せなかった写真は
+// 警告を出して残す(行は消えているため、誰からも参照されない)。
+// 何度実行しても保持期間を過ぎたものを消すだけのため、cronの多重起動でも安全。
+//
+// 想定運用: OSのcronから毎日深夜に起動する。
+//
+// 使い方:
+//
+//	# 削除せず対象の件数だけ確認する(デフォルト)
+//	go run ./cmd/purge-trash
+//
+//	# 実際に物理削除する
+//	go run ./cmd/purge-trash -dry-run=false
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/joho/godotenv"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/postgres"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	ExitCodeOK = iota
+	ExitCodeNG
+)
+
+// newAttachmentStorage は core-apiserver と同じ環境変数から、写真の配置先を作る。
+// memory はプロセスごとに別物のため、消す写真が無い空のストレージになる。
+func newAttachmentStorage() (infrastructure.DeckAssetStorage, error) {
+	switch storage := os.Getenv("DECK_ASSET_STORAGE"); storage {
+	case "", "s3":
+		if _, err := config.LoadDefaultConfig(context.Background()); err != nil {
+			return nil, err
+		}
+
+		endpoint := os.Getenv("DECK_ASSET_S3_ENDPOINT")
+		if endpoint == "" {
+			endpoint = infrastructure.DefaultDeckAssetS3Endpoint
+		}
+
+		bucket := os.Getenv("DECK_ASSET_S3_BUCKET")
+		if bucket == "" {
+			bucket = infrastructure.DefaultDeckAssetS3Bucket
+		}
+
+		usePathStyle := false
+		if v := os.Getenv("DECK_ASSET_S3_USE_PATH_STYLE"); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("DECK_ASSET_S3_USE_PATH_STYLE must be a boolean: %w", err)
+			}
+			usePathStyle = b
+		}
+
+		return infrastructure.NewPrivateS3DeckAssetStorage(endpoint, bucket, usePathStyle), nil
+
+	case "local":
+		dir := os.Getenv("ATTACHMENT_LOCAL_DIR")
+		if dir == "" {
+			deckAssetDir := os.Getenv("DECK_ASSET_LOCAL_DIR")
+			if deckAssetDir == "" {
+				return nil, errors.New("DECK_ASSET_LOCAL_DIR is not set")
+			}
+			dir = filepath.Join(filepath.Dir(filepath.Clean(deckAssetDir)), "attachments")
+		}
+
+		return infrastructure.NewLocalDeckAssetStorage(dir), nil
+
+	case "memory":
+		return infrastructure.NewInMemoryDeckAssetStorage(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown DECK_ASSET_STORAGE: %q", storage)
+	}
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", true, "true の場合、削除は行わず対象の件数の確認のみ行う")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("failed to load .env file: %v", err)
+	}
+
+	db, err := postgres.NewDB(
+		os.Getenv("DB_HOSTNAME"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER_NAME"),
+		os.Getenv("DB_USER_PASSWORD"),
+		os.Getenv("DB_NAME"),
+	)
+	if err != nil {
+		log.Printf("failed to connect database: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	attachmentStorage, err := newAttachmentStorage()
+	if err != nil {
+		log.Printf("failed to set up attachment storage: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	// 物理削除では復元しないため、ストリークの作り直し(バッジ判定)は使わない。
+	trash := usecase.NewTrash(
+		infrastructure.NewTrash(db),
+		nil,
+		infrastructure.NewAttachment(db),
+		infrastructure.NewAttachmentStorage(attachmentStorage),
+	)
+
+	if *dryRun {
+		log.Printf("[dry-run] counting rows deleted more than %s ago (削除は行いません)\n", entity.TrashRetention)
+	} else {
+		log.Printf("purging rows deleted more than %s ago\n", entity.TrashRetention)
+	}
+
+	result, err := trash.Purge(context.Background(), *dryRun)
+	if err != nil {
+		log.Printf("failed to purge trash: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	prefix := ""
+	if *dryRun {
+		prefix = "[dry-run] "
+	}
+	log.Printf(
+		"%scompleted: records=%d matches=%d games=%d unofficial_events=%d decks=%d deck_codes=%d attachments=%d (total=%d)\n",
+		prefix,
+		result.Records,
+		result.Matches,
+		result.Games,
+		result.UnofficialEvents,
+		result.Decks,
+		result.DeckCodes,
+		result.Attachments,
+		result.Total(),
+	)
+
+	os.Exit(ExitCodeOK)
+}
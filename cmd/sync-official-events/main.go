@@ -0,0 +1,653 @@
+// sync-official-events は公式サイト(プレイヤーズクラブ)のイベント検索API から、
+// 指定した開催日の範囲の公式イベントを取得し、shops / official_events テーブルへ保存するバッチ。
+//
+// shops / official_events はこのリポジトリの外で投入されており、ステージングやローカルの
+// DB は空か古いままになりがち。このバッチで必要な期間ぶんを取り込めるようにする。
+// 取得先は -base-url (未指定なら環境変数 OFFICIAL_EVENT_SEARCH_BASE_URL、それも無ければ
+// 公式サイト)で差し替えられるので、ステージングではミラーやモックサーバを指定できる。
+//
+// 冪等性: 店舗は shops.id、イベントは official_events.id(=event_holding_id)を基準に
+// 上書き(upsert)する。何度実行しても重複しない。shops.id=0(株式会社ポケモン)は
+// schema.sql で投入する固定の行なので上書きしない。
+// 必須項目が欠けたイベントや、列の長さを超える項目のあるイベントはスキップしてログに残す。
+// 店舗の情報が不正(列の長さを超える場合を含む)なイベントは、店舗を紐付けずに
+// (shop_id を NULL にして)保存する。
+//
+// 実行結果(取得件数・保存件数・成否)は official_event_sync_logs に1行残す。
+// -dry-run では取得と変換のみ行い、DBには接続しない。
+//
+// 使い方:
+//
+//	# 取得・変換の結果を確認するだけ(デフォルト、書き込みなし。既定は7日前〜60日後)
+//	go run ./cmd/sync-official-events
+//
+//	# 実際に shops / official_events へ保存する
+//	go run ./cmd/sync-official-events -dry-run=false
+//
+//	# 期間と取得先を指定する
+//	go run ./cmd/sync-official-events -from=2026-01-01 -to=2026-03-31 -base-url=http://localhost:8081 -dry-run=false
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/joho/godotenv"
+	ulid "github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/httpclient"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/postgres"
+)
+
+const (
+	ExitCodeOK = iota
+	ExitCodeNG
+)
+
+const defaultBaseURL = "https://players.pokemon-card.com"
+
+// eventSearchPath はイベント検索API のパス。-base-url の後ろに付ける。
+const eventSearchPath = "/event_search"
+
+// dateLayout は -from / -to とイベント検索API の開催日の書式。
+const dateLayout = "2006-01-02"
+
+// clockLayout はイベント検索API の開始・終了時刻の書式。開催日と組み合わせて日時にする。
+const clockLayout = "15:04"
+
+// pageSize は1リクエストで取得するイベント数。
+const pageSize = 100
+
+// maxPages は1回の実行で取得するページ数の上限。eventCount が不正でも無限に取り続けないための保険。
+const maxPages = 500
+
+// maxRangeDays は -from から -to までの最大日数。
+const maxRangeDays = 366
+
+// pokemonShopId は schema.sql で投入する「株式会社ポケモン」の shops.id。上書きしない。
+const pokemonShopId = 0
+
+// fetchInterval は公式サイトへの取得間隔。外部サイトへ一気に投げないよう1ページごとに待つ。
+// テストでは待たないよう差し替える。
+var fetchInterval = 500 * time.Millisecond
+
+const (
+	syncStatusSucceeded = "succeeded"
+	syncStatusFailed    = "failed"
+)
+
+type eventSearchResponse struct {
+	Code       int          `json:"code"`
+	EventCount int          `json:"eventCount"`
+	Event      []eventEntry `json:"event"`
+}
+
+// eventEntry はイベント検索API の1イベント。任意項目は省略・null のことがある。
+type eventEntry struct {
+	EventHoldingId  int        `json:"event_holding_id"`
+	EventTitle      string     `json:"event_title"`
+	Address         string     `json:"address"`
+	Venue           string     `json:"venue"`
+	EventDate       string     `json:"event_date"`
+	EventStartedAt  string     `json:"event_started_at"`
+	EventEndedAt    string     `json:"event_ended_at"`
+	DeckCount       string     `json:"deck_count"`
+	EventType       *int       `json:"event_type"`
+	EventTypeName   string     `json:"event_type_name"`
+	CSPFlg          *bool      `json:"csp_flg"`
+	LeagueId        *int       `json:"league_id"`
+	LeagueTitle     string     `json:"league_title"`
+	RegulationId    *int       `json:"regulation_id"`
+	RegulationTitle string     `json:"regulation_title"`
+	Capacity        *int       `json:"capacity"`
+	EventAttrId     *int       `json:"event_attr_id"`
+	Shop            *shopEntry `json:"shop"`
+}
+
+type shopEntry struct {
+	ShopId        int    `json:"shop_id"`
+	ShopName      string `json:"shop_name"`
+	ShopTerm      int    `json:"shop_term"`
+	ZipCode       string `json:"zip_code"`
+	PrefectureId  int    `json:"prefecture_id"`
+	Address       string `json:"address"`
+	Tel           string `json:"tel"`
+	Access        string `json:"access"`
+	BusinessHours string `json:"business_hours"`
+	URL           string `json:"url"`
+	GeoCoding     string `json:"geo_coding"`
+}
+
+type shopRow struct {
+	ID            int `gorm:"primaryKey;autoIncrement:false"`
+	Name          string
+	Term          int
+	ZipCode       *string
+	PrefectureId  int
+	Address       *string
+	Tel           *string
+	Access        *string
+	BusinessHours *string
+	URL           *string `gorm:"column:url"`
+	GeoCoding     *string
+}
+
+func (shopRow) TableName() string {
+	return "shops"
+}
+
+type officialEventRow struct {
+	ID              int `gorm:"primaryKey;autoIncrement:false"`
+	Title           string
+	Address         string
+	Venue           *string
+	Date            time.Time
+	StartedAt       *time.Time
+	EndedAt         *time.Time
+	DeckCount       *string
+	TypeId          *int
+	TypeName        *string
+	CSPFlg          *bool `gorm:"column:csp_flg"`
+	LeagueId        *int
+	LeagueTitle     *string
+	RegulationId    *int
+	RegulationTitle *string
+	Capacity        *int
+	AttrId          *int
+	ShopId          *int
+	ShopName        *string
+}
+
+func (officialEventRow) TableName() string {
+	return "official_events"
+}
+
+type syncLogRow struct {
+	ID                 string
+	StartedAt          time.Time
+	FinishedAt         time.Time
+	FromDate           time.Time
+	ToDate             time.Time
+	FetchedCount       int
+	SkippedCount       int
+	UpsertedShopCount  int
+	UpsertedEventCount int
+	Status             string
+	ErrorMessage       string
+}
+
+func (syncLogRow) TableName() string {
+	return "official_event_sync_logs"
+}
+
+var entropy = ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0)
+
+func generateId() (string, error) {
+	ms := ulid.Timestamp(time.Now())
+	id, err := ulid.New(ms, entropy)
+
+	return id.String(), err
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", true, "true の場合、書き込みは行わず取得・変換の結果の確認のみ行う")
+	baseURL := flag.String("base-url", "", "イベント検索API の取得先(未指定なら OFFICIAL_EVENT_SEARCH_BASE_URL、それも無ければ公式サイト)")
+	from := flag.String("from", "", "取得する開催日の開始(YYYY-MM-DD、未指定なら7日前)")
+	to := flag.String("to", "", "取得する開催日の終了(YYYY-MM-DD、未指定なら60日後)")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("failed to load .env file: %v", err)
+	}
+
+	if *baseURL == "" {
+		*baseURL = os.Getenv("OFFICIAL_EVENT_SEARCH_BASE_URL")
+	}
+	if *baseURL == "" {
+		*baseURL = defaultBaseURL
+	}
+
+	fromDate, toDate, err := parseDateRange(*from, *to, time.Now().Local())
+	if err != nil {
+		log.Printf("invalid date range: %v\n", err)
+		os.Exit(ExitCodeNG)
+	}
+
+	var db *gorm.DB
+	if !*dryRun {
+		db, err = postgres.NewDB(
+			os.Getenv("DB_HOSTNAME"),
+			os.Getenv("DB_PORT"),
+			os.Getenv("DB_USER_NAME"),
+			os.Getenv("DB_USER_PASSWORD"),
+			os.Getenv("DB_NAME"),
+		)
+		if err != nil {
+			log.Printf("failed to connect database: %v\n", err)
+			os.Exit(ExitCodeNG)
+		}
+	}
+
+	startedAt := time.Now().Local()
+	syncLog := &syncLogRow{
+		StartedAt: startedAt,
+		FromDate:  fromDate,
+		ToDate:    toDate,
+		Status:    syncStatusSucceeded,
+	}
+
+	log.Printf("fetching official events from %s (%s - %s)\n", *baseURL, fromDate.Format(dateLayout), toDate.Format(dateLayout))
+
+	entries, err := fetchEvents(*baseURL, fromDate, toDate)
+	if err != nil {
+		log.Printf("failed to fetch official events: %v\n", err)
+		if !*dryRun {
+			saveSyncLog(db, syncLog, err)
+		}
+		os.Exit(ExitCodeNG)
+	}
+
+	shops, events, skipped := convertEvents(entries)
+	syncLog.FetchedCount = len(entries)
+	syncLog.SkippedCount = len(skipped)
+	for _, reason := range skipped {
+		log.Printf("skipped: %s\n", reason)
+	}
+
+	log.Printf("fetched %d events (shops: %d, events: %d, skipped: %d)\n", len(entries), len(shops), len(events), len(skipped))
+
+	if *dryRun {
+		log.Println("dry-run: no changes were written")
+		os.Exit(ExitCodeOK)
+	}
+
+	if err := upsertEvents(db, shops, events); err != nil {
+		log.Printf("failed to save official events: %v\n", err)
+		saveSyncLog(db, syncLog, err)
+		os.Exit(ExitCodeNG)
+	}
+
+	syncLog.UpsertedShopCount = len(shops)
+	syncLog.UpsertedEventCount = len(events)
+	saveSyncLog(db, syncLog, nil)
+
+	log.Printf("saved %d shops and %d events\n", len(shops), len(events))
+	os.Exit(ExitCodeOK)
+}
+
+// parseDateRange は -from / -to を開催日の範囲にする。省略した側は now を基準に補う。
+func parseDateRange(from string, to string, now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	fromDate := today.AddDate(0, 0, -7)
+	if from != "" {
+		d, err := time.ParseInLocation(dateLayout, from, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid -from: %w", err)
+		}
+		fromDate = d
+	}
+
+	toDate := today.AddDate(0, 0, 60)
+	if to != "" {
+		d, err := time.ParseInLocation(dateLayout, to, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid -to: %w", err)
+		}
+		toDate = d
+	}
+
+	if toDate.Before(fromDate) {
+		return time.Time{}, time.Time{}, errors.New("-to must not be before -from")
+	}
+
+	if toDate.Sub(fromDate) > maxRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range must be within %d days", maxRangeDays)
+	}
+
+	return fromDate, toDate, nil
+}
+
+// eventSearchURL はイベント検索API の1ページぶんのURLを返す。
+func eventSearchURL(baseURL string, fromDate time.Time, toDate time.Time, offset int) string {
+	q := url.Values{}
+	q.Set("start_date", fromDate.Format(dateLayout))
+	q.Set("end_date", toDate.Format(dateLayout))
+	q.Set("offset", strconv.Itoa(offset))
+	q.Set("limit", strconv.Itoa(pageSize))
+
+	return strings.TrimRight(baseURL, "/") + eventSearchPath + "?" + q.Encode()
+}
+
+// fetchEvents はイベント検索API から、期間内の全イベントをページを辿って取得する。
+func fetchEvents(baseURL string, fromDate time.Time, toDate time.Time) ([]eventEntry, error) {
+	var entries []eventEntry
+
+	for page := 0; page < maxPages; page++ {
+		if page > 0 {
+			time.Sleep(fetchInterval)
+		}
+
+		res, err := fetchEventPage(eventSearchURL(baseURL, fromDate, toDate, len(entries)))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, res.Event...)
+
+		if len(res.Event) == 0 || len(entries) >= res.EventCount {
+			return entries, nil
+		}
+	}
+
+	return nil, fmt.Errorf("too many pages: fetched %d events in %d pages", len(entries), maxPages)
+}
+
+func fetchEventPage(url string) (*eventSearchResponse, error) {
+	resp, err := httpclient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	return parseEventSearchResponse(resp.Body)
+}
+
+// parseEventSearchResponse はイベント検索API のレスポンスボディを読み取る。
+func parseEventSearchResponse(r io.Reader) (*eventSearchResponse, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res eventSearchResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Code != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response code: %d", res.Code)
+	}
+
+	return &res, nil
+}
+
+// convertEvents はイベント検索API のイベントを保存する行に変換する。
+// 店舗は shops.id ごとに1行にまとめ、後に出てきた情報を優先する。
+// 保存できないイベントはスキップし、その理由を skipped に入れる。
+func convertEvents(entries []eventEntry) ([]*shopRow, []*officialEventRow, []string) {
+	var (
+		shops   []*shopRow
+		events  []*officialEventRow
+		skipped []string
+	)
+
+	shopIndex := map[int]int{}
+	eventIndex := map[int]int{}
+
+	for _, e := range entries {
+		event, err := newOfficialEventRow(e)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("event_holding_id=%d: %v", e.EventHoldingId, err))
+			continue
+		}
+
+		if e.Shop != nil {
+			shop, err := newShopRow(e.Shop)
+			if err != nil {
+				// 店舗が登録できなければ外部キーを満たせないので、店舗名だけ残して紐付けない
+				log.Printf("event_holding_id=%d: shop is not linked: %v\n", e.EventHoldingId, err)
+				event.ShopId = nil
+			} else if shop.ID != pokemonShopId {
+				if i, ok := shopIndex[shop.ID]; ok {
+					shops[i] = shop
+				} else {
+					shopIndex[shop.ID] = len(shops)
+					shops = append(shops, shop)
+				}
+			}
+		}
+
+		// ページ送りの間にイベントが増減すると同じイベントが2度返ることがある
+		if i, ok := eventIndex[event.ID]; ok {
+			events[i] = event
+		} else {
+			eventIndex[event.ID] = len(events)
+			events = append(events, event)
+		}
+	}
+
+	return shops, events, skipped
+}
+
+func newOfficialEventRow(e eventEntry) (*officialEventRow, error) {
+	if e.EventHoldingId <= 0 {
+		return nil, errors.New("event_holding_id is missing")
+	}
+
+	title := strings.TrimSpace(e.EventTitle)
+	if title == "" {
+		return nil, errors.New("event_title is missing")
+	}
+
+	date, err := time.ParseInLocation(dateLayout, e.EventDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_date: %w", err)
+	}
+
+	startedAt, err := parseClock(date, e.EventStartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_started_at: %w", err)
+	}
+
+	endedAt, err := parseClock(date, e.EventEndedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event_ended_at: %w", err)
+	}
+
+	// 1件でも列の長さを超えるとバッチ全体の INSERT が失敗するため、欠けたイベントと同じくスキップする
+	if err := checkLengths(
+		stringColumn{"event_title", title, 255},
+		stringColumn{"address", e.Address, 255},
+		stringColumn{"venue", e.Venue, 255},
+		stringColumn{"deck_count", e.DeckCount, 255},
+		stringColumn{"event_type_name", e.EventTypeName, 255},
+		stringColumn{"league_title", e.LeagueTitle, 255},
+		stringColumn{"regulation_title", e.RegulationTitle, 255},
+	); err != nil {
+		return nil, err
+	}
+
+	row := &officialEventRow{
+		ID:              e.EventHoldingId,
+		Title:           title,
+		Address:         strings.TrimSpace(e.Address),
+		Venue:           nullableString(e.Venue),
+		Date:            date,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		DeckCount:       nullableString(e.DeckCount),
+		TypeId:          e.EventType,
+		TypeName:        nullableString(e.EventTypeName),
+		CSPFlg:          e.CSPFlg,
+		LeagueId:        e.LeagueId,
+		LeagueTitle:     nullableString(e.LeagueTitle),
+		RegulationId:    e.RegulationId,
+		RegulationTitle: nullableString(e.RegulationTitle),
+		Capacity:        e.Capacity,
+		AttrId:          e.EventAttrId,
+	}
+
+	if e.Shop != nil {
+		shopId := e.Shop.ShopId
+		row.ShopId = &shopId
+		// 店舗名はイベントの表示用に残すだけなので、長すぎるときはイベントごと捨てずに NULL にする
+		if checkLengths(stringColumn{"shop_name", e.Shop.ShopName, 255}) == nil {
+			row.ShopName = nullableString(e.Shop.ShopName)
+		}
+	}
+
+	return row, nil
+}
+
+func newShopRow(s *shopEntry) (*shopRow, error) {
+	if s.ShopId < 0 {
+		return nil, fmt.Errorf("invalid shop_id: %d", s.ShopId)
+	}
+
+	name := strings.TrimSpace(s.ShopName)
+	if name == "" {
+		return nil, errors.New("shop_name is missing")
+	}
+
+	// prefectures は 0(未設定)と 1〜47 のみ
+	if s.PrefectureId < 0 || s.PrefectureId > entity.MaxPrefectureId {
+		return nil, fmt.Errorf("invalid prefecture_id: %d", s.PrefectureId)
+	}
+
+	if err := checkLengths(
+		stringColumn{"shop_name", name, 255},
+		stringColumn{"zip_code", s.ZipCode, 8},
+		stringColumn{"address", s.Address, 255},
+		stringColumn{"tel", s.Tel, 32},
+		stringColumn{"business_hours", s.BusinessHours, 255},
+		stringColumn{"url", s.URL, 255},
+		stringColumn{"geo_coding", s.GeoCoding, 63},
+	); err != nil {
+		return nil, err
+	}
+
+	return &shopRow{
+		ID:            s.ShopId,
+		Name:          name,
+		Term:          s.ShopTerm,
+		ZipCode:       nullableString(s.ZipCode),
+		PrefectureId:  s.PrefectureId,
+		Address:       nullableString(s.Address),
+		Tel:           nullableString(s.Tel),
+		Access:        nullableString(s.Access),
+		BusinessHours: nullableString(s.BusinessHours),
+		URL:           nullableString(s.URL),
+		GeoCoding:     nullableString(s.GeoCoding),
+	}, nil
+}
+
+// parseClock は開催日と "HH:MM" の時刻から日時を作る。時刻が空なら nil を返す。
+func parseClock(date time.Time, clock string) (*time.Time, error) {
+	clock = strings.TrimSpace(clock)
+	if clock == "" {
+		return nil, nil
+	}
+
+	t, err := time.ParseInLocation(clockLayout, clock, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, time.Local)
+
+	return &dt, nil
+}
+
+// stringColumn は保存する文字列と db/schema.sql の VARCHAR の長さ。
+type stringColumn struct {
+	name  string
+	value string
+	max   int
+}
+
+// checkLengths は前後の空白を除いた値が列の長さ(文字数)を超えていればエラーを返す。
+func checkLengths(columns ...stringColumn) error {
+	for _, c := range columns {
+		if n := utf8.RuneCountInString(strings.TrimSpace(c.value)); n > c.max {
+			return fmt.Errorf("%s is too long: %d > %d", c.name, n, c.max)
+		}
+	}
+
+	return nil
+}
+
+// nullableString は前後の空白を除いた文字列を返す。空なら NULL として保存するため nil を返す。
+func nullableString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// upsertEvents は店舗、イベントの順に1トランザクションで upsert する。
+// official_events.shop_id が shops を参照するため、店舗を先に保存する。
+func upsertEvents(db *gorm.DB, shops []*shopRow, events []*officialEventRow) error {
+	const batchSize = 200
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if len(shops) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"name", "term", "zip_code", "prefecture_id", "address",
+					"tel", "access", "business_hours", "url", "geo_coding",
+				}),
+			}).CreateInBatches(shops, batchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(events) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"title", "address", "venue", "date", "started_at", "ended_at",
+					"deck_count", "type_id", "type_name", "csp_flg", "league_id", "league_title",
+					"regulation_id", "regulation_title", "capacity", "attr_id", "shop_id", "shop_name",
+				}),
+			}).CreateInBatches(events, batchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// saveSyncLog は実行結果を official_event_sync_logs に残す。
+// 記録に失敗しても同期自体の成否は変えず、ログに出すだけにする。
+func saveSyncLog(db *gorm.DB, syncLog *syncLogRow, syncErr error) {
+	id, err := generateId()
+	if err != nil {
+		log.Printf("failed to generate sync log id: %v\n", err)
+		return
+	}
+
+	syncLog.ID = id
+	syncLog.FinishedAt = time.Now().Local()
+	if syncErr != nil {
+		syncLog.Status = syncStatusFailed
+		syncLog.ErrorMessage = syncErr.Error()
+	}
+
+	if err := db.Create(syncLog).Error; err != nil {
+		log.Printf("failed to save sync log: %v\n", err)
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func loadFixture(t *testing.T, name string) []eventEntry {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	res, err := parseEventSearchResponse(f)
+	require.NoError(t, err)
+
+	return res.Event
+}
+
+func TestParseEventSearchResponse(t *testing.T) {
+	t.Run("正常系_イベントと店舗を読み取る", func(t *testing.T) {
+		f, err := os.Open(filepath.Join("testdata", "event_search_page1.json"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		res, err := parseEventSearchResponse(f)
+		require.NoError(t, err)
+
+		require.Equal(t, 3, res.EventCount)
+		require.Len(t, res.Event, 2)
+		require.Equal(t, 606466, res.Event[0].EventHoldingId)
+		require.Equal(t, 1234, res.Event[1].Shop.ShopId)
+		require.Nil(t, res.Event[1].LeagueId)
+	})
+
+	t.Run("異常系_codeが200以外ならエラーを返す", func(t *testing.T) {
+		f, err := os.Open(filepath.Join("testdata", "event_search_error.json"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		_, err = parseEventSearchResponse(f)
+		require.Error(t, err)
+	})
+}
+
+func TestConvertEvents(t *testing.T) {
+	t.Run("正常系_イベントを行に変換し店舗はIDごとにまとめる", func(t *testing.T) {
+		entries := append(loadFixture(t, "event_search_page1.json"), loadFixture(t, "event_search_page2.json")...)
+
+		shops, events, skipped := convertEvents(entries)
+
+		require.Empty(t, skipped)
+		require.Len(t, events, 3)
+
+		// 大型大会: 株式会社ポケモン(shops.id=0)の主催。店舗の行は上書きしないが紐付けは残す
+		cl := events[0]
+		require.Equal(t, 606466, cl.ID)
+		require.Equal(t, time.Date(2025, 2, 15, 0, 0, 0, 0, time.Local), cl.Date)
+		require.Equal(t, time.Date(2025, 2, 15, 7, 30, 0, 0, time.Local), *cl.StartedAt)
+		require.Equal(t, time.Date(2025, 2, 15, 20, 50, 0, 0, time.Local), *cl.EndedAt)
+		require.Equal(t, "マリンメッセ福岡 A館", *cl.Venue)
+		require.True(t, *cl.CSPFlg)
+		require.Equal(t, 0, *cl.ShopId)
+
+		// ジムバトル: 空の任意項目は NULL、タイトルはトリムする
+		gym := events[1]
+		require.Equal(t, "ジムバトル", gym.Title)
+		require.Nil(t, gym.Venue)
+		require.Nil(t, gym.EndedAt)
+		require.Nil(t, gym.DeckCount)
+		require.Nil(t, gym.LeagueId)
+		require.Nil(t, gym.LeagueTitle)
+		require.Equal(t, 1234, *gym.ShopId)
+		require.Equal(t, "カードショップ池袋", *gym.ShopName)
+
+		// 同じ店舗は1行にまとめ、後に出てきた情報を優先する
+		require.Len(t, shops, 1)
+		require.Equal(t, 1234, shops[0].ID)
+		require.Equal(t, "カードショップ池袋 サンシャイン店", shops[0].Name)
+		require.Equal(t, 13, shops[0].PrefectureId)
+		require.Equal(t, "35.7295,139.7193", *shops[0].GeoCoding)
+		require.Nil(t, shops[0].ZipCode)
+	})
+
+	t.Run("正常系_同じイベントが2度返っても1行にまとめる", func(t *testing.T) {
+		page1 := loadFixture(t, "event_search_page1.json")
+		entries := append(page1, page1[1])
+
+		_, events, skipped := convertEvents(entries)
+
+		require.Empty(t, skipped)
+		require.Len(t, events, 2)
+	})
+
+	t.Run("異常系_必須項目の欠けたイベントはスキップし不正な店舗は紐付けない", func(t *testing.T) {
+		shops, events, skipped := convertEvents(loadFixture(t, "event_search_invalid.json"))
+
+		require.Len(t, skipped, 3)
+		require.Contains(t, skipped[0], "event_holding_id is missing")
+		require.Contains(t, skipped[1], "event_title is missing")
+		require.Contains(t, skipped[2], "invalid event_date")
+
+		require.Empty(t, shops)
+		require.Len(t, events, 1)
+		require.Equal(t, 800003, events[0].ID)
+		require.Nil(t, events[0].ShopId)
+		require.Equal(t, "なぞのショップ", *events[0].ShopName)
+	})
+
+	t.Run("異常系_列の長さを超えるイベントはスキップし店舗は紐付けない", func(t *testing.T) {
+		entries := []eventEntry{
+			{
+				EventHoldingId: 900001,
+				EventTitle:     strings.Repeat("あ", 256),
+				EventDate:      "2025-02-16",
+			},
+			{
+				EventHoldingId: 900002,
+				EventTitle:     strings.Repeat("あ", 255),
+				EventDate:      "2025-02-16",
+				Shop: &shopEntry{
+					ShopId:       5678,
+					ShopName:     "長い郵便番号のショップ",
+					PrefectureId: 13,
+					ZipCode:      "123-45678",
+				},
+			},
+			{
+				EventHoldingId: 900003,
+				EventTitle:     "長い電話番号のショップのイベント",
+				EventDate:      "2025-02-16",
+				Shop: &shopEntry{
+					ShopId:       5679,
+					ShopName:     "長い電話番号のショップ",
+					PrefectureId: 13,
+					Tel:          strings.Repeat("0", 33),
+				},
+			},
+		}
+
+		shops, events, skipped := convertEvents(entries)
+
+		require.Len(t, skipped, 1)
+		require.Contains(t, skipped[0], "event_holding_id=900001: event_title is too long")
+
+		require.Empty(t, shops)
+		require.Len(t, events, 2)
+		require.Equal(t, 900002, events[0].ID)
+		require.Nil(t, events[0].ShopId)
+		require.Equal(t, "長い郵便番号のショップ", *events[0].ShopName)
+		require.Equal(t, 900003, events[1].ID)
+		require.Nil(t, events[1].ShopId)
+	})
+}
+
+func TestParseDateRange(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.Local)
+
+	t.Run("正常系_省略時は7日前から60日後", func(t *testing.T) {
+		from, to, err := parseDateRange("", "", now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local), from)
+		require.Equal(t, time.Date(2026, 12, 17, 0, 0, 0, 0, time.Local), to)
+	})
+
+	t.Run("正常系_指定した期間を使う", func(t *testing.T) {
+		from, to, err := parseDateRange("2026-01-01", "2026-03-31", now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local), from)
+		require.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local), to)
+	})
+
+	t.Run("異常系_不正な期間はエラーを返す", func(t *testing.T) {
+		for _, tc := range [][2]string{
+			{"2026/01/01", "2026-03-31"},
+			{"2026-03-31", "2026-01-01"},
+			{"2025-01-01", "2026-03-31"},
+		} {
+			_, _, err := parseDateRange(tc[0], tc[1], now)
+			require.Error(t, err, tc)
+		}
+	})
+}
+
+func TestFetchEvents(t *testing.T) {
+	fetchInterval = 0
+
+	t.Run("正常系_eventCountに達するまでページを辿る", func(t *testing.T) {
+		var queries []string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, eventSearchPath, r.URL.Path)
+			queries = append(queries, r.URL.RawQuery)
+
+			fixture := "event_search_page1.json"
+			if r.URL.Query().Get("offset") != "0" {
+				fixture = "event_search_page2.json"
+			}
+			http.ServeFile(w, r, filepath.Join("testdata", fixture))
+		}))
+		defer ts.Close()
+
+		from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.Local)
+		to := time.Date(2025, 2, 28, 0, 0, 0, 0, time.Local)
+
+		entries, err := fetchEvents(ts.URL+"/", from, to)
+		require.NoError(t, err)
+
+		require.Len(t, entries, 3)
+		require.Equal(t, []string{
+			"end_date=2025-02-28&limit=100&offset=0&start_date=2025-02-01",
+			"end_date=2025-02-28&limit=100&offset=2&start_date=2025-02-01",
+		}, queries)
+	})
+
+	t.Run("異常系_200以外のステータスはエラーを返す", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+
+		_, err := fetchEvents(ts.URL, time.Now(), time.Now())
+		require.ErrorContains(t, err, "503")
+	})
+}
+
+// upsertEvents が店舗・イベントを保存し、再実行で上書きすることを実DBで検証する。
+// VSRECORDER_TEST_DATABASE_URL 未設定時はスキップ(make integration-test で実行される)。
+func TestIntegrationUpsertEvents(t *testing.T) {
+	dsn := os.Getenv("VSRECORDER_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("VSRECORDER_TEST_DATABASE_URL が未設定のためスキップ(make integration-test で実行できます)")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, db.Exec("TRUNCATE TABLE official_events CASCADE").Error)
+	// shops.id=0 は schema.sql で投入する固定の行なので残す
+	require.NoError(t, db.Exec("DELETE FROM shops WHERE id <> 0").Error)
+
+	shops, events, _ := convertEvents(loadFixture(t, "event_search_page1.json"))
+	require.NoError(t, upsertEvents(db, shops, events))
+
+	// 2ページ目で店舗名が変わったものを再投入すると上書きされる
+	shops, events, _ = convertEvents(loadFixture(t, "event_search_page2.json"))
+	require.NoError(t, upsertEvents(db, shops, events))
+
+	var eventCount int64
+	require.NoError(t, db.Table("official_events").Count(&eventCount).Error)
+	require.Equal(t, int64(3), eventCount)
+
+	var shopName string
+	require.NoError(t, db.Table("shops").Where("id = ?", 1234).Pluck("name", &shopName).Error)
+	require.Equal(t, "カードショップ池袋 サンシャイン店", shopName)
+}
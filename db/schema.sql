@@ -106,6 +106,25 @@ CREATE TABLE official_events (
     FOREIGN KEY (shop_id)   REFERENCES shops (id)
 );
 
+-- cmd/sync-official-events の実行結果。shops / official_events はこのリポジトリの外で
+-- 投入されるほか、このバッチで公式サイトのイベント検索から取り込む。いつ・どの期間を
+-- 取り込んだかを追えるよう、実行ごとに1行残す。status は 'succeeded' か 'failed'。
+CREATE TABLE official_event_sync_logs (
+    id                   VARCHAR(26) NOT NULL PRIMARY KEY,
+    started_at           TIMESTAMP NOT NULL,
+    finished_at          TIMESTAMP NOT NULL,
+    from_date            DATE NOT NULL,
+    to_date              DATE NOT NULL,
+    fetched_count        INT NOT NULL DEFAULT 0,
+    skipped_count        INT NOT NULL DEFAULT 0,
+    upserted_shop_count  INT NOT NULL DEFAULT 0,
+    upserted_event_count INT NOT NULL DEFAULT 0,
+    status               VARCHAR(16) NOT NULL,
+    error_message        TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_official_event_sync_logs_started_at ON official_event_sync_logs (started_at DESC);
+
 
 
 -- Tonamel の大会情報(タイトル・説明・画像)を保持するキャッシュテーブル。
@@ -134,10 +153,62 @@ CREATE TABLE unofficial_events (
     deleted_at TIMESTAMP    DEFAULT NULL,
     user_id    VARCHAR(32)  NOT NULL,
     title      VARCHAR(255) NOT NULL,
-    date       DATE         NOT NULL
+    date       DATE         NOT NULL,
+    -- 他のユーザが自分の記録をこのイベントに付けるための参加コード。共有していなければ NULL。
+    join_code  VARCHAR(8)   DEFAULT NULL
 );
 
 CREATE INDEX idx_unofficial_events_deleted_at ON unofficial_events(deleted_at);
+CREATE UNIQUE INDEX idx_unofficial_events_join_code ON unofficial_events(join_code) WHERE join_code IS NOT NULL;
+
+-- 自由形式イベントをスイスドローの大会として運営するときの設定。大会にしていないイベントには行が無い。
+-- top_cut_size はトップカットに進む人数で、0 はトップカットをまだ始めていないことを表す。
+CREATE TABLE unofficial_event_tournaments (
+    unofficial_event_id VARCHAR(26) NOT NULL PRIMARY KEY,
+    created_at          TIMESTAMP   NOT NULL,
+    updated_at          TIMESTAMP   NOT NULL,
+    bo3_flg             BOOLEAN     NOT NULL DEFAULT FALSE,
+    top_cut_size        INTEGER     NOT NULL DEFAULT 0
+);
+
+-- 大会の参加者。登録ユーザ(user_id)かゲスト(guest_name)のどちらか一方を持つ。
+-- record_id は登録ユーザの対戦結果を書き込む記録で、最初の結果を入力したときに作る。
+-- 棄権しても、これまでの組み合わせ・順位表に残すため行は消さず dropped_at を入れる。
+CREATE TABLE unofficial_event_participants (
+    id                  VARCHAR(26) NOT NULL PRIMARY KEY,
+    created_at          TIMESTAMP   NOT NULL,
+    updated_at          TIMESTAMP   NOT NULL,
+    unofficial_event_id VARCHAR(26) NOT NULL,
+    user_id             VARCHAR(32) DEFAULT NULL,
+    guest_name          VARCHAR(32) NOT NULL DEFAULT '',
+    record_id           VARCHAR(26) DEFAULT NULL,
+    dropped_at          TIMESTAMP   DEFAULT NULL,
+    CHECK ((user_id IS NULL) <> (guest_name = ''))
+);
+
+CREATE INDEX idx_unofficial_event_participants_unofficial_event_id ON unofficial_event_participants(unofficial_event_id, created_at);
+CREATE UNIQUE INDEX idx_unofficial_event_participants_user_id ON unofficial_event_participants(unofficial_event_id, user_id) WHERE user_id IS NOT NULL;
+
+-- 大会の組み合わせと結果。round はスイスドローからトップカットまで通しの回戦番号。
+-- player2_participant_id が NULL の行は player1 の不戦勝(バイ)で、組み合わせた時点で reported_at が入る。
+-- player1_match_id / player2_match_id は登録ユーザの記録に作った対戦結果。
+CREATE TABLE unofficial_event_pairings (
+    id                     VARCHAR(26) NOT NULL PRIMARY KEY,
+    created_at             TIMESTAMP   NOT NULL,
+    updated_at             TIMESTAMP   NOT NULL,
+    unofficial_event_id    VARCHAR(26) NOT NULL,
+    stage                  VARCHAR(16) NOT NULL CHECK (stage IN ('swiss', 'top_cut')),
+    round                  INTEGER     NOT NULL,
+    table_no               INTEGER     NOT NULL,
+    player1_participant_id VARCHAR(26) NOT NULL,
+    player2_participant_id VARCHAR(26) DEFAULT NULL,
+    player1_wins           INTEGER     NOT NULL DEFAULT 0,
+    player2_wins           INTEGER     NOT NULL DEFAULT 0,
+    reported_at            TIMESTAMP   DEFAULT NULL,
+    player1_match_id       VARCHAR(26) DEFAULT NULL,
+    player2_match_id       VARCHAR(26) DEFAULT NULL,
+    UNIQUE (unofficial_event_id, round, table_no)
+);
 
 CREATE TABLE decks (
     id               VARCHAR(26) PRIMARY KEY,
@@ -254,6 +325,50 @@ CREATE TABLE deck_code_tags (
 
 CREATE INDEX idx_deck_code_tags_tag_id ON deck_code_tags(tag_id);
 
+-- デッキコード(バージョン)のカード構成。公式サイトのデッキ結果HTML(オブジェクトストレージの
+-- deck-result_html/{code})を解析し、1種類のカードを1行で持つ。card_id は公式サイトのカードIDで
+-- cards.id と同じ採番。cards は外部で同期しているマスタで新弾直後は未反映のことがあるため、
+-- cards への外部キーは張らない(張るとマスタ未反映のカードを含むデッキが登録できなくなる)。
+-- デッキコードの中身は不変なので、解析し直すときは行ごと入れ替える(論理削除列は持たない)。
+CREATE TABLE deck_code_cards (
+    deck_code_id  VARCHAR(26) NOT NULL,
+    card_id       INT NOT NULL,
+    -- category は公式サイトの区分('pokemon'/'goods'/'tool'/'technical_machine'/'supporter'/
+    -- 'stadium'/'ace_spec'/'energy')。値の定義はアプリ側(entity.DeckCardCategory)が持つ。
+    category      VARCHAR(32) NOT NULL,
+    count         SMALLINT NOT NULL CHECK (count > 0),
+    -- position は公式サイトの掲載順(1始まり)。表示は position 昇順。
+    position      SMALLINT NOT NULL,
+    PRIMARY KEY (deck_code_id, card_id),
+    FOREIGN KEY (deck_code_id) REFERENCES deck_codes(id)
+);
+
+-- カード単位の集計(あるカードを採用しているデッキコードの逆引き)用。
+CREATE INDEX idx_deck_code_cards_card_id ON deck_code_cards(card_id);
+
+-- デッキコードのリソース(結果HTML・画像)を公式サイトから取得して配置するジョブのキュー。
+-- デッキコードの登録と同じトランザクションで積み、APIサーバ内のワーカーが非同期に処理する。
+-- 完了(succeeded)・デッドレター(dead)の行も GET /deckcodes/:id/assets で状態を返すために残す。
+CREATE TABLE deck_asset_jobs (
+    id            VARCHAR(26) PRIMARY KEY,
+    created_at    TIMESTAMP NOT NULL,
+    updated_at    TIMESTAMP NOT NULL,
+    deck_code_id  VARCHAR(26) NOT NULL,
+    code          VARCHAR(21) NOT NULL,
+    -- kind は 'result_html' / 'image'。値の定義はアプリ側(entity.DeckAssetJobKind)が持つ。
+    kind          VARCHAR(16) NOT NULL,
+    -- status は 'pending' / 'running' / 'succeeded' / 'dead' / 'invalid'(デッキコードが正しくない)。
+    status        VARCHAR(16) NOT NULL,
+    attempts      SMALLINT NOT NULL DEFAULT 0,
+    next_run_at   TIMESTAMP NOT NULL,
+    last_error    TEXT NOT NULL DEFAULT '',
+    UNIQUE (deck_code_id, kind),
+    FOREIGN KEY (deck_code_id) REFERENCES deck_codes(id)
+);
+
+-- ワーカーの取り出し(実行待ちを next_run_at 順に引く)用。
+CREATE INDEX idx_deck_asset_jobs_next_run_at ON deck_asset_jobs(next_run_at) WHERE status = 'pending';
+
 -- 対戦結果(match) ⇔ タグの中間テーブルは、FK参照先の matches を定義した後で作成する
 -- (matches の CREATE TABLE 直後、下の方に定義してある)。
 
@@ -323,6 +438,21 @@ CREATE INDEX idx_records_user_id ON records(user_id);
 --      → event_date の索引で範囲スキャンにする。
 CREATE INDEX IF NOT EXISTS idx_records_official_event_id_user_id ON records (official_event_id, user_id) WHERE official_event_id IS NOT NULL;
 CREATE INDEX IF NOT EXISTS idx_records_event_date ON records (event_date);
+-- 共有した自由形式イベントの集計(GET /unofficial_events/:id/summary)で、イベントに付いた記録を引く。
+CREATE INDEX IF NOT EXISTS idx_records_unofficial_event_id ON records (unofficial_event_id) WHERE unofficial_event_id IS NOT NULL;
+
+-- 記録(record) ⇔ タグ。match_tags と同じ規約で、「調整会」「大会前最終確認」など
+-- 記録そのものの分類に使う。position は ReplaceRecordTags が採番する。
+CREATE TABLE record_tags (
+    record_id  VARCHAR(26) NOT NULL,
+    tag_id     VARCHAR(26) NOT NULL,
+    position   SMALLINT NOT NULL DEFAULT 1,
+    PRIMARY KEY (record_id, tag_id),
+    FOREIGN KEY (record_id) REFERENCES records(id),
+    FOREIGN KEY (tag_id)    REFERENCES tags(id)
+);
+
+CREATE INDEX idx_record_tags_tag_id ON record_tags(tag_id);
 
 CREATE TABLE matches (
     id                        VARCHAR(26) PRIMARY KEY,
@@ -373,6 +503,52 @@ CREATE TABLE match_tags (
 
 CREATE INDEX idx_match_tags_tag_id ON match_tags(tag_id);
 
+-- 記録・対戦結果に添付した写真(組み合わせ表・スコアシート・相手の盤面など)。
+-- record_id と match_id のどちらか一方だけを持つ。本体とサムネイルはデッキのリソースと同じ
+-- ストレージに非公開で置き(キーは entity.Attachment.ObjectKey / ThumbnailKey)、
+-- 親の論理削除中は復元に備えて残して、purge-trash が親を物理削除するときに一緒に消す。
+-- content_type・size・width・height は EXIF を除いて再エンコードした本体の値。
+CREATE TABLE attachments (
+    id            VARCHAR(26) PRIMARY KEY,
+    created_at    TIMESTAMP NOT NULL,
+    user_id       VARCHAR(32) NOT NULL,
+    record_id     VARCHAR(26) DEFAULT NULL,
+    match_id      VARCHAR(26) DEFAULT NULL,
+    content_type  VARCHAR(32) NOT NULL,
+    size          INTEGER NOT NULL,
+    width         INTEGER NOT NULL,
+    height        INTEGER NOT NULL,
+    FOREIGN KEY (record_id) REFERENCES records(id),
+    FOREIGN KEY (match_id)  REFERENCES matches(id),
+    CHECK ((record_id IS NULL) <> (match_id IS NULL))
+);
+
+CREATE INDEX idx_attachments_record_id ON attachments (record_id, created_at) WHERE record_id IS NOT NULL;
+CREATE INDEX idx_attachments_match_id ON attachments (match_id, created_at) WHERE match_id IS NOT NULL;
+
+-- 登録ユーザ同士の対戦結果の確認依頼。match_id(依頼した側の対戦結果)の対戦相手
+-- (opponent_user_id)が承認すると、相手の記録に勝敗・サイドの枚数を入れ替えた対戦結果を作り
+-- mirrored_match_id に持つ。両側の対戦結果を結び付けておき、後から片方を編集したときに
+-- 食い違いを検出できるようにする。異議を申し立てられた依頼は、依頼した側が直して再度依頼できる。
+CREATE TABLE match_confirmations (
+    id                 VARCHAR(26) PRIMARY KEY,
+    created_at         TIMESTAMP NOT NULL,
+    updated_at         TIMESTAMP NOT NULL,
+    match_id           VARCHAR(26) NOT NULL UNIQUE,
+    requester_user_id  VARCHAR(32) NOT NULL,
+    opponent_user_id   VARCHAR(32) NOT NULL,
+    -- status は pending(回答待ち) / confirmed(承認) / disputed(異議あり)。
+    status             VARCHAR(16) NOT NULL,
+    mirrored_match_id  VARCHAR(26) DEFAULT NULL,
+    dispute_reason     TEXT NOT NULL DEFAULT '',
+    responded_at       TIMESTAMP DEFAULT NULL,
+    FOREIGN KEY (match_id)          REFERENCES matches(id),
+    FOREIGN KEY (mirrored_match_id) REFERENCES matches(id)
+);
+
+CREATE INDEX idx_match_confirmations_opponent_user_id ON match_confirmations (opponent_user_id, created_at);
+CREATE INDEX idx_match_confirmations_mirrored_match_id ON match_confirmations (mirrored_match_id) WHERE mirrored_match_id IS NOT NULL;
+
 CREATE TABLE games (
     id                       VARCHAR(26) PRIMARY KEY,
     created_at               TIMESTAMP NOT NULL,
@@ -393,6 +569,21 @@ CREATE TABLE games (
 -- 実測で 863ms → 4.7ms(対局30万件時)。差はサービス全体の対局数に比例して開く。
 CREATE INDEX idx_games_match_id ON games(match_id);
 
+-- メモの検索(GET /users/:id/search)用。日本語は単語の区切りが無く tsvector の分かち書きが
+-- 効かないため、語の区切りに依存しない trigram の GIN 索引で ILIKE '%語%' の部分一致を引く。
+-- pg_trgm は LC_CTYPE が C だと英数字以外を trigram にしないため、日本語のメモに効かせるには
+-- データベースを UTF-8 のロケール(ja_JP.UTF-8 / C.UTF-8 等)で作成しておく必要がある。
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS idx_records_memo_trgm ON records USING GIN (memo gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_matches_memo_trgm ON matches USING GIN (memo gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_games_memo_trgm   ON games   USING GIN (memo gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- 公式イベントの検索(GET /official_events/search)用。メモの検索と同じく trigram の GIN 索引で
+-- イベント名・店舗名の部分一致を引き、開催日の新しい順に並べるため開催日にも索引を張る。
+CREATE INDEX IF NOT EXISTS idx_official_events_title_trgm     ON official_events USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_official_events_shop_name_trgm ON official_events USING GIN (shop_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_official_events_date           ON official_events(date);
+
 CREATE TABLE users (
     id          VARCHAR(32) PRIMARY KEY,
     created_at  TIMESTAMP NOT NULL,
@@ -405,6 +596,23 @@ CREATE TABLE users (
 CREATE INDEX idx_users_created_at ON users(created_at);
 CREATE INDEX idx_users_deleted_at ON users(deleted_at);
 
+-- ユーザ同士の関係。user_id から target_user_id への向きを持ち、status は
+-- pending(フレンド申請中) / accepted(フレンド) / blocked(user_id が target_user_id をブロック)。
+-- フレンドは向きを問わず accepted の行が1つあればよい。申請を断る・フレンドをやめる・
+-- ブロックを解くと行ごと消し、同じ2人の間でやり直せるようにする。
+CREATE TABLE user_relationships (
+    id              VARCHAR(26) PRIMARY KEY,
+    created_at      TIMESTAMP NOT NULL,
+    updated_at      TIMESTAMP NOT NULL,
+    user_id         VARCHAR(32) NOT NULL,
+    target_user_id  VARCHAR(32) NOT NULL,
+    status          VARCHAR(16) NOT NULL,
+    UNIQUE (user_id, target_user_id),
+    CHECK (user_id <> target_user_id)
+);
+
+CREATE INDEX idx_user_relationships_target_user_id ON user_relationships (target_user_id, status, created_at);
+
 
 
 
@@ -666,6 +874,20 @@ CREATE TABLE cards (
     regulation_mark     VARCHAR(32) NOT NULL
 );
 
+-- レギュレーションごとの禁止カード(公式の「禁止カード」「殿堂の禁止カード」)。
+-- 禁止は収録弾に関係なく同名カードすべてが対象のため、カードIDではなくカード名で持つ。
+-- to_date は禁止が解除された場合の最終日(含む)。NULL なら現在も禁止中。
+CREATE TABLE banned_cards (
+    id            SERIAL PRIMARY KEY,
+    regulation_id SMALLINT NOT NULL,
+    card_name     VARCHAR(512) NOT NULL,
+    from_date     DATE NOT NULL,
+    to_date       DATE,
+    FOREIGN KEY (regulation_id) REFERENCES regulations(id)
+);
+
+CREATE INDEX idx_banned_cards_regulation_id ON banned_cards(regulation_id);
+
 
 
 CREATE TABLE pokemon_cards (
@@ -986,13 +1208,74 @@ CREATE TABLE notifications (
 
 CREATE INDEX idx_notifications_user_id_created_at ON notifications (user_id, created_at DESC);
 
+-- ユーザの全データのエクスポート(テイクアウト)のジョブ。POST /users/:id/export で積み、
+-- APIサーバ内のワーカーが非同期にアーカイブ(zip)を作ってオブジェクトストレージへ非公開で置く。
+-- 完了・失敗の行もダウンロードと状態の参照に使うため残す。
+CREATE TABLE user_exports (
+    id            VARCHAR(26) PRIMARY KEY,
+    created_at    TIMESTAMP NOT NULL,
+    updated_at    TIMESTAMP NOT NULL,
+    user_id       VARCHAR(32) NOT NULL,
+    -- status は 'pending' / 'running' / 'succeeded' / 'failed'。値の定義はアプリ側(entity.UserExportStatus)が持つ。
+    status        VARCHAR(16) NOT NULL,
+    attempts      SMALLINT NOT NULL DEFAULT 0,
+    next_run_at   TIMESTAMP NOT NULL,
+    last_error    TEXT NOT NULL DEFAULT '',
+    completed_at  TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX idx_user_exports_user_id_created_at ON user_exports (user_id, created_at DESC);
+-- ワーカーの取り出し(実行待ちを next_run_at 順に引く)用。
+CREATE INDEX idx_user_exports_next_run_at ON user_exports (next_run_at) WHERE status = 'pending';
+
+-- 作成系のリクエスト(POST /records・/matches・/decks)に付けられた Idempotency-Key と、その応答。
+-- 電波の悪い会場でのリトライによる二重作成を防ぐため、24時間は同じキーのリトライに保存した応答を返す。
+-- status_code が 0 の行は最初のリクエストを処理中。期限切れの行は purge-idempotency-keys が消す。
+CREATE TABLE idempotency_keys (
+    user_id       VARCHAR(32) NOT NULL,
+    key           VARCHAR(255) NOT NULL,
+    -- request_hash はメソッド・パス・ボディのSHA-256(16進)。同じキーで別の内容が届いたら409を返す。
+    request_hash  CHAR(64) NOT NULL,
+    status_code   SMALLINT NOT NULL DEFAULT 0,
+    response_body BYTEA DEFAULT NULL,
+    created_at    TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- 記録・対戦結果の変更履歴(追記のみ)。「勝率がなぜ変わったか」を説明できるよう、
+-- usecase.Record.Update/Delete と usecase.Match.Update/Delete/Reorder が、変更と同じ
+-- トランザクションで変更前後のスナップショット(JSON)を書き込む。
+CREATE TABLE entity_revisions (
+    id          VARCHAR(26) PRIMARY KEY,
+    created_at  TIMESTAMP NOT NULL,
+    -- entity_type は record / match、action は update / delete / reorder。
+    entity_type VARCHAR(16) NOT NULL,
+    entity_id   VARCHAR(26) NOT NULL,
+    action      VARCHAR(16) NOT NULL,
+    -- user_id は変更された記録・対戦結果の持ち主、actor_uid は変更したユーザ。
+    user_id     VARCHAR(32) NOT NULL,
+    actor_uid   VARCHAR(32) NOT NULL DEFAULT '',
+    request_id  VARCHAR(64) NOT NULL DEFAULT '',
+    before      JSONB DEFAULT NULL,
+    after       JSONB DEFAULT NULL
+);
+
+CREATE INDEX idx_entity_revisions_entity ON entity_revisions (entity_type, entity_id, created_at DESC);
+CREATE INDEX idx_entity_revisions_user_id ON entity_revisions (user_id);
+
 
 
 
 
 GRANT SELECT ON shops                   TO grafana;
 GRANT SELECT ON official_events         TO grafana;
+GRANT SELECT ON official_event_sync_logs TO grafana;
 GRANT SELECT ON unofficial_events       TO grafana;
+GRANT SELECT ON unofficial_event_tournaments  TO grafana;
+GRANT SELECT ON unofficial_event_participants TO grafana;
+GRANT SELECT ON unofficial_event_pairings     TO grafana;
 
 GRANT SELECT ON pokemon_sprites         TO grafana;
 GRANT SELECT ON pokemon_avatars         TO grafana;
@@ -1009,6 +1292,8 @@ GRANT SELECT ON games                   TO grafana;
 
 GRANT SELECT ON decks                   TO grafana;
 GRANT SELECT ON deck_codes              TO grafana;
+GRANT SELECT ON deck_code_cards         TO grafana;
+GRANT SELECT ON deck_asset_jobs         TO grafana;
 GRANT SELECT ON deck_pokemon_sprites    TO grafana;
 GRANT SELECT ON deck_name_aliases       TO grafana;
 
@@ -1016,6 +1301,10 @@ GRANT SELECT ON tags                    TO grafana;
 GRANT SELECT ON deck_tags               TO grafana;
 GRANT SELECT ON deck_code_tags          TO grafana;
 GRANT SELECT ON match_tags              TO grafana;
+GRANT SELECT ON record_tags             TO grafana;
+GRANT SELECT ON attachments             TO grafana;
+GRANT SELECT ON match_confirmations     TO grafana;
+GRANT SELECT ON user_relationships      TO grafana;
 
 GRANT SELECT ON championship_series     TO grafana;
 GRANT SELECT ON standard_regulations    TO grafana;
@@ -1026,6 +1315,7 @@ GRANT SELECT ON cityleague_schedules    TO grafana;
 GRANT SELECT ON cityleague_results      TO grafana;
 
 GRANT SELECT ON cards                   TO grafana;
+GRANT SELECT ON banned_cards            TO grafana;
 GRANT SELECT ON pokemon_cards           TO grafana;
 
 GRANT SELECT ON badge_definitions       TO grafana;
@@ -1038,3 +1328,4 @@ GRANT SELECT ON user_environment_badges TO grafana;
 GRANT SELECT ON designations            TO grafana;
 
 GRANT SELECT ON notifications           TO grafana;
+GRANT SELECT ON user_exports            TO grafana;
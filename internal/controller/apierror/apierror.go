@@ -138,6 +138,58 @@ var (
 	// ErrUserPlayerLocked は紐付けから1ヶ月経過しておらず変更できない場合(409)。
 	ErrUserPlayerLocked = New(http.StatusConflict, errors.New("cannot change player_id within 1 month of linking"))
 
+	// ErrUserExportNotReady はエクスポートのアーカイブがまだ完成しておらず、ダウンロードできない場合(409)。
+	ErrUserExportNotReady = New(http.StatusConflict, errors.New("export is not ready"))
+
+	// ErrTrashParentDeleted は親(記録・デッキ)が削除されたままで、対戦結果・デッキコードを復元できない場合(409)。
+	ErrTrashParentDeleted = New(http.StatusConflict, errors.New("restore the parent first"))
+
+	// ErrTooManyAttachments は記録・対戦結果に添付できる写真の上限に達している場合(409)。
+	ErrTooManyAttachments = New(http.StatusConflict, errors.New("too many attachments"))
+
+	// ErrAttachmentTooLarge はアップロードされた写真が上限(entity.MaxAttachmentBytes)を超えている場合(413)。
+	ErrAttachmentTooLarge = New(http.StatusRequestEntityTooLarge, errors.New("attachment is too large"))
+
+	// ErrUnsupportedAttachment はアップロードされたファイルが写真(JPEG/PNG)として扱えない場合(415)。
+	ErrUnsupportedAttachment = New(http.StatusUnsupportedMediaType, errors.New("unsupported attachment"))
+
+	// ErrNoMatchOpponent は対戦結果に確認を依頼できる対戦相手(登録ユーザ)がいない場合(422)。
+	ErrNoMatchOpponent = New(http.StatusUnprocessableEntity, errors.New("match has no registered opponent"))
+
+	// ErrMatchConfirmationClosed は確認依頼が回答済み、または回答待ち・承認済みの依頼が既にある場合(409)。
+	ErrMatchConfirmationClosed = New(http.StatusConflict, errors.New("match confirmation is already answered or pending"))
+
+	// ErrUserRelationshipExists は既にフレンドか、フレンド申請中の相手に申請した場合(409)。
+	ErrUserRelationshipExists = New(http.StatusConflict, errors.New("already friends or requested"))
+
+	// ErrUserBlocked はブロックしている・されている相手にフレンド申請や対戦結果の確認依頼・回答をした場合(403)。
+	ErrUserBlocked = New(http.StatusForbidden, errors.New("user is blocked"))
+
+	// ErrTournamentRoundInProgress は大会の今の回戦に未入力の結果が残っていて、次の回戦を組めない場合(409)。
+	ErrTournamentRoundInProgress = New(http.StatusConflict, errors.New("results of the current round are not reported yet"))
+
+	// ErrTournamentClosed は大会の進み具合からもうできない操作の場合(409)。
+	ErrTournamentClosed = New(http.StatusConflict, errors.New("tournament does not accept this operation any more"))
+
+	// ErrNotEnoughParticipants は組み合わせ・トップカットを組むのに参加者が足りない場合(422)。
+	ErrNotEnoughParticipants = New(http.StatusUnprocessableEntity, errors.New("not enough participants"))
+
+	// ErrTooManyParticipants は大会の参加者が上限に達している場合(409)。
+	ErrTooManyParticipants = New(http.StatusConflict, errors.New("too many participants"))
+
+	// ErrTonamelPlayerNotFound は Tonamel の組み合わせに指定したプレイヤー名の試合が無い場合(422)。
+	ErrTonamelPlayerNotFound = New(http.StatusUnprocessableEntity, errors.New("player is not found in the tonamel bracket"))
+
+	// ErrIdempotencyKeyMismatch は同じ Idempotency-Key で、前回と異なる内容のリクエストが届いた場合(409)。
+	ErrIdempotencyKeyMismatch = New(http.StatusConflict, errors.New("idempotency key is already used for a different request"))
+
+	// ErrIdempotencyKeyInProgress は同じ Idempotency-Key の前回のリクエストがまだ処理中の場合(409)。
+	ErrIdempotencyKeyInProgress = New(http.StatusConflict, errors.New("a request with the same idempotency key is in progress"))
+
+	// ErrPreconditionFailed は If-Match の ETag が現在の版と一致せず、他の端末での
+	// 変更を上書きしてしまうため更新・削除を拒否した場合(412)。
+	ErrPreconditionFailed = New(http.StatusPreconditionFailed, errors.New("resource has been modified"))
+
 	// ErrTooManyRequests は短時間に試行が集中し、レート制限に達した場合(429)。
 	ErrTooManyRequests = New(http.StatusTooManyRequests, errors.New("too many requests"))
 
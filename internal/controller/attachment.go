@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	AttachmentsPath         = "/attachments"
+	AttachmentThumbnailPath = "/thumbnail"
+
+	// MaxAttachmentRequestBodyBytes は写真の添付で受け付けるリクエストボディの上限。
+	// 写真1枚(entity.MaxAttachmentBytes)に multipart の境界・ヘッダの分の余裕を足している。
+	MaxAttachmentRequestBodyBytes = entity.MaxAttachmentBytes + 64<<10
+)
+
+type Attachment struct {
+	router           *gin.Engine
+	usecase          usecase.AttachmentInterface
+	recordRepository repository.RecordInterface
+	matchRepository  repository.MatchInterface
+	relativePath     string
+}
+
+func NewAttachment(
+	router *gin.Engine,
+	usecase usecase.AttachmentInterface,
+	recordRepository repository.RecordInterface,
+	matchRepository repository.MatchInterface,
+) *Attachment {
+	return &Attachment{
+		router:           router,
+		usecase:          usecase,
+		recordRepository: recordRepository,
+		matchRepository:  matchRepository,
+	}
+}
+
+func (c *Attachment) RegisterRoute(relativePath string) {
+	// 写真の取得先のURLを組み立てるために保持する。
+	c.relativePath = relativePath
+
+	// 閲覧は記録・対戦結果と同じ権限(公開なら誰でも、非公開なら本人のみ)、添付・削除は本人のみ。
+	for ownerType, routes := range map[entity.AttachmentOwnerType]struct {
+		path   string
+		get    gin.HandlerFunc
+		modify gin.HandlerFunc
+	}{
+		entity.AttachmentOwnerTypeRecord: {
+			path:   RecordsPath,
+			get:    authorization.RecordGetByIdAuthorizationMiddleware(c.recordRepository),
+			modify: authorization.RecordAttachmentAuthorizationMiddleware(c.recordRepository),
+		},
+		entity.AttachmentOwnerTypeMatch: {
+			path:   MatchesPath,
+			get:    authorization.MatchGetByIdAuthorizationMiddleware(c.matchRepository, c.recordRepository),
+			modify: authorization.MatchAttachmentAuthorizationMiddleware(c.matchRepository),
+		},
+	} {
+		r := c.router.Group(relativePath + routes.path)
+		r.GET(
+			"/:id"+AttachmentsPath,
+			authentication.OptionalAuthenticationMiddleware(),
+			routes.get,
+			c.GetByOwner(ownerType),
+		)
+		r.GET(
+			"/:id"+AttachmentsPath+"/:attachment_id",
+			authentication.OptionalAuthenticationMiddleware(),
+			routes.get,
+			c.Download(ownerType, false),
+		)
+		r.GET(
+			"/:id"+AttachmentsPath+"/:attachment_id"+AttachmentThumbnailPath,
+			authentication.OptionalAuthenticationMiddleware(),
+			routes.get,
+			c.Download(ownerType, true),
+		)
+		r.POST(
+			"/:id"+AttachmentsPath,
+			authentication.RequiredAuthenticationMiddleware(),
+			routes.modify,
+			validation.AttachmentCreateMiddleware(),
+			c.Create(ownerType),
+		)
+		r.DELETE(
+			"/:id"+AttachmentsPath+"/:attachment_id",
+			authentication.RequiredAuthenticationMiddleware(),
+			routes.modify,
+			c.Delete(ownerType),
+		)
+	}
+}
+
+// url は写真の取得先(thumbnail ならサムネイルの取得先)を返す。
+func (c *Attachment) url(attachment *entity.Attachment, thumbnail bool) string {
+	path := RecordsPath
+	if attachment.OwnerType() == entity.AttachmentOwnerTypeMatch {
+		path = MatchesPath
+	}
+
+	url := c.relativePath + path + "/" + attachment.OwnerId() + AttachmentsPath + "/" + attachment.ID
+	if thumbnail {
+		url += AttachmentThumbnailPath
+	}
+
+	return url
+}
+
+// Create は ownerType の記録・対戦結果に写真を添付する。
+func (c *Attachment) Create(ownerType entity.AttachmentOwnerType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ownerId := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+		body := helper.GetAttachmentBody(ctx)
+
+		attachment, err := c.usecase.Create(ctx.Request.Context(), uid, ownerType, ownerId, body)
+		if err != nil {
+			if errors.Is(err, apperror.ErrInvalidAttachment) {
+				apierror.ErrUnsupportedAttachment.JSON(ctx, err)
+				return
+			}
+
+			if errors.Is(err, apperror.ErrTooManyAttachments) {
+				apierror.ErrTooManyAttachments.JSON(ctx, err)
+				return
+			}
+
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		res := presenter.NewAttachmentResponse(attachment, c.url)
+
+		ctx.JSON(http.StatusCreated, res)
+	}
+}
+
+func (c *Attachment) GetByOwner(ownerType entity.AttachmentOwnerType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ownerId := helper.GetId(ctx)
+
+		attachments, err := c.usecase.FindByOwner(ctx.Request.Context(), ownerType, ownerId)
+		if err != nil {
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		res := presenter.NewAttachmentGetResponse(attachments, c.url)
+
+		ctx.JSON(http.StatusOK, res)
+	}
+}
+
+// Download は写真(thumbnail ならサムネイル)を返す。写真はストレージに非公開で置いているため、
+// 公開URLへリダイレクトせず、記録・対戦結果を見られる人にAPIが直接返す。
+func (c *Attachment) Download(ownerType entity.AttachmentOwnerType, thumbnail bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ownerId := helper.GetId(ctx)
+		attachmentId := ctx.Param("attachment_id")
+
+		body, err := c.usecase.Download(ctx.Request.Context(), ownerType, ownerId, attachmentId, thumbnail)
+		if err != nil {
+			if errors.Is(err, apperror.ErrRecordNotFound) {
+				apierror.ErrNotFound.JSON(ctx, err)
+				return
+			}
+
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		// 記録を非公開に切り替えた後に共有のキャッシュから返されないよう、保存させない。
+		ctx.Header("Cache-Control", "private, no-store")
+		ctx.Data(http.StatusOK, "image/jpeg", body)
+	}
+}
+
+func (c *Attachment) Delete(ownerType entity.AttachmentOwnerType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ownerId := helper.GetId(ctx)
+		attachmentId := ctx.Param("attachment_id")
+
+		if err := c.usecase.Delete(ctx.Request.Context(), ownerType, ownerId, attachmentId); err != nil {
+			if errors.Is(err, apperror.ErrRecordNotFound) {
+				apierror.ErrNotFound.JSON(ctx, err)
+				return
+			}
+
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, gin.H{})
+	}
+}
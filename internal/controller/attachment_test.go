@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+type attachmentControllerMocks struct {
+	usecase          *mock_usecase.MockAttachmentInterface
+	recordRepository *mock_repository.MockRecordInterface
+	matchRepository  *mock_repository.MockMatchInterface
+}
+
+func setup4TestAttachmentController(t *testing.T) (*Attachment, *attachmentControllerMocks, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	m := &attachmentControllerMocks{
+		usecase:          mock_usecase.NewMockAttachmentInterface(mockCtrl),
+		recordRepository: mock_repository.NewMockRecordInterface(mockCtrl),
+		matchRepository:  mock_repository.NewMockMatchInterface(mockCtrl),
+	}
+
+	r := gin.Default()
+	// main と同じく、写真の添付だけはボディの上限を引き上げる。
+	r.Use(internal.BodySizeLimitMiddleware(internal.MaxRequestBodyBytes, map[string]int64{
+		RecordsPath + "/:id" + AttachmentsPath: MaxAttachmentRequestBodyBytes,
+		MatchesPath + "/:id" + AttachmentsPath: MaxAttachmentRequestBodyBytes,
+	}))
+	c := NewAttachment(r, m.usecase, m.recordRepository, m.matchRepository)
+	c.RegisterRoute("")
+
+	return c, m, secretKey
+}
+
+// newAttachmentRequest は file に body を載せた multipart/form-data のリクエストを作る。
+func newAttachmentRequest(t *testing.T, path string, body []byte) *http.Request {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	part, err := writer.CreateFormFile(validation.AttachmentFormField, "scoresheet.jpg")
+	require.NoError(t, err)
+	_, err = part.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest("POST", path, buf)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return req
+}
+
+func TestAttachmentController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherUid := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TR0"
+	matchId := "01HD7Y3K8D6FDHMHTZ2GT41TR2"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	body := []byte("jpeg")
+
+	record := &entity.Record{ID: recordId, UserId: uid}
+	privateRecord := &entity.Record{ID: recordId, UserId: uid, PrivateFlg: true}
+	match := &entity.Match{ID: matchId, UserId: uid, RecordId: recordId}
+
+	newAttachment := func(ownerType entity.AttachmentOwnerType, ownerId string) *entity.Attachment {
+		attachment := entity.NewAttachment(id, now, uid, ownerType, ownerId)
+		attachment.SetImage(&entity.AttachmentImage{ContentType: "image/jpeg", Size: 1024, Width: 40, Height: 20})
+		return attachment
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		path := MatchesPath + "/" + matchId + AttachmentsPath
+
+		t.Run("正常系_対戦結果に写真を添付して201で返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.usecase.EXPECT().Create(gomock.Any(), uid, entity.AttachmentOwnerTypeMatch, matchId, body).
+				Return(newAttachment(entity.AttachmentOwnerTypeMatch, matchId), nil)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, body)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			var res dto.AttachmentResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, id, res.ID)
+			require.Equal(t, "image/jpeg", res.ContentType)
+			require.Equal(t, path+"/"+id, res.URL)
+			require.Equal(t, path+"/"+id+AttachmentThumbnailPath, res.ThumbnailURL)
+		})
+
+		t.Run("正常系_通常のボディの上限を超える写真も受け付ける", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			large := make([]byte, internal.MaxRequestBodyBytes*2)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.usecase.EXPECT().Create(gomock.Any(), uid, entity.AttachmentOwnerTypeMatch, matchId, large).
+				Return(newAttachment(entity.AttachmentOwnerTypeMatch, matchId), nil)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, large)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+		})
+
+		t.Run("異常系_上限を超える写真は413を返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, make([]byte, entity.MaxAttachmentBytes+1))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		})
+
+		t.Run("異常系_写真として扱えないファイルは415を返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.usecase.EXPECT().Create(gomock.Any(), uid, entity.AttachmentOwnerTypeMatch, matchId, body).
+				Return(nil, apperror.ErrInvalidAttachment)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, body)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		})
+
+		t.Run("異常系_添付できる上限に達していれば409を返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.usecase.EXPECT().Create(gomock.Any(), uid, entity.AttachmentOwnerTypeMatch, matchId, body).
+				Return(nil, apperror.ErrTooManyAttachments)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, body)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_fileが無ければ400を返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", path, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_他人の対戦結果には添付できない", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+
+			w := httptest.NewRecorder()
+			req := newAttachmentRequest(t, path, body)
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("GetByOwner", func(t *testing.T) {
+		path := RecordsPath + "/" + recordId + AttachmentsPath
+
+		t.Run("正常系_公開の記録の写真は未認証でも返す", func(t *testing.T) {
+			c, m, _ := setup4TestAttachmentController(t)
+
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			m.usecase.EXPECT().FindByOwner(gomock.Any(), entity.AttachmentOwnerTypeRecord, recordId).
+				Return([]*entity.Attachment{newAttachment(entity.AttachmentOwnerTypeRecord, recordId)}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.AttachmentGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res.Attachments, 1)
+			require.Equal(t, path+"/"+id, res.Attachments[0].URL)
+		})
+
+		t.Run("異常系_非公開の記録の写真は本人以外に返さない", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(privateRecord, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		path := MatchesPath + "/" + matchId + AttachmentsPath + "/" + id
+
+		t.Run("正常系_サムネイルを保存させずに返す", func(t *testing.T) {
+			c, m, _ := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			m.usecase.EXPECT().Download(gomock.Any(), entity.AttachmentOwnerTypeMatch, matchId, id, true).Return(body, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path+AttachmentThumbnailPath, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+			require.Equal(t, "private, no-store", w.Header().Get("Cache-Control"))
+			require.Equal(t, body, w.Body.Bytes())
+		})
+
+		t.Run("異常系_非公開の記録の対戦結果の写真は本人以外に返さない", func(t *testing.T) {
+			c, m, _ := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(privateRecord, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_別の添付先の写真は404を返す", func(t *testing.T) {
+			c, m, _ := setup4TestAttachmentController(t)
+
+			m.matchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			m.usecase.EXPECT().Download(gomock.Any(), entity.AttachmentOwnerTypeMatch, matchId, id, false).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		path := RecordsPath + "/" + recordId + AttachmentsPath + "/" + id
+
+		t.Run("正常系_写真を削除して204を返す", func(t *testing.T) {
+			c, m, secretKey := setup4TestAttachmentController(t)
+
+			m.recordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			m.usecase.EXPECT().Delete(gomock.Any(), entity.AttachmentOwnerTypeRecord, recordId, id).Return(nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", path, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNoContent, w.Code)
+		})
+
+		t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
+			c, _, _ := setup4TestAttachmentController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", path, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	})
+}
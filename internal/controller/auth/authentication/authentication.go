@@ -53,29 +53,40 @@ func parseToken(tokenString string, secretKey string) (*jwt.Token, error) {
 	return token, nil
 }
 
-func RequiredAuthenticationMiddleware() gin.HandlerFunc {
-	return func(ctx *gin.Context) {
-		secretKey := os.Getenv("VSRECORDER_JWT_SECRET")
+// ParseUID は Authorization ヘッダのトークンを検証し、uid を返す。
+// 認証ミドルウェアより前に uid を知りたいグローバルなミドルウェア(Idempotency-Key 等)が使う。
+// 応答は書かないため、検証に失敗した場合の 401 は後続の認証ミドルウェアに任せる。
+func ParseUID(ctx *gin.Context) (string, error) {
+	secretKey := os.Getenv("VSRECORDER_JWT_SECRET")
 
-		header := http.Header{}
-		header.Add("Authorization", ctx.GetHeader("Authorization"))
+	header := http.Header{}
+	header.Add("Authorization", ctx.GetHeader("Authorization"))
 
-		tokenString := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	tokenString := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
 
-		token, err := parseToken(tokenString, secretKey)
-		if err != nil {
-			apierror.ErrUnauthorized.JSON(ctx, err)
-			return
-		}
+	token, err := parseToken(tokenString, secretKey)
+	if err != nil {
+		return "", err
+	}
 
-		claims := token.Claims.(*VSRClaims)
+	claims := token.Claims.(*VSRClaims)
 
-		if claims.UID == "" {
-			apierror.ErrUnauthorized.JSON(ctx)
+	if claims.UID == "" {
+		return "", errors.New("uid is empty")
+	}
+
+	return claims.UID, nil
+}
+
+func RequiredAuthenticationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		uid, err := ParseUID(ctx)
+		if err != nil {
+			apierror.ErrUnauthorized.JSON(ctx, err)
 			return
 		}
 
-		helper.SetUID(ctx, claims.UID)
+		helper.SetUID(ctx, uid)
 	}
 }
 
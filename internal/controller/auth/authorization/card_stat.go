@@ -0,0 +1,27 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// CardStatAuthorizationMiddleware は GET /users/:id/stats/cards の認可。
+// 非公開のデッキコードのカード構成も集計に含むため、本人にだけ返す。
+func CardStatAuthorizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid != id {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
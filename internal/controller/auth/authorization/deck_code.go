@@ -6,21 +6,81 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
 func DeckCodeAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		id := helper.GetId(ctx)
-		uid := helper.GetUID(ctx)
 
-		if uid == "" {
-			apierror.ErrForbidden.JSON(ctx)
-			return
+		authorizeDeckCodeOwner(ctx, repository, id)
+	}
+}
+
+// authorizeDeckCodeOwner は id のデッキコードがログイン中のユーザの所有であることを確かめる。
+// 所有者でなければエラーレスポンスを書き込んで nil を返す。
+func authorizeDeckCodeOwner(ctx *gin.Context, repository repository.DeckCodeInterface, id string) *entity.DeckCode {
+	uid := helper.GetUID(ctx)
+
+	if uid == "" {
+		apierror.ErrForbidden.JSON(ctx)
+		return nil
+	}
+
+	deckcode, err := repository.FindById(ctx.Request.Context(), id)
+
+	if err == apperror.ErrRecordNotFound {
+		apierror.ErrNotFound.JSON(ctx, err)
+		return nil
+	} else if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return nil
+	}
+
+	if uid != deckcode.UserId {
+		apierror.ErrForbidden.JSON(ctx, err)
+		return nil
+	}
+
+	return deckcode
+}
+
+// DeckCodeDiffAuthorizationMiddleware は GET /decks/:id/deckcodes/diff の認可。
+// from / to の両方が本人のデッキコードで、かつ :id のデッキのバージョンであることを求める。
+func DeckCodeDiffAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		deckId := helper.GetId(ctx)
+
+		for _, id := range []string{helper.GetQueryFrom(ctx), helper.GetQueryTo(ctx)} {
+			if id == "" {
+				apierror.ErrBadRequest.JSON(ctx)
+				return
+			}
+
+			deckcode := authorizeDeckCodeOwner(ctx, repository, id)
+			if deckcode == nil {
+				return
+			}
+
+			// 別のデッキのデッキコードとは比べない(存在しないものとして扱う)
+			if deckcode.DeckId != deckId {
+				apierror.ErrNotFound.JSON(ctx)
+				return
+			}
 		}
+	}
+}
 
-		deckcode, err := repository.FindById(ctx.Request.Context(), id)
+// DeckCodeCardsAuthorizationMiddleware は GET /deckcodes/:id/cards・/export の認可。
+// カード構成からデッキコードを実質的に再現できるため、非公開のデッキコードは作成者以外には返さない。
+// カード構成の解釈・保存より前に弾き、作成者以外のリクエストでストレージを読み書きさせない。
+func DeckCodeCardsAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
 
+		deckcode, err := repository.FindById(ctx.Request.Context(), id)
 		if err == apperror.ErrRecordNotFound {
 			apierror.ErrNotFound.JSON(ctx, err)
 			return
@@ -29,13 +89,26 @@ func DeckCodeAuthorizationMiddleware(repository repository.DeckCodeInterface) gi
 			return
 		}
 
-		if uid != deckcode.UserId {
-			apierror.ErrForbidden.JSON(ctx, err)
+		if deckcode.PrivateCodeFlg && uid != deckcode.UserId {
+			apierror.ErrForbidden.JSON(ctx)
 			return
 		}
 	}
 }
 
+// DeckCodeLegalityAuthorizationMiddleware は GET /deckcodes/:id/legality の認可。
+// 判定結果からカード構成が分かるため、GET /deckcodes/:id/cards と同じ扱いにする。
+func DeckCodeLegalityAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
+	return DeckCodeCardsAuthorizationMiddleware(repository)
+}
+
+// DeckCodeAssetsAuthorizationMiddleware は GET /deckcodes/:id/assets の認可。
+// 画像等のURLはデッキコードから組み立てられるため、非公開のデッキコードは作成者以外には返さない
+// (GET /deckcodes/:id/legality と同じ扱い)。
+func DeckCodeAssetsAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
+	return DeckCodeLegalityAuthorizationMiddleware(repository)
+}
+
 func DeckCodeUpdateAuthorizationMiddleware(repository repository.DeckCodeInterface) gin.HandlerFunc {
 	return DeckCodeAuthorizationMiddleware(repository)
 }
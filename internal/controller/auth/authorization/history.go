@@ -0,0 +1,51 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// authorizeHistoryOwner は変更履歴の参照を、id の記録・対戦結果の所有者にだけ許す。
+// findOwner は論理削除済みのものを引けないため、見つからなければゴミ箱からも探し、
+// 削除した後も "delete" の履歴を見られるようにする。
+func authorizeHistoryOwner(
+	ctx *gin.Context,
+	trashRepository repository.TrashInterface,
+	itemType entity.TrashItemType,
+	id string,
+	findOwner func() (string, error),
+) {
+	uid := helper.GetUID(ctx)
+
+	if uid == "" {
+		apierror.ErrForbidden.JSON(ctx)
+		return
+	}
+
+	userId, err := findOwner()
+	if err == apperror.ErrRecordNotFound {
+		var item *entity.TrashItem
+		item, err = trashRepository.FindById(ctx.Request.Context(), itemType, id)
+		if err == nil {
+			userId = item.UserId
+		}
+	}
+
+	if err == apperror.ErrRecordNotFound {
+		apierror.ErrNotFound.JSON(ctx, err)
+		return
+	} else if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	if uid != userId {
+		apierror.ErrForbidden.JSON(ctx)
+		return
+	}
+}
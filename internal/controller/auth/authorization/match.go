@@ -6,6 +6,7 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
@@ -98,6 +99,23 @@ func MatchDeleteAuthorizationMiddleware(repository repository.MatchInterface) gi
 	return MatchAuthorizationMiddleware(repository)
 }
 
+// MatchHistoryAuthorizationMiddleware は記録の履歴と同じく、対戦結果の所有者にだけ見せる。
+// 削除済み(ゴミ箱にある)対戦結果も対象にする。
+func MatchHistoryAuthorizationMiddleware(repository repository.MatchInterface, trashRepository repository.TrashInterface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+
+		authorizeHistoryOwner(ctx, trashRepository, entity.TrashItemTypeMatch, id, func() (string, error) {
+			match, err := repository.FindById(ctx.Request.Context(), id)
+			if err != nil {
+				return "", err
+			}
+
+			return match.UserId, nil
+		})
+	}
+}
+
 // MatchReorderAuthorizationMiddleware は record 単位の並び替え(書き込み操作)を
 // 対象とするため、private_flg に関わらず record の所有者以外は常に Forbidden とする。
 func MatchReorderAuthorizationMiddleware(recordRepository repository.RecordInterface) gin.HandlerFunc {
@@ -121,3 +139,13 @@ func MatchReorderAuthorizationMiddleware(recordRepository repository.RecordInter
 		}
 	}
 }
+
+// MatchAttachmentAuthorizationMiddleware は記録の写真と同じく、添付・削除を対戦結果の所有者にだけ許す。
+func MatchAttachmentAuthorizationMiddleware(repository repository.MatchInterface) gin.HandlerFunc {
+	return MatchAuthorizationMiddleware(repository)
+}
+
+// MatchConfirmationRequestAuthorizationMiddleware は結果の確認依頼を、対戦結果の所有者にだけ許す。
+func MatchConfirmationRequestAuthorizationMiddleware(repository repository.MatchInterface) gin.HandlerFunc {
+	return MatchAuthorizationMiddleware(repository)
+}
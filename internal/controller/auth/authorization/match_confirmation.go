@@ -0,0 +1,27 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// MatchConfirmationAuthorizationMiddleware は届いた確認依頼の一覧を本人しか見られないようにする。
+// 個々の依頼の閲覧・回答は、依頼の当事者かを usecase 側で確かめる。
+func MatchConfirmationAuthorizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid != id {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
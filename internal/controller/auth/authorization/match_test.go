@@ -24,6 +24,7 @@ func TestMatchAuthorizationMiddleware(t *testing.T) {
 	){
 		"MatchAuthorizationMiddleware":        test_MatchAuthorizationMiddleware,
 		"MatchReorderAuthorizationMiddleware": test_MatchReorderAuthorizationMiddleware,
+		"MatchHistoryAuthorizationMiddleware": test_MatchHistoryAuthorizationMiddleware,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -342,3 +343,111 @@ func test_MatchReorderAuthorizationMiddleware(t *testing.T) {
 		require.Equal(t, http.StatusOK, w.Code)
 	})
 }
+
+func test_MatchHistoryAuthorizationMiddleware(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+	mockTrashRepository := mock_repository.NewMockTrashInterface(mockCtrl)
+
+	newContext := func(t *testing.T, uid string, id string) (*gin.Context, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+
+		// Middlewareのテストのためuidをセット
+		helper.SetUID(ginContext, uid)
+
+		// idが必要なMiddlewareのテストのためパスパラメータを追加
+		ginContext.Params = append(
+			ginContext.Params,
+			gin.Param{
+				Key:   "id",
+				Value: id,
+			},
+		)
+
+		// Middlewareのテストのためpathは何でもよい
+		req, err := http.NewRequest("GET", "/", nil)
+		require.NoError(t, err)
+
+		ginContext.Request = req
+
+		return ginContext, w
+	}
+
+	t.Run("正常系_削除済みでも所有者なら通過する", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		ginContext, w := newContext(t, uid, id)
+
+		gomock.InOrder(
+			mockRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound),
+			mockTrashRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeMatch, id).Return(&entity.TrashItem{
+				ID:     id,
+				Type:   entity.TrashItemTypeMatch,
+				UserId: uid,
+			}, nil),
+		)
+
+		middleware := MatchHistoryAuthorizationMiddleware(mockRepository, mockTrashRepository)
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系_削除済みの他人の対戦結果なら403を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		ginContext, w := newContext(t, "zor5SLfEfwfZ90yRVXzlxBEFARy2", id)
+
+		gomock.InOrder(
+			mockRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound),
+			mockTrashRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeMatch, id).Return(&entity.TrashItem{
+				ID:     id,
+				Type:   entity.TrashItemTypeMatch,
+				UserId: "other",
+			}, nil),
+		)
+
+		middleware := MatchHistoryAuthorizationMiddleware(mockRepository, mockTrashRepository)
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_ゴミ箱にも無ければ404を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		ginContext, w := newContext(t, "zor5SLfEfwfZ90yRVXzlxBEFARy2", id)
+
+		gomock.InOrder(
+			mockRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound),
+			mockTrashRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeMatch, id).Return(nil, apperror.ErrRecordNotFound),
+		)
+
+		middleware := MatchHistoryAuthorizationMiddleware(mockRepository, mockTrashRepository)
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("異常系_ゴミ箱の取得エラーなら500を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		ginContext, w := newContext(t, "zor5SLfEfwfZ90yRVXzlxBEFARy2", id)
+
+		gomock.InOrder(
+			mockRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound),
+			mockTrashRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeMatch, id).Return(nil, errors.New("")),
+		)
+
+		middleware := MatchHistoryAuthorizationMiddleware(mockRepository, mockTrashRepository)
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,27 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// MemoSearchAuthorizationMiddleware はメモを本人しか検索できないようにする。
+// 非公開の記録のメモも検索対象になるため、他人のIDを指定された場合は必ず弾く。
+func MemoSearchAuthorizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid != id {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
@@ -3,23 +3,12 @@ package authorization
 import (
 	"github.com/gin-gonic/gin"
 
-	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
-	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
-func OpponentDeckUsageStatAuthorizationMiddleware() gin.HandlerFunc {
-	return func(ctx *gin.Context) {
-		id := helper.GetId(ctx)
-		uid := helper.GetUID(ctx)
-
-		if uid == "" {
-			apierror.ErrForbidden.JSON(ctx)
-			return
-		}
-
-		if uid != id {
-			apierror.ErrForbidden.JSON(ctx)
-			return
-		}
-	}
+// OpponentDeckUsageStatAuthorizationMiddleware は対戦相手のデッキの集計を本人とフレンドに見せる。
+// 自分のデッキの名前が並ぶデッキ使用率(deck_usage)と違い、非公開のデッキ名は含まれない。
+// フレンドに見せる集計からは、非公開の記録の対戦結果を除く(controller.OpponentDeckUsageStat)。
+func OpponentDeckUsageStatAuthorizationMiddleware(repository repository.UserRelationshipInterface) gin.HandlerFunc {
+	return FriendAuthorizationMiddleware(repository)
 }
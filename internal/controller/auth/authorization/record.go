@@ -6,6 +6,7 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
@@ -66,3 +67,32 @@ func RecordUpdateAuthorizationMiddleware(repository repository.RecordInterface)
 func RecordDeleteAuthorizationMiddleware(repository repository.RecordInterface) gin.HandlerFunc {
 	return RecordAuthorizationMiddleware(repository)
 }
+
+// RecordHistoryAuthorizationMiddleware は変更履歴に変更した人やリクエストが含まれるため、
+// private_flg に関わらず記録の所有者にだけ見せる。削除済み(ゴミ箱にある)記録も対象にする。
+func RecordHistoryAuthorizationMiddleware(repository repository.RecordInterface, trashRepository repository.TrashInterface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+
+		authorizeHistoryOwner(ctx, trashRepository, entity.TrashItemTypeRecord, id, func() (string, error) {
+			record, err := repository.FindById(ctx.Request.Context(), id)
+			if err != nil {
+				return "", err
+			}
+
+			return record.UserId, nil
+		})
+	}
+}
+
+// RecordAttachmentAuthorizationMiddleware は写真の添付・削除(書き込み操作)を対象とするため、
+// 記録の所有者にだけ許す。
+func RecordAttachmentAuthorizationMiddleware(repository repository.RecordInterface) gin.HandlerFunc {
+	return RecordAuthorizationMiddleware(repository)
+}
+
+// RecordTonamelMatchImportAuthorizationMiddleware は Tonamel からの対戦結果の取り込み(書き込み操作)を
+// 対象とするため、記録の所有者にだけ許す。
+func RecordTonamelMatchImportAuthorizationMiddleware(repository repository.RecordInterface) gin.HandlerFunc {
+	return RecordAuthorizationMiddleware(repository)
+}
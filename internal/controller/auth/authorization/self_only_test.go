@@ -17,10 +17,14 @@ func TestSelfOnlyAuthorizationMiddlewares(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	middlewares := map[string]gin.HandlerFunc{
-		"CalendarAuthorizationMiddleware":              CalendarAuthorizationMiddleware(),
-		"DeckUsageStatAuthorizationMiddleware":         DeckUsageStatAuthorizationMiddleware(),
-		"OldestRecordAuthorizationMiddleware":          OldestRecordAuthorizationMiddleware(),
-		"OpponentDeckUsageStatAuthorizationMiddleware": OpponentDeckUsageStatAuthorizationMiddleware(),
+		"CalendarAuthorizationMiddleware":          CalendarAuthorizationMiddleware(),
+		"CardStatAuthorizationMiddleware":          CardStatAuthorizationMiddleware(),
+		"DeckUsageStatAuthorizationMiddleware":     DeckUsageStatAuthorizationMiddleware(),
+		"MatchConfirmationAuthorizationMiddleware": MatchConfirmationAuthorizationMiddleware(),
+		"OldestRecordAuthorizationMiddleware":      OldestRecordAuthorizationMiddleware(),
+		"TrashAuthorizationMiddleware":             TrashAuthorizationMiddleware(),
+		"UserRelationshipAuthorizationMiddleware":  UserRelationshipAuthorizationMiddleware(),
+		"UserExportAuthorizationMiddleware":        UserExportAuthorizationMiddleware(),
 	}
 
 	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
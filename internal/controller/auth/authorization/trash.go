@@ -0,0 +1,28 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// TrashAuthorizationMiddleware はゴミ箱を本人しか見られないようにする。
+// 削除した非公開の記録やデッキも並ぶため、他人のIDを指定された場合は必ず弾く。
+// 復元の可否は、削除済みのものを引ける usecase 側で本人のものかを確かめる。
+func TrashAuthorizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid != id {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
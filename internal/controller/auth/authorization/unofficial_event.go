@@ -43,3 +43,14 @@ func UnofficialEventUpdateAuthorizationMiddleware(repository repository.Unoffici
 func UnofficialEventDeleteAuthorizationMiddleware(repository repository.UnofficialEventInterface) gin.HandlerFunc {
 	return UnofficialEventAuthorizationMiddleware(repository)
 }
+
+// UnofficialEventTournamentAuthorizationMiddleware は大会の運営(参加者の登録・組み合わせ・結果の入力)を
+// 自由形式イベントの作成者だけに許可する。
+func UnofficialEventTournamentAuthorizationMiddleware(repository repository.UnofficialEventInterface) gin.HandlerFunc {
+	return UnofficialEventAuthorizationMiddleware(repository)
+}
+
+// UnofficialEventShareAuthorizationMiddleware は参加コードの発行・取り消しを自由形式イベントの作成者だけに許可する。
+func UnofficialEventShareAuthorizationMiddleware(repository repository.UnofficialEventInterface) gin.HandlerFunc {
+	return UnofficialEventAuthorizationMiddleware(repository)
+}
@@ -0,0 +1,57 @@
+package authorization
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// UserRelationshipAuthorizationMiddleware はフレンド申請の一覧・フレンドの一覧・ブロックを
+// 本人しか扱えないようにする。
+func UserRelationshipAuthorizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid != id {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
+
+// FriendAuthorizationMiddleware はパスパラメータのユーザの記録・デッキ・統計を、本人と
+// フレンドにだけ見せる。フレンドに非公開のものを見せないのは各ハンドラで行う。
+func FriendAuthorizationMiddleware(repository repository.UserRelationshipInterface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if uid == "" {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+
+		if uid == id {
+			return
+		}
+
+		isFriend, err := repository.IsFriend(ctx.Request.Context(), uid, id)
+		if err != nil {
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		if !isFriend {
+			apierror.ErrForbidden.JSON(ctx)
+			return
+		}
+	}
+}
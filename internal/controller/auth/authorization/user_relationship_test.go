@@ -0,0 +1,101 @@
+package authorization
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func TestFriendAuthorizationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockUserRelationshipInterface(mockCtrl)
+
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+
+	// Middlewareのテストのためuid・パスパラメータを持たせたgin.Contextを組み立てる
+	setup := func(t *testing.T, uid string, id string) (*gin.Context, *httptest.ResponseRecorder) {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+
+		if uid != "" {
+			helper.SetUID(ginContext, uid)
+		}
+
+		ginContext.Params = append(
+			ginContext.Params,
+			gin.Param{
+				Key:   "id",
+				Value: id,
+			},
+		)
+
+		// Middlewareのテストのためpathは何でもよい
+		req, err := http.NewRequest("GET", "/", nil)
+		require.NoError(t, err)
+
+		ginContext.Request = req
+
+		return ginContext, w
+	}
+
+	t.Run("正常系_本人ならフレンドか確かめずに通過する", func(t *testing.T) {
+		ginContext, w := setup(t, uid, uid)
+
+		FriendAuthorizationMiddleware(mockRepository)(ginContext)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.False(t, ginContext.IsAborted())
+	})
+
+	t.Run("正常系_フレンドなら通過する", func(t *testing.T) {
+		ginContext, w := setup(t, otherId, uid)
+
+		mockRepository.EXPECT().IsFriend(gomock.Any(), otherId, uid).Return(true, nil)
+
+		FriendAuthorizationMiddleware(mockRepository)(ginContext)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.False(t, ginContext.IsAborted())
+	})
+
+	t.Run("異常系_未認証なら403を返す", func(t *testing.T) {
+		ginContext, w := setup(t, "", uid)
+
+		FriendAuthorizationMiddleware(mockRepository)(ginContext)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_フレンドでなければ403を返す", func(t *testing.T) {
+		ginContext, w := setup(t, otherId, uid)
+
+		mockRepository.EXPECT().IsFriend(gomock.Any(), otherId, uid).Return(false, nil)
+
+		FriendAuthorizationMiddleware(mockRepository)(ginContext)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_取得エラーなら500を返す", func(t *testing.T) {
+		ginContext, w := setup(t, otherId, uid)
+
+		mockRepository.EXPECT().IsFriend(gomock.Any(), otherId, uid).Return(false, errors.New(""))
+
+		FriendAuthorizationMiddleware(mockRepository)(ginContext)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
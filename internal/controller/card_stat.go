@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	CardStatsPath = "/cards"
+)
+
+type CardStat struct {
+	router  *gin.Engine
+	usecase usecase.CardStatInterface
+}
+
+func NewCardStat(
+	router *gin.Engine,
+	usecase usecase.CardStatInterface,
+) *CardStat {
+	return &CardStat{router, usecase}
+}
+
+func (c *CardStat) RegisterRoute(relativePath string) {
+	r := c.router.Group(relativePath + UsersPath)
+	// 期間・レギュレーションの指定は /users/:id/stats と同じものを受け付ける
+	r.GET(
+		"/:id"+UserStatsPath+CardStatsPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.CardStatAuthorizationMiddleware(),
+		validation.UserStatGetMiddleware(),
+		c.GetByUserId,
+	)
+}
+
+func (c *CardStat) GetByUserId(ctx *gin.Context) {
+	uid := helper.GetId(ctx)
+	yearMonth := helper.GetYearMonth(ctx)
+	environmentId := helper.GetEnvironmentId(ctx)
+	season := helper.GetSeason(ctx)
+	standardRegulationId := helper.GetStandardRegulationId(ctx)
+	regulationId := helper.GetRegulationId(ctx)
+
+	stat, err := c.usecase.GetUserCardStat(ctx.Request.Context(), uid, yearMonth, environmentId, season, standardRegulationId, regulationId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewCardStatResponse(stat, yearMonth, environmentId, season, standardRegulationId, regulationId)
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestCardStatController(t *testing.T) (*CardStat, *mock_usecase.MockCardStatInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockCardStatInterface(mockCtrl)
+
+	r := gin.Default()
+	// /users/:id/stats 配下に同居させるため、UserStat のルートと衝突しないことも確かめる
+	NewUserStat(
+		r,
+		mock_usecase.NewMockUserStatInterface(mockCtrl),
+		mock_usecase.NewMockUserStatHistoryInterface(mockCtrl),
+		mock_usecase.NewMockUserStatRecentInterface(mockCtrl),
+	).RegisterRoute("")
+	c := NewCardStat(r, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestCardStatController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+
+	t.Run("GetByUserId", func(t *testing.T) {
+		t.Run("正常系_集計条件をユースケースへ渡してカードごとの成績を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestCardStatController(t)
+
+			stat := entity.CalculateUserCardStat(
+				uid,
+				[]*entity.CardStatDeckCodeAggregate{entity.NewCardStatDeckCodeAggregate("v1", 2, 1, 0, 2, 1, 1, 0)},
+				[]*entity.DeckCodeCard{entity.NewDeckCodeCard("v1", 1, "ピカチュウex", entity.DeckCardCategoryPokemon, 2)},
+			)
+
+			mockUsecase.EXPECT().GetUserCardStat(gomock.Any(), uid, "2026-07", "", "", "", uint(1)).Return(stat, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+UserStatsPath+CardStatsPath+"?year_month=2026-07&regulation_id=1", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.CardStatResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, uint(2), res.TotalMatches)
+			require.Len(t, res.Cards, 1)
+			require.Equal(t, uint(2), res.Cards[0].With.MatchCount)
+			require.Equal(t, 0.5, res.Cards[0].With.WinRate)
+		})
+
+		t.Run("異常系_year_monthの形式が不正なら400を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestCardStatController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+UserStatsPath+CardStatsPath+"?year_month=abc", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestCardStatController(t)
+
+			mockUsecase.EXPECT().GetUserCardStat(gomock.Any(), uid, "", "", "", "", uint(0)).Return(nil, errors.New(""))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+UserStatsPath+CardStatsPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusInternalServerError, w.Code)
+		})
+
+		t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
+			c, _, _ := setup4TestCardStatController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+UserStatsPath+CardStatsPath, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+
+		t.Run("異常系_他人の統計は403を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestCardStatController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+UserStatsPath+CardStatsPath, nil)
+			setJWTAuthHeader(t, req, "KBp7roRDZobZg1t0OPzFR1kvLeO2", secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
 	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/usecase"
 )
@@ -23,11 +25,12 @@ const (
 )
 
 type Deck struct {
-	logger           *slog.Logger
-	router           *gin.Engine
-	deckRepository   repository.DeckInterface
-	recordRepository repository.RecordInterface
-	usecase          usecase.DeckInterface
+	logger                 *slog.Logger
+	router                 *gin.Engine
+	deckRepository         repository.DeckInterface
+	recordRepository       repository.RecordInterface
+	relationshipRepository repository.UserRelationshipInterface
+	usecase                usecase.DeckInterface
 }
 
 func NewDeck(
@@ -35,9 +38,10 @@ func NewDeck(
 	router *gin.Engine,
 	deckRepository repository.DeckInterface,
 	recordRepository repository.RecordInterface,
+	relationshipRepository repository.UserRelationshipInterface,
 	usecase usecase.DeckInterface,
 ) *Deck {
-	return &Deck{logger, router, deckRepository, recordRepository, usecase}
+	return &Deck{logger, router, deckRepository, recordRepository, relationshipRepository, usecase}
 }
 
 func (c *Deck) RegisterRoute(relativePath string) {
@@ -103,6 +107,15 @@ func (c *Deck) RegisterRoute(relativePath string) {
 		authorization.DeckDeleteAuthorizationMiddleware(c.deckRepository, c.recordRepository),
 		c.Delete,
 	)
+
+	// 他のユーザのデッキの一覧は、本人とフレンドだけが見られる。
+	c.router.GET(
+		relativePath+UsersPath+"/:id"+DecksPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.FriendAuthorizationMiddleware(c.relationshipRepository),
+		validation.UserDeckGetMiddleware(),
+		c.GetVisibleByUserId,
+	)
 }
 
 func (c *Deck) Get(ctx *gin.Context) {
@@ -202,6 +215,37 @@ func (c *Deck) GetByUserId(ctx *gin.Context) {
 	}
 }
 
+// GetVisibleByUserId はパスパラメータのユーザのアーカイブしていないデッキを返す。
+// フレンドには非公開のデッキを返さない。
+func (c *Deck) GetVisibleByUserId(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+
+	var decks []*entity.Deck
+	var err error
+	if uid == userId {
+		decks, err = c.usecase.FindByUserId(ctx.Request.Context(), userId, false, limit, offset)
+	} else {
+		decks, err = c.usecase.FindPublicByUserId(ctx.Request.Context(), userId, limit, offset)
+	}
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	for _, deck := range decks {
+		if deck.LatestDeckCode.PrivateCodeFlg && uid != deck.LatestDeckCode.UserId {
+			deck.LatestDeckCode.Code = ""
+		}
+	}
+
+	res := presenter.NewDeckGetByUserIdResponse(false, limit, offset, time.Time{}, decks)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (c *Deck) GetById(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 	uid := helper.GetUID(ctx)
@@ -223,6 +267,7 @@ func (c *Deck) GetById(ctx *gin.Context) {
 
 	res := presenter.NewDeckGetByIdResponse(deck)
 
+	helper.SetETag(ctx, deck.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -243,6 +288,7 @@ func (c *Deck) Create(ctx *gin.Context) {
 		req.Name,
 		req.PrivateFlg,
 		req.DeckCode,
+		req.DeckList,
 		req.PrivateDeckCodeFlg,
 		pokemonSprites,
 		req.TagIds,
@@ -256,6 +302,12 @@ func (c *Deck) Create(ctx *gin.Context) {
 			return
 		}
 
+		// デッキリストの解釈に失敗した行は、直せるようにメッセージごと返す
+		if errors.Is(err, apperror.ErrInvalidDeckList) {
+			apierror.New(http.StatusBadRequest, err).JSON(ctx, err)
+			return
+		}
+
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
 	}
@@ -284,14 +336,26 @@ func (c *Deck) Update(ctx *gin.Context) {
 		req.TagIds,
 	)
 
-	deck, err := c.usecase.Update(ctx.Request.Context(), id, param)
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	deck, err := c.usecase.Update(reqCtx, id, param)
 	if err != nil {
+		// 読み込んだ版から他の端末で更新されていた場合は 412。
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
 	}
 
 	res := presenter.NewDeckUpdateResponse(deck)
 
+	helper.SetETag(ctx, deck.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -354,11 +418,21 @@ func (c *Deck) Unfavorite(ctx *gin.Context) {
 func (c *Deck) Delete(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 
-	if err := c.usecase.Delete(ctx.Request.Context(), id); err != nil {
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	if err := c.usecase.Delete(reqCtx, id); err != nil {
 		if err == apperror.ErrRecordNotFound {
 			apierror.ErrBadRequestNotFound.JSON(ctx, err)
 			return
 		}
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
@@ -28,6 +28,8 @@ type DeckCode struct {
 	deckcodeRepository repository.DeckCodeInterface
 	recordRepository   repository.RecordInterface
 	usecase            usecase.DeckCodeInterface
+	legality           usecase.DeckLegalityInterface
+	deckAssetJob       usecase.DeckAssetJobInterface
 }
 
 func NewDeckCode(
@@ -36,8 +38,10 @@ func NewDeckCode(
 	deckcodeRepository repository.DeckCodeInterface,
 	recordRepository repository.RecordInterface,
 	usecase usecase.DeckCodeInterface,
+	legality usecase.DeckLegalityInterface,
+	deckAssetJob usecase.DeckAssetJobInterface,
 ) *DeckCode {
-	return &DeckCode{logger, router, deckcodeRepository, recordRepository, usecase}
+	return &DeckCode{logger, router, deckcodeRepository, recordRepository, usecase, legality, deckAssetJob}
 }
 
 func (c *DeckCode) RegisterRoute(relativePath string) {
@@ -48,6 +52,32 @@ func (c *DeckCode) RegisterRoute(relativePath string) {
 			authentication.OptionalAuthenticationMiddleware(),
 			c.GetById,
 		)
+		r.GET(
+			"/:id/cards",
+			authentication.OptionalAuthenticationMiddleware(),
+			authorization.DeckCodeCardsAuthorizationMiddleware(c.deckcodeRepository),
+			c.GetCardsById,
+		)
+		r.GET(
+			"/:id/export",
+			authentication.OptionalAuthenticationMiddleware(),
+			authorization.DeckCodeCardsAuthorizationMiddleware(c.deckcodeRepository),
+			validation.DeckCodeExportGetMiddleware(),
+			c.Export,
+		)
+		r.GET(
+			"/:id/legality",
+			authentication.OptionalAuthenticationMiddleware(),
+			authorization.DeckCodeLegalityAuthorizationMiddleware(c.deckcodeRepository),
+			validation.DeckCodeLegalityGetMiddleware(),
+			c.GetLegalityById,
+		)
+		r.GET(
+			"/:id/assets",
+			authentication.OptionalAuthenticationMiddleware(),
+			authorization.DeckCodeAssetsAuthorizationMiddleware(c.deckcodeRepository),
+			c.GetAssetsById,
+		)
 		r.POST(
 			"",
 			authentication.RequiredAuthenticationMiddleware(),
@@ -76,6 +106,12 @@ func (c *DeckCode) RegisterRoute(relativePath string) {
 			authentication.OptionalAuthenticationMiddleware(),
 			c.GetByDeckId,
 		)
+		r.GET(
+			"/:id"+DeckCodesPath+"/diff",
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.DeckCodeDiffAuthorizationMiddleware(c.deckcodeRepository),
+			c.Diff,
+		)
 	}
 }
 
@@ -103,6 +139,88 @@ func (c *DeckCode) GetById(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+func (c *DeckCode) GetCardsById(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	deckcode, err := c.usecase.FindByIdWithCards(ctx.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewDeckCodeCardsResponse(deckcode)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// Export はカード構成をデッキリストとして返す。format=text はそのまま取り込み直せる
+// テキスト、format=json は GetCardsById と同じ形。
+func (c *DeckCode) Export(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	format := helper.GetFormat(ctx)
+
+	deckcode, err := c.usecase.FindByIdWithCards(ctx.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	if format == "json" {
+		ctx.JSON(http.StatusOK, presenter.NewDeckCodeCardsResponse(deckcode))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(presenter.NewDeckListText(deckcode)))
+}
+
+func (c *DeckCode) GetLegalityById(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	regulationId := helper.GetRegulationId(ctx)
+	date := helper.GetDate(ctx)
+
+	legality, err := c.legality.Check(ctx.Request.Context(), id, regulationId, date)
+	if err != nil {
+		// 公式サイトの結果ページが未取得でカード構成が分からない場合も含む
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewDeckLegalityResponse(legality)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// GetAssetsById はデッキコードのリソース(結果HTML・画像)の取得状況を返す。
+// 取得は登録後にワーカーが非同期に行うため、webapp はこれで「画像を準備中」等を出し分ける。
+func (c *DeckCode) GetAssetsById(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	jobs, err := c.deckAssetJob.FindByDeckCodeId(ctx.Request.Context(), id)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewDeckAssetsResponse(id, jobs)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (c *DeckCode) GetByDeckId(ctx *gin.Context) {
 	deckId := helper.GetId(ctx)
 	uid := helper.GetUID(ctx)
@@ -129,6 +247,26 @@ func (c *DeckCode) GetByDeckId(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+func (c *DeckCode) Diff(ctx *gin.Context) {
+	fromId := helper.GetQueryFrom(ctx)
+	toId := helper.GetQueryTo(ctx)
+
+	diff, err := c.usecase.Diff(ctx.Request.Context(), fromId, toId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewDeckCodeDiffResponse(diff)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (c *DeckCode) Create(ctx *gin.Context) {
 	req := helper.GetDeckCodeCreateRequest(ctx)
 	uid := helper.GetUID(ctx)
@@ -137,6 +275,7 @@ func (c *DeckCode) Create(ctx *gin.Context) {
 		uid,
 		req.DeckId,
 		req.Code,
+		req.DeckList,
 		req.PrivateCodeFlg,
 		req.Memo,
 		req.TagIds,
@@ -144,6 +283,12 @@ func (c *DeckCode) Create(ctx *gin.Context) {
 
 	deckcode, err := c.usecase.Create(ctx.Request.Context(), param)
 	if err != nil {
+		// デッキリストの解釈に失敗した行は、直せるようにメッセージごと返す
+		if errors.Is(err, apperror.ErrInvalidDeckList) {
+			apierror.New(http.StatusBadRequest, err).JSON(ctx, err)
+			return
+		}
+
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
 	"github.com/vsrecorder/core-apiserver/internal/testutil"
 	"github.com/vsrecorder/core-apiserver/internal/usecase"
 )
@@ -29,6 +31,7 @@ import (
 type stubDeckCodeUsecase struct {
 	deckCode  *entity.DeckCode
 	deckCodes []*entity.DeckCode
+	diff      *entity.DeckCodeDiff
 	err       error
 }
 
@@ -40,6 +43,14 @@ func (s stubDeckCodeUsecase) FindByDeckId(ctx context.Context, deckId string) ([
 	return s.deckCodes, s.err
 }
 
+func (s stubDeckCodeUsecase) FindByIdWithCards(ctx context.Context, id string) (*entity.DeckCode, error) {
+	return s.deckCode, s.err
+}
+
+func (s stubDeckCodeUsecase) Diff(ctx context.Context, fromId string, toId string) (*entity.DeckCodeDiff, error) {
+	return s.diff, s.err
+}
+
 func (s stubDeckCodeUsecase) Create(ctx context.Context, param *usecase.DeckCodeCreateParam) (*entity.DeckCode, error) {
 	return s.deckCode, s.err
 }
@@ -69,15 +80,27 @@ func setup4TestDeckCodeController(t *testing.T, u stubDeckCodeUsecase) (
 	mockCtrl := gomock.NewController(t)
 	mockDeckCodeRepository := mock_repository.NewMockDeckCodeInterface(mockCtrl)
 	mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+	mockLegality := mock_usecase.NewMockDeckLegalityInterface(mockCtrl)
+	mockDeckAssetJob := mock_usecase.NewMockDeckAssetJobInterface(mockCtrl)
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	r := gin.Default()
-	c := NewDeckCode(logger, r, mockDeckCodeRepository, mockRecordRepository, u)
+	c := NewDeckCode(logger, r, mockDeckCodeRepository, mockRecordRepository, u, mockLegality, mockDeckAssetJob)
 	c.RegisterRoute("")
 
 	return c, mockDeckCodeRepository, mockRecordRepository, secretKey
 }
 
+// mockDeckLegality は setup4TestDeckCodeController で組み込んだ判定ユースケースのモックを返す。
+func mockDeckLegality(c *DeckCode) *mock_usecase.MockDeckLegalityInterface {
+	return c.legality.(*mock_usecase.MockDeckLegalityInterface)
+}
+
+// mockDeckAssetJob は setup4TestDeckCodeController で組み込んだジョブのユースケースのモックを返す。
+func mockDeckAssetJob(c *DeckCode) *mock_usecase.MockDeckAssetJobInterface {
+	return c.deckAssetJob.(*mock_usecase.MockDeckAssetJobInterface)
+}
+
 func newTestDeckCodeEntity(id string, uid string, privateCodeFlg bool) *entity.DeckCode {
 	return entity.NewDeckCode(
 		id, time.Now().Local(), uid, "01HD7Y3K8D6FDHMHTZ2GT41TD1", "5dbFbk-uBwjqP-VVk5Vv", privateCodeFlg, "メモ",
@@ -143,6 +166,327 @@ func TestDeckCodeController(t *testing.T) {
 		})
 	})
 
+	t.Run("GetCardsById", func(t *testing.T) {
+		newDeckCodeWithCards := func(privateCodeFlg bool) *entity.DeckCode {
+			deckcode := newTestDeckCodeEntity(id, uid, privateCodeFlg)
+			deckcode.Cards = []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(id, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+				entity.NewDeckCodeCard(id, 44560, "基本雷エネルギー", entity.DeckCardCategoryEnergy, 8),
+			}
+			return deckcode
+		}
+
+		t.Run("正常系_公開デッキコードのカード構成を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{deckCode: newDeckCodeWithCards(false)})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/cards", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckCodeCardsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, id, res.DeckCodeId)
+			require.Equal(t, uint(10), res.TotalCount)
+			require.Len(t, res.Cards, 2)
+			require.Equal(t, "pokemon", res.Cards[0].Category)
+		})
+
+		t.Run("正常系_非公開デッキコードでも本人には返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{deckCode: newDeckCodeWithCards(true)})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/cards", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+
+		t.Run("異常系_他人の非公開デッキコードはカード構成を読まずに403を返す", func(t *testing.T) {
+			// カード構成を読む経路(ユースケース)に進めば解釈・保存が走るため、エラーを仕込んでおく
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{err: errors.New("must not be called")})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/cards", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_存在しないIDは404を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/cards", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		newDeckCodeWithCards := func(privateCodeFlg bool) *entity.DeckCode {
+			deckcode := newTestDeckCodeEntity(id, uid, privateCodeFlg)
+			deckcode.Cards = []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(id, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+				entity.NewDeckCodeCard(id, 46001, "ナンジャモ", entity.DeckCardCategorySupporter, 4),
+				entity.NewDeckCodeCard(id, 44560, "基本雷エネルギー", entity.DeckCardCategoryEnergy, 8),
+			}
+			return deckcode
+		}
+
+		t.Run("正常系_区分ごとのテキストで返し取り込み直せる", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{deckCode: newDeckCodeWithCards(false)})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/export", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+			require.Equal(t, "ポケモン (2)\n2 ピカチュウex\n\nサポート (4)\n4 ナンジャモ\n\nエネルギー (8)\n8 基本雷エネルギー\n", w.Body.String())
+
+			lines, err := entity.ParseDeckList(w.Body.String())
+			require.NoError(t, err)
+			require.Len(t, lines, 3)
+			require.Equal(t, entity.DeckCardCategorySupporter, lines[1].Category)
+		})
+
+		t.Run("正常系_format=jsonならカード構成のJSONを返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{deckCode: newDeckCodeWithCards(false)})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/export?format=json", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckCodeCardsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, uint(14), res.TotalCount)
+		})
+
+		t.Run("異常系_未知のformatは400を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{deckCode: newDeckCodeWithCards(false)})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/export?format=csv", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_他人の非公開デッキコードはカード構成を読まずに403を返す", func(t *testing.T) {
+			// カード構成を読む経路(ユースケース)に進めば解釈・保存が走るため、エラーを仕込んでおく
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{err: errors.New("must not be called")})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/export", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("GetLegalityById", func(t *testing.T) {
+		date := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+		legality := &entity.DeckLegality{
+			DeckCodeId:         id,
+			RegulationId:       entity.RegulationIdStandard,
+			Date:               date,
+			StandardRegulation: entity.NewStandardRegulation("HIJ", "H・I・J", date, date.AddDate(1, 0, 0)),
+			IllegalCards: []*entity.DeckIllegalCard{
+				{CardId: 1, CardName: "クイックボール", RegulationMark: "G", Count: 4, Reason: entity.DeckIllegalReasonRotated},
+			},
+			UncheckedCardIds: []uint{},
+		}
+
+		t.Run("正常系_日付とレギュレーションを指定して判定結果を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckLegality(c).EXPECT().Check(gomock.Any(), id, entity.RegulationIdStandard, date).Return(legality, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/legality?date=2026-02-01&regulation_id=1", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckLegalityResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.False(t, res.Legal)
+			require.Equal(t, "HIJ", res.StandardRegulationId)
+			require.Len(t, res.IllegalCards, 1)
+			require.Equal(t, "rotated", res.IllegalCards[0].Reason)
+		})
+
+		t.Run("正常系_日付とレギュレーションを省略するとゼロ値で判定する", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckLegality(c).EXPECT().Check(gomock.Any(), id, uint(0), time.Time{}).Return(legality, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/legality", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+
+		t.Run("異常系_日付の形式が不正なら400を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/legality?date=20260201", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_他人の非公開デッキコードは403を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/legality", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_カード構成が取得できなければ404を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckLegality(c).EXPECT().Check(gomock.Any(), id, uint(0), time.Time{}).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/legality", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("GetAssetsById", func(t *testing.T) {
+		t.Run("正常系_種類ごとの取得状況を返しジョブの無い種類はnoneにする", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+			job := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR1", now, id, "5dbFbk-uBwjqP-VVk5Vv", entity.DeckAssetJobKindImage)
+			job.Attempts = 1
+			job.Fail(now, errors.New("timeout"))
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckAssetJob(c).EXPECT().FindByDeckCodeId(gomock.Any(), id).Return([]*entity.DeckAssetJob{job}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/assets", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckAssetsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, id, res.DeckCodeId)
+			require.Len(t, res.Assets, 2)
+			require.Equal(t, "result_html", res.Assets[0].Kind)
+			require.Equal(t, "none", res.Assets[0].Status)
+			require.Nil(t, res.Assets[0].NextRunAt)
+			require.Equal(t, "image", res.Assets[1].Kind)
+			require.Equal(t, "pending", res.Assets[1].Status)
+			require.Equal(t, uint(1), res.Assets[1].Attempts)
+			require.NotNil(t, res.Assets[1].NextRunAt)
+			require.True(t, job.NextRunAt.Equal(*res.Assets[1].NextRunAt))
+			require.False(t, res.DeckCodeInvalid)
+		})
+
+		t.Run("正常系_デッキコードが正しくないと分かったら知らせる", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+			htmlJob := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR1", now, id, "5dbFbk-uBwjqP-VVk5Vv", entity.DeckAssetJobKindResultHTML)
+			htmlJob.Attempts = 1
+			htmlJob.Invalidate(now, apperror.ErrDeckCodeInvalid)
+			imageJob := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR2", now, id, "5dbFbk-uBwjqP-VVk5Vv", entity.DeckAssetJobKindImage)
+			imageJob.Invalidate(now, apperror.ErrDeckCodeInvalid)
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckAssetJob(c).EXPECT().FindByDeckCodeId(gomock.Any(), id).Return([]*entity.DeckAssetJob{htmlJob, imageJob}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/assets", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckAssetsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.True(t, res.DeckCodeInvalid)
+			require.Equal(t, "invalid", res.Assets[0].Status)
+			require.Equal(t, "invalid", res.Assets[1].Status)
+		})
+
+		t.Run("異常系_他人の非公開デッキコードは403を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/assets", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_存在しないデッキコードは404を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/assets", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+
+		t.Run("異常系_取得状況の取得に失敗したら500を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, _ := setup4TestDeckCodeController(t, stubDeckCodeUsecase{})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), id).Return(newTestDeckCodeEntity(id, uid, false), nil)
+			mockDeckAssetJob(c).EXPECT().FindByDeckCodeId(gomock.Any(), id).Return(nil, errors.New(""))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DeckCodesPath+"/"+id+"/assets", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusInternalServerError, w.Code)
+		})
+	})
+
 	t.Run("GetByDeckId", func(t *testing.T) {
 		t.Run("正常系_他人の非公開デッキコードだけが伏せられる", func(t *testing.T) {
 			deckCodes := []*entity.DeckCode{
@@ -176,6 +520,113 @@ func TestDeckCodeController(t *testing.T) {
 		})
 	})
 
+	t.Run("Diff", func(t *testing.T) {
+		deckId := "01HD7Y3K8D6FDHMHTZ2GT41TD1"
+		fromId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+		toId := "01HD7Y3K8D6FDHMHTZ2GT41TC2"
+		path := DecksPath + "/" + deckId + DeckCodesPath + "/diff?from=" + fromId + "&to=" + toId
+
+		newDiff := func() *entity.DeckCodeDiff {
+			from := newTestDeckCodeEntity(fromId, uid, false)
+			from.Cards = []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(fromId, 1, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+				entity.NewDeckCodeCard(fromId, 2, "ネストボール", entity.DeckCardCategoryGoods, 4),
+			}
+			to := newTestDeckCodeEntity(toId, uid, false)
+			to.Cards = []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(toId, 1, "ピカチュウex", entity.DeckCardCategoryPokemon, 3),
+				entity.NewDeckCodeCard(toId, 3, "基本雷エネルギー", entity.DeckCardCategoryEnergy, 8),
+			}
+			return entity.NewDeckCodeDiff(
+				from,
+				to,
+				entity.NewDeckCodeStat(fromId, 1, 3, 1, 2, 0, 1.0/3),
+				entity.NewDeckCodeStat(toId, 1, 3, 3, 0, 0, 1),
+			)
+		}
+
+		t.Run("正常系_大分類ごとに差分を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{diff: newDiff()})
+
+			// DeckCodeDiffAuthorizationMiddlewareが from / to の本人確認のために参照する
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), fromId).Return(&entity.DeckCode{ID: fromId, UserId: uid, DeckId: deckId}, nil)
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), toId).Return(&entity.DeckCode{ID: toId, UserId: uid, DeckId: deckId}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.DeckCodeDiffResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, res.Pokemon.Changed, 1)
+			require.Len(t, res.Trainer.Removed, 1)
+			require.Len(t, res.Energy.Added, 1)
+			require.Empty(t, res.Trainer.Added)
+			require.Equal(t, uint(3), res.To.Stat.WinCount)
+		})
+
+		t.Run("異常系_別のデッキのデッキコードは404を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{diff: newDiff()})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), fromId).Return(&entity.DeckCode{ID: fromId, UserId: uid, DeckId: "01HD7Y3K8D6FDHMHTZ2GT41TD9"}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+
+		t.Run("異常系_他人のデッキコードは403を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{diff: newDiff()})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), fromId).Return(&entity.DeckCode{ID: fromId, UserId: "KBp7roRDZobZg1t0OPzFR1kvLeO2", DeckId: deckId}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_toの指定が無ければ400を返す", func(t *testing.T) {
+			c, mockDeckCodeRepository, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{diff: newDiff()})
+
+			mockDeckCodeRepository.EXPECT().FindById(gomock.Any(), fromId).Return(&entity.DeckCode{ID: fromId, UserId: uid, DeckId: deckId}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", DecksPath+"/"+deckId+DeckCodesPath+"/diff?from="+fromId, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("異常系_解釈できないデッキリストは行番号付きのメッセージで400を返す", func(t *testing.T) {
+			c, _, _, secretKey := setup4TestDeckCodeController(t, stubDeckCodeUsecase{
+				err: fmt.Errorf("%w: %v", apperror.ErrInvalidDeckList, &entity.DeckListError{LineNumber: 2, Message: "unknown card"}),
+			})
+
+			b, err := json.Marshal(dto.DeckCodeCreateRequest{DeckId: "01HD7Y3K8D6FDHMHTZ2GT41TD1", DeckList: "4 ナンジャモ\n1 未知のカード"})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", DeckCodesPath, strings.NewReader(string(b)))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "line 2: unknown card")
+		})
+	})
+
 	t.Run("Update", func(t *testing.T) {
 		newRequestBody := func(t *testing.T) *http.Request {
 			t.Helper()
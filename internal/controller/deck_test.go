@@ -48,7 +48,7 @@ func setup4TestDeckController(t *testing.T, r *gin.Engine) (
 	mockDeckRepository, mockRecordRepository, mockUsecase := setupMock4TestDeckController(t)
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-	c := NewDeck(logger, r, mockDeckRepository, mockRecordRepository, mockUsecase)
+	c := NewDeck(logger, r, mockDeckRepository, mockRecordRepository, mock_repository.NewMockUserRelationshipInterface(gomock.NewController(t)), mockUsecase)
 	c.RegisterRoute("")
 
 	return c, mockDeckRepository, mockRecordRepository, mockUsecase
@@ -86,19 +86,37 @@ func newTestDeck(id string, uid string, deckCode string, privateCodeFlg bool) *e
 	}
 }
 
+// setup4TestDeckControllerWithRelationship はフレンドへの公開を検証するテスト向けに、
+// ユーザ同士の関係のリポジトリのモックも返す。
+func setup4TestDeckControllerWithRelationship(t *testing.T, r *gin.Engine) (
+	*Deck,
+	*mock_repository.MockUserRelationshipInterface,
+	*mock_usecase.MockDeckInterface,
+) {
+	mockDeckRepository, mockRecordRepository, mockUsecase := setupMock4TestDeckController(t)
+	mockRelationshipRepository := mock_repository.NewMockUserRelationshipInterface(gomock.NewController(t))
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c := NewDeck(logger, r, mockDeckRepository, mockRecordRepository, mockRelationshipRepository, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockRelationshipRepository, mockUsecase
+}
+
 func TestDeckController(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	for scenario, fn := range map[string]func(t *testing.T){
-		"Get":         test_DeckController_Get,
-		"GetAll":      test_DeckController_GetAll,
-		"GetById":     test_DeckController_GetById,
-		"GetByUserId": test_DeckController_GetByUserId,
-		"Create":      test_DeckController_Create,
-		"Update":      test_DeckController_Update,
-		"Archive":     test_DeckController_Archive,
-		"Unarchive":   test_DeckController_Unarchive,
-		"Delete":      test_DeckController_Delete,
+		"Get":                test_DeckController_Get,
+		"GetAll":             test_DeckController_GetAll,
+		"GetById":            test_DeckController_GetById,
+		"GetByUserId":        test_DeckController_GetByUserId,
+		"GetVisibleByUserId": test_DeckController_GetVisibleByUserId,
+		"Create":             test_DeckController_Create,
+		"Update":             test_DeckController_Update,
+		"Archive":            test_DeckController_Archive,
+		"Unarchive":          test_DeckController_Unarchive,
+		"Delete":             test_DeckController_Delete,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -426,6 +444,76 @@ func test_DeckController_GetById(t *testing.T) {
 	})
 }
 
+func test_DeckController_GetVisibleByUserId(t *testing.T) {
+	r := gin.Default()
+
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	friendId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	c, mockRelationshipRepository, mockUsecase := setup4TestDeckControllerWithRelationship(t, r)
+
+	t.Run("正常系_本人には非公開のデッキも返す", func(t *testing.T) {
+		decks := []*entity.Deck{
+			newTestDeck("01HD7Y3K8D6FDHMHTZ2GT41TN2", uid, "5dbFbk-uBwjqP-VVk5Vv", true),
+		}
+
+		mockUsecase.EXPECT().FindByUserId(gomock.Any(), uid, false, 10, 0).Return(decks, nil)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+DecksPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.DeckGetByUserIdResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res.Decks, 1)
+		require.Equal(t, "5dbFbk-uBwjqP-VVk5Vv", res.Decks[0].Data.LatestDeckCode.Code)
+	})
+
+	t.Run("正常系_フレンドには公開のデッキだけを非公開のデッキコードを伏せて返す", func(t *testing.T) {
+		decks := []*entity.Deck{
+			newTestDeck("01HD7Y3K8D6FDHMHTZ2GT41TN2", uid, "5dbFbk-uBwjqP-VVk5Vv", true),
+		}
+
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), friendId, uid).Return(true, nil)
+		mockUsecase.EXPECT().FindPublicByUserId(gomock.Any(), uid, 10, 0).Return(decks, nil)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+DecksPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, friendId, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.DeckGetByUserIdResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res.Decks, 1)
+		require.Empty(t, res.Decks[0].Data.LatestDeckCode.Code)
+	})
+
+	t.Run("異常系_フレンドでなければ403を返す", func(t *testing.T) {
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), friendId, uid).Return(false, nil)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+DecksPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, friendId, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
 func test_DeckController_GetByUserId(t *testing.T) {
 	r := gin.Default()
 
@@ -605,6 +693,7 @@ func test_DeckController_Create(t *testing.T) {
 			"テストデッキ",
 			false,
 			"",
+			"",
 			false,
 			nil,
 			nil,
@@ -655,6 +744,7 @@ func test_DeckController_Create(t *testing.T) {
 			"テストデッキ",
 			true,
 			"",
+			"",
 			false,
 			[]*usecase.PokemonSpriteParam{usecase.NewPokemonSpriteParam("pikachu")},
 			nil,
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type AttachmentResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// ContentType・Size・Width・Height は保存した本体の値。アップロードしたファイルは
+	// EXIF を除いて JPEG に作り直すため、元のファイルとは一致しない。
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	// URL・ThumbnailURL は本体・サムネイルの取得先。記録・対戦結果と同じ閲覧権限が必要な
+	// APIのパスで、ストレージの公開URLではない。
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+type AttachmentGetResponse struct {
+	Attachments []*AttachmentResponse `json:"attachments"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+type CardStatSplitResponse struct {
+	MatchCount      uint    `json:"match_count"`
+	Wins            uint    `json:"wins"`
+	Losses          uint    `json:"losses"`
+	Draws           uint    `json:"draws"`
+	WinRate         float64 `json:"win_rate"`
+	GoFirstCount    uint    `json:"go_first_count"`
+	GoFirstWins     uint    `json:"go_first_wins"`
+	GoFirstWinRate  float64 `json:"go_first_win_rate"`
+	GoSecondCount   uint    `json:"go_second_count"`
+	GoSecondWins    uint    `json:"go_second_wins"`
+	GoSecondWinRate float64 `json:"go_second_win_rate"`
+}
+
+type CardStatItemResponse struct {
+	CardId   uint                  `json:"card_id"`
+	CardName string                `json:"card_name"`
+	Category string                `json:"category"`
+	With     CardStatSplitResponse `json:"with"`
+	Without  CardStatSplitResponse `json:"without"`
+}
+
+type CardStatResponse struct {
+	UserId               string                  `json:"user_id"`
+	YearMonth            string                  `json:"year_month,omitempty"`
+	EnvironmentId        string                  `json:"environment_id,omitempty"`
+	Season               string                  `json:"season,omitempty"`
+	StandardRegulationId string                  `json:"standard_regulation_id,omitempty"`
+	RegulationId         uint                    `json:"regulation_id,omitempty"`
+	TotalMatches         uint                    `json:"total_matches"`
+	Cards                []*CardStatItemResponse `json:"cards"`
+}
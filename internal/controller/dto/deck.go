@@ -6,6 +6,7 @@ type DeckCreateRequest struct {
 	Name               string                  `json:"name"`
 	PrivateFlg         bool                    `json:"private_flg"`
 	DeckCode           string                  `json:"deck_code"`
+	DeckList           string                  `json:"deck_list"`
 	PrivateDeckCodeFlg bool                    `json:"private_deck_code_flg"`
 	PokemonSprites     []*PokemonSpriteRequest `json:"pokemon_sprites"`
 	TagIds             []string                `json:"tag_ids"`
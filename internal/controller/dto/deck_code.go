@@ -5,6 +5,7 @@ import "time"
 type DeckCodeCreateRequest struct {
 	DeckId         string   `json:"deck_id"`
 	Code           string   `json:"code"`
+	DeckList       string   `json:"deck_list"`
 	PrivateCodeFlg bool     `json:"private_code_flg"`
 	Memo           string   `json:"memo"`
 	TagIds         []string `json:"tag_ids"`
@@ -38,3 +39,94 @@ type DeckCodeCreateResponse struct {
 type DeckCodeUpdateResponse struct {
 	DeckCodeResponse
 }
+
+type DeckCodeCardResponse struct {
+	CardId   uint   `json:"card_id"`
+	CardName string `json:"card_name"`
+	Category string `json:"category"`
+	Count    uint   `json:"count"`
+}
+
+type DeckCodeCardsResponse struct {
+	DeckCodeId string                  `json:"deck_code_id"`
+	TotalCount uint                    `json:"total_count"`
+	Cards      []*DeckCodeCardResponse `json:"cards"`
+}
+
+type DeckCodeStatResponse struct {
+	RecordCount uint    `json:"record_count"`
+	MatchCount  uint    `json:"match_count"`
+	WinCount    uint    `json:"win_count"`
+	LoseCount   uint    `json:"lose_count"`
+	DrawCount   uint    `json:"draw_count"`
+	WinRate     float64 `json:"win_rate"`
+}
+
+type DeckCodeVersionResponse struct {
+	DeckCodeResponse
+	Stat DeckCodeStatResponse `json:"stat"`
+}
+
+type DeckCodeCardChangeResponse struct {
+	CardId    uint   `json:"card_id"`
+	CardName  string `json:"card_name"`
+	Category  string `json:"category"`
+	FromCount uint   `json:"from_count"`
+	ToCount   uint   `json:"to_count"`
+}
+
+type DeckCodeCardChangesResponse struct {
+	Added   []*DeckCodeCardChangeResponse `json:"added"`
+	Removed []*DeckCodeCardChangeResponse `json:"removed"`
+	Changed []*DeckCodeCardChangeResponse `json:"changed"`
+}
+
+type DeckCodeDiffResponse struct {
+	From    DeckCodeVersionResponse     `json:"from"`
+	To      DeckCodeVersionResponse     `json:"to"`
+	Pokemon DeckCodeCardChangesResponse `json:"pokemon"`
+	Trainer DeckCodeCardChangesResponse `json:"trainer"`
+	Energy  DeckCodeCardChangesResponse `json:"energy"`
+}
+
+type DeckIllegalCardResponse struct {
+	CardId         uint   `json:"card_id"`
+	CardName       string `json:"card_name"`
+	RegulationMark string `json:"regulation_mark"`
+	Count          uint   `json:"count"`
+	// Reason は "rotated"(スタンダードで使えないマーク) / "banned"(禁止カード)。
+	Reason string `json:"reason"`
+}
+
+type DeckLegalityResponse struct {
+	DeckCodeId   string    `json:"deck_code_id"`
+	RegulationId uint      `json:"regulation_id"`
+	Date         time.Time `json:"date"`
+	// StandardRegulationId / StandardRegulationMarks は判定に使ったスタンダードの期間。
+	// スタンダード以外や、日付に該当する期間が無い場合は空文字。
+	StandardRegulationId    string                     `json:"standard_regulation_id"`
+	StandardRegulationMarks string                     `json:"standard_regulation_marks"`
+	Legal                   bool                       `json:"legal"`
+	IllegalCards            []*DeckIllegalCardResponse `json:"illegal_cards"`
+	UncheckedCardIds        []uint                     `json:"unchecked_card_ids"`
+}
+
+type DeckAssetResponse struct {
+	// Kind は "result_html"(公式サイトの結果HTML) / "image"(デッキ画像)。
+	Kind string `json:"kind"`
+	// Status は "pending" / "running" / "succeeded" / "dead"(取得を諦めた) /
+	// "invalid"(デッキコードが正しくなかった)。
+	// ジョブの無いデッキコード(デッキリストから取り込んだもの等)は "none"。
+	Status   string `json:"status"`
+	Attempts uint   `json:"attempts"`
+	// NextRunAt は次に取得を試みる日時。"pending" 以外では null。
+	NextRunAt *time.Time `json:"next_run_at"`
+}
+
+type DeckAssetsResponse struct {
+	DeckCodeId string `json:"deck_code_id"`
+	// DeckCodeInvalid は取得の結果、デッキコードが公式サイトに存在しないと分かったかどうか。
+	// 登録時には確かめないため、webapp はこれでデッキコードの入力ミスを知らせる。
+	DeckCodeInvalid bool                 `json:"deck_code_invalid"`
+	Assets          []*DeckAssetResponse `json:"assets"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+type RecordRevisionResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Action は "update" / "delete"。
+	Action string `json:"action"`
+	// ActorUid は変更した人の uid、RequestId は変更したリクエストの応答に付いた X-Request-ID。
+	ActorUid  string          `json:"actor_uid"`
+	RequestId string          `json:"request_id"`
+	Before    *RecordResponse `json:"before"`
+	// After は削除では null。
+	After *RecordResponse `json:"after"`
+}
+
+type RecordHistoryResponse struct {
+	Limit     int                       `json:"limit"`
+	Offset    int                       `json:"offset"`
+	Revisions []*RecordRevisionResponse `json:"revisions"`
+}
+
+// MatchRevisionSnapshotResponse は履歴上の対戦結果。並び替えの前後が分かるよう、
+// 通常のレスポンスには無い記録内での位置(0始まり)も返す。
+type MatchRevisionSnapshotResponse struct {
+	MatchResponse
+	Position int `json:"position"`
+}
+
+type MatchRevisionResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Action は "update" / "delete" / "reorder"。
+	Action    string                         `json:"action"`
+	ActorUid  string                         `json:"actor_uid"`
+	RequestId string                         `json:"request_id"`
+	Before    *MatchRevisionSnapshotResponse `json:"before"`
+	After     *MatchRevisionSnapshotResponse `json:"after"`
+}
+
+type MatchHistoryResponse struct {
+	Limit     int                      `json:"limit"`
+	Offset    int                      `json:"offset"`
+	Revisions []*MatchRevisionResponse `json:"revisions"`
+}
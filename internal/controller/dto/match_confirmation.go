@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+type MatchConfirmationConfirmRequest struct {
+	// RecordId は承認した側の対戦結果を作る、承認した人自身の記録。
+	RecordId string `json:"record_id"`
+}
+
+type MatchConfirmationDisputeRequest struct {
+	Reason string `json:"reason"`
+}
+
+type MatchConfirmationResponse struct {
+	ID              string    `json:"id"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	MatchId         string    `json:"match_id"`
+	RequesterUserId string    `json:"requester_user_id"`
+	OpponentUserId  string    `json:"opponent_user_id"`
+	// Status は "pending" / "confirmed" / "disputed"。
+	Status          string     `json:"status"`
+	MirroredMatchId string     `json:"mirrored_match_id"`
+	DisputeReason   string     `json:"dispute_reason"`
+	RespondedAt     *time.Time `json:"responded_at"`
+	// InSync は承認後の両側の対戦結果が、いまも互いに裏返しの内容になっているか。
+	// false なら、どちらかが承認後に編集・削除している。
+	InSync bool `json:"in_sync"`
+	// Match・MirroredMatch は依頼した側・承認した側の対戦結果。削除済み・未承認なら null。
+	// 相手の対戦結果のメモは返さない。
+	Match         *MatchResponse `json:"match"`
+	MirroredMatch *MatchResponse `json:"mirrored_match"`
+}
+
+type MatchConfirmationGetResponse struct {
+	Limit              int                          `json:"limit"`
+	Offset             int                          `json:"offset"`
+	MatchConfirmations []*MatchConfirmationResponse `json:"match_confirmations"`
+}
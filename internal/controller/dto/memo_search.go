@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type MemoHighlightResponse struct {
+	// Offset / Length は snippet.text 内の一致した範囲(文字単位)。
+	Offset int `json:"offset"`
+	Length int `json:"length"`
+}
+
+type MemoSnippetResponse struct {
+	Text       string                   `json:"text"`
+	Highlights []*MemoHighlightResponse `json:"highlights"`
+}
+
+type MemoSearchHitResponse struct {
+	// Type は "record" / "match" / "game"。
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	RecordId string `json:"record_id"`
+	// MatchId は対戦結果・対局のメモのときだけ入る。
+	MatchId   string               `json:"match_id"`
+	EventDate time.Time            `json:"event_date"`
+	CreatedAt time.Time            `json:"created_at"`
+	Snippet   *MemoSnippetResponse `json:"snippet"`
+	// RecordURL / MatchURL は一致したメモを持つ(または属する)記録・対戦結果の取得先。
+	// 対局は単体で取得するAPIが無いため、対戦結果のURLを返す。
+	RecordURL string `json:"record_url"`
+	MatchURL  string `json:"match_url"`
+}
+
+type MemoSearchResponse struct {
+	Limit  int                      `json:"limit"`
+	Offset int                      `json:"offset"`
+	Hits   []*MemoSearchHitResponse `json:"hits"`
+}
@@ -38,3 +38,15 @@ type OfficialEventGetResponse struct {
 type OfficialEventGetByIdResponse struct {
 	OfficialEventResponse
 }
+
+type OfficialEventSearchHitResponse struct {
+	OfficialEventResponse
+	// DistanceKm は lat / lng を指定したときだけ入る、店舗までの距離(km)。
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+}
+
+type OfficialEventSearchResponse struct {
+	Limit          int                               `json:"limit"`
+	Offset         int                               `json:"offset"`
+	OfficialEvents []*OfficialEventSearchHitResponse `json:"official_events"`
+}
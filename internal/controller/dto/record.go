@@ -17,10 +17,14 @@ type RecordRequest struct {
 	RegulationId      uint      `json:"regulation_id"`
 	TCGMeisterURL     string    `json:"tcg_meister_url"`
 	Memo              string    `json:"memo"`
+	TagIds            []string  `json:"tag_ids"`
 }
 
+// RecordCreateRequest の Matches は記録と一緒に作成する対戦結果(任意)。
+// 対戦結果の record_id は作成時に設定するため指定できない。
 type RecordCreateRequest struct {
 	RecordRequest
+	Matches []*MatchRequest `json:"matches"`
 }
 
 type RecordUpdateRequest struct {
@@ -33,21 +37,22 @@ type RecordData struct {
 }
 
 type RecordResponse struct {
-	ID                string    `json:"id"`
-	CreatedAt         time.Time `json:"created_at"`
-	OfficialEventId   uint      `json:"official_event_id"`
-	TonamelEventId    string    `json:"tonamel_event_id"`
-	FriendId          string    `json:"friend_id"`
-	UnofficialEventId string    `json:"unofficial_event_id"`
-	UserId            string    `json:"user_id"`
-	DeckId            string    `json:"deck_id"`
-	DeckCodeId        string    `json:"deck_code_id"`
-	EventDate         time.Time `json:"event_date"`
-	PrivateFlg        bool      `json:"private_flg"`
-	IgnoreStatsFlg    bool      `json:"ignore_stats_flg"`
-	RegulationId      uint      `json:"regulation_id"`
-	TCGMeisterURL     string    `json:"tcg_meister_url"`
-	Memo              string    `json:"memo"`
+	ID                string         `json:"id"`
+	CreatedAt         time.Time      `json:"created_at"`
+	OfficialEventId   uint           `json:"official_event_id"`
+	TonamelEventId    string         `json:"tonamel_event_id"`
+	FriendId          string         `json:"friend_id"`
+	UnofficialEventId string         `json:"unofficial_event_id"`
+	UserId            string         `json:"user_id"`
+	DeckId            string         `json:"deck_id"`
+	DeckCodeId        string         `json:"deck_code_id"`
+	EventDate         time.Time      `json:"event_date"`
+	PrivateFlg        bool           `json:"private_flg"`
+	IgnoreStatsFlg    bool           `json:"ignore_stats_flg"`
+	RegulationId      uint           `json:"regulation_id"`
+	TCGMeisterURL     string         `json:"tcg_meister_url"`
+	Memo              string         `json:"memo"`
+	Tags              []*TagResponse `json:"tags"`
 }
 
 type RecordGetResponse struct {
@@ -68,10 +73,42 @@ type RecordGetByUserIdResponse struct {
 	Records []*RecordData `json:"records"`
 }
 
+// RecordCreateResponse / RecordUpdateResponse の Warnings は、記録したデッキコードに
+// 記録の日付・レギュレーションで使用できないカードが含まれている場合の警告。
+// 記録自体は保存済みで、クライアントは確認を促す表示に使う。
+// RecordCreateResponse の Matches は記録と一緒に作成した対戦結果(無ければ空)。
 type RecordCreateResponse struct {
 	RecordResponse
+	Warnings []*DeckIllegalCardResponse `json:"warnings"`
+	Matches  []*MatchResponse           `json:"matches"`
 }
 
 type RecordUpdateResponse struct {
 	RecordResponse
+	Warnings []*DeckIllegalCardResponse `json:"warnings"`
+}
+
+// RecordImportRecordRequest は一括取り込みする記録1件と、その対戦結果。
+// 対戦結果の record_id は取り込み時に採番するため指定できない。
+type RecordImportRecordRequest struct {
+	RecordRequest
+	Matches []*MatchRequest `json:"matches"`
+}
+
+type RecordImportRequest struct {
+	Records []*RecordImportRecordRequest `json:"records"`
+}
+
+type RecordImportRecordResponse struct {
+	RecordResponse
+	Matches []*MatchResponse `json:"matches"`
+}
+
+// RecordImportResponse は一括取り込みの結果。dry_run のときは保存せずに、
+// 取り込む予定の内容(採番済みのIDを含むが保存はされていない)を返す。
+type RecordImportResponse struct {
+	DryRun      bool                          `json:"dry_run"`
+	RecordCount int                           `json:"record_count"`
+	MatchCount  int                           `json:"match_count"`
+	Records     []*RecordImportRecordResponse `json:"records"`
 }
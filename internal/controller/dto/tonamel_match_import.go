@@ -0,0 +1,6 @@
+package dto
+
+type TonamelMatchImportRequest struct {
+	// PlayerName は Tonamel の大会にエントリーしたときのプレイヤー名(表示名)。
+	PlayerName string `json:"player_name"`
+}
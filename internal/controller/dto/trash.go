@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type TrashItemResponse struct {
+	ID string `json:"id"`
+	// Type は "record" / "match" / "deck" / "deck_code"。
+	Type string `json:"type"`
+	// ParentId は対戦結果なら記録のID、デッキコードならデッキのID。記録・デッキは空。
+	ParentId string `json:"parent_id"`
+	// Name は一覧で見分けるための表示名(記録は開催日、対戦結果は相手のデッキ、
+	// デッキはデッキ名、デッキコードはコード)。
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+	// ExpiresAt はこの日時を過ぎると復元できなくなる(物理削除される)日時。
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type TrashResponse struct {
+	Items []*TrashItemResponse `json:"items"`
+}
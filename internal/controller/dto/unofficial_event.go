@@ -22,6 +22,8 @@ type UnofficialEventResponse struct {
 	UserId string    `json:"user_id"`
 	Title  string    `json:"title"`
 	Date   time.Time `json:"date"`
+	// JoinCode は作成者にだけ返す。共有していないイベントや他のユーザには省略する。
+	JoinCode string `json:"join_code,omitempty"`
 }
 
 type UnofficialEventGetByIdResponse struct {
@@ -35,3 +37,39 @@ type UnofficialEventCreateResponse struct {
 type UnofficialEventUpdateResponse struct {
 	UnofficialEventResponse
 }
+
+type UnofficialEventJoinCodeResponse struct {
+	UnofficialEventResponse
+}
+
+type UnofficialEventJoinRequest struct {
+	JoinCode string `json:"join_code"`
+	RecordId string `json:"record_id"`
+}
+
+type UnofficialEventSummaryParticipantResponse struct {
+	RecordId       string                   `json:"record_id"`
+	UserId         string                   `json:"user_id"`
+	UserName       string                   `json:"user_name"`
+	UserImageURL   string                   `json:"user_image_url"`
+	Wins           int                      `json:"wins"`
+	Losses         int                      `json:"losses"`
+	Draws          int                      `json:"draws"`
+	PokemonSprites []*PokemonSpriteResponse `json:"pokemon_sprites"`
+}
+
+type UnofficialEventArchetypeResponse struct {
+	Fingerprint    string                   `json:"fingerprint"`
+	Count          int                      `json:"count"`
+	Wins           int                      `json:"wins"`
+	Losses         int                      `json:"losses"`
+	Draws          int                      `json:"draws"`
+	PokemonSprites []*PokemonSpriteResponse `json:"pokemon_sprites"`
+}
+
+type UnofficialEventSummaryResponse struct {
+	UnofficialEventId string                                       `json:"unofficial_event_id"`
+	Participants      []*UnofficialEventSummaryParticipantResponse `json:"participants"`
+	Archetypes        []*UnofficialEventArchetypeResponse          `json:"archetypes"`
+	TotalMatches      int                                          `json:"total_matches"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import "time"
+
+type UnofficialEventTournamentCreateRequest struct {
+	BO3Flg bool `json:"bo3_flg"`
+}
+
+// UnofficialEventParticipantCreateRequest は登録ユーザ(user_id)かゲスト(guest_name)のどちらか一方を指定する。
+type UnofficialEventParticipantCreateRequest struct {
+	UserId    string `json:"user_id"`
+	GuestName string `json:"guest_name"`
+}
+
+type UnofficialEventPairingUpdateRequest struct {
+	Player1Wins int `json:"player1_wins"`
+	Player2Wins int `json:"player2_wins"`
+}
+
+type UnofficialEventTopCutCreateRequest struct {
+	Size int `json:"size"`
+}
+
+type UnofficialEventTournamentResponse struct {
+	UnofficialEventId string    `json:"unofficial_event_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	BO3Flg            bool      `json:"bo3_flg"`
+	// TopCutSize はトップカットに進む人数。0 ならトップカットはまだ始めていない。
+	TopCutSize int `json:"top_cut_size"`
+}
+
+type UnofficialEventParticipantResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UserId    string    `json:"user_id"`
+	GuestName string    `json:"guest_name"`
+	// Name は登録ユーザならユーザ名、ゲストなら guest_name。
+	Name       string        `json:"name"`
+	DroppedFlg bool          `json:"dropped_flg"`
+	User       *UserResponse `json:"user"`
+}
+
+type UnofficialEventParticipantGetResponse struct {
+	Participants []*UnofficialEventParticipantResponse `json:"participants"`
+}
+
+type UnofficialEventPairingResponse struct {
+	ID      string `json:"id"`
+	Stage   string `json:"stage"`
+	Round   int    `json:"round"`
+	TableNo int    `json:"table_no"`
+	// Player2 はバイなら null。
+	Player1     *UnofficialEventParticipantResponse `json:"player1"`
+	Player2     *UnofficialEventParticipantResponse `json:"player2"`
+	Player1Wins int                                 `json:"player1_wins"`
+	Player2Wins int                                 `json:"player2_wins"`
+	ByeFlg      bool                                `json:"bye_flg"`
+	ReportedFlg bool                                `json:"reported_flg"`
+}
+
+type UnofficialEventPairingGetResponse struct {
+	Pairings []*UnofficialEventPairingResponse `json:"pairings"`
+}
+
+type UnofficialEventStandingResponse struct {
+	Rank                          int                                 `json:"rank"`
+	Participant                   *UnofficialEventParticipantResponse `json:"participant"`
+	Wins                          int                                 `json:"wins"`
+	Losses                        int                                 `json:"losses"`
+	Draws                         int                                 `json:"draws"`
+	MatchPoints                   int                                 `json:"match_points"`
+	OpponentWinPercentage         float64                             `json:"opponent_win_percentage"`
+	OpponentOpponentWinPercentage float64                             `json:"opponent_opponent_win_percentage"`
+}
+
+type UnofficialEventStandingGetResponse struct {
+	Standings []*UnofficialEventStandingResponse `json:"standings"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type UserExportResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Status は "pending" / "running" / "succeeded" / "failed"(作成を諦めた)。
+	Status   string `json:"status"`
+	Attempts uint   `json:"attempts"`
+	// CompletedAt は完了・失敗した日時。未完了なら null。
+	CompletedAt *time.Time `json:"completed_at"`
+	// DownloadURL はアーカイブ(zip)のダウンロード先。"succeeded" 以外では空。
+	// 本人の認証が必要なAPIのパスで、ストレージの公開URLではない。
+	DownloadURL string `json:"download_url"`
+}
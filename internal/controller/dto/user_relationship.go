@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+type FollowRequestCreateRequest struct {
+	TargetUserId string `json:"target_user_id"`
+}
+
+type UserBlockCreateRequest struct {
+	TargetUserId string `json:"target_user_id"`
+}
+
+type UserRelationshipResponse struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UserId       string    `json:"user_id"`
+	TargetUserId string    `json:"target_user_id"`
+	// Status は "pending"(申請中) / "accepted"(フレンド) / "blocked"(ブロック)。
+	Status string `json:"status"`
+	// User は相手のユーザ。
+	User *UserResponse `json:"user"`
+}
+
+type FollowRequestGetResponse struct {
+	Limit          int                         `json:"limit"`
+	Offset         int                         `json:"offset"`
+	FollowRequests []*UserRelationshipResponse `json:"follow_requests"`
+}
+
+type FriendResponse struct {
+	User *UserResponse `json:"user"`
+	// Since はフレンドになった日時。
+	Since time.Time `json:"since"`
+}
+
+type FriendGetResponse struct {
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+	Friends []*FriendResponse `json:"friends"`
+}
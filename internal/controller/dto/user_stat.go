@@ -7,6 +7,7 @@ type UserStatResponse struct {
 	Season               string  `json:"season,omitempty"`
 	StandardRegulationId string  `json:"standard_regulation_id,omitempty"`
 	RegulationId         uint    `json:"regulation_id,omitempty"`
+	RecordTagId          string  `json:"record_tag_id,omitempty"`
 	TotalRecords         int     `json:"total_records"`
 	OfficialEventCount   int     `json:"official_event_count"`
 	TonamelEventCount    int     `json:"tonamel_event_count"`
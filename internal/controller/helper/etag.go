@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match")
+
+// FormatETag は updated_at から ETag を作る。
+//
+// updated_at は TIMESTAMP(タイムゾーン無し)で、保存直後はローカル時刻、
+// 読み込み後は同じ壁時計の値を持つ UTC として扱われる。どちらから作っても
+// 同じ ETag になるよう、タイムゾーンを無視した壁時計の値をマイクロ秒で表す。
+func FormatETag(updatedAt time.Time) string {
+	wall := time.Date(
+		updatedAt.Year(),
+		updatedAt.Month(),
+		updatedAt.Day(),
+		updatedAt.Hour(),
+		updatedAt.Minute(),
+		updatedAt.Second(),
+		updatedAt.Nanosecond(),
+		time.UTC,
+	)
+
+	return strconv.Quote(strconv.FormatInt(wall.UnixMicro(), 10))
+}
+
+// SetETag は updated_at から作った ETag をレスポンスヘッダへ設定する。
+func SetETag(ctx *gin.Context, updatedAt time.Time) {
+	ctx.Header(ETagHeader, FormatETag(updatedAt))
+}
+
+// ParseIfMatch は If-Match ヘッダから、クライアントが前提とする版を取り出す。
+// ヘッダが無い、または "*"(存在さえすればよい)の場合は ok=false を返す。
+//
+// 圧縮を挟むプロキシが ETag を弱い ETag(W/)へ書き換えることがあるため、W/ も受け付ける。
+// 複数の ETag の列挙や解釈できない値は、どの版とも一致しないものとして error を返す。
+func ParseIfMatch(ctx *gin.Context) (updatedAt time.Time, ok bool, err error) {
+	header := strings.TrimSpace(ctx.GetHeader(IfMatchHeader))
+	if header == "" || header == "*" {
+		return time.Time{}, false, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	value, err := strconv.Unquote(tag)
+	if err != nil {
+		return time.Time{}, false, errInvalidIfMatch
+	}
+
+	micro, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false, errInvalidIfMatch
+	}
+
+	return time.UnixMicro(micro).UTC(), true, nil
+}
+
+// IfMatchContext は If-Match で指定された id のリソースの版を埋め込んだ
+// リクエストの context を返す。リポジトリの Save / Update / Delete がこの版を
+// WHERE updated_at = ? の条件に使い、一致しなければ apperror.ErrPreconditionFailed を返す。
+func IfMatchContext(ctx *gin.Context, id string) (context.Context, error) {
+	updatedAt, ok, err := ParseIfMatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return ctx.Request.Context(), nil
+	}
+
+	return repository.WithExpectedUpdatedAt(ctx.Request.Context(), id, updatedAt), nil
+}
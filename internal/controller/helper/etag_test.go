@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// newIfMatchTestContext は If-Match ヘッダを持つPUTリクエストのgin.Contextを返す。
+func newIfMatchTestContext(t *testing.T, ifMatch string) *gin.Context {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+
+	req, err := http.NewRequest("PUT", "/", nil)
+	require.NoError(t, err)
+	if ifMatch != "" {
+		req.Header.Set(IfMatchHeader, ifMatch)
+	}
+
+	ctx.Request = req
+
+	return ctx
+}
+
+func TestFormatETag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系_保存直後のローカル時刻と再読み込み後のUTCで同じETagになる", func(t *testing.T) {
+		jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+		saved := time.Date(2026, 10, 1, 21, 30, 0, 123456000, jst)
+		// TIMESTAMP は壁時計の値だけを保存するため、読み込むと同じ値の UTC になる
+		loaded := time.Date(2026, 10, 1, 21, 30, 0, 123456000, time.UTC)
+
+		require.Equal(t, FormatETag(saved), FormatETag(loaded))
+	})
+
+	t.Run("正常系_ParseIfMatchで元の版へ戻せる", func(t *testing.T) {
+		updatedAt := time.Date(2026, 10, 1, 21, 30, 0, 123456000, time.UTC)
+
+		ctx := newIfMatchTestContext(t, FormatETag(updatedAt))
+
+		got, ok, err := ParseIfMatch(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, updatedAt.Equal(got))
+	})
+}
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, 10, 1, 21, 30, 0, 123456000, time.UTC)
+
+	for name, tc := range map[string]struct {
+		header  string
+		ok      bool
+		wantErr bool
+	}{
+		"正常系_ヘッダ無しは前提条件なし":       {header: "", ok: false},
+		"正常系_アスタリスクは前提条件なし":      {header: "*", ok: false},
+		"正常系_強いETag":             {header: FormatETag(updatedAt), ok: true},
+		"正常系_プロキシが弱めたETagも受け付ける": {header: "W/" + FormatETag(updatedAt), ok: true},
+		"異常系_引用符が無い":             {header: "1791000000000000", wantErr: true},
+		"異常系_数値でない":              {header: `"abc"`, wantErr: true},
+		"異常系_複数のETagの列挙":         {header: FormatETag(updatedAt) + `, "1"`, wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := newIfMatchTestContext(t, tc.header)
+
+			got, ok, err := ParseIfMatch(ctx)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.ok, ok)
+			if ok {
+				require.True(t, updatedAt.Equal(got))
+			}
+		})
+	}
+}
+
+func TestIfMatchContext(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, 10, 1, 21, 30, 0, 123456000, time.UTC)
+
+	t.Run("正常系_指定したidの版だけを埋め込む", func(t *testing.T) {
+		ctx := newIfMatchTestContext(t, FormatETag(updatedAt))
+
+		reqCtx, err := IfMatchContext(ctx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		require.NoError(t, err)
+
+		got, ok := repository.ExpectedUpdatedAt(reqCtx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		require.True(t, ok)
+		require.True(t, updatedAt.Equal(got))
+
+		_, ok = repository.ExpectedUpdatedAt(reqCtx, "01HD7Y3K8D6FDHMHTZ2GT41TN3")
+		require.False(t, ok)
+	})
+
+	t.Run("正常系_ヘッダ無しなら版を埋め込まない", func(t *testing.T) {
+		ctx := newIfMatchTestContext(t, "")
+
+		reqCtx, err := IfMatchContext(ctx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		require.NoError(t, err)
+
+		_, ok := repository.ExpectedUpdatedAt(reqCtx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		require.False(t, ok)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/logging"
 )
 
@@ -31,6 +32,17 @@ func GetOffset(ctx *gin.Context) int {
 	return offset
 }
 
+func SetSearchTerms(ctx *gin.Context, value []string) {
+	ctx.Set("search_terms", value)
+}
+
+func GetSearchTerms(ctx *gin.Context) []string {
+	value, _ := ctx.Get("search_terms")
+	terms, _ := value.([]string)
+
+	return terms
+}
+
 func SetCursor(ctx *gin.Context, value time.Time) {
 	ctx.Set("cursor", value)
 }
@@ -260,6 +272,18 @@ func GetStandardRegulationId(ctx *gin.Context) string {
 	return standardRegulationId
 }
 
+// 成績の集計を、このタグが付いた記録に絞り込む。空なら絞り込まない。
+func SetRecordTagId(ctx *gin.Context, value string) {
+	ctx.Set("record_tag_id", value)
+}
+
+func GetRecordTagId(ctx *gin.Context) string {
+	value, _ := ctx.Get("record_tag_id")
+	recordTagId, _ := value.(string)
+
+	return recordTagId
+}
+
 // レギュレーション区分(スタンダード/エクストラ/殿堂)での絞り込み。
 // 0 は「絞り込まない(全レギュレーション)」を表す。
 func SetRegulationId(ctx *gin.Context, value uint) {
@@ -306,6 +330,17 @@ func GetPeriod(ctx *gin.Context) string {
 	return period
 }
 
+func SetFormat(ctx *gin.Context, value string) {
+	ctx.Set("format", value)
+}
+
+func GetFormat(ctx *gin.Context) string {
+	value, _ := ctx.Get("format")
+	format, _ := value.(string)
+
+	return format
+}
+
 func SetUnofficialEventCreateRequest(ctx *gin.Context, value dto.UnofficialEventCreateRequest) {
 	ctx.Set("unofficial_event_create_request", value)
 }
@@ -350,6 +385,40 @@ func GetRecordUpdateRequest(ctx *gin.Context) dto.RecordUpdateRequest {
 	return recordRequest
 }
 
+func SetRecordImportRequest(ctx *gin.Context, value dto.RecordImportRequest) {
+	ctx.Set("record_import_request", value)
+}
+
+func GetRecordImportRequest(ctx *gin.Context) dto.RecordImportRequest {
+	value, _ := ctx.Get("record_import_request")
+	recordImportRequest, _ := value.(dto.RecordImportRequest)
+
+	return recordImportRequest
+}
+
+func SetDryRun(ctx *gin.Context, value bool) {
+	ctx.Set("dry_run", value)
+}
+
+func GetDryRun(ctx *gin.Context) bool {
+	value, _ := ctx.Get("dry_run")
+	dryRun, _ := value.(bool)
+
+	return dryRun
+}
+
+// アップロードされた写真の中身(multipart の file)。
+func SetAttachmentBody(ctx *gin.Context, value []byte) {
+	ctx.Set("attachment_body", value)
+}
+
+func GetAttachmentBody(ctx *gin.Context) []byte {
+	value, _ := ctx.Get("attachment_body")
+	body, _ := value.([]byte)
+
+	return body
+}
+
 func SetDeckCreateRequest(ctx *gin.Context, value dto.DeckCreateRequest) {
 	ctx.Set("deck_create_request", value)
 }
@@ -481,3 +550,147 @@ func GetUserPlayerCreateRequest(ctx *gin.Context) dto.UserPlayerCreateRequest {
 
 	return ret
 }
+
+// 記録の絞り込み条件。絞り込みのクエリが1つも無ければ nil。
+func SetRecordCriteria(ctx *gin.Context, value *entity.RecordCriteria) {
+	ctx.Set("record_criteria", value)
+}
+
+func GetRecordCriteria(ctx *gin.Context) *entity.RecordCriteria {
+	value, _ := ctx.Get("record_criteria")
+	criteria, _ := value.(*entity.RecordCriteria)
+
+	return criteria
+}
+
+func SetOfficialEventSearchCriteria(ctx *gin.Context, value *entity.OfficialEventSearchCriteria) {
+	ctx.Set("official_event_search_criteria", value)
+}
+
+func GetOfficialEventSearchCriteria(ctx *gin.Context) *entity.OfficialEventSearchCriteria {
+	value, _ := ctx.Get("official_event_search_criteria")
+	criteria, _ := value.(*entity.OfficialEventSearchCriteria)
+
+	return criteria
+}
+
+func SetMatchConfirmationConfirmRequest(ctx *gin.Context, value dto.MatchConfirmationConfirmRequest) {
+	ctx.Set("match_confirmation_confirm_request", value)
+}
+
+func GetMatchConfirmationConfirmRequest(ctx *gin.Context) dto.MatchConfirmationConfirmRequest {
+	value, _ := ctx.Get("match_confirmation_confirm_request")
+	ret, _ := value.(dto.MatchConfirmationConfirmRequest)
+
+	return ret
+}
+
+func SetMatchConfirmationDisputeRequest(ctx *gin.Context, value dto.MatchConfirmationDisputeRequest) {
+	ctx.Set("match_confirmation_dispute_request", value)
+}
+
+func GetMatchConfirmationDisputeRequest(ctx *gin.Context) dto.MatchConfirmationDisputeRequest {
+	value, _ := ctx.Get("match_confirmation_dispute_request")
+	ret, _ := value.(dto.MatchConfirmationDisputeRequest)
+
+	return ret
+}
+
+func SetFollowRequestCreateRequest(ctx *gin.Context, value dto.FollowRequestCreateRequest) {
+	ctx.Set("follow_request_create_request", value)
+}
+
+func GetFollowRequestCreateRequest(ctx *gin.Context) dto.FollowRequestCreateRequest {
+	value, _ := ctx.Get("follow_request_create_request")
+	ret, _ := value.(dto.FollowRequestCreateRequest)
+
+	return ret
+}
+
+func SetUserBlockCreateRequest(ctx *gin.Context, value dto.UserBlockCreateRequest) {
+	ctx.Set("user_block_create_request", value)
+}
+
+func GetUserBlockCreateRequest(ctx *gin.Context) dto.UserBlockCreateRequest {
+	value, _ := ctx.Get("user_block_create_request")
+	ret, _ := value.(dto.UserBlockCreateRequest)
+
+	return ret
+}
+
+func SetUnofficialEventJoinRequest(ctx *gin.Context, value dto.UnofficialEventJoinRequest) {
+	ctx.Set("unofficial_event_join_request", value)
+}
+
+func GetUnofficialEventJoinRequest(ctx *gin.Context) dto.UnofficialEventJoinRequest {
+	value, _ := ctx.Get("unofficial_event_join_request")
+	ret, _ := value.(dto.UnofficialEventJoinRequest)
+
+	return ret
+}
+
+func SetUnofficialEventTournamentCreateRequest(ctx *gin.Context, value dto.UnofficialEventTournamentCreateRequest) {
+	ctx.Set("unofficial_event_tournament_create_request", value)
+}
+
+func GetUnofficialEventTournamentCreateRequest(ctx *gin.Context) dto.UnofficialEventTournamentCreateRequest {
+	value, _ := ctx.Get("unofficial_event_tournament_create_request")
+	ret, _ := value.(dto.UnofficialEventTournamentCreateRequest)
+
+	return ret
+}
+
+func SetUnofficialEventParticipantCreateRequest(ctx *gin.Context, value dto.UnofficialEventParticipantCreateRequest) {
+	ctx.Set("unofficial_event_participant_create_request", value)
+}
+
+func GetUnofficialEventParticipantCreateRequest(ctx *gin.Context) dto.UnofficialEventParticipantCreateRequest {
+	value, _ := ctx.Get("unofficial_event_participant_create_request")
+	ret, _ := value.(dto.UnofficialEventParticipantCreateRequest)
+
+	return ret
+}
+
+func SetUnofficialEventPairingUpdateRequest(ctx *gin.Context, value dto.UnofficialEventPairingUpdateRequest) {
+	ctx.Set("unofficial_event_pairing_update_request", value)
+}
+
+func GetUnofficialEventPairingUpdateRequest(ctx *gin.Context) dto.UnofficialEventPairingUpdateRequest {
+	value, _ := ctx.Get("unofficial_event_pairing_update_request")
+	ret, _ := value.(dto.UnofficialEventPairingUpdateRequest)
+
+	return ret
+}
+
+func SetUnofficialEventTopCutCreateRequest(ctx *gin.Context, value dto.UnofficialEventTopCutCreateRequest) {
+	ctx.Set("unofficial_event_top_cut_create_request", value)
+}
+
+func GetUnofficialEventTopCutCreateRequest(ctx *gin.Context) dto.UnofficialEventTopCutCreateRequest {
+	value, _ := ctx.Get("unofficial_event_top_cut_create_request")
+	ret, _ := value.(dto.UnofficialEventTopCutCreateRequest)
+
+	return ret
+}
+
+func SetRound(ctx *gin.Context, value int) {
+	ctx.Set("round", value)
+}
+
+func GetRound(ctx *gin.Context) int {
+	value, _ := ctx.Get("round")
+	round, _ := value.(int)
+
+	return round
+}
+
+func SetTonamelMatchImportRequest(ctx *gin.Context, value dto.TonamelMatchImportRequest) {
+	ctx.Set("tonamel_match_import_request", value)
+}
+
+func GetTonamelMatchImportRequest(ctx *gin.Context) dto.TonamelMatchImportRequest {
+	value, _ := ctx.Get("tonamel_match_import_request")
+	ret, _ := value.(dto.TonamelMatchImportRequest)
+
+	return ret
+}
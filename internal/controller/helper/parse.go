@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
 
@@ -400,3 +402,250 @@ func ParseQueryWeek(ctx *gin.Context) (string, error) {
 
 	return query, nil
 }
+
+// ParseQuerySearch はメモの検索語を空白で区切って返す。空、または長さ・語数が
+// 上限(entity.MemoSearchMaxQueryLength / entity.MemoSearchMaxTerms)を超える場合はエラーにする。
+func ParseQuerySearch(ctx *gin.Context) ([]string, error) {
+	query := GetQuerySearch(ctx)
+
+	if utf8.RuneCountInString(query) > entity.MemoSearchMaxQueryLength {
+		return nil, errors.New("search query is too long")
+	}
+
+	terms := entity.ParseMemoSearchQuery(query)
+
+	if len(terms) == 0 {
+		return nil, errors.New("search query is required")
+	} else if len(terms) > entity.MemoSearchMaxTerms {
+		return nil, errors.New("too many search terms")
+	}
+
+	return terms, nil
+}
+
+// ParseQueryRecordCriteria は記録・対戦結果の絞り込みのクエリを解析する。
+// 絞り込みのクエリが1つも無ければ nil を返し、呼び出し側は従来の一覧取得を使う。
+// UserId / EventType / DeckId やページングは呼び出し側で詰める。
+//
+// 既存の regulation_id(ParseQueryRegulationId)とは異なり、不正な値は無視せずエラーにする。
+// 絞り込みが黙って外れると、条件に合わない記録を条件に合うものとして見せてしまうため。
+func ParseQueryRecordCriteria(ctx *gin.Context) (*entity.RecordCriteria, error) {
+	queries := []string{
+		GetQueryFromDate(ctx),
+		GetQueryToDate(ctx),
+		GetQueryRegulationId(ctx),
+		GetQueryEnvironmentId(ctx),
+		GetQueryOfficialEventType(ctx),
+		GetQueryDeckCodeId(ctx),
+		GetQueryOpponentFingerprint(ctx),
+		GetQueryOpponentDeckName(ctx),
+		GetQueryResult(ctx),
+		GetQueryBO3(ctx),
+		GetQueryGoFirst(ctx),
+		GetQueryTagIds(ctx),
+		GetQueryRecordTagIds(ctx),
+	}
+
+	found := false
+	for _, query := range queries {
+		if query != "" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	criteria := &entity.RecordCriteria{
+		EnvironmentId:       GetQueryEnvironmentId(ctx),
+		DeckCodeId:          GetQueryDeckCodeId(ctx),
+		OpponentFingerprint: GetQueryOpponentFingerprint(ctx),
+		OpponentDeckName:    GetQueryOpponentDeckName(ctx),
+	}
+
+	var err error
+
+	if criteria.FromDate, err = ParseQueryFromDate(ctx); err != nil {
+		return nil, err
+	}
+	if criteria.ToDate, err = ParseQueryToDate(ctx); err != nil {
+		return nil, err
+	}
+	if !criteria.FromDate.IsZero() && !criteria.ToDate.IsZero() && criteria.FromDate.After(criteria.ToDate) {
+		return nil, errors.New("from_date is after to_date")
+	}
+
+	if query := GetQueryRegulationId(ctx); query != "" {
+		regulationId, err := strconv.ParseUint(query, 10, 0)
+		if err != nil {
+			return nil, err
+		} else if !entity.IsValidRegulationId(uint(regulationId)) {
+			return nil, errors.New("bad query parameter")
+		}
+		criteria.RegulationId = uint(regulationId)
+	}
+
+	if query := GetQueryOfficialEventType(ctx); query != "" {
+		for _, name := range strings.Split(query, ",") {
+			typeId, ok := entity.OfficialEventTypeId(name)
+			if !ok {
+				return nil, errors.New("bad query parameter")
+			}
+			criteria.OfficialEventTypeIds = append(criteria.OfficialEventTypeIds, typeId)
+		}
+	}
+
+	switch result := entity.MatchResult(GetQueryResult(ctx)); result {
+	case "", entity.MatchResultWin, entity.MatchResultLose, entity.MatchResultDraw:
+		criteria.Result = result
+	default:
+		return nil, errors.New("bad query parameter")
+	}
+
+	if query := GetQueryBO3(ctx); query != "" {
+		bo3Flg, err := strconv.ParseBool(query)
+		if err != nil {
+			return nil, err
+		}
+		criteria.BO3Flg = &bo3Flg
+	}
+
+	if query := GetQueryGoFirst(ctx); query != "" {
+		goFirst, err := strconv.ParseBool(query)
+		if err != nil {
+			return nil, err
+		}
+		criteria.GoFirst = &goFirst
+	}
+
+	if query := criteria.OpponentFingerprint; query != "" {
+		for _, spriteId := range strings.Split(query, ",") {
+			if spriteId == "" {
+				return nil, errors.New("bad query parameter")
+			}
+		}
+	}
+
+	if criteria.TagIds, err = parseQueryIds(GetQueryTagIds(ctx)); err != nil {
+		return nil, err
+	}
+	if criteria.RecordTagIds, err = parseQueryIds(GetQueryRecordTagIds(ctx)); err != nil {
+		return nil, err
+	}
+
+	return criteria, nil
+}
+
+// ParseQueryOfficialEventSearchCriteria は公式イベントの検索のクエリを解析する。
+// ページングは呼び出し側で詰める。
+//
+// ParseQueryRecordCriteria と同じく、不正な値は無視せずエラーにする。
+// lat / lng は両方指定したときだけ距離で絞り込み、distance_km は省略すると既定の半径にする。
+func ParseQueryOfficialEventSearchCriteria(ctx *gin.Context) (*entity.OfficialEventSearchCriteria, error) {
+	criteria := &entity.OfficialEventSearchCriteria{}
+
+	query := GetQuerySearch(ctx)
+	if utf8.RuneCountInString(query) > entity.OfficialEventSearchMaxQueryLength {
+		return nil, errors.New("search query is too long")
+	}
+	criteria.Terms = entity.ParseMemoSearchQuery(query)
+	if len(criteria.Terms) > entity.OfficialEventSearchMaxTerms {
+		return nil, errors.New("too many search terms")
+	}
+
+	if query := GetQueryPrefectureId(ctx); query != "" {
+		prefectureId, err := strconv.ParseUint(query, 10, 0)
+		if err != nil {
+			return nil, err
+		} else if prefectureId == 0 || prefectureId > entity.MaxPrefectureId {
+			return nil, errors.New("bad query parameter")
+		}
+		criteria.PrefectureId = uint(prefectureId)
+	}
+
+	var err error
+
+	if criteria.FromDate, err = ParseQueryFromDate(ctx); err != nil {
+		return nil, err
+	}
+	if criteria.ToDate, err = ParseQueryToDate(ctx); err != nil {
+		return nil, err
+	}
+	if !criteria.FromDate.IsZero() && !criteria.ToDate.IsZero() && criteria.FromDate.After(criteria.ToDate) {
+		return nil, errors.New("from_date is after to_date")
+	}
+
+	latQuery, lngQuery, distanceQuery := GetQueryLatitude(ctx), GetQueryLongitude(ctx), GetQueryDistanceKm(ctx)
+	if (latQuery == "") != (lngQuery == "") {
+		return nil, errors.New("lat and lng must be specified together")
+	}
+	if latQuery == "" {
+		if distanceQuery != "" {
+			return nil, errors.New("distance_km requires lat and lng")
+		}
+		return criteria, nil
+	}
+
+	lat, err := strconv.ParseFloat(latQuery, 64)
+	if err != nil {
+		return nil, err
+	}
+	lng, err := strconv.ParseFloat(lngQuery, 64)
+	if err != nil {
+		return nil, err
+	}
+	// NaN も範囲外として弾く
+	if !(-90 <= lat && lat <= 90) || !(-180 <= lng && lng <= 180) {
+		return nil, errors.New("bad query parameter")
+	}
+	criteria.Near = &entity.GeoPoint{Latitude: lat, Longitude: lng}
+
+	criteria.DistanceKm = entity.OfficialEventSearchDefaultDistanceKm
+	if distanceQuery != "" {
+		distanceKm, err := strconv.ParseFloat(distanceQuery, 64)
+		if err != nil {
+			return nil, err
+		} else if !(0 < distanceKm && distanceKm <= entity.OfficialEventSearchMaxDistanceKm) {
+			return nil, errors.New("bad query parameter")
+		}
+		criteria.DistanceKm = distanceKm
+	}
+
+	return criteria, nil
+}
+
+// parseQueryIds はカンマで区切ったIDを分割する。空なら nil を返し、空のIDを含めばエラーにする。
+func parseQueryIds(query string) ([]string, error) {
+	if query == "" {
+		return nil, nil
+	}
+
+	ids := strings.Split(query, ",")
+	for _, id := range ids {
+		if id == "" {
+			return nil, errors.New("bad query parameter")
+		}
+	}
+
+	return ids, nil
+}
+
+// ParseQueryRound は回戦番号を解析して返す。未指定なら 0(全ての回戦)。
+func ParseQueryRound(ctx *gin.Context) (int, error) {
+	query := GetQueryRound(ctx)
+
+	if query == "" {
+		return 0, nil
+	}
+
+	round, err := strconv.Atoi(query)
+
+	if err != nil { // 取得したクエリパラメータが数値か否か
+		return 0, err
+	} else if round <= 0 {
+		return 0, errors.New("bad query parameter")
+	}
+
+	return round, nil
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
 // newTestContext は指定したクエリ文字列を持つGETリクエストのgin.Contextを返す。
@@ -508,3 +510,57 @@ func TestParseQueryWeek(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestParseQueryRecordCriteria(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系_絞り込みのクエリが無ければnilを返す", func(t *testing.T) {
+		criteria, err := ParseQueryRecordCriteria(newTestContext(t, "limit=10&event_type=official&deck_id=01JMPK4VF04QX714CG4PHYJ88K"))
+		require.NoError(t, err)
+		require.Nil(t, criteria)
+	})
+
+	t.Run("正常系_全ての絞り込みを解析する", func(t *testing.T) {
+		criteria, err := ParseQueryRecordCriteria(newTestContext(t,
+			"from_date=2026-07-01&to_date=2026-07-31&regulation_id=1&environment_id=env-1"+
+				"&official_event_type=city,gym&deck_code_id=code-1"+
+				"&opponent_fingerprint=s2,s1&opponent_deck_name=%E3%83%89%E3%83%A9%E3%83%91%E3%83%AB%E3%83%88"+
+				"&result=win&bo3=true&go_first=false&tag_ids=tag-1,tag-2&record_tag_ids=tag-3",
+		))
+		require.NoError(t, err)
+		require.NotNil(t, criteria)
+
+		require.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.Local), criteria.FromDate)
+		require.Equal(t, time.Date(2026, 7, 31, 0, 0, 0, 0, time.Local), criteria.ToDate)
+		require.Equal(t, uint(1), criteria.RegulationId)
+		require.Equal(t, "env-1", criteria.EnvironmentId)
+		require.Equal(t, []uint{2, 4}, criteria.OfficialEventTypeIds)
+		require.Equal(t, "code-1", criteria.DeckCodeId)
+		require.Equal(t, "s2,s1", criteria.OpponentFingerprint)
+		require.Equal(t, "ドラパルト", criteria.OpponentDeckName)
+		require.Equal(t, entity.MatchResultWin, criteria.Result)
+		require.True(t, *criteria.BO3Flg)
+		require.False(t, *criteria.GoFirst)
+		require.Equal(t, []string{"tag-1", "tag-2"}, criteria.TagIds)
+		require.Equal(t, []string{"tag-3"}, criteria.RecordTagIds)
+	})
+
+	t.Run("異常系_不正な値はエラーを返す", func(t *testing.T) {
+		for _, rawQuery := range []string{
+			"from_date=2026/07/01",
+			"from_date=2026-08-01&to_date=2026-07-31",
+			"regulation_id=abc",
+			"regulation_id=99",
+			"official_event_type=city,champions",
+			"opponent_fingerprint=s1,,s2",
+			"result=lost",
+			"bo3=maybe",
+			"go_first=1st",
+			"tag_ids=tag-1,",
+			"record_tag_ids=,tag-3",
+		} {
+			_, err := ParseQueryRecordCriteria(newTestContext(t, rawQuery))
+			require.Error(t, err, rawQuery)
+		}
+	})
+}
@@ -20,6 +20,15 @@ func GetQueryDate(ctx *gin.Context) string {
 	return ctx.Query("date")
 }
 
+// GetQueryFrom / GetQueryTo は差分を取る2つのデッキコードID(比較元/比較先)。
+func GetQueryFrom(ctx *gin.Context) string {
+	return ctx.Query("from")
+}
+
+func GetQueryTo(ctx *gin.Context) string {
+	return ctx.Query("to")
+}
+
 func GetQueryFromDate(ctx *gin.Context) string {
 	return ctx.Query("from_date")
 }
@@ -98,3 +107,86 @@ func GetQueryPeriod(ctx *gin.Context) string {
 func GetQueryWeek(ctx *gin.Context) string {
 	return ctx.Query("week")
 }
+
+// GetQueryFormat はエクスポートの形式(text / json)。
+func GetQueryFormat(ctx *gin.Context) string {
+	return ctx.Query("format")
+}
+
+// GetQuerySearch はメモの検索語(空白区切りで AND)。
+func GetQuerySearch(ctx *gin.Context) string {
+	return ctx.Query("q")
+}
+
+// 以下は記録・対戦結果の絞り込み(entity.RecordCriteria)に使うクエリ。
+
+// GetQueryOfficialEventType は公式イベントの種類(city / trainers / gym)をカンマで区切ったもの。
+func GetQueryOfficialEventType(ctx *gin.Context) string {
+	return ctx.Query("official_event_type")
+}
+
+func GetQueryDeckCodeId(ctx *gin.Context) string {
+	return ctx.Query("deck_code_id")
+}
+
+func GetQueryOpponentFingerprint(ctx *gin.Context) string {
+	return ctx.Query("opponent_fingerprint")
+}
+
+func GetQueryOpponentDeckName(ctx *gin.Context) string {
+	return ctx.Query("opponent_deck_name")
+}
+
+// GetQueryResult は対戦結果(win / lose / draw)。
+func GetQueryResult(ctx *gin.Context) string {
+	return ctx.Query("result")
+}
+
+func GetQueryBO3(ctx *gin.Context) string {
+	return ctx.Query("bo3")
+}
+
+func GetQueryGoFirst(ctx *gin.Context) string {
+	return ctx.Query("go_first")
+}
+
+// GetQueryTagIds はタグのIDをカンマで区切ったもの。
+func GetQueryTagIds(ctx *gin.Context) string {
+	return ctx.Query("tag_ids")
+}
+
+// GetQueryRecordTagIds は記録に付いたタグのIDをカンマで区切ったもの。
+// tag_ids(対戦結果のタグ)とは別に指定する。
+func GetQueryRecordTagIds(ctx *gin.Context) string {
+	return ctx.Query("record_tag_ids")
+}
+
+// GetQueryRecordTagId は成績を集計する記録のタグID。
+func GetQueryRecordTagId(ctx *gin.Context) string {
+	return ctx.Query("record_tag_id")
+}
+
+// GetQueryRound は組み合わせを取得する大会の回戦番号。
+func GetQueryRound(ctx *gin.Context) string {
+	return ctx.Query("round")
+}
+
+// 以下は公式イベントの検索(entity.OfficialEventSearchCriteria)に使うクエリ。
+
+func GetQueryPrefectureId(ctx *gin.Context) string {
+	return ctx.Query("prefecture_id")
+}
+
+// GetQueryLatitude / GetQueryLongitude は距離で絞り込む中心の緯度・経度(度)。
+func GetQueryLatitude(ctx *gin.Context) string {
+	return ctx.Query("lat")
+}
+
+func GetQueryLongitude(ctx *gin.Context) string {
+	return ctx.Query("lng")
+}
+
+// GetQueryDistanceKm は lat / lng からの半径(km)。
+func GetQueryDistanceKm(ctx *gin.Context) string {
+	return ctx.Query("distance_km")
+}
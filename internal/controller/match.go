@@ -27,6 +27,7 @@ type Match struct {
 	router           *gin.Engine
 	matchRepository  repository.MatchInterface
 	recordRepository repository.RecordInterface
+	trashRepository  repository.TrashInterface
 	usecase          usecase.MatchInterface
 }
 
@@ -34,9 +35,10 @@ func NewMatch(
 	router *gin.Engine,
 	matchRepository repository.MatchInterface,
 	recordRepository repository.RecordInterface,
+	trashRepository repository.TrashInterface,
 	usecase usecase.MatchInterface,
 ) *Match {
-	return &Match{router, matchRepository, recordRepository, usecase}
+	return &Match{router, matchRepository, recordRepository, trashRepository, usecase}
 }
 
 func (c *Match) RegisterRoute(relativePath string) {
@@ -53,6 +55,12 @@ func (c *Match) RegisterRoute(relativePath string) {
 			authorization.MatchGetByIdAuthorizationMiddleware(c.matchRepository, c.recordRepository),
 			c.GetById,
 		)
+		r.GET(
+			"/:id/history",
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.MatchHistoryAuthorizationMiddleware(c.matchRepository, c.trashRepository),
+			c.History,
+		)
 		r.POST(
 			"",
 			authentication.RequiredAuthenticationMiddleware(),
@@ -140,6 +148,7 @@ func (c *Match) GetById(ctx *gin.Context) {
 
 	res := presenter.NewMatchGetByIdResponse(match)
 
+	helper.SetETag(ctx, match.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -177,7 +186,36 @@ func (c *Match) GetByUserId(ctx *gin.Context) {
 		return
 	}
 
-	matches, err := c.usecase.FindByUserId(ctx.Request.Context(), userId, limit)
+	criteria, err := helper.ParseQueryRecordCriteria(ctx)
+	if err != nil {
+		apierror.ErrBadRequest.JSON(ctx, err)
+		return
+	}
+
+	var matches []*entity.Match
+	if criteria != nil {
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		eventType, err := helper.ParseQueryEventType(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		criteria.UserId = userId
+		criteria.EventType = eventType
+		criteria.DeckId = helper.GetQueryDeckId(ctx)
+		criteria.Limit = limit
+		criteria.Offset = offset
+
+		matches, err = c.usecase.FindByCriteria(ctx.Request.Context(), criteria)
+	} else {
+		matches, err = c.usecase.FindByUserId(ctx.Request.Context(), userId, limit)
+	}
 	if err != nil {
 		if errors.Is(err, apperror.ErrRecordNotFound) {
 			ctx.JSON(http.StatusOK, []*dto.MatchResponse{})
@@ -309,30 +347,52 @@ func (c *Match) Update(ctx *gin.Context) {
 	// TagIds は NewMatchParam の引数に含めていないため、ここで直接設定する。
 	param.TagIds = req.TagIds
 
-	match, err := c.usecase.Update(ctx.Request.Context(), id, param)
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	match, err := c.usecase.Update(reqCtx, id, param)
 	if err != nil {
 		// 対戦結果の整合性エラーは 400。
 		if errors.Is(err, apperror.ErrInvalidMatch) {
 			apierror.ErrBadRequest.JSON(ctx, err)
 			return
 		}
+		// 読み込んだ版から他の端末で更新されていた場合は 412。
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
 	}
 
 	res := presenter.NewMatchUpdateResponse(match)
 
+	helper.SetETag(ctx, match.UpdatedAt)
 	ctx.JSON(http.StatusCreated, res)
 }
 
 func (c *Match) Delete(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 
-	if err := c.usecase.Delete(ctx.Request.Context(), id); err != nil {
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	if err := c.usecase.Delete(reqCtx, id); err != nil {
 		if err == apperror.ErrRecordNotFound {
 			apierror.ErrBadRequestNotFound.JSON(ctx, err)
 			return
 		}
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
@@ -366,3 +426,33 @@ func (c *Match) Reorder(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusNoContent, gin.H{})
 }
+
+func (c *Match) History(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	limit, err := helper.ParseQueryLimit(ctx)
+	if err != nil {
+		apierror.ErrBadRequest.JSON(ctx, err)
+		return
+	}
+
+	offset, err := helper.ParseQueryOffset(ctx)
+	if err != nil {
+		apierror.ErrBadRequest.JSON(ctx, err)
+		return
+	}
+
+	revisions, err := c.usecase.History(ctx.Request.Context(), id, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res, err := presenter.NewMatchHistoryResponse(limit, offset, revisions)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
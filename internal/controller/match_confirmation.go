@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	MatchConfirmationsPath = "/match_confirmations"
+	MatchConfirmationPath  = "/confirmation"
+)
+
+type MatchConfirmation struct {
+	router          *gin.Engine
+	matchRepository repository.MatchInterface
+	usecase         usecase.MatchConfirmationInterface
+}
+
+func NewMatchConfirmation(
+	router *gin.Engine,
+	matchRepository repository.MatchInterface,
+	usecase usecase.MatchConfirmationInterface,
+) *MatchConfirmation {
+	return &MatchConfirmation{router, matchRepository, usecase}
+}
+
+func (c *MatchConfirmation) RegisterRoute(relativePath string) {
+	c.router.POST(
+		relativePath+MatchesPath+"/:id"+MatchConfirmationPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.MatchConfirmationRequestAuthorizationMiddleware(c.matchRepository),
+		c.Request,
+	)
+
+	c.router.GET(
+		relativePath+UsersPath+"/:id"+MatchConfirmationsPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.MatchConfirmationAuthorizationMiddleware(),
+		validation.MatchConfirmationGetMiddleware(),
+		c.GetByOpponentUserId,
+	)
+
+	// 個々の依頼は当事者(依頼した側・対戦相手)かを usecase 側で確かめる。
+	{
+		r := c.router.Group(relativePath + MatchConfirmationsPath)
+		r.GET(
+			"/:id",
+			authentication.RequiredAuthenticationMiddleware(),
+			c.GetById,
+		)
+		r.POST(
+			"/:id/confirm",
+			authentication.RequiredAuthenticationMiddleware(),
+			validation.MatchConfirmationConfirmMiddleware(),
+			c.Confirm,
+		)
+		r.POST(
+			"/:id/dispute",
+			authentication.RequiredAuthenticationMiddleware(),
+			validation.MatchConfirmationDisputeMiddleware(),
+			c.Dispute,
+		)
+	}
+}
+
+// handleError は確認依頼の usecase のエラーをレスポンスにする。
+func (c *MatchConfirmation) handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apperror.ErrRecordNotFound):
+		apierror.ErrNotFound.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrNoMatchOpponent):
+		apierror.ErrNoMatchOpponent.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrMatchConfirmationClosed):
+		apierror.ErrMatchConfirmationClosed.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrUserBlocked):
+		apierror.ErrUserBlocked.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrInvalidRecord) || errors.Is(err, apperror.ErrInvalidMatch):
+		apierror.ErrBadRequest.JSON(ctx, err)
+	default:
+		apierror.ErrInternalServerError.JSON(ctx, err)
+	}
+}
+
+func (c *MatchConfirmation) Request(ctx *gin.Context) {
+	matchId := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	confirmation, err := c.usecase.Request(ctx.Request.Context(), uid, matchId)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	res := presenter.NewMatchConfirmationResponse(confirmation, uid)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *MatchConfirmation) GetByOpponentUserId(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+
+	confirmations, err := c.usecase.FindByOpponentUserId(ctx.Request.Context(), userId, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewMatchConfirmationGetResponse(limit, offset, confirmations, userId)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *MatchConfirmation) GetById(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	confirmation, err := c.usecase.FindById(ctx.Request.Context(), uid, id)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	res := presenter.NewMatchConfirmationResponse(confirmation, uid)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *MatchConfirmation) Confirm(ctx *gin.Context) {
+	req := helper.GetMatchConfirmationConfirmRequest(ctx)
+	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	confirmation, err := c.usecase.Confirm(ctx.Request.Context(), uid, id, req.RecordId)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	res := presenter.NewMatchConfirmationResponse(confirmation, uid)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *MatchConfirmation) Dispute(ctx *gin.Context) {
+	req := helper.GetMatchConfirmationDisputeRequest(ctx)
+	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	confirmation, err := c.usecase.Dispute(ctx.Request.Context(), uid, id, req.Reason)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	res := presenter.NewMatchConfirmationResponse(confirmation, uid)
+
+	ctx.JSON(http.StatusOK, res)
+}
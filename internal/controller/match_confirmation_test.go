@@ -0,0 +1,309 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestMatchConfirmationController(t *testing.T) (
+	*MatchConfirmation,
+	*mock_repository.MockMatchInterface,
+	*mock_usecase.MockMatchConfirmationInterface,
+	string,
+) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockMatchRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+	mockUsecase := mock_usecase.NewMockMatchConfirmationInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewMatchConfirmation(r, mockMatchRepository, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockMatchRepository, mockUsecase, secretKey
+}
+
+func TestMatchConfirmationController(t *testing.T) {
+	requesterId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	opponentId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	matchId := "01JTESTMATCH0000000000000A"
+	id := "01JTESTCONFIRM000000000000"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	newMatch := func() *entity.Match {
+		return &entity.Match{
+			ID:         matchId,
+			UserId:     requesterId,
+			VictoryFlg: true,
+			Memo:       "相手の動きが読めた",
+			Games: []*entity.Game{
+				{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 2, Memo: "序盤から押し切った"},
+			},
+		}
+	}
+
+	t.Run("Request", func(t *testing.T) {
+		t.Run("正常系_対戦結果の所有者が確認を依頼する", func(t *testing.T) {
+			c, mockMatchRepository, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Match = newMatch()
+
+			mockMatchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mockUsecase.EXPECT().Request(gomock.Any(), requesterId, matchId).Return(confirmation, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+matchId+MatchConfirmationPath, nil)
+			setJWTAuthHeader(t, req, requesterId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			var res dto.MatchConfirmationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "pending", res.Status)
+			require.Nil(t, res.RespondedAt)
+			require.False(t, res.InSync)
+			require.Equal(t, "相手の動きが読めた", res.Match.Memo)
+		})
+
+		t.Run("異常系_対戦相手がいなければ422を返す", func(t *testing.T) {
+			c, mockMatchRepository, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockMatchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mockUsecase.EXPECT().Request(gomock.Any(), requesterId, matchId).Return(nil, apperror.ErrNoMatchOpponent)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+matchId+MatchConfirmationPath, nil)
+			setJWTAuthHeader(t, req, requesterId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		})
+
+		t.Run("異常系_回答待ちの依頼があれば409を返す", func(t *testing.T) {
+			c, mockMatchRepository, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockMatchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mockUsecase.EXPECT().Request(gomock.Any(), requesterId, matchId).Return(nil, apperror.ErrMatchConfirmationClosed)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+matchId+MatchConfirmationPath, nil)
+			setJWTAuthHeader(t, req, requesterId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_どちらかがブロックしていれば403を返す", func(t *testing.T) {
+			c, mockMatchRepository, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockMatchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mockUsecase.EXPECT().Request(gomock.Any(), requesterId, matchId).Return(nil, apperror.ErrUserBlocked)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+matchId+MatchConfirmationPath, nil)
+			setJWTAuthHeader(t, req, requesterId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_他人の対戦結果には依頼できない", func(t *testing.T) {
+			c, mockMatchRepository, _, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockMatchRepository.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+matchId+MatchConfirmationPath, nil)
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("GetById", func(t *testing.T) {
+		t.Run("正常系_対戦相手には依頼した側のメモを返さない", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Match = newMatch()
+
+			mockUsecase.EXPECT().FindById(gomock.Any(), opponentId, id).Return(confirmation, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", MatchConfirmationsPath+"/"+id, nil)
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.MatchConfirmationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Empty(t, res.Match.Memo)
+			require.Empty(t, res.Match.Games[0].Memo)
+			require.Nil(t, res.MirroredMatch)
+		})
+
+		t.Run("異常系_当事者でなければ404を返す", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockUsecase.EXPECT().FindById(gomock.Any(), opponentId, id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", MatchConfirmationsPath+"/"+id, nil)
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("GetByOpponentUserId", func(t *testing.T) {
+		t.Run("正常系_届いた依頼の一覧を返す", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+
+			mockUsecase.EXPECT().FindByOpponentUserId(gomock.Any(), opponentId, 10, 0).Return([]*entity.MatchConfirmation{confirmation}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+opponentId+MatchConfirmationsPath+"?limit=10", nil)
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.MatchConfirmationGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, 10, res.Limit)
+			require.Len(t, res.MatchConfirmations, 1)
+		})
+
+		t.Run("異常系_他人に届いた依頼は見られない", func(t *testing.T) {
+			c, _, _, secretKey := setup4TestMatchConfirmationController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+opponentId+MatchConfirmationsPath, nil)
+			setJWTAuthHeader(t, req, requesterId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		t.Run("正常系_承認する", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			recordId := "01JTESTRECORD000000000000B"
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Confirm("01JTESTMATCH0000000000000B", now)
+
+			mockUsecase.EXPECT().Confirm(gomock.Any(), opponentId, id, recordId).Return(confirmation, nil)
+
+			body, _ := json.Marshal(dto.MatchConfirmationConfirmRequest{RecordId: recordId})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchConfirmationsPath+"/"+id+"/confirm", bytes.NewBuffer(body))
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.MatchConfirmationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "confirmed", res.Status)
+			require.Equal(t, "01JTESTMATCH0000000000000B", res.MirroredMatchId)
+			require.NotNil(t, res.RespondedAt)
+		})
+
+		t.Run("異常系_record_idが無ければ400を返す", func(t *testing.T) {
+			c, _, _, secretKey := setup4TestMatchConfirmationController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchConfirmationsPath+"/"+id+"/confirm", bytes.NewBufferString(`{}`))
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_他人の記録を指定すると400を返す", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			recordId := "01JTESTRECORD000000000000A"
+			mockUsecase.EXPECT().Confirm(gomock.Any(), opponentId, id, recordId).Return(nil, apperror.ErrInvalidRecord)
+
+			body, _ := json.Marshal(dto.MatchConfirmationConfirmRequest{RecordId: recordId})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchConfirmationsPath+"/"+id+"/confirm", bytes.NewBuffer(body))
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	})
+
+	t.Run("Dispute", func(t *testing.T) {
+		t.Run("正常系_異議を申し立てる", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Dispute("2戦目は私の勝ちです", now)
+
+			mockUsecase.EXPECT().Dispute(gomock.Any(), opponentId, id, "2戦目は私の勝ちです").Return(confirmation, nil)
+
+			body, _ := json.Marshal(dto.MatchConfirmationDisputeRequest{Reason: "2戦目は私の勝ちです"})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchConfirmationsPath+"/"+id+"/dispute", bytes.NewBuffer(body))
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.MatchConfirmationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "disputed", res.Status)
+			require.Equal(t, "2戦目は私の勝ちです", res.DisputeReason)
+		})
+
+		t.Run("異常系_回答済みなら409を返す", func(t *testing.T) {
+			c, _, mockUsecase, secretKey := setup4TestMatchConfirmationController(t)
+
+			mockUsecase.EXPECT().Dispute(gomock.Any(), opponentId, id, "").Return(nil, apperror.ErrMatchConfirmationClosed)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchConfirmationsPath+"/"+id+"/dispute", bytes.NewBufferString(`{}`))
+			setJWTAuthHeader(t, req, opponentId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+	})
+}
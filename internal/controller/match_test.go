@@ -39,7 +39,7 @@ func setup4TestMatchController(t *testing.T, r *gin.Engine) (
 ) {
 	mockMatchRepository, mockRecordRepository, mockUsecase := setupMock4TestMatchController(t)
 
-	c := NewMatch(r, mockMatchRepository, mockRecordRepository, mockUsecase)
+	c := NewMatch(r, mockMatchRepository, mockRecordRepository, mock_repository.NewMockTrashInterface(gomock.NewController(t)), mockUsecase)
 	c.RegisterRoute("")
 
 	return c, mockMatchRepository, mockRecordRepository, mockUsecase
@@ -51,6 +51,7 @@ func TestMatchController(t *testing.T) {
 	for scenario, fn := range map[string]func(t *testing.T){
 		"GetById":       test_MatchController_GetById,
 		"GetByRecordId": test_MatchController_GetByRecordId,
+		"GetByUserId":   test_MatchController_GetByUserId,
 		"Create":        test_MatchController_Create,
 		"Update":        test_MatchController_Update,
 		"Delete":        test_MatchController_Delete,
@@ -267,6 +268,113 @@ func test_MatchController_GetByRecordId(t *testing.T) {
 	})
 }
 
+func test_MatchController_GetByUserId(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	t.Run("正常系_絞り込みのクエリが無ければ最近の対戦結果を返す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, mockUsecase := setup4TestMatchController(t, r)
+
+		mockUsecase.EXPECT().FindByUserId(gomock.Any(), uid, 10).Return([]*entity.Match{{ID: "match-1", UserId: uid}}, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/users/"+uid+"/matches", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res []*dto.MatchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res, 1)
+	})
+
+	t.Run("正常系_絞り込みのクエリがあれば条件をまとめて渡す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, mockUsecase := setup4TestMatchController(t, r)
+
+		goFirst := false
+		expected := &entity.RecordCriteria{
+			UserId:              uid,
+			DeckId:              "deck-1",
+			OpponentFingerprint: "s1,s2",
+			GoFirst:             &goFirst,
+			Limit:               5,
+			Offset:              5,
+		}
+
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), expected).Return([]*entity.Match{{ID: "match-1", UserId: uid}}, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/users/"+uid+"/matches?limit=5&offset=5&deck_id=deck-1&opponent_fingerprint=s1,s2&go_first=false", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res []*dto.MatchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res, 1)
+	})
+
+	t.Run("正常系_該当なしの場合も200で空一覧を返す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, mockUsecase := setup4TestMatchController(t, r)
+
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), gomock.Any()).Return(nil, apperror.ErrRecordNotFound)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/users/"+uid+"/matches?result=draw", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, "[]", w.Body.String())
+	})
+
+	t.Run("異常系_絞り込みのクエリが不正なら400を返す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, _ := setup4TestMatchController(t, r)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/users/"+uid+"/matches?official_event_type=worlds", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_他人の対戦結果は403を返す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, _ := setup4TestMatchController(t, r)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/users/other-user/matches?result=win", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
 func test_MatchController_Create(t *testing.T) {
 
 	t.Run("正常系_マッチを作成する", func(t *testing.T) {
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	MemoSearchPath = "/search"
+)
+
+type MemoSearch struct {
+	router       *gin.Engine
+	usecase      usecase.MemoSearchInterface
+	relativePath string
+}
+
+func NewMemoSearch(
+	router *gin.Engine,
+	usecase usecase.MemoSearchInterface,
+) *MemoSearch {
+	return &MemoSearch{router: router, usecase: usecase}
+}
+
+func (c *MemoSearch) RegisterRoute(relativePath string) {
+	// 一致したメモの記録・対戦結果のURLを組み立てるために保持する。
+	c.relativePath = relativePath
+
+	r := c.router.Group(relativePath + UsersPath)
+	r.GET(
+		"/:id"+MemoSearchPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.MemoSearchAuthorizationMiddleware(),
+		validation.MemoSearchMiddleware(),
+		c.Search,
+	)
+}
+
+func (c *MemoSearch) recordURL(id string) string {
+	return c.relativePath + RecordsPath + "/" + id
+}
+
+func (c *MemoSearch) matchURL(id string) string {
+	return c.relativePath + MatchesPath + "/" + id
+}
+
+func (c *MemoSearch) Search(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	terms := helper.GetSearchTerms(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+
+	hits, err := c.usecase.Search(ctx.Request.Context(), userId, terms, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewMemoSearchResponse(limit, offset, hits, c.recordURL, c.matchURL)
+
+	ctx.JSON(http.StatusOK, res)
+}
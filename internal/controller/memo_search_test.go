@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestMemoSearchController(t *testing.T) (*MemoSearch, *mock_usecase.MockMemoSearchInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockMemoSearchInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewMemoSearch(r, mockUsecase)
+	c.RegisterRoute("/api/v1beta")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestMemoSearchController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	searchPath := "/api/v1beta" + UsersPath + "/" + uid + MemoSearchPath
+
+	t.Run("正常系_一致したメモをスニペットと記録・対戦結果のURL付きで返す", func(t *testing.T) {
+		c, mockUsecase, secretKey := setup4TestMemoSearchController(t)
+
+		mockUsecase.EXPECT().Search(gomock.Any(), uid, []string{"ドラパルト", "先攻"}, 20, 0).Return([]*entity.MemoSearchHit{
+			{
+				Type:     entity.MemoSearchHitTypeGame,
+				ID:       "01JMPK4VF04QX714CG4PHYJ88N",
+				RecordId: "01JMPK4VF04QX714CG4PHYJ88K",
+				MatchId:  "01JMPK4VF04QX714CG4PHYJ88M",
+				Snippet: &entity.MemoSnippet{
+					Text:       "先攻でドラパルト",
+					Highlights: []*entity.MemoHighlight{{Offset: 0, Length: 2}, {Offset: 3, Length: 5}},
+				},
+			},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", searchPath+"?limit=20&q="+url.QueryEscape("ドラパルト　先攻"), nil)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var res dto.MemoSearchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Hits, 1)
+		require.Equal(t, "game", res.Hits[0].Type)
+		require.Equal(t, "/api/v1beta/records/01JMPK4VF04QX714CG4PHYJ88K", res.Hits[0].RecordURL)
+		require.Equal(t, "/api/v1beta/matches/01JMPK4VF04QX714CG4PHYJ88M", res.Hits[0].MatchURL)
+		require.Len(t, res.Hits[0].Snippet.Highlights, 2)
+	})
+
+	t.Run("異常系_検索語が無ければ400を返す", func(t *testing.T) {
+		c, _, secretKey := setup4TestMemoSearchController(t)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", searchPath+"?q=", nil)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_他人のメモは検索できない", func(t *testing.T) {
+		c, _, secretKey := setup4TestMemoSearchController(t)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", searchPath+"?q=test", nil)
+		setJWTAuthHeader(t, req, "other-user", secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+		c, mockUsecase, secretKey := setup4TestMemoSearchController(t)
+
+		mockUsecase.EXPECT().Search(gomock.Any(), uid, []string{"test"}, 10, 0).Return(nil, errors.New(""))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", searchPath+"?q=test", nil)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
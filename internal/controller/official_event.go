@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	OfficialEventsPath = "/official_events"
+	OfficialEventsPath      = "/official_events"
+	OfficialEventSearchPath = "/search"
 )
 
 type OfficialEvent struct {
@@ -38,6 +39,11 @@ func (c *OfficialEvent) RegisterRoute(relativePath string) {
 		validation.OfficialEventGetMiddleware(),
 		c.Get,
 	)
+	r.GET(
+		OfficialEventSearchPath,
+		validation.OfficialEventSearchMiddleware(),
+		c.Search,
+	)
 	r.GET(
 		"/:id",
 		validation.OfficialEventGetByIdMiddleware(),
@@ -95,3 +101,17 @@ func (c *OfficialEvent) GetById(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, res)
 }
+
+func (c *OfficialEvent) Search(ctx *gin.Context) {
+	criteria := helper.GetOfficialEventSearchCriteria(ctx)
+
+	hits, err := c.usecase.Search(ctx.Request.Context(), criteria)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewOfficialEventSearchResponse(criteria.Limit, criteria.Offset, hits)
+
+	ctx.JSON(http.StatusOK, res)
+}
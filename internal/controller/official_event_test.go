@@ -48,6 +48,7 @@ func TestOfficialEventController(t *testing.T) {
 	for scenario, fn := range map[string]func(t *testing.T){
 		"Get":     test_OfficialEventController_Get,
 		"GetById": test_OfficialEventController_GetById,
+		"Search":  test_OfficialEventController_Search,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -276,3 +277,89 @@ func test_OfficialEventController_GetById(t *testing.T) {
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func test_OfficialEventController_Search(t *testing.T) {
+	r := gin.Default()
+	c, mockUsecase := setup4TestOfficialEventController(t, r)
+
+	t.Run("正常系_検索語と都道府県と位置で検索する", func(t *testing.T) {
+		distanceKm := 3.2
+		hits := []*entity.OfficialEventSearchHit{
+			{OfficialEvent: &entity.OfficialEvent{ID: uint(606466), ShopName: "ポケモンセンターフクオカ"}, DistanceKm: &distanceKm},
+		}
+
+		mockUsecase.EXPECT().Search(gomock.Any(), &entity.OfficialEventSearchCriteria{
+			Terms:        []string{"ジムバトル", "フクオカ"},
+			PrefectureId: 40,
+			Near:         &entity.GeoPoint{Latitude: 33.5902, Longitude: 130.4017},
+			DistanceKm:   5,
+			Limit:        20,
+			Offset:       40,
+		}).Return(hits, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", OfficialEventsPath+OfficialEventSearchPath+
+			"?q=%E3%82%B8%E3%83%A0%E3%83%90%E3%83%88%E3%83%AB+%E3%83%95%E3%82%AF%E3%82%AA%E3%82%AB"+
+			"&prefecture_id=40&lat=33.5902&lng=130.4017&distance_km=5&limit=20&offset=40", nil)
+		c.router.ServeHTTP(w, req)
+
+		var res dto.OfficialEventSearchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, 20, res.Limit)
+		require.Equal(t, 40, res.Offset)
+		require.Len(t, res.OfficialEvents, 1)
+		require.Equal(t, uint(606466), res.OfficialEvents[0].ID)
+		require.Equal(t, 3.2, *res.OfficialEvents[0].DistanceKm)
+	})
+
+	t.Run("正常系_距離を省略すると既定の半径で検索する", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(gomock.Any(), &entity.OfficialEventSearchCriteria{
+			Near:       &entity.GeoPoint{Latitude: 35.6812, Longitude: 139.7671},
+			DistanceKm: entity.OfficialEventSearchDefaultDistanceKm,
+			Limit:      10,
+		}).Return([]*entity.OfficialEventSearchHit{}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", OfficialEventsPath+OfficialEventSearchPath+"?lat=35.6812&lng=139.7671", nil)
+		c.router.ServeHTTP(w, req)
+
+		var res dto.OfficialEventSearchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, res.OfficialEvents)
+	})
+
+	t.Run("異常系_不正なクエリは400を返す", func(t *testing.T) {
+		for _, query := range []string{
+			"prefecture_id=0",
+			"prefecture_id=48",
+			"lat=35.6812",
+			"lat=91&lng=139.7671",
+			"lat=NaN&lng=139.7671",
+			"distance_km=5",
+			"lat=35.6812&lng=139.7671&distance_km=0",
+			"lat=35.6812&lng=139.7671&distance_km=301",
+			"from_date=2025-03-01&to_date=2025-02-01",
+			"q=a+b+c+d+e+f",
+		} {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", OfficialEventsPath+OfficialEventSearchPath+"?"+query, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+
+	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New(""))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", OfficialEventsPath+OfficialEventSearchPath+"?q=test", nil)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
 	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/usecase"
 )
 
@@ -21,15 +22,17 @@ const (
 )
 
 type OpponentDeckUsageStat struct {
-	router  *gin.Engine
-	usecase usecase.OpponentDeckUsageStatInterface
+	router                 *gin.Engine
+	relationshipRepository repository.UserRelationshipInterface
+	usecase                usecase.OpponentDeckUsageStatInterface
 }
 
 func NewOpponentDeckUsageStat(
 	router *gin.Engine,
+	relationshipRepository repository.UserRelationshipInterface,
 	usecase usecase.OpponentDeckUsageStatInterface,
 ) *OpponentDeckUsageStat {
-	return &OpponentDeckUsageStat{router, usecase}
+	return &OpponentDeckUsageStat{router, relationshipRepository, usecase}
 }
 
 func (c *OpponentDeckUsageStat) RegisterRoute(relativePath string) {
@@ -37,7 +40,7 @@ func (c *OpponentDeckUsageStat) RegisterRoute(relativePath string) {
 	r.GET(
 		"/:id"+OpponentDeckUsageStatsPath,
 		authentication.RequiredAuthenticationMiddleware(),
-		authorization.OpponentDeckUsageStatAuthorizationMiddleware(),
+		authorization.OpponentDeckUsageStatAuthorizationMiddleware(c.relationshipRepository),
 		validation.OpponentDeckUsageStatGetMiddleware(),
 		c.GetByUserId,
 	)
@@ -51,8 +54,10 @@ func (c *OpponentDeckUsageStat) GetByUserId(ctx *gin.Context) {
 	standardRegulationId := helper.GetStandardRegulationId(ctx)
 	regulationId := helper.GetRegulationId(ctx)
 	deckId := helper.GetDeckId(ctx)
+	// フレンドには非公開の記録の対戦相手を見せない。
+	excludePrivate := helper.GetUID(ctx) != uid
 
-	stat, err := c.usecase.GetOpponentDeckUsageStat(ctx.Request.Context(), uid, yearMonth, environmentId, season, standardRegulationId, regulationId, deckId)
+	stat, err := c.usecase.GetOpponentDeckUsageStat(ctx.Request.Context(), uid, yearMonth, environmentId, season, standardRegulationId, regulationId, deckId, excludePrivate)
 	if err != nil {
 		if errors.Is(err, apperror.ErrRecordNotFound) {
 			apierror.ErrNotFound.JSON(ctx, err)
@@ -11,11 +11,17 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
 	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
 	"github.com/vsrecorder/core-apiserver/internal/testutil"
 )
 
-func setup4TestOpponentDeckUsageStatController(t *testing.T) (*OpponentDeckUsageStat, *mock_usecase.MockOpponentDeckUsageStatInterface, string) {
+func setup4TestOpponentDeckUsageStatController(t *testing.T) (
+	*OpponentDeckUsageStat,
+	*mock_repository.MockUserRelationshipInterface,
+	*mock_usecase.MockOpponentDeckUsageStatInterface,
+	string,
+) {
 	t.Helper()
 
 	gin.SetMode(gin.TestMode)
@@ -25,24 +31,25 @@ func setup4TestOpponentDeckUsageStatController(t *testing.T) (*OpponentDeckUsage
 	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
 
 	mockCtrl := gomock.NewController(t)
+	mockRelationshipRepository := mock_repository.NewMockUserRelationshipInterface(mockCtrl)
 	mockUsecase := mock_usecase.NewMockOpponentDeckUsageStatInterface(mockCtrl)
 
 	r := gin.Default()
-	c := NewOpponentDeckUsageStat(r, mockUsecase)
+	c := NewOpponentDeckUsageStat(r, mockRelationshipRepository, mockUsecase)
 	c.RegisterRoute("")
 
-	return c, mockUsecase, secretKey
+	return c, mockRelationshipRepository, mockUsecase, secretKey
 }
 
 func TestOpponentDeckUsageStatController_GetByUserId(t *testing.T) {
 	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
 
 	t.Run("正常系_本人なら集計条件を渡して相手デッキ統計を返す", func(t *testing.T) {
-		c, mockUsecase, secretKey := setup4TestOpponentDeckUsageStatController(t)
+		c, _, mockUsecase, secretKey := setup4TestOpponentDeckUsageStatController(t)
 
 		deckId := "01HD7Y3K8D6FDHMHTZ2GT41TN2"
 
-		mockUsecase.EXPECT().GetOpponentDeckUsageStat(gomock.Any(), uid, "2026-07", "", "", "", uint(0), deckId).
+		mockUsecase.EXPECT().GetOpponentDeckUsageStat(gomock.Any(), uid, "2026-07", "", "", "", uint(0), deckId, false).
 			Return(&entity.OpponentDeckUsageStat{}, nil)
 
 		w := httptest.NewRecorder()
@@ -54,7 +61,7 @@ func TestOpponentDeckUsageStatController_GetByUserId(t *testing.T) {
 	})
 
 	t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
-		c, _, _ := setup4TestOpponentDeckUsageStatController(t)
+		c, _, _, _ := setup4TestOpponentDeckUsageStatController(t)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", UsersPath+"/"+uid+OpponentDeckUsageStatsPath, nil)
@@ -63,8 +70,28 @@ func TestOpponentDeckUsageStatController_GetByUserId(t *testing.T) {
 		require.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 
+	t.Run("正常系_フレンドなら非公開の記録を除いた相手デッキ統計を返す", func(t *testing.T) {
+		c, mockRelationshipRepository, mockUsecase, secretKey := setup4TestOpponentDeckUsageStatController(t)
+
+		friendId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), friendId, uid).Return(true, nil)
+		// フレンドには非公開の記録の対戦相手を含めない。
+		mockUsecase.EXPECT().GetOpponentDeckUsageStat(gomock.Any(), uid, "", "", "", "", uint(0), "", true).
+			Return(&entity.OpponentDeckUsageStat{}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", UsersPath+"/"+uid+OpponentDeckUsageStatsPath, nil)
+		setJWTAuthHeader(t, req, friendId, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
 	t.Run("異常系_他人の統計は403を返す", func(t *testing.T) {
-		c, _, secretKey := setup4TestOpponentDeckUsageStatController(t)
+		c, mockRelationshipRepository, _, secretKey := setup4TestOpponentDeckUsageStatController(t)
+
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), "KBp7roRDZobZg1t0OPzFR1kvLeO2", uid).Return(false, nil)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", UsersPath+"/"+uid+OpponentDeckUsageStatsPath, nil)
@@ -75,9 +102,9 @@ func TestOpponentDeckUsageStatController_GetByUserId(t *testing.T) {
 	})
 
 	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
-		c, mockUsecase, secretKey := setup4TestOpponentDeckUsageStatController(t)
+		c, _, mockUsecase, secretKey := setup4TestOpponentDeckUsageStatController(t)
 
-		mockUsecase.EXPECT().GetOpponentDeckUsageStat(gomock.Any(), uid, "", "", "", "", uint(0), "").
+		mockUsecase.EXPECT().GetOpponentDeckUsageStat(gomock.Any(), uid, "", "", "", "", uint(0), "", false).
 			Return(nil, errors.New(""))
 
 		w := httptest.NewRecorder()
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// NewAttachmentResponse は写真を返す。取得先のURLは添付先のパスに依存するため、
+// url(thumbnail ならサムネイルの取得先)で controller から受け取る。
+func NewAttachmentResponse(
+	attachment *entity.Attachment,
+	url func(attachment *entity.Attachment, thumbnail bool) string,
+) *dto.AttachmentResponse {
+	return &dto.AttachmentResponse{
+		ID:           attachment.ID,
+		CreatedAt:    attachment.CreatedAt,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		Width:        attachment.Width,
+		Height:       attachment.Height,
+		URL:          url(attachment, false),
+		ThumbnailURL: url(attachment, true),
+	}
+}
+
+func NewAttachmentGetResponse(
+	attachments []*entity.Attachment,
+	url func(attachment *entity.Attachment, thumbnail bool) string,
+) *dto.AttachmentGetResponse {
+	res := &dto.AttachmentGetResponse{
+		Attachments: []*dto.AttachmentResponse{},
+	}
+
+	for _, attachment := range attachments {
+		res.Attachments = append(res.Attachments, NewAttachmentResponse(attachment, url))
+	}
+
+	return res
+}
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func NewCardStatResponse(
+	stat *entity.UserCardStat,
+	yearMonth string,
+	environmentId string,
+	season string,
+	standardRegulationId string,
+	regulationId uint,
+) *dto.CardStatResponse {
+	cards := []*dto.CardStatItemResponse{}
+	for _, card := range stat.Cards {
+		cards = append(cards, &dto.CardStatItemResponse{
+			CardId:   card.CardId,
+			CardName: card.CardName,
+			Category: string(card.Category),
+			With:     newCardStatSplitResponse(card.With),
+			Without:  newCardStatSplitResponse(card.Without),
+		})
+	}
+
+	return &dto.CardStatResponse{
+		UserId:               stat.UserId,
+		YearMonth:            yearMonth,
+		EnvironmentId:        environmentId,
+		Season:               season,
+		StandardRegulationId: standardRegulationId,
+		RegulationId:         regulationId,
+		TotalMatches:         stat.MatchCount,
+		Cards:                cards,
+	}
+}
+
+func newCardStatSplitResponse(split *entity.CardStatSplit) dto.CardStatSplitResponse {
+	return dto.CardStatSplitResponse{
+		MatchCount:      split.MatchCount,
+		Wins:            split.WinCount,
+		Losses:          split.LoseCount,
+		Draws:           split.DrawCount,
+		WinRate:         split.WinRate,
+		GoFirstCount:    split.GoFirstCount,
+		GoFirstWins:     split.GoFirstWins,
+		GoFirstWinRate:  split.GoFirstWinRate,
+		GoSecondCount:   split.GoSecondCount,
+		GoSecondWins:    split.GoSecondWins,
+		GoSecondWinRate: split.GoSecondWinRate,
+	}
+}
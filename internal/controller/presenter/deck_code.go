@@ -1,6 +1,9 @@
 package presenter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
@@ -55,3 +58,217 @@ func NewDeckCodeUpdateResponse(
 		DeckCodeResponse: newDeckCodeResponse(deckcode),
 	}
 }
+
+func NewDeckCodeCardsResponse(
+	deckcode *entity.DeckCode,
+) *dto.DeckCodeCardsResponse {
+	cards := []*dto.DeckCodeCardResponse{}
+	totalCount := uint(0)
+
+	for _, card := range deckcode.Cards {
+		cards = append(cards, &dto.DeckCodeCardResponse{
+			CardId:   card.CardId,
+			CardName: card.CardName,
+			Category: string(card.Category),
+			Count:    card.Count,
+		})
+		totalCount += card.Count
+	}
+
+	return &dto.DeckCodeCardsResponse{
+		DeckCodeId: deckcode.ID,
+		TotalCount: totalCount,
+		Cards:      cards,
+	}
+}
+
+// NewDeckListText はカード構成をテキスト形式のデッキリストにする。
+// 区分ごとに「見出し (枚数)」と「枚数 カード名」の行を並べ、そのまま取り込み直せる形にする。
+func NewDeckListText(
+	deckcode *entity.DeckCode,
+) string {
+	categories := []entity.DeckCardCategory{}
+	cardsByCategory := map[entity.DeckCardCategory][]*entity.DeckCodeCard{}
+	for _, card := range deckcode.Cards {
+		if _, ok := cardsByCategory[card.Category]; !ok {
+			categories = append(categories, card.Category)
+		}
+		cardsByCategory[card.Category] = append(cardsByCategory[card.Category], card)
+	}
+
+	var b strings.Builder
+	for i, category := range categories {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		count := uint(0)
+		for _, card := range cardsByCategory[category] {
+			count += card.Count
+		}
+		fmt.Fprintf(&b, "%s (%d)\n", category.Label(), count)
+
+		for _, card := range cardsByCategory[category] {
+			fmt.Fprintf(&b, "%d %s\n", card.Count, card.CardName)
+		}
+	}
+
+	return b.String()
+}
+
+func newDeckCodeVersionResponse(
+	deckcode *entity.DeckCode,
+	stat *entity.DeckCodeStat,
+) dto.DeckCodeVersionResponse {
+	return dto.DeckCodeVersionResponse{
+		DeckCodeResponse: newDeckCodeResponse(deckcode),
+		Stat: dto.DeckCodeStatResponse{
+			RecordCount: stat.RecordCount,
+			MatchCount:  stat.MatchCount,
+			WinCount:    stat.WinCount,
+			LoseCount:   stat.LoseCount,
+			DrawCount:   stat.DrawCount,
+			WinRate:     stat.WinRate,
+		},
+	}
+}
+
+func newDeckCodeCardChangesResponse() dto.DeckCodeCardChangesResponse {
+	return dto.DeckCodeCardChangesResponse{
+		Added:   []*dto.DeckCodeCardChangeResponse{},
+		Removed: []*dto.DeckCodeCardChangeResponse{},
+		Changed: []*dto.DeckCodeCardChangeResponse{},
+	}
+}
+
+func NewDeckCodeDiffResponse(
+	diff *entity.DeckCodeDiff,
+) *dto.DeckCodeDiffResponse {
+	res := &dto.DeckCodeDiffResponse{
+		From:    newDeckCodeVersionResponse(diff.From, diff.FromStat),
+		To:      newDeckCodeVersionResponse(diff.To, diff.ToStat),
+		Pokemon: newDeckCodeCardChangesResponse(),
+		Trainer: newDeckCodeCardChangesResponse(),
+		Energy:  newDeckCodeCardChangesResponse(),
+	}
+
+	// ポケモン/トレーナーズ/エネルギーの大分類ごとに振り分ける
+	groups := map[entity.DeckCardGroup]*dto.DeckCodeCardChangesResponse{
+		entity.DeckCardGroupPokemon: &res.Pokemon,
+		entity.DeckCardGroupTrainer: &res.Trainer,
+		entity.DeckCardGroupEnergy:  &res.Energy,
+	}
+
+	for _, change := range diff.Added {
+		group := groups[change.Category.Group()]
+		group.Added = append(group.Added, newDeckCodeCardChangeResponse(change))
+	}
+	for _, change := range diff.Removed {
+		group := groups[change.Category.Group()]
+		group.Removed = append(group.Removed, newDeckCodeCardChangeResponse(change))
+	}
+	for _, change := range diff.Changed {
+		group := groups[change.Category.Group()]
+		group.Changed = append(group.Changed, newDeckCodeCardChangeResponse(change))
+	}
+
+	return res
+}
+
+func newDeckCodeCardChangeResponse(
+	change *entity.DeckCodeCardChange,
+) *dto.DeckCodeCardChangeResponse {
+	return &dto.DeckCodeCardChangeResponse{
+		CardId:    change.CardId,
+		CardName:  change.CardName,
+		Category:  string(change.Category),
+		FromCount: change.FromCount,
+		ToCount:   change.ToCount,
+	}
+}
+
+func NewDeckIllegalCardResponses(
+	legality *entity.DeckLegality,
+) []*dto.DeckIllegalCardResponse {
+	ret := []*dto.DeckIllegalCardResponse{}
+	for _, card := range legality.IllegalCards {
+		ret = append(ret, &dto.DeckIllegalCardResponse{
+			CardId:         card.CardId,
+			CardName:       card.CardName,
+			RegulationMark: card.RegulationMark,
+			Count:          card.Count,
+			Reason:         string(card.Reason),
+		})
+	}
+
+	return ret
+}
+
+func NewDeckLegalityResponse(
+	legality *entity.DeckLegality,
+) *dto.DeckLegalityResponse {
+	res := &dto.DeckLegalityResponse{
+		DeckCodeId:       legality.DeckCodeId,
+		RegulationId:     legality.RegulationId,
+		Date:             legality.Date,
+		Legal:            legality.IsLegal(),
+		IllegalCards:     NewDeckIllegalCardResponses(legality),
+		UncheckedCardIds: legality.UncheckedCardIds,
+	}
+
+	if legality.StandardRegulation != nil {
+		res.StandardRegulationId = legality.StandardRegulation.ID
+		res.StandardRegulationMarks = legality.StandardRegulation.Marks
+	}
+
+	return res
+}
+
+// deckAssetStatusNone はジョブの無い種類の状態。デッキリストから取り込んだデッキコードや、
+// ジョブのキューを導入する前に登録したデッキコードが該当する。
+const deckAssetStatusNone = "none"
+
+// NewDeckAssetsResponse はデッキコードのリソースの取得状況を、種類ごとに1件ずつ返す。
+func NewDeckAssetsResponse(
+	deckCodeId string,
+	jobs []*entity.DeckAssetJob,
+) *dto.DeckAssetsResponse {
+	jobByKind := map[entity.DeckAssetJobKind]*entity.DeckAssetJob{}
+	for _, job := range jobs {
+		jobByKind[job.Kind] = job
+	}
+
+	deckCodeInvalid := false
+	assets := make([]*dto.DeckAssetResponse, 0, len(entity.DeckAssetJobKinds))
+	for _, kind := range entity.DeckAssetJobKinds {
+		job, ok := jobByKind[kind]
+		if !ok {
+			assets = append(assets, &dto.DeckAssetResponse{
+				Kind:   string(kind),
+				Status: deckAssetStatusNone,
+			})
+			continue
+		}
+
+		asset := &dto.DeckAssetResponse{
+			Kind:     string(kind),
+			Status:   string(job.Status),
+			Attempts: job.Attempts,
+		}
+		if job.Status == entity.DeckAssetJobStatusPending {
+			nextRunAt := job.NextRunAt
+			asset.NextRunAt = &nextRunAt
+		}
+		if job.Status == entity.DeckAssetJobStatusInvalid {
+			deckCodeInvalid = true
+		}
+
+		assets = append(assets, asset)
+	}
+
+	return &dto.DeckAssetsResponse{
+		DeckCodeId:      deckCodeId,
+		DeckCodeInvalid: deckCodeInvalid,
+		Assets:          assets,
+	}
+}
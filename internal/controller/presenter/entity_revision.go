@@ -0,0 +1,94 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// NewRecordHistoryResponse は記録の変更履歴を返す。スナップショットは
+// 保存時の entity.Record に戻し、GET /records/:id と同じ形で返す。
+func NewRecordHistoryResponse(
+	limit int,
+	offset int,
+	revisions []*entity.EntityRevision,
+) (*dto.RecordHistoryResponse, error) {
+	res := &dto.RecordHistoryResponse{
+		Limit:     limit,
+		Offset:    offset,
+		Revisions: make([]*dto.RecordRevisionResponse, 0, len(revisions)),
+	}
+
+	for _, revision := range revisions {
+		var before, after entity.Record
+		hasBefore, hasAfter, err := revision.DecodeSnapshots(&before, &after)
+		if err != nil {
+			return nil, err
+		}
+
+		r := &dto.RecordRevisionResponse{
+			ID:        revision.ID,
+			CreatedAt: revision.CreatedAt,
+			Action:    string(revision.Action),
+			ActorUid:  revision.ActorUid,
+			RequestId: revision.RequestId,
+		}
+		if hasBefore {
+			r.Before = &NewRecordGetByIdResponse(&before).RecordResponse
+		}
+		if hasAfter {
+			r.After = &NewRecordGetByIdResponse(&after).RecordResponse
+		}
+
+		res.Revisions = append(res.Revisions, r)
+	}
+
+	return res, nil
+}
+
+// NewMatchHistoryResponse は対戦結果の変更履歴を返す。
+func NewMatchHistoryResponse(
+	limit int,
+	offset int,
+	revisions []*entity.EntityRevision,
+) (*dto.MatchHistoryResponse, error) {
+	res := &dto.MatchHistoryResponse{
+		Limit:     limit,
+		Offset:    offset,
+		Revisions: make([]*dto.MatchRevisionResponse, 0, len(revisions)),
+	}
+
+	for _, revision := range revisions {
+		var before, after entity.Match
+		hasBefore, hasAfter, err := revision.DecodeSnapshots(&before, &after)
+		if err != nil {
+			return nil, err
+		}
+
+		r := &dto.MatchRevisionResponse{
+			ID:        revision.ID,
+			CreatedAt: revision.CreatedAt,
+			Action:    string(revision.Action),
+			ActorUid:  revision.ActorUid,
+			RequestId: revision.RequestId,
+		}
+		if hasBefore {
+			r.Before = newMatchRevisionSnapshotResponse(&before)
+		}
+		if hasAfter {
+			r.After = newMatchRevisionSnapshotResponse(&after)
+		}
+
+		res.Revisions = append(res.Revisions, r)
+	}
+
+	return res, nil
+}
+
+func newMatchRevisionSnapshotResponse(
+	match *entity.Match,
+) *dto.MatchRevisionSnapshotResponse {
+	return &dto.MatchRevisionSnapshotResponse{
+		MatchResponse: NewMatchGetByIdResponse(match).MatchResponse,
+		Position:      match.Position,
+	}
+}
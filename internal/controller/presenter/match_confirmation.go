@@ -0,0 +1,74 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// newMatchConfirmationMatchResponse は確認依頼に含める対戦結果を返す。メモは記録した本人の
+// 覚え書きのため、本人(uid)以外には返さない。
+func newMatchConfirmationMatchResponse(
+	match *entity.Match,
+	uid string,
+) *dto.MatchResponse {
+	if match == nil {
+		return nil
+	}
+
+	res := NewMatchGetByIdResponse(match).MatchResponse
+	if match.UserId != uid {
+		res.Memo = ""
+		for _, game := range res.Games {
+			game.Memo = ""
+		}
+	}
+
+	return &res
+}
+
+// NewMatchConfirmationResponse は確認依頼を、閲覧している uid から見た形で返す。
+func NewMatchConfirmationResponse(
+	confirmation *entity.MatchConfirmation,
+	uid string,
+) *dto.MatchConfirmationResponse {
+	res := &dto.MatchConfirmationResponse{
+		ID:              confirmation.ID,
+		CreatedAt:       confirmation.CreatedAt,
+		UpdatedAt:       confirmation.UpdatedAt,
+		MatchId:         confirmation.MatchId,
+		RequesterUserId: confirmation.RequesterUserId,
+		OpponentUserId:  confirmation.OpponentUserId,
+		Status:          string(confirmation.Status),
+		MirroredMatchId: confirmation.MirroredMatchId,
+		DisputeReason:   confirmation.DisputeReason,
+		InSync:          confirmation.InSync(),
+		Match:           newMatchConfirmationMatchResponse(confirmation.Match, uid),
+		MirroredMatch:   newMatchConfirmationMatchResponse(confirmation.MirroredMatch, uid),
+	}
+
+	if !confirmation.RespondedAt.IsZero() {
+		respondedAt := confirmation.RespondedAt
+		res.RespondedAt = &respondedAt
+	}
+
+	return res
+}
+
+func NewMatchConfirmationGetResponse(
+	limit int,
+	offset int,
+	confirmations []*entity.MatchConfirmation,
+	uid string,
+) *dto.MatchConfirmationGetResponse {
+	res := &dto.MatchConfirmationGetResponse{
+		Limit:              limit,
+		Offset:             offset,
+		MatchConfirmations: []*dto.MatchConfirmationResponse{},
+	}
+
+	for _, confirmation := range confirmations {
+		res.MatchConfirmations = append(res.MatchConfirmations, NewMatchConfirmationResponse(confirmation, uid))
+	}
+
+	return res
+}
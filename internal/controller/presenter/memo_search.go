@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// NewMemoSearchResponse はメモの検索結果を返す。recordURL / matchURL は
+// 記録・対戦結果のIDから取得先のURLを作る(対戦結果に属さないものは match_url を空にする)。
+func NewMemoSearchResponse(
+	limit int,
+	offset int,
+	hits []*entity.MemoSearchHit,
+	recordURL func(id string) string,
+	matchURL func(id string) string,
+) *dto.MemoSearchResponse {
+	res := &dto.MemoSearchResponse{
+		Limit:  limit,
+		Offset: offset,
+		Hits:   make([]*dto.MemoSearchHitResponse, 0, len(hits)),
+	}
+
+	for _, hit := range hits {
+		h := &dto.MemoSearchHitResponse{
+			Type:      string(hit.Type),
+			ID:        hit.ID,
+			RecordId:  hit.RecordId,
+			MatchId:   hit.MatchId,
+			EventDate: hit.EventDate,
+			CreatedAt: hit.CreatedAt,
+			Snippet:   &dto.MemoSnippetResponse{Highlights: []*dto.MemoHighlightResponse{}},
+			RecordURL: recordURL(hit.RecordId),
+		}
+
+		if hit.MatchId != "" {
+			h.MatchURL = matchURL(hit.MatchId)
+		}
+
+		if hit.Snippet != nil {
+			h.Snippet.Text = hit.Snippet.Text
+			for _, highlight := range hit.Snippet.Highlights {
+				h.Snippet.Highlights = append(h.Snippet.Highlights, &dto.MemoHighlightResponse{
+					Offset: highlight.Offset,
+					Length: highlight.Length,
+				})
+			}
+		}
+
+		res.Hits = append(res.Hits, h)
+	}
+
+	return res
+}
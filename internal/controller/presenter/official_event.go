@@ -86,3 +86,49 @@ func NewOfficialEventGetByIdResponse(
 		},
 	}
 }
+
+func NewOfficialEventSearchResponse(
+	limit int,
+	offset int,
+	hits []*entity.OfficialEventSearchHit,
+) *dto.OfficialEventSearchResponse {
+	ret := make([]*dto.OfficialEventSearchHitResponse, 0, len(hits))
+
+	for _, hit := range hits {
+		officialEvent := hit.OfficialEvent
+		date := time.Date(officialEvent.Date.Year(), officialEvent.Date.Month(), officialEvent.Date.Day(), 0, 0, 0, 0, time.Local)
+
+		ret = append(ret, &dto.OfficialEventSearchHitResponse{
+			OfficialEventResponse: dto.OfficialEventResponse{
+				ID:                      officialEvent.ID,
+				Title:                   officialEvent.Title,
+				Address:                 officialEvent.Address,
+				Venue:                   officialEvent.Venue,
+				Date:                    date,
+				StartedAt:               officialEvent.StartedAt,
+				EndedAt:                 officialEvent.EndedAt,
+				TypeId:                  officialEvent.TypeId,
+				TypeName:                officialEvent.TypeName,
+				LeagueTitle:             officialEvent.LeagueTitle,
+				RegulationTitle:         officialEvent.RegulationTitle,
+				CSPFlg:                  officialEvent.CSPFlg,
+				Capacity:                officialEvent.Capacity,
+				ShopId:                  officialEvent.ShopId,
+				ShopName:                officialEvent.ShopName,
+				PrefectureId:            officialEvent.PrefectureId,
+				PrefectureName:          officialEvent.PrefectureName,
+				EnvironmentId:           officialEvent.EnvironmentId,
+				EnvironmentTitle:        officialEvent.EnvironmentTitle,
+				StandardRegulationId:    officialEvent.StandardRegulationId,
+				StandardRegulationMarks: officialEvent.StandardRegulationMarks,
+			},
+			DistanceKm: hit.DistanceKm,
+		})
+	}
+
+	return &dto.OfficialEventSearchResponse{
+		Limit:          limit,
+		Offset:         offset,
+		OfficialEvents: ret,
+	}
+}
@@ -50,6 +50,7 @@ func NewRecordGetResponse(
 				RegulationId:      record.RegulationId,
 				TCGMeisterURL:     record.TCGMeisterURL,
 				Memo:              record.Memo,
+				Tags:              newTagResponses(record.Tags),
 			},
 		})
 	}
@@ -82,6 +83,7 @@ func NewRecordGetByIdResponse(
 			RegulationId:      record.RegulationId,
 			TCGMeisterURL:     record.TCGMeisterURL,
 			Memo:              record.Memo,
+			Tags:              newTagResponses(record.Tags),
 		},
 	}
 }
@@ -114,6 +116,7 @@ func NewRecordGetByUserIdResponse(
 				RegulationId:      record.RegulationId,
 				TCGMeisterURL:     record.TCGMeisterURL,
 				Memo:              record.Memo,
+				Tags:              newTagResponses(record.Tags),
 			},
 		})
 	}
@@ -126,10 +129,26 @@ func NewRecordGetByUserIdResponse(
 	}
 }
 
+// NewRecordCreateResponse の legality はデッキコードの使用可否の判定結果で、判定しなかった
+// (デッキコード未指定・判定できなかった)場合は nil。使用できないカードを warnings で返す。
 func NewRecordCreateResponse(
 	record *entity.Record,
+	matches []*entity.Match,
+	legality *entity.DeckLegality,
 ) *dto.RecordCreateResponse {
+	warnings := []*dto.DeckIllegalCardResponse{}
+	if legality != nil {
+		warnings = NewDeckIllegalCardResponses(legality)
+	}
+
+	matchResponses := []*dto.MatchResponse{}
+	for _, match := range matches {
+		matchResponses = append(matchResponses, &NewMatchCreateResponse(match).MatchResponse)
+	}
+
 	return &dto.RecordCreateResponse{
+		Warnings: warnings,
+		Matches:  matchResponses,
 		RecordResponse: dto.RecordResponse{
 			ID:                record.ID,
 			CreatedAt:         record.CreatedAt,
@@ -146,14 +165,24 @@ func NewRecordCreateResponse(
 			RegulationId:      record.RegulationId,
 			TCGMeisterURL:     record.TCGMeisterURL,
 			Memo:              record.Memo,
+			Tags:              newTagResponses(record.Tags),
 		},
 	}
 }
 
+// NewRecordUpdateResponse の legality はデッキコードの使用可否の判定結果で、判定しなかった
+// (デッキコード未指定・判定できなかった)場合は nil。使用できないカードを warnings で返す。
 func NewRecordUpdateResponse(
 	record *entity.Record,
+	legality *entity.DeckLegality,
 ) *dto.RecordUpdateResponse {
+	warnings := []*dto.DeckIllegalCardResponse{}
+	if legality != nil {
+		warnings = NewDeckIllegalCardResponses(legality)
+	}
+
 	return &dto.RecordUpdateResponse{
+		Warnings: warnings,
 		RecordResponse: dto.RecordResponse{
 			ID:                record.ID,
 			CreatedAt:         record.CreatedAt,
@@ -170,6 +199,35 @@ func NewRecordUpdateResponse(
 			RegulationId:      record.RegulationId,
 			TCGMeisterURL:     record.TCGMeisterURL,
 			Memo:              record.Memo,
+			Tags:              newTagResponses(record.Tags),
 		},
 	}
 }
+
+func NewRecordImportResponse(
+	dryRun bool,
+	imported []*entity.ImportedRecord,
+) *dto.RecordImportResponse {
+	records := []*dto.RecordImportRecordResponse{}
+	matchCount := 0
+
+	for _, ir := range imported {
+		matches := []*dto.MatchResponse{}
+		for _, match := range ir.Matches {
+			matches = append(matches, &NewMatchCreateResponse(match).MatchResponse)
+		}
+		matchCount += len(matches)
+
+		records = append(records, &dto.RecordImportRecordResponse{
+			RecordResponse: NewRecordGetByIdResponse(ir.Record).RecordResponse,
+			Matches:        matches,
+		})
+	}
+
+	return &dto.RecordImportResponse{
+		DryRun:      dryRun,
+		RecordCount: len(records),
+		MatchCount:  matchCount,
+		Records:     records,
+	}
+}
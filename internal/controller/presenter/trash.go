@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func NewTrashResponse(
+	items []*entity.TrashItem,
+) *dto.TrashResponse {
+	res := &dto.TrashResponse{
+		Items: make([]*dto.TrashItemResponse, 0, len(items)),
+	}
+
+	for _, item := range items {
+		res.Items = append(res.Items, &dto.TrashItemResponse{
+			ID:        item.ID,
+			Type:      string(item.Type),
+			ParentId:  item.ParentId,
+			Name:      item.Name,
+			DeletedAt: item.DeletedAt,
+			ExpiresAt: item.DeletedAt.Add(entity.TrashRetention),
+		})
+	}
+
+	return res
+}
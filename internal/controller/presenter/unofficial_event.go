@@ -7,17 +7,25 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
+// NewUnofficialEventGetByIdResponse の uid は閲覧しているユーザ。参加コードは作成者本人にだけ返す。
 func NewUnofficialEventGetByIdResponse(
 	unofficialEvent *entity.UnofficialEvent,
+	uid string,
 ) *dto.UnofficialEventGetByIdResponse {
 	date := time.Date(unofficialEvent.Date.Year(), unofficialEvent.Date.Month(), unofficialEvent.Date.Day(), 0, 0, 0, 0, time.Local)
 
+	joinCode := ""
+	if uid != "" && uid == unofficialEvent.UserId {
+		joinCode = unofficialEvent.JoinCode
+	}
+
 	return &dto.UnofficialEventGetByIdResponse{
 		UnofficialEventResponse: dto.UnofficialEventResponse{
-			ID:     unofficialEvent.ID,
-			UserId: unofficialEvent.UserId,
-			Title:  unofficialEvent.Title,
-			Date:   date,
+			ID:       unofficialEvent.ID,
+			UserId:   unofficialEvent.UserId,
+			Title:    unofficialEvent.Title,
+			Date:     date,
+			JoinCode: joinCode,
 		},
 	}
 }
@@ -44,10 +52,79 @@ func NewUnofficialEventUpdateResponse(
 
 	return &dto.UnofficialEventUpdateResponse{
 		UnofficialEventResponse: dto.UnofficialEventResponse{
-			ID:     unofficialEvent.ID,
-			UserId: unofficialEvent.UserId,
-			Title:  unofficialEvent.Title,
-			Date:   date,
+			ID:       unofficialEvent.ID,
+			UserId:   unofficialEvent.UserId,
+			Title:    unofficialEvent.Title,
+			Date:     date,
+			JoinCode: unofficialEvent.JoinCode,
+		},
+	}
+}
+
+func NewUnofficialEventJoinCodeResponse(
+	unofficialEvent *entity.UnofficialEvent,
+) *dto.UnofficialEventJoinCodeResponse {
+	date := time.Date(unofficialEvent.Date.Year(), unofficialEvent.Date.Month(), unofficialEvent.Date.Day(), 0, 0, 0, 0, time.Local)
+
+	return &dto.UnofficialEventJoinCodeResponse{
+		UnofficialEventResponse: dto.UnofficialEventResponse{
+			ID:       unofficialEvent.ID,
+			UserId:   unofficialEvent.UserId,
+			Title:    unofficialEvent.Title,
+			Date:     date,
+			JoinCode: unofficialEvent.JoinCode,
 		},
 	}
 }
+
+func newUnofficialEventPokemonSpriteResponses(
+	pokemonSprites []*entity.PokemonSprite,
+) []*dto.PokemonSpriteResponse {
+	ret := []*dto.PokemonSpriteResponse{}
+
+	for _, pokemonSprite := range pokemonSprites {
+		ret = append(ret, &dto.PokemonSpriteResponse{
+			ID:       pokemonSprite.ID,
+			Position: pokemonSprite.Position,
+		})
+	}
+
+	return ret
+}
+
+func NewUnofficialEventSummaryResponse(
+	summary *entity.UnofficialEventSummary,
+) *dto.UnofficialEventSummaryResponse {
+	participants := []*dto.UnofficialEventSummaryParticipantResponse{}
+	for _, participant := range summary.Participants {
+		participants = append(participants, &dto.UnofficialEventSummaryParticipantResponse{
+			RecordId:       participant.RecordId,
+			UserId:         participant.UserId,
+			UserName:       participant.UserName,
+			UserImageURL:   participant.UserImageURL,
+			Wins:           participant.Wins,
+			Losses:         participant.Losses,
+			Draws:          participant.Draws,
+			PokemonSprites: newUnofficialEventPokemonSpriteResponses(participant.PokemonSprites),
+		})
+	}
+
+	archetypes := []*dto.UnofficialEventArchetypeResponse{}
+	for _, archetype := range summary.Archetypes {
+		archetypes = append(archetypes, &dto.UnofficialEventArchetypeResponse{
+			Fingerprint:    archetype.Fingerprint,
+			Count:          archetype.Count,
+			Wins:           archetype.Wins,
+			Losses:         archetype.Losses,
+			Draws:          archetype.Draws,
+			PokemonSprites: newUnofficialEventPokemonSpriteResponses(archetype.PokemonSprites),
+		})
+	}
+
+	return &dto.UnofficialEventSummaryResponse{
+		UnofficialEventId: summary.UnofficialEventId,
+		Participants:      participants,
+		Archetypes:        archetypes,
+		TotalMatches:      summary.TotalMatches,
+	}
+}
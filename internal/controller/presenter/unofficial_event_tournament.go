@@ -0,0 +1,104 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func NewUnofficialEventTournamentResponse(
+	tournament *entity.UnofficialEventTournament,
+) *dto.UnofficialEventTournamentResponse {
+	return &dto.UnofficialEventTournamentResponse{
+		UnofficialEventId: tournament.UnofficialEventId,
+		CreatedAt:         tournament.CreatedAt,
+		UpdatedAt:         tournament.UpdatedAt,
+		BO3Flg:            tournament.BO3Flg,
+		TopCutSize:        tournament.TopCutSize,
+	}
+}
+
+func NewUnofficialEventParticipantResponse(
+	participant *entity.UnofficialEventParticipant,
+) *dto.UnofficialEventParticipantResponse {
+	if participant == nil {
+		return nil
+	}
+
+	return &dto.UnofficialEventParticipantResponse{
+		ID:         participant.ID,
+		CreatedAt:  participant.CreatedAt,
+		UserId:     participant.UserId,
+		GuestName:  participant.GuestName,
+		Name:       participant.Name(),
+		DroppedFlg: participant.IsDropped(),
+		User:       newUserRelationshipUserResponse(participant.User),
+	}
+}
+
+func NewUnofficialEventParticipantGetResponse(
+	participants []*entity.UnofficialEventParticipant,
+) *dto.UnofficialEventParticipantGetResponse {
+	res := &dto.UnofficialEventParticipantGetResponse{
+		Participants: []*dto.UnofficialEventParticipantResponse{},
+	}
+
+	for _, participant := range participants {
+		res.Participants = append(res.Participants, NewUnofficialEventParticipantResponse(participant))
+	}
+
+	return res
+}
+
+func NewUnofficialEventPairingResponse(
+	pairing *entity.UnofficialEventPairing,
+) *dto.UnofficialEventPairingResponse {
+	return &dto.UnofficialEventPairingResponse{
+		ID:          pairing.ID,
+		Stage:       string(pairing.Stage),
+		Round:       pairing.Round,
+		TableNo:     pairing.TableNo,
+		Player1:     NewUnofficialEventParticipantResponse(pairing.Player1),
+		Player2:     NewUnofficialEventParticipantResponse(pairing.Player2),
+		Player1Wins: pairing.Player1Wins,
+		Player2Wins: pairing.Player2Wins,
+		ByeFlg:      pairing.IsBye(),
+		ReportedFlg: pairing.IsReported(),
+	}
+}
+
+func NewUnofficialEventPairingGetResponse(
+	pairings []*entity.UnofficialEventPairing,
+) *dto.UnofficialEventPairingGetResponse {
+	res := &dto.UnofficialEventPairingGetResponse{
+		Pairings: []*dto.UnofficialEventPairingResponse{},
+	}
+
+	for _, pairing := range pairings {
+		res.Pairings = append(res.Pairings, NewUnofficialEventPairingResponse(pairing))
+	}
+
+	return res
+}
+
+func NewUnofficialEventStandingGetResponse(
+	standings []*entity.UnofficialEventStanding,
+) *dto.UnofficialEventStandingGetResponse {
+	res := &dto.UnofficialEventStandingGetResponse{
+		Standings: []*dto.UnofficialEventStandingResponse{},
+	}
+
+	for _, standing := range standings {
+		res.Standings = append(res.Standings, &dto.UnofficialEventStandingResponse{
+			Rank:                          standing.Rank,
+			Participant:                   NewUnofficialEventParticipantResponse(standing.Participant),
+			Wins:                          standing.Wins,
+			Losses:                        standing.Losses,
+			Draws:                         standing.Draws,
+			MatchPoints:                   standing.MatchPoints,
+			OpponentWinPercentage:         standing.OpponentWinPercentage,
+			OpponentOpponentWinPercentage: standing.OpponentOpponentWinPercentage,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// NewUserExportResponse はエクスポートの状態を返す。downloadURL は完了している場合にだけ含める。
+func NewUserExportResponse(
+	export *entity.UserExport,
+	downloadURL string,
+) *dto.UserExportResponse {
+	res := &dto.UserExportResponse{
+		ID:        export.ID,
+		CreatedAt: export.CreatedAt,
+		Status:    string(export.Status),
+		Attempts:  export.Attempts,
+	}
+
+	if export.Finished() {
+		completedAt := export.CompletedAt
+		res.CompletedAt = &completedAt
+	}
+
+	if export.Status == entity.UserExportStatusSucceeded {
+		res.DownloadURL = downloadURL
+	}
+
+	return res
+}
@@ -0,0 +1,74 @@
+package presenter
+
+import (
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func newUserRelationshipUserResponse(user *entity.User) *dto.UserResponse {
+	if user == nil {
+		return nil
+	}
+
+	return &dto.UserResponse{
+		ID:        user.ID,
+		CreatedAt: user.CreatedAt,
+		Name:      user.Name,
+		ImageURL:  user.ImageURL,
+	}
+}
+
+func NewUserRelationshipResponse(
+	relationship *entity.UserRelationship,
+) *dto.UserRelationshipResponse {
+	return &dto.UserRelationshipResponse{
+		ID:           relationship.ID,
+		CreatedAt:    relationship.CreatedAt,
+		UpdatedAt:    relationship.UpdatedAt,
+		UserId:       relationship.UserId,
+		TargetUserId: relationship.TargetUserId,
+		Status:       string(relationship.Status),
+		User:         newUserRelationshipUserResponse(relationship.User),
+	}
+}
+
+func NewFollowRequestGetResponse(
+	limit int,
+	offset int,
+	relationships []*entity.UserRelationship,
+) *dto.FollowRequestGetResponse {
+	res := &dto.FollowRequestGetResponse{
+		Limit:          limit,
+		Offset:         offset,
+		FollowRequests: []*dto.UserRelationshipResponse{},
+	}
+
+	for _, relationship := range relationships {
+		res.FollowRequests = append(res.FollowRequests, NewUserRelationshipResponse(relationship))
+	}
+
+	return res
+}
+
+// NewFriendGetResponse はフレンドの一覧を返す。フレンドになった日時は、申請が承認された
+// (関係が最後に更新された)日時。
+func NewFriendGetResponse(
+	limit int,
+	offset int,
+	relationships []*entity.UserRelationship,
+) *dto.FriendGetResponse {
+	res := &dto.FriendGetResponse{
+		Limit:   limit,
+		Offset:  offset,
+		Friends: []*dto.FriendResponse{},
+	}
+
+	for _, relationship := range relationships {
+		res.Friends = append(res.Friends, &dto.FriendResponse{
+			User:  newUserRelationshipUserResponse(relationship.User),
+			Since: relationship.UpdatedAt,
+		})
+	}
+
+	return res
+}
@@ -12,6 +12,7 @@ func NewUserStatResponse(
 	season string,
 	standardRegulationId string,
 	regulationId uint,
+	recordTagId string,
 ) *dto.UserStatResponse {
 	return &dto.UserStatResponse{
 		UserId:               stats.UserId,
@@ -20,6 +21,7 @@ func NewUserStatResponse(
 		Season:               season,
 		StandardRegulationId: standardRegulationId,
 		RegulationId:         regulationId,
+		RecordTagId:          recordTagId,
 		TotalRecords:         stats.TotalRecords,
 		OfficialEventCount:   stats.OfficialEventCount,
 		TonamelEventCount:    stats.TonamelEventCount,
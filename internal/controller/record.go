@@ -9,10 +9,12 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
 	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
 	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
 	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
 	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/usecase"
 )
@@ -22,17 +24,25 @@ const (
 )
 
 type Record struct {
-	router     *gin.Engine
-	repository repository.RecordInterface
-	usecase    usecase.RecordInterface
+	router                 *gin.Engine
+	repository             repository.RecordInterface
+	relationshipRepository repository.UserRelationshipInterface
+	trashRepository        repository.TrashInterface
+	usecase                usecase.RecordInterface
+	legality               usecase.DeckLegalityInterface
+	recordImport           usecase.RecordImportInterface
 }
 
 func NewRecord(
 	router *gin.Engine,
 	repository repository.RecordInterface,
+	relationshipRepository repository.UserRelationshipInterface,
+	trashRepository repository.TrashInterface,
 	usecase usecase.RecordInterface,
+	legality usecase.DeckLegalityInterface,
+	recordImport usecase.RecordImportInterface,
 ) *Record {
-	return &Record{router, repository, usecase}
+	return &Record{router, repository, relationshipRepository, trashRepository, usecase, legality, recordImport}
 }
 
 func (c *Record) RegisterRoute(relativePath string) {
@@ -50,12 +60,24 @@ func (c *Record) RegisterRoute(relativePath string) {
 		authorization.RecordGetByIdAuthorizationMiddleware(c.repository),
 		c.GetById,
 	)
+	r.GET(
+		"/:id/history",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.RecordHistoryAuthorizationMiddleware(c.repository, c.trashRepository),
+		c.History,
+	)
 	r.POST(
 		"",
 		authentication.RequiredAuthenticationMiddleware(),
 		validation.RecordCreateMiddleware(),
 		c.Create,
 	)
+	r.POST(
+		"/import",
+		authentication.RequiredAuthenticationMiddleware(),
+		validation.RecordImportMiddleware(),
+		c.Import,
+	)
 	r.PUT(
 		"/:id",
 		authentication.RequiredAuthenticationMiddleware(),
@@ -69,6 +91,15 @@ func (c *Record) RegisterRoute(relativePath string) {
 		authorization.RecordDeleteAuthorizationMiddleware(c.repository),
 		c.Delete,
 	)
+
+	// 他のユーザの記録の一覧は、本人とフレンドだけが見られる。
+	c.router.GET(
+		relativePath+UsersPath+"/:id"+RecordsPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.FriendAuthorizationMiddleware(c.relationshipRepository),
+		validation.RecordGetMiddleware(),
+		c.GetVisibleByUserId,
+	)
 }
 
 func (c *Record) Get(ctx *gin.Context) {
@@ -112,6 +143,28 @@ func (c *Record) GetByUserId(ctx *gin.Context) {
 		eventType := helper.GetEventType(ctx)
 		deckId := helper.GetDeckId(ctx)
 
+		if criteria := helper.GetRecordCriteria(ctx); criteria != nil {
+			criteria.UserId = uid
+			criteria.EventType = eventType
+			criteria.DeckId = deckId
+			criteria.Limit = limit
+			criteria.Offset = offset
+			criteria.CursorEventDate = cursorEventDate
+			criteria.CursorCreatedAt = cursorCreatedAt
+
+			records, err := c.usecase.FindByCriteria(ctx.Request.Context(), criteria)
+
+			if err != nil {
+				apierror.ErrInternalServerError.JSON(ctx, err)
+				return
+			}
+
+			res := presenter.NewRecordGetByUserIdResponse(limit, offset, cursorEventDate, cursorCreatedAt, records)
+
+			ctx.JSON(http.StatusOK, res)
+			return
+		}
+
 		if !cursorCreatedAt.IsZero() {
 			if deckId != "" {
 				records, err := c.usecase.FindByDeckIdOnCursor(ctx.Request.Context(), deckId, limit, cursorEventDate, cursorCreatedAt, eventType)
@@ -166,6 +219,39 @@ func (c *Record) GetByUserId(ctx *gin.Context) {
 	}
 }
 
+// GetVisibleByUserId はパスパラメータのユーザの記録を返す。フレンドには非公開の記録を返さない。
+func (c *Record) GetVisibleByUserId(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+	cursorEventDate := helper.GetCursorEventDate(ctx)
+	cursorCreatedAt := helper.GetCursorCreatedAt(ctx)
+
+	criteria := helper.GetRecordCriteria(ctx)
+	if criteria == nil {
+		criteria = &entity.RecordCriteria{}
+	}
+	criteria.UserId = userId
+	criteria.EventType = helper.GetEventType(ctx)
+	criteria.DeckId = helper.GetDeckId(ctx)
+	criteria.Limit = limit
+	criteria.Offset = offset
+	criteria.CursorEventDate = cursorEventDate
+	criteria.CursorCreatedAt = cursorCreatedAt
+	criteria.ExcludePrivate = uid != userId
+
+	records, err := c.usecase.FindByCriteria(ctx.Request.Context(), criteria)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewRecordGetByUserIdResponse(limit, offset, cursorEventDate, cursorCreatedAt, records)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (c *Record) GetById(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 
@@ -182,6 +268,7 @@ func (c *Record) GetById(ctx *gin.Context) {
 
 	res := presenter.NewRecordGetByIdResponse(record)
 
+	helper.SetETag(ctx, record.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -204,12 +291,22 @@ func (c *Record) Create(ctx *gin.Context) {
 		req.TCGMeisterURL,
 		req.Memo,
 	)
-
-	record, err := c.usecase.Create(ctx.Request.Context(), param)
+	// TagIds は NewRecordParam の引数に含めていないため、ここで直接設定する。
+	param.TagIds = req.TagIds
+
+	// matches を埋め込んだ場合は、記録と対戦結果を1つのトランザクションでまとめて作成する。
+	var record *entity.Record
+	var matches []*entity.Match
+	var err error
+	if len(req.Matches) != 0 {
+		record, matches, err = c.usecase.CreateWithMatches(ctx.Request.Context(), param, newEmbeddedMatchParams(uid, req.Matches))
+	} else {
+		record, err = c.usecase.Create(ctx.Request.Context(), param)
+	}
 	if err != nil {
-		// 記録の整合性エラーは 400。middleware を通れば通常は発生しないが、
+		// 記録・対戦結果の整合性エラーは 400。middleware を通れば通常は発生しないが、
 		// usecase 層でも検証しているため防御的に 400 を返す。
-		if errors.Is(err, apperror.ErrInvalidRecord) {
+		if errors.Is(err, apperror.ErrInvalidRecord) || errors.Is(err, apperror.ErrInvalidMatch) {
 			apierror.ErrBadRequest.JSON(ctx, err)
 			return
 		}
@@ -217,7 +314,7 @@ func (c *Record) Create(ctx *gin.Context) {
 		return
 	}
 
-	res := presenter.NewRecordCreateResponse(record)
+	res := presenter.NewRecordCreateResponse(record, matches, c.checkDeckLegality(ctx, record))
 
 	ctx.JSON(http.StatusCreated, res)
 }
@@ -242,31 +339,167 @@ func (c *Record) Update(ctx *gin.Context) {
 		req.TCGMeisterURL,
 		req.Memo,
 	)
+	// TagIds は NewRecordParam の引数に含めていないため、ここで直接設定する。
+	param.TagIds = req.TagIds
+
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
 
-	record, err := c.usecase.Update(ctx.Request.Context(), id, param)
+	record, err := c.usecase.Update(reqCtx, id, param)
 	if err != nil {
 		// 記録の整合性エラーは 400。
 		if errors.Is(err, apperror.ErrInvalidRecord) {
 			apierror.ErrBadRequest.JSON(ctx, err)
 			return
 		}
+		// 読み込んだ版から他の端末で更新されていた場合は 412。
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
 	}
 
-	res := presenter.NewRecordUpdateResponse(record)
+	res := presenter.NewRecordUpdateResponse(record, c.checkDeckLegality(ctx, record))
 
+	helper.SetETag(ctx, record.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
+// Import は CSV / JSON の記録と対戦結果をまとめて取り込む。dry_run=true なら保存せずに
+// 取り込む予定の内容を返す(200)。取り込んだ場合は 201 を返す。
+func (c *Record) Import(ctx *gin.Context) {
+	req := helper.GetRecordImportRequest(ctx)
+	dryRun := helper.GetDryRun(ctx)
+	uid := helper.GetUID(ctx)
+
+	params := make([]*usecase.RecordImportParam, 0, len(req.Records))
+	for _, r := range req.Records {
+		matchParams := newEmbeddedMatchParams(uid, r.Matches)
+
+		params = append(params, usecase.NewRecordImportParam(
+			usecase.NewRecordParam(
+				r.OfficialEventId,
+				r.TonamelEventId,
+				r.FriendId,
+				r.UnofficialEventId,
+				uid,
+				r.DeckId,
+				r.DeckCodeId,
+				r.EventDate,
+				r.PrivateFlg,
+				r.IgnoreStatsFlg,
+				r.RegulationId,
+				r.TCGMeisterURL,
+				r.Memo,
+			),
+			matchParams,
+		))
+	}
+
+	imported, err := c.recordImport.Import(ctx.Request.Context(), uid, params, dryRun)
+	if err != nil {
+		// どの記録・対戦結果が不正かを直せるよう、メッセージごと返す
+		if errors.Is(err, apperror.ErrInvalidRecord) || errors.Is(err, apperror.ErrInvalidMatch) {
+			apierror.New(http.StatusBadRequest, err).JSON(ctx, err)
+			return
+		}
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewRecordImportResponse(dryRun, imported)
+
+	if dryRun {
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// newEmbeddedMatchParams は記録に埋め込まれた対戦結果を usecase の引数に変換する。
+// record_id は記録の作成時に設定するため、ここでは空のままにする。
+func newEmbeddedMatchParams(uid string, reqs []*dto.MatchRequest) []*usecase.MatchParam {
+	matchParams := make([]*usecase.MatchParam, 0, len(reqs))
+	for _, m := range reqs {
+		var gameParams []*usecase.GameParam
+		for _, g := range m.Games {
+			gameParams = append(gameParams, usecase.NewGameParam(g.GoFirst, g.WinningFlg, g.YourPrizeCards, g.OpponentsPrizeCards, g.Memo))
+		}
+
+		var pokemonSpriteParams []*usecase.PokemonSpriteParam
+		for _, p := range m.PokemonSprites {
+			pokemonSpriteParams = append(pokemonSpriteParams, usecase.NewPokemonSpriteParamWithPosition(p.ID, p.Position))
+		}
+
+		param := usecase.NewMatchParam(
+			"",
+			m.DeckId,
+			m.DeckCodeId,
+			uid,
+			m.OpponentsUserId,
+			m.BO3Flg,
+			m.GroupMatchFlg,
+			m.QualifyingRoundFlg,
+			m.FinalTournamentFlg,
+			m.DefaultVictoryFlg,
+			m.DefaultDefeatFlg,
+			m.VictoryFlg,
+			m.DrawFlg,
+			m.GroupMatchVictoryFlg,
+			m.OpponentsDeckInfo,
+			m.Memo,
+			gameParams,
+			pokemonSpriteParams,
+		)
+		// TagIds は NewMatchParam の引数に含めていないため、ここで直接設定する。
+		param.TagIds = m.TagIds
+
+		matchParams = append(matchParams, param)
+	}
+
+	return matchParams
+}
+
+// checkDeckLegality は記録のデッキコードが、記録の日付・レギュレーションで使用できるかを判定する。
+// 結果は警告として返すだけで記録の保存は妨げないため、判定できなければ(カード構成が
+// 未取得など) nil を返して警告なしとする。エラーは usecase 側でログに残る。
+func (c *Record) checkDeckLegality(ctx *gin.Context, record *entity.Record) *entity.DeckLegality {
+	if record.DeckCodeId == "" {
+		return nil
+	}
+
+	legality, err := c.legality.Check(ctx.Request.Context(), record.DeckCodeId, record.RegulationId, record.EventDate)
+	if err != nil {
+		return nil
+	}
+
+	return legality
+}
+
 func (c *Record) Delete(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 
-	if err := c.usecase.Delete(ctx.Request.Context(), id); err != nil {
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	if err := c.usecase.Delete(reqCtx, id); err != nil {
 		if err == apperror.ErrRecordNotFound {
 			apierror.ErrBadRequestNotFound.JSON(ctx, err)
 			return
 		}
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
@@ -274,3 +507,33 @@ func (c *Record) Delete(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusNoContent, gin.H{})
 }
+
+func (c *Record) History(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	limit, err := helper.ParseQueryLimit(ctx)
+	if err != nil {
+		apierror.ErrBadRequest.JSON(ctx, err)
+		return
+	}
+
+	offset, err := helper.ParseQueryOffset(ctx)
+	if err != nil {
+		apierror.ErrBadRequest.JSON(ctx, err)
+		return
+	}
+
+	revisions, err := c.usecase.History(ctx.Request.Context(), id, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res, err := presenter.NewRecordHistoryResponse(limit, offset, revisions)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -16,8 +17,11 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
 	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
 	"github.com/vsrecorder/core-apiserver/internal/testutil"
@@ -47,25 +51,75 @@ func setup4TestRecordController(t *testing.T, r *gin.Engine) (
 	*Record,
 	*mock_repository.MockRecordInterface,
 	*mock_usecase.MockRecordInterface,
+) {
+	c, mockRepository, mockUsecase, _ := setup4TestRecordControllerWithLegality(t, r)
+
+	return c, mockRepository, mockUsecase
+}
+
+// setup4TestRecordControllerWithLegality はデッキコードの使用可否の判定(警告)を検証するテスト向けに、
+// 判定ユースケースのモックも返す。
+func setup4TestRecordControllerWithLegality(t *testing.T, r *gin.Engine) (
+	*Record,
+	*mock_repository.MockRecordInterface,
+	*mock_usecase.MockRecordInterface,
+	*mock_usecase.MockDeckLegalityInterface,
+) {
+	c, mockRepository, mockUsecase, mockLegality, _ := setup4TestRecordControllerWithImport(t, r)
+
+	return c, mockRepository, mockUsecase, mockLegality
+}
+
+// setup4TestRecordControllerWithImport は一括取り込みを検証するテスト向けに、
+// 取り込みユースケースのモックも返す。
+func setup4TestRecordControllerWithImport(t *testing.T, r *gin.Engine) (
+	*Record,
+	*mock_repository.MockRecordInterface,
+	*mock_usecase.MockRecordInterface,
+	*mock_usecase.MockDeckLegalityInterface,
+	*mock_usecase.MockRecordImportInterface,
 ) {
 	mockRepository, mockUsecase := setupMock4TestRecordController(t)
+	mockLegality := mock_usecase.NewMockDeckLegalityInterface(gomock.NewController(t))
+	mockRecordImport := mock_usecase.NewMockRecordImportInterface(gomock.NewController(t))
 
-	c := NewRecord(r, mockRepository, mockUsecase)
+	c := NewRecord(r, mockRepository, mock_repository.NewMockUserRelationshipInterface(gomock.NewController(t)), mock_repository.NewMockTrashInterface(gomock.NewController(t)), mockUsecase, mockLegality, mockRecordImport)
 	c.RegisterRoute("")
 
-	return c, mockRepository, mockUsecase
+	return c, mockRepository, mockUsecase, mockLegality, mockRecordImport
+}
+
+// setup4TestRecordControllerWithRelationship はフレンドへの公開を検証するテスト向けに、
+// ユーザ同士の関係のリポジトリのモックも返す。
+func setup4TestRecordControllerWithRelationship(t *testing.T, r *gin.Engine) (
+	*Record,
+	*mock_repository.MockUserRelationshipInterface,
+	*mock_usecase.MockRecordInterface,
+) {
+	mockRepository, mockUsecase := setupMock4TestRecordController(t)
+	mockRelationshipRepository := mock_repository.NewMockUserRelationshipInterface(gomock.NewController(t))
+	mockLegality := mock_usecase.NewMockDeckLegalityInterface(gomock.NewController(t))
+	mockRecordImport := mock_usecase.NewMockRecordImportInterface(gomock.NewController(t))
+
+	c := NewRecord(r, mockRepository, mockRelationshipRepository, mock_repository.NewMockTrashInterface(gomock.NewController(t)), mockUsecase, mockLegality, mockRecordImport)
+	c.RegisterRoute("")
+
+	return c, mockRelationshipRepository, mockUsecase
 }
 
 func TestRecordController(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	for scenario, fn := range map[string]func(t *testing.T){
-		"Get":         test_RecordController_Get,
-		"GetById":     test_RecordController_GetById,
-		"GetByUserId": test_RecordController_GetByUserId,
-		"Create":      test_RecordController_Create,
-		"Update":      test_RecordController_Update,
-		"Delete":      test_RecordController_Delete,
+		"Get":                test_RecordController_Get,
+		"GetById":            test_RecordController_GetById,
+		"GetByUserId":        test_RecordController_GetByUserId,
+		"GetVisibleByUserId": test_RecordController_GetVisibleByUserId,
+		"Create":             test_RecordController_Create,
+		"Update":             test_RecordController_Update,
+		"Delete":             test_RecordController_Delete,
+		"Import":             test_RecordController_Import,
+		"History":            test_RecordController_History,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -73,6 +127,74 @@ func TestRecordController(t *testing.T) {
 	}
 }
 
+func test_RecordController_GetVisibleByUserId(t *testing.T) {
+	r := gin.Default()
+
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	friendId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	c, mockRelationshipRepository, mockUsecase := setup4TestRecordControllerWithRelationship(t, r)
+
+	t.Run("正常系_本人には非公開の記録も返す", func(t *testing.T) {
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Record, error) {
+				require.Equal(t, uid, criteria.UserId)
+				require.False(t, criteria.ExcludePrivate)
+				return []*entity.Record{{UserId: uid, PrivateFlg: true}}, nil
+			},
+		)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+RecordsPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordGetByUserIdResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res.Records, 1)
+	})
+
+	t.Run("正常系_フレンドには非公開の記録を除いて返す", func(t *testing.T) {
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), friendId, uid).Return(true, nil)
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Record, error) {
+				require.Equal(t, uid, criteria.UserId)
+				require.True(t, criteria.ExcludePrivate)
+				return []*entity.Record{}, nil
+			},
+		)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+RecordsPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, friendId, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系_フレンドでなければ403を返す", func(t *testing.T) {
+		mockRelationshipRepository.EXPECT().IsFriend(gomock.Any(), friendId, uid).Return(false, nil)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", UsersPath+"/"+uid+RecordsPath, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, friendId, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
 func test_RecordController_Get(t *testing.T) {
 	r := gin.Default()
 	c, _, mockUsecase := setup4TestRecordController(t, r)
@@ -246,6 +368,7 @@ func test_RecordController_GetById(t *testing.T) {
 			CreatedAt:       createdAt,
 			OfficialEventId: officialEventId,
 			PrivateFlg:      privateFlg,
+			UpdatedAt:       createdAt,
 		}
 
 		// RecordGetByIdAuthorizationMiddlewareが参照する
@@ -267,6 +390,7 @@ func test_RecordController_GetById(t *testing.T) {
 		require.WithinDuration(t, createdAt, res.CreatedAt, time.Second)
 		require.Equal(t, officialEventId, res.OfficialEventId)
 		require.Equal(t, privateFlg, res.PrivateFlg)
+		require.Equal(t, helper.FormatETag(createdAt), w.Header().Get(helper.ETagHeader))
 	})
 
 	t.Run("異常系_記録が存在しなければ404を返す", func(t *testing.T) {
@@ -483,6 +607,67 @@ func test_RecordController_GetByUserId(t *testing.T) {
 
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("正常系_絞り込みのクエリがあれば条件をまとめて渡す", func(t *testing.T) {
+		records := []*entity.Record{
+			{UserId: uid},
+		}
+
+		bo3Flg := true
+		expected := &entity.RecordCriteria{
+			UserId:               uid,
+			EventType:            "official",
+			RegulationId:         1,
+			OfficialEventTypeIds: []uint{uint(entity.KizunaStageCityLeague)},
+			Result:               entity.MatchResultWin,
+			BO3Flg:               &bo3Flg,
+			TagIds:               []string{"tag-1"},
+			Limit:                20,
+			Offset:               40,
+		}
+
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), expected).Return(records, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"?limit=20&offset=40&event_type=official&regulation_id=1&official_event_type=city&result=win&bo3=true&tag_ids=tag-1", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordGetByUserIdResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, len(records), len(res.Records))
+	})
+
+	t.Run("異常系_絞り込みのクエリが不正なら400を返す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"?result=lost", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_絞り込みのエラーで500を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().FindByCriteria(gomock.Any(), gomock.Any()).Return(nil, errors.New(""))
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"?from_date=2026-07-01", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
 }
 
 func test_RecordController_Create(t *testing.T) {
@@ -566,6 +751,128 @@ func test_RecordController_Create(t *testing.T) {
 		require.Equal(t, uid, res.UserId)
 	})
 
+	t.Run("正常系_使用できないカードを含むデッキコードは警告を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, mockUsecase, mockLegality := setup4TestRecordControllerWithLegality(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		deckId := "01HD7Y3K8D6FDHMHTZ2GT41TD1"
+		deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+		eventDate := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+
+		record := &entity.Record{
+			ID:              id,
+			CreatedAt:       time.Now().Local(),
+			OfficialEventId: 10000,
+			UserId:          uid,
+			DeckId:          deckId,
+			DeckCodeId:      deckCodeId,
+			EventDate:       eventDate,
+			RegulationId:    entity.RegulationIdStandard,
+		}
+
+		mockUsecase.EXPECT().Create(gomock.Any(), gomock.Any()).Return(record, nil)
+		mockLegality.EXPECT().Check(gomock.Any(), deckCodeId, entity.RegulationIdStandard, eventDate).Return(&entity.DeckLegality{
+			DeckCodeId: deckCodeId,
+			IllegalCards: []*entity.DeckIllegalCard{
+				{CardId: 1, CardName: "クイックボール", RegulationMark: "G", Count: 4, Reason: entity.DeckIllegalReasonRotated},
+			},
+		}, nil)
+
+		data := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+				DeckId:          deckId,
+				DeckCodeId:      deckCodeId,
+				EventDate:       eventDate,
+				RegulationId:    entity.RegulationIdStandard,
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordCreateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Len(t, res.Warnings, 1)
+		require.Equal(t, "rotated", res.Warnings[0].Reason)
+		require.Equal(t, "クイックボール", res.Warnings[0].CardName)
+	})
+
+	t.Run("正常系_使用可否を判定できなくても記録の作成は成功する", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, mockUsecase, mockLegality := setup4TestRecordControllerWithLegality(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		deckId := "01HD7Y3K8D6FDHMHTZ2GT41TD1"
+		deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+
+		record := &entity.Record{
+			ID:              id,
+			CreatedAt:       time.Now().Local(),
+			OfficialEventId: 10000,
+			UserId:          uid,
+			DeckId:          deckId,
+			DeckCodeId:      deckCodeId,
+			RegulationId:    entity.RegulationIdStandard,
+		}
+
+		mockUsecase.EXPECT().Create(gomock.Any(), gomock.Any()).Return(record, nil)
+		mockLegality.EXPECT().Check(gomock.Any(), deckCodeId, entity.RegulationIdStandard, gomock.Any()).Return(nil, apperror.ErrRecordNotFound)
+
+		data := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+				DeckId:          deckId,
+				DeckCodeId:      deckCodeId,
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordCreateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Equal(t, id, res.ID)
+		require.Empty(t, res.Warnings)
+	})
+
 	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
 		r := gin.Default()
 
@@ -609,6 +916,101 @@ func test_RecordController_Create(t *testing.T) {
 
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("正常系_対戦結果を埋め込むと記録と一緒に作成する", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, mockUsecase := setup4TestRecordController(t, r)
+
+		recordId, err := generateId()
+		require.NoError(t, err)
+		matchId, err := generateId()
+		require.NoError(t, err)
+
+		tagId := "01JQ7T1V3T0Y2R3X9M5F8K6W4Z"
+		record := &entity.Record{ID: recordId, OfficialEventId: 10000, UserId: uid}
+		match := &entity.Match{ID: matchId, RecordId: recordId, UserId: uid, VictoryFlg: true, Tags: []*entity.Tag{{ID: tagId}}}
+
+		mockUsecase.EXPECT().CreateWithMatches(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *usecase.RecordParam, matchParams []*usecase.MatchParam) (*entity.Record, []*entity.Match, error) {
+				require.Len(t, matchParams, 1)
+				require.Equal(t, "", matchParams[0].RecordId)
+				require.Equal(t, uid, matchParams[0].UserId)
+				require.Equal(t, []string{tagId}, matchParams[0].TagIds)
+				require.Len(t, matchParams[0].Games, 1)
+				return record, []*entity.Match{match}, nil
+			},
+		)
+
+		data := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{OfficialEventId: 10000},
+			Matches: []*dto.MatchRequest{
+				{
+					VictoryFlg: true,
+					Games:      []*dto.GameRequest{{GoFirst: true, WinningFlg: true}},
+					TagIds:     []string{tagId},
+				},
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordCreateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Equal(t, recordId, res.ID)
+		require.Len(t, res.Matches, 1)
+		require.Equal(t, matchId, res.Matches[0].ID)
+		require.Equal(t, recordId, res.Matches[0].RecordId)
+	})
+
+	t.Run("異常系_不正な対戦結果が含まれていれば400を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, mockUsecase := setup4TestRecordController(t, r)
+
+		mockUsecase.EXPECT().CreateWithMatches(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, apperror.ErrInvalidMatch)
+
+		data := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{OfficialEventId: 10000},
+			Matches: []*dto.MatchRequest{
+				{VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: true}}},
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func test_RecordController_Update(t *testing.T) {
@@ -695,7 +1097,103 @@ func test_RecordController_Update(t *testing.T) {
 		require.Equal(t, uid, res.UserId)
 	})
 
-	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+	t.Run("正常系_tag_idsを指定すると記録のタグを付け替える", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, mockRepository, mockUsecase := setup4TestRecordController(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		tagId := "01JQ7T1V3T0Y2R3X9M5F8K6W4Z"
+		record := &entity.Record{
+			ID:              id,
+			OfficialEventId: 10000,
+			UserId:          uid,
+			Tags:            []*entity.Tag{{ID: tagId, Name: "調整"}},
+		}
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+
+		param := usecase.NewRecordParam(10000, "", "", "", uid, "", "", time.Time{}, false, false, uint(0), "", "")
+		param.TagIds = []string{tagId}
+
+		mockUsecase.EXPECT().Update(gomock.Any(), id, param).Return(record, nil)
+
+		data := dto.RecordUpdateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+				TagIds:          []string{tagId},
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("PUT", RecordsPath+"/"+id, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordCreateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, res.Tags, 1)
+		require.Equal(t, tagId, res.Tags[0].ID)
+		require.Equal(t, "調整", res.Tags[0].Name)
+	})
+
+	t.Run("異常系_tag_idsが多すぎれば400を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, mockRepository, _ := setup4TestRecordController(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil).AnyTimes()
+
+		tagIds := make([]string, validation.MaxTagsPerEntity+1)
+		for i := range tagIds {
+			tagIds[i] = "01JQ7T1V3T0Y2R3X9M5F8K6W4Z"
+		}
+
+		data := dto.RecordUpdateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+				TagIds:          tagIds,
+			},
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("PUT", RecordsPath+"/"+id, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
 		r := gin.Default()
 
 		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
@@ -762,6 +1260,81 @@ func test_RecordController_Update(t *testing.T) {
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 
+	t.Run("異常系_If-Matchの版から更新されていれば412を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, mockRepository, mockUsecase := setup4TestRecordController(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		updatedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), id, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, id string, _ *usecase.RecordParam) (*entity.Record, error) {
+				// If-Match の版がリポジトリへ渡る ctx に載っていること
+				expected, ok := repository.ExpectedUpdatedAt(ctx, id)
+				require.True(t, ok)
+				require.True(t, updatedAt.Equal(expected))
+
+				return nil, apperror.ErrPreconditionFailed
+			},
+		)
+
+		dataBytes, err := json.Marshal(dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{OfficialEventId: 10000},
+		})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("PUT", RecordsPath+"/"+id, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		req.Header.Set(helper.IfMatchHeader, helper.FormatETag(updatedAt))
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	t.Run("異常系_解釈できないIf-Matchは更新せず412を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, mockRepository, _ := setup4TestRecordController(t, r)
+
+		id, err := generateId()
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+
+		dataBytes, err := json.Marshal(dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{OfficialEventId: 10000},
+		})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("PUT", RecordsPath+"/"+id, strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		req.Header.Set(helper.IfMatchHeader, `"not-a-version"`)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
 }
 
 func test_RecordController_Delete(t *testing.T) {
@@ -827,4 +1400,242 @@ func test_RecordController_Delete(t *testing.T) {
 
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("異常系_If-Matchの版から更新されていれば412を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+		mockUsecase.EXPECT().Delete(gomock.Any(), id).Return(apperror.ErrPreconditionFailed)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("DELETE", RecordsPath+"/"+id, nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+		req.Header.Set(helper.IfMatchHeader, helper.FormatETag(time.Now()))
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+}
+
+func test_RecordController_History(t *testing.T) {
+	r := gin.Default()
+	c, mockRepository, mockUsecase := setup4TestRecordController(t, r)
+
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	t.Run("正常系_本人の記録の変更前後を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		revision, err := entity.NewEntityRevision(
+			"01JMPK4VF04QX714CG4PHYJ88K", time.Now(), entity.EntityRevisionTypeRecord, id,
+			entity.EntityRevisionActionUpdate, uid, uid, "request-1",
+			&entity.Record{ID: id, UserId: uid, Memo: "before"},
+			&entity.Record{ID: id, UserId: uid, Memo: "after"},
+		)
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+		mockUsecase.EXPECT().History(gomock.Any(), id, 10, 0).Return([]*entity.EntityRevision{revision}, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"/"+id+"/history?limit=10", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var res dto.RecordHistoryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Revisions, 1)
+		require.Equal(t, "update", res.Revisions[0].Action)
+		require.Equal(t, "request-1", res.Revisions[0].RequestId)
+		require.Equal(t, "before", res.Revisions[0].Before.Memo)
+		require.Equal(t, "after", res.Revisions[0].After.Memo)
+	})
+
+	t.Run("異常系_他人の記録の履歴は403を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: "other-user"}, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"/"+id+"/history", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_limitが数値でなければ400を返す", func(t *testing.T) {
+		id, err := generateId()
+		require.NoError(t, err)
+
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(&entity.Record{ID: id, UserId: uid}, nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", RecordsPath+"/"+id+"/history?limit=abc", nil)
+		require.NoError(t, err)
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func test_RecordController_Import(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	csv := "record_no,event_date,friend_id,victory_flg,game1_winning_flg\n" +
+		"1,2026-06-07,friend,true,true\n" +
+		"1,2026-06-07,friend,false,false\n"
+
+	newImported := func() []*entity.ImportedRecord {
+		record := entity.NewRecord("01JMPK4VF04QX714CG4PHYJ88K", time.Now().Local(), 0, "", "friend", "", uid, "", "", time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local), false, false, entity.RegulationIdStandard, "", "")
+		matches := []*entity.Match{
+			entity.NewMatch("01JMPK4VF04QX714CG4PHYJ88M", record.CreatedAt, record.ID, "", "", uid, "", false, false, false, false, false, false, true, false, false, "", "", nil, nil),
+			entity.NewMatch("01JMPK4VF04QX714CG4PHYJ88N", record.CreatedAt, record.ID, "", "", uid, "", false, false, false, false, false, false, false, false, false, "", "", nil, nil),
+		}
+		return []*entity.ImportedRecord{entity.NewImportedRecord(record, matches)}
+	}
+
+	t.Run("正常系_CSVを取り込み201を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, _, _, mockRecordImport := setup4TestRecordControllerWithImport(t, r)
+
+		mockRecordImport.EXPECT().Import(gomock.Any(), uid, gomock.Any(), false).DoAndReturn(
+			func(ctx context.Context, userId string, params []*usecase.RecordImportParam, dryRun bool) ([]*entity.ImportedRecord, error) {
+				require.Len(t, params, 1)
+				require.Len(t, params[0].Matches, 2)
+				return newImported(), nil
+			},
+		)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath+"/import", strings.NewReader(csv))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordImportResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.False(t, res.DryRun)
+		require.Equal(t, 1, res.RecordCount)
+		require.Equal(t, 2, res.MatchCount)
+		require.Len(t, res.Records[0].Matches, 2)
+	})
+
+	t.Run("正常系_dry_runなら保存せずに200で内容を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, _, _, mockRecordImport := setup4TestRecordControllerWithImport(t, r)
+
+		mockRecordImport.EXPECT().Import(gomock.Any(), uid, gomock.Any(), true).Return(newImported(), nil)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath+"/import?dry_run=true", strings.NewReader(csv))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		var res dto.RecordImportResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.True(t, res.DryRun)
+	})
+
+	t.Run("異常系_不正な記録はメッセージ付きで400を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, _, _, mockRecordImport := setup4TestRecordControllerWithImport(t, r)
+
+		mockRecordImport.EXPECT().Import(gomock.Any(), uid, gomock.Any(), false).Return(nil, fmt.Errorf("%w: records[0]", apperror.ErrInvalidRecord))
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath+"/import", strings.NewReader(csv))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "records[0]")
+	})
+
+	t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
+		r := gin.Default()
+		c, _, _, _, _ := setup4TestRecordControllerWithImport(t, r)
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath+"/import", strings.NewReader(csv))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("異常系_取り込みに失敗したら500を返す", func(t *testing.T) {
+		r := gin.Default()
+
+		secretKey, err := testutil.GenerateJWTSecret()
+		require.NoError(t, err)
+		t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+		c, _, _, _, mockRecordImport := setup4TestRecordControllerWithImport(t, r)
+
+		mockRecordImport.EXPECT().Import(gomock.Any(), uid, gomock.Any(), false).Return(nil, errors.New(""))
+
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("POST", RecordsPath+"/import", strings.NewReader(csv))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+		setJWTAuthHeader(t, req, uid, secretKey)
+
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
 }
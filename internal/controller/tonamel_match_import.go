@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	TonamelMatchesPath = "/tonamel_matches"
+)
+
+type TonamelMatchImport struct {
+	router           *gin.Engine
+	recordRepository repository.RecordInterface
+	usecase          usecase.TonamelMatchImportInterface
+}
+
+func NewTonamelMatchImport(
+	router *gin.Engine,
+	recordRepository repository.RecordInterface,
+	usecase usecase.TonamelMatchImportInterface,
+) *TonamelMatchImport {
+	return &TonamelMatchImport{router, recordRepository, usecase}
+}
+
+func (c *TonamelMatchImport) RegisterRoute(relativePath string) {
+	c.router.POST(
+		relativePath+RecordsPath+"/:id"+TonamelMatchesPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.RecordTonamelMatchImportAuthorizationMiddleware(c.recordRepository),
+		validation.TonamelMatchImportMiddleware(),
+		c.Import,
+	)
+}
+
+func (c *TonamelMatchImport) Import(ctx *gin.Context) {
+	req := helper.GetTonamelMatchImportRequest(ctx)
+	id := helper.GetId(ctx)
+
+	matches, err := c.usecase.Import(ctx.Request.Context(), id, req.PlayerName)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrInvalidRecord) || errors.Is(err, apperror.ErrInvalidMatch):
+			apierror.ErrBadRequest.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrAlreadyExists):
+			apierror.ErrConflict.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrTonamelPlayerNotFound):
+			apierror.ErrTonamelPlayerNotFound.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrRecordNotFound):
+			// 記録は認可で確かめているため、見つからないのは Tonamel の大会(組み合わせ)
+			apierror.ErrNotFound.JSON(ctx, err)
+		default:
+			apierror.ErrInternalServerError.JSON(ctx, err)
+		}
+		return
+	}
+
+	res := presenter.NewMatchGetByRecordIdResponse(matches)
+
+	ctx.JSON(http.StatusCreated, res)
+}
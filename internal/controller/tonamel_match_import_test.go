@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestTonamelMatchImportController(t *testing.T) (
+	*TonamelMatchImport,
+	*mock_repository.MockRecordInterface,
+	*mock_usecase.MockTonamelMatchImportInterface,
+	string,
+) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+	mockUsecase := mock_usecase.NewMockTonamelMatchImportInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewTonamelMatchImport(r, mockRecordRepository, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockRecordRepository, mockUsecase, secretKey
+}
+
+func TestTonamelMatchImportController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherUid := "Q8qU2m0aBcXyZ1234567890abcd"
+	recordId := "01JTESTRECORD000000000000A"
+	record := &entity.Record{ID: recordId, UserId: uid, TonamelEventId: "OakZc"}
+	path := RecordsPath + "/" + recordId + TonamelMatchesPath
+
+	t.Run("正常系_取り込んだ対戦結果を201で返す", func(t *testing.T) {
+		c, mockRecordRepository, mockUsecase, secretKey := setup4TestTonamelMatchImportController(t)
+
+		mockRecordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+		// 前後の空白は取り除いてから渡す
+		mockUsecase.EXPECT().Import(gomock.Any(), recordId, "さとし").Return([]*entity.Match{
+			{ID: "01JTESTMATCH0000000000000A", RecordId: recordId, UserId: uid, VictoryFlg: true, Memo: "予選1回戦 vs かすみ"},
+			{ID: "01JTESTMATCH0000000000000B", RecordId: recordId, UserId: uid, DefaultVictoryFlg: true, VictoryFlg: true, Memo: "予選2回戦 バイ"},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, strings.NewReader(`{"player_name":" さとし "}`))
+		setJWTAuthHeader(t, req, uid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		var res []*dto.MatchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Len(t, res, 2)
+		require.Equal(t, "予選1回戦 vs かすみ", res[0].Memo)
+	})
+
+	t.Run("異常系_記録の所有者以外は403を返す", func(t *testing.T) {
+		c, mockRecordRepository, _, secretKey := setup4TestTonamelMatchImportController(t)
+
+		mockRecordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, strings.NewReader(`{"player_name":"さとし"}`))
+		setJWTAuthHeader(t, req, otherUid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("異常系_プレイヤー名が空なら400を返す", func(t *testing.T) {
+		c, mockRecordRepository, _, secretKey := setup4TestTonamelMatchImportController(t)
+
+		mockRecordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, strings.NewReader(`{"player_name":"  "}`))
+		setJWTAuthHeader(t, req, uid, secretKey)
+		c.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_usecaseのエラーをステータスに変換する", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{apperror.ErrInvalidRecord, http.StatusBadRequest},
+			{apperror.ErrAlreadyExists, http.StatusConflict},
+			{apperror.ErrTonamelPlayerNotFound, http.StatusUnprocessableEntity},
+			{apperror.ErrRecordNotFound, http.StatusNotFound},
+		}
+
+		for _, tc := range cases {
+			c, mockRecordRepository, mockUsecase, secretKey := setup4TestTonamelMatchImportController(t)
+
+			mockRecordRepository.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mockUsecase.EXPECT().Import(gomock.Any(), recordId, "さとし").Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", path, strings.NewReader(`{"player_name":"さとし"}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.status, w.Code, tc.err.Error())
+		}
+	})
+}
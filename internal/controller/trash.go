@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	TrashPath   = "/trash"
+	RestorePath = "/restore"
+)
+
+type Trash struct {
+	router  *gin.Engine
+	usecase usecase.TrashInterface
+}
+
+func NewTrash(
+	router *gin.Engine,
+	usecase usecase.TrashInterface,
+) *Trash {
+	return &Trash{router: router, usecase: usecase}
+}
+
+func (c *Trash) RegisterRoute(relativePath string) {
+	c.router.GET(
+		relativePath+UsersPath+"/:id"+TrashPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.TrashAuthorizationMiddleware(),
+		c.GetByUserId,
+	)
+
+	// 削除済みのものは各リソースの認可ミドルウェア(未削除のものを引く)では見つからないため、
+	// 本人のものかは usecase.Trash.Restore が確かめる。
+	for path, itemType := range map[string]entity.TrashItemType{
+		RecordsPath:   entity.TrashItemTypeRecord,
+		MatchesPath:   entity.TrashItemTypeMatch,
+		DecksPath:     entity.TrashItemTypeDeck,
+		DeckCodesPath: entity.TrashItemTypeDeckCode,
+	} {
+		c.router.POST(
+			relativePath+path+"/:id"+RestorePath,
+			authentication.RequiredAuthenticationMiddleware(),
+			c.Restore(itemType),
+		)
+	}
+}
+
+func (c *Trash) GetByUserId(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+
+	items, err := c.usecase.FindByUserId(ctx.Request.Context(), userId)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewTrashResponse(items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// Restore は削除した itemType を、一緒に削除された子とともに復元する。
+func (c *Trash) Restore(itemType entity.TrashItemType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := helper.GetId(ctx)
+		uid := helper.GetUID(ctx)
+
+		if _, err := c.usecase.Restore(ctx.Request.Context(), uid, itemType, id); err != nil {
+			if errors.Is(err, apperror.ErrRecordNotFound) {
+				apierror.ErrNotFound.JSON(ctx, err)
+				return
+			}
+
+			if errors.Is(err, apperror.ErrParentDeleted) {
+				apierror.ErrTrashParentDeleted.JSON(ctx, err)
+				return
+			}
+
+			apierror.ErrInternalServerError.JSON(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, gin.H{})
+	}
+}
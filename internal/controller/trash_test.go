@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestTrashController(t *testing.T) (*Trash, *mock_usecase.MockTrashInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockTrashInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewTrash(r, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestTrashController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	trashPath := UsersPath + "/" + uid + TrashPath
+
+	t.Run("GetByUserId", func(t *testing.T) {
+		t.Run("正常系_ゴミ箱の中身を復元期限付きで返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+			items := []*entity.TrashItem{
+				{ID: id, Type: entity.TrashItemTypeMatch, UserId: uid, ParentId: "01HD7Y3K8D6FDHMHTZ2GT41TR9", Name: "ドラパルトex", DeletedAt: now},
+			}
+			mockUsecase.EXPECT().FindByUserId(gomock.Any(), uid).Return(items, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", trashPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.TrashResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res.Items, 1)
+			require.Equal(t, "match", res.Items[0].Type)
+			require.Equal(t, "01HD7Y3K8D6FDHMHTZ2GT41TR9", res.Items[0].ParentId)
+			require.True(t, now.Add(entity.TrashRetention).Equal(res.Items[0].ExpiresAt))
+		})
+
+		t.Run("正常系_空なら空の配列を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+			mockUsecase.EXPECT().FindByUserId(gomock.Any(), uid).Return(nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", trashPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.JSONEq(t, `{"items":[]}`, w.Body.String())
+		})
+
+		t.Run("異常系_他人のゴミ箱は見られない", func(t *testing.T) {
+			c, _, secretKey := setup4TestTrashController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", trashPath, nil)
+			setJWTAuthHeader(t, req, "KBp7roRDZobZg1t0OPzFR1kvLeO2", secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		for path, itemType := range map[string]entity.TrashItemType{
+			RecordsPath:   entity.TrashItemTypeRecord,
+			MatchesPath:   entity.TrashItemTypeMatch,
+			DecksPath:     entity.TrashItemTypeDeck,
+			DeckCodesPath: entity.TrashItemTypeDeckCode,
+		} {
+			t.Run("正常系_"+string(itemType)+"を復元して204を返す", func(t *testing.T) {
+				c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+				mockUsecase.EXPECT().Restore(gomock.Any(), uid, itemType, id).Return(&entity.TrashItem{ID: id, Type: itemType}, nil)
+
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequest("POST", path+"/"+id+RestorePath, nil)
+				setJWTAuthHeader(t, req, uid, secretKey)
+				c.router.ServeHTTP(w, req)
+
+				require.Equal(t, http.StatusNoContent, w.Code)
+			})
+		}
+
+		t.Run("異常系_他人のもの・期限切れは404を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+			mockUsecase.EXPECT().Restore(gomock.Any(), uid, entity.TrashItemTypeRecord, id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", RecordsPath+"/"+id+RestorePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+
+		t.Run("異常系_親が削除されたままなら409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+			mockUsecase.EXPECT().Restore(gomock.Any(), uid, entity.TrashItemTypeMatch, id).Return(nil, apperror.ErrParentDeleted)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", MatchesPath+"/"+id+RestorePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestTrashController(t)
+
+			mockUsecase.EXPECT().Restore(gomock.Any(), uid, entity.TrashItemTypeDeck, id).Return(nil, errors.New(""))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", DecksPath+"/"+id+RestorePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusInternalServerError, w.Code)
+		})
+
+		t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
+			c, _, _ := setup4TestTrashController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", RecordsPath+"/"+id+RestorePath, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	})
+}
@@ -39,6 +39,7 @@ func (c *UnofficialEvent) RegisterRoute(relativePath string) {
 	r := c.router.Group(relativePath + UnofficialEventsPath)
 	r.GET(
 		"/:id",
+		authentication.OptionalAuthenticationMiddleware(),
 		c.GetById,
 	)
 	r.POST(
@@ -64,6 +65,7 @@ func (c *UnofficialEvent) RegisterRoute(relativePath string) {
 
 func (c *UnofficialEvent) GetById(ctx *gin.Context) {
 	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
 
 	unofficialEvent, err := c.usecase.FindById(ctx.Request.Context(), id)
 	if err != nil {
@@ -76,8 +78,9 @@ func (c *UnofficialEvent) GetById(ctx *gin.Context) {
 		return
 	}
 
-	res := presenter.NewUnofficialEventGetByIdResponse(unofficialEvent)
+	res := presenter.NewUnofficialEventGetByIdResponse(unofficialEvent, uid)
 
+	helper.SetETag(ctx, unofficialEvent.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -113,12 +116,23 @@ func (c *UnofficialEvent) Update(ctx *gin.Context) {
 		req.Date,
 	)
 
-	unofficialEvent, err := c.usecase.Update(ctx.Request.Context(), id, param)
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	unofficialEvent, err := c.usecase.Update(reqCtx, id, param)
 	if err != nil {
 		if errors.Is(err, apperror.ErrRecordNotFound) {
 			apierror.ErrNotFound.JSON(ctx, err)
 			return
 		}
+		// 読み込んだ版から他の端末で更新されていた場合は 412。
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
@@ -126,17 +140,28 @@ func (c *UnofficialEvent) Update(ctx *gin.Context) {
 
 	res := presenter.NewUnofficialEventUpdateResponse(unofficialEvent)
 
+	helper.SetETag(ctx, unofficialEvent.UpdatedAt)
 	ctx.JSON(http.StatusOK, res)
 }
 
 func (c *UnofficialEvent) Delete(ctx *gin.Context) {
 	id := helper.GetId(ctx)
 
-	if err := c.usecase.Delete(ctx.Request.Context(), id); err != nil {
+	reqCtx, err := helper.IfMatchContext(ctx, id)
+	if err != nil {
+		apierror.ErrPreconditionFailed.JSON(ctx, err)
+		return
+	}
+
+	if err := c.usecase.Delete(reqCtx, id); err != nil {
 		if errors.Is(err, apperror.ErrRecordNotFound) {
 			apierror.ErrBadRequestNotFound.JSON(ctx, err)
 			return
 		}
+		if errors.Is(err, apperror.ErrPreconditionFailed) {
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+			return
+		}
 
 		apierror.ErrInternalServerError.JSON(ctx, err)
 		return
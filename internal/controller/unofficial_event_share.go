@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	JoinCodePath = "/join_code"
+	JoinPath     = "/join"
+	SummaryPath  = "/summary"
+)
+
+type UnofficialEventShare struct {
+	router     *gin.Engine
+	repository repository.UnofficialEventInterface
+	usecase    usecase.UnofficialEventShareInterface
+}
+
+func NewUnofficialEventShare(
+	router *gin.Engine,
+	repository repository.UnofficialEventInterface,
+	usecase usecase.UnofficialEventShareInterface,
+) *UnofficialEventShare {
+	return &UnofficialEventShare{router, repository, usecase}
+}
+
+func (c *UnofficialEventShare) RegisterRoute(relativePath string) {
+	// 参加コードの発行・取り消しは作成者だけ、参加は記録を持つ登録ユーザ、集計は誰でも見られる。
+	r := c.router.Group(relativePath + UnofficialEventsPath)
+	r.POST(
+		JoinPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		validation.UnofficialEventJoinMiddleware(),
+		c.Join,
+	)
+	r.POST(
+		"/:id"+JoinCodePath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventShareAuthorizationMiddleware(c.repository),
+		c.IssueJoinCode,
+	)
+	r.DELETE(
+		"/:id"+JoinCodePath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventShareAuthorizationMiddleware(c.repository),
+		c.RevokeJoinCode,
+	)
+	r.GET(
+		"/:id"+SummaryPath,
+		c.GetSummary,
+	)
+}
+
+func (c *UnofficialEventShare) IssueJoinCode(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	unofficialEvent, err := c.usecase.IssueJoinCode(ctx.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		if errors.Is(err, apperror.ErrAlreadyExists) {
+			apierror.ErrConflict.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventJoinCodeResponse(unofficialEvent)
+
+	helper.SetETag(ctx, unofficialEvent.UpdatedAt)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventShare) RevokeJoinCode(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	unofficialEvent, err := c.usecase.RevokeJoinCode(ctx.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	helper.SetETag(ctx, unofficialEvent.UpdatedAt)
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+func (c *UnofficialEventShare) Join(ctx *gin.Context) {
+	req := helper.GetUnofficialEventJoinRequest(ctx)
+	uid := helper.GetUID(ctx)
+
+	record, err := c.usecase.Join(ctx.Request.Context(), uid, req.JoinCode, req.RecordId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrRecordNotFound):
+			apierror.ErrNotFound.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrInvalidRecord):
+			apierror.ErrBadRequest.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrPreconditionFailed):
+			apierror.ErrPreconditionFailed.JSON(ctx, err)
+		default:
+			apierror.ErrInternalServerError.JSON(ctx, err)
+		}
+		return
+	}
+
+	res := presenter.NewRecordGetByIdResponse(record)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventShare) GetSummary(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	summary, err := c.usecase.FindSummary(ctx.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventSummaryResponse(summary)
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestUnofficialEventShareController(
+	t *testing.T,
+	repo *stubUnofficialEventRepository,
+) (*UnofficialEventShare, *mock_usecase.MockUnofficialEventShareInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockUnofficialEventShareInterface(mockCtrl)
+
+	// /unofficial_events/join と /unofficial_events/:id 以下が同じルータに並んでも
+	// 登録できることを確かめるため、main.go と同じく自由形式イベントのルートも登録しておく。
+	r := gin.Default()
+	NewUnofficialEvent(r, repo, &stubUnofficialEventUsecase{}).RegisterRoute("")
+	c := NewUnofficialEventShare(r, repo, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestUnofficialEventShareController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherUid := "Q8qU2m0aBcXyZ1234567890abcd"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TN2"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TN3"
+	date := time.Date(2026, 7, 18, 0, 0, 0, 0, time.Local)
+	event := entity.NewUnofficialEvent(id, uid, "自主大会", date)
+
+	t.Run("IssueJoinCode", func(t *testing.T) {
+		t.Run("正常系_作成者が参加コードを発行する", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{event: event})
+
+			shared := entity.NewUnofficialEvent(id, uid, "自主大会", date)
+			shared.JoinCode = "K7QX2M9P"
+			mockUsecase.EXPECT().IssueJoinCode(gomock.Any(), id).Return(shared, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+"/"+id+JoinCodePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventJoinCodeResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, "K7QX2M9P", res.JoinCode)
+		})
+
+		t.Run("異常系_参加コードが他のイベントと重なり続ければ409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().IssueJoinCode(gomock.Any(), id).Return(nil, apperror.ErrAlreadyExists)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+"/"+id+JoinCodePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_作成者以外は403を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{event: event})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+"/"+id+JoinCodePath, nil)
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("RevokeJoinCode", func(t *testing.T) {
+		t.Run("正常系_作成者が参加コードを取り消す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().RevokeJoinCode(gomock.Any(), id).Return(event, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", UnofficialEventsPath+"/"+id+JoinCodePath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNoContent, w.Code)
+		})
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		t.Run("正常系_参加コードで自分の記録をイベントに付ける", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			// 小文字・前後の空白は揃えてから渡す
+			mockUsecase.EXPECT().Join(gomock.Any(), otherUid, "K7QX2M9P", recordId).
+				Return(&entity.Record{ID: recordId, UserId: otherUid, UnofficialEventId: id}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+JoinPath, strings.NewReader(`{"join_code":" k7qx2m9p ","record_id":"`+recordId+`"}`))
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.RecordGetByIdResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, id, res.UnofficialEventId)
+		})
+
+		t.Run("異常系_参加コードの桁数が違えば400を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+JoinPath, strings.NewReader(`{"join_code":"K7QX","record_id":"`+recordId+`"}`))
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_存在しない参加コードは404を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			mockUsecase.EXPECT().Join(gomock.Any(), otherUid, "K7QX2M9P", recordId).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+JoinPath, strings.NewReader(`{"join_code":"K7QX2M9P","record_id":"`+recordId+`"}`))
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+
+		t.Run("異常系_他のユーザの記録は400を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			mockUsecase.EXPECT().Join(gomock.Any(), otherUid, "K7QX2M9P", recordId).Return(nil, apperror.ErrInvalidRecord)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+JoinPath, strings.NewReader(`{"join_code":"K7QX2M9P","record_id":"`+recordId+`"}`))
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_未認証は401を返す", func(t *testing.T) {
+			c, _, _ := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UnofficialEventsPath+JoinPath, strings.NewReader(`{"join_code":"K7QX2M9P","record_id":"`+recordId+`"}`))
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	})
+
+	t.Run("GetSummary", func(t *testing.T) {
+		t.Run("正常系_参加者とデッキの集計を返す", func(t *testing.T) {
+			c, mockUsecase, _ := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			sprites := []*entity.PokemonSprite{entity.NewPokemonSpriteWithPosition("gardevoir", 1)}
+			mockUsecase.EXPECT().FindSummary(gomock.Any(), id).Return(&entity.UnofficialEventSummary{
+				UnofficialEventId: id,
+				Participants: []*entity.UnofficialEventSummaryParticipant{
+					{RecordId: recordId, UserId: uid, UserName: "ユーザ", Wins: 2, Losses: 1, PokemonSprites: sprites},
+				},
+				Archetypes: []*entity.UnofficialEventArchetype{
+					{Fingerprint: "gardevoir", Count: 1, Wins: 2, Losses: 1, PokemonSprites: sprites},
+				},
+				TotalMatches: 3,
+			}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UnofficialEventsPath+"/"+id+SummaryPath, nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventSummaryResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, 3, res.TotalMatches)
+			require.Equal(t, "ユーザ", res.Participants[0].UserName)
+			require.Equal(t, "gardevoir", res.Archetypes[0].PokemonSprites[0].ID)
+		})
+
+		t.Run("異常系_存在しないイベントは404を返す", func(t *testing.T) {
+			c, mockUsecase, _ := setup4TestUnofficialEventShareController(t, &stubUnofficialEventRepository{})
+
+			mockUsecase.EXPECT().FindSummary(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UnofficialEventsPath+"/"+id+SummaryPath, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+}
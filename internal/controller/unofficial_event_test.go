@@ -63,6 +63,14 @@ func (s *stubUnofficialEventRepository) FindById(ctx context.Context, id string)
 	return s.event, s.findErr
 }
 
+func (s *stubUnofficialEventRepository) FindByJoinCode(ctx context.Context, joinCode string) (*entity.UnofficialEvent, error) {
+	return s.event, s.findErr
+}
+
+func (s *stubUnofficialEventRepository) FindByUserId(ctx context.Context, userId string) ([]*entity.UnofficialEvent, error) {
+	return nil, nil
+}
+
 func (s *stubUnofficialEventRepository) Save(ctx context.Context, e *entity.UnofficialEvent) error {
 	return nil
 }
@@ -71,6 +79,10 @@ func (s *stubUnofficialEventRepository) Delete(ctx context.Context, id string) e
 	return nil
 }
 
+func (s *stubUnofficialEventRepository) DeleteIfUnreferenced(ctx context.Context, id string, userId string) error {
+	return nil
+}
+
 func setup4TestUnofficialEventController(t *testing.T, u *stubUnofficialEventUsecase) (*UnofficialEvent, string) {
 	t.Helper()
 
@@ -119,6 +131,34 @@ func TestUnofficialEventController(t *testing.T) {
 			require.Equal(t, "自主大会", res.Title)
 		})
 
+		t.Run("正常系_参加コードは作成者にだけ返す", func(t *testing.T) {
+			event := entity.NewUnofficialEvent(id, uid, "自主大会", date)
+			event.JoinCode = "K7QX2M9P"
+			c, secretKey := setup4TestUnofficialEventController(t, &stubUnofficialEventUsecase{event: event})
+
+			for _, tc := range []struct {
+				viewer   string
+				joinCode string
+			}{
+				{uid, "K7QX2M9P"},
+				{"Q8qU2m0aBcXyZ1234567890abcd", ""},
+				{"", ""},
+			} {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequest("GET", UnofficialEventsPath+"/"+id, nil)
+				if tc.viewer != "" {
+					setJWTAuthHeader(t, req, tc.viewer, secretKey)
+				}
+				c.router.ServeHTTP(w, req)
+
+				var res dto.UnofficialEventGetByIdResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+				require.Equal(t, http.StatusOK, w.Code)
+				require.Equal(t, tc.joinCode, res.JoinCode)
+			}
+		})
+
 		t.Run("異常系_存在しないIDは404を返す", func(t *testing.T) {
 			c, _ := setup4TestUnofficialEventController(t, &stubUnofficialEventUsecase{findErr: apperror.ErrRecordNotFound})
 
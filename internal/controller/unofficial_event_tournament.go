@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	TournamentPath = "/tournament"
+)
+
+type UnofficialEventTournament struct {
+	router     *gin.Engine
+	repository repository.UnofficialEventInterface
+	usecase    usecase.UnofficialEventTournamentInterface
+}
+
+func NewUnofficialEventTournament(
+	router *gin.Engine,
+	repository repository.UnofficialEventInterface,
+	usecase usecase.UnofficialEventTournamentInterface,
+) *UnofficialEventTournament {
+	return &UnofficialEventTournament{router, repository, usecase}
+}
+
+func (c *UnofficialEventTournament) RegisterRoute(relativePath string) {
+	// 組み合わせ・順位表は参加者が見られるよう公開し、運営の操作はイベントの作成者だけに許可する。
+	r := c.router.Group(relativePath + UnofficialEventsPath + "/:id" + TournamentPath)
+	r.GET(
+		"",
+		c.Get,
+	)
+	r.POST(
+		"",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		validation.UnofficialEventTournamentCreateMiddleware(),
+		c.Create,
+	)
+	r.GET(
+		"/participants",
+		c.GetParticipants,
+	)
+	r.POST(
+		"/participants",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		validation.UnofficialEventParticipantCreateMiddleware(),
+		c.AddParticipant,
+	)
+	r.DELETE(
+		"/participants/:participant_id",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		c.RemoveParticipant,
+	)
+	r.GET(
+		"/pairings",
+		validation.UnofficialEventPairingGetMiddleware(),
+		c.GetPairings,
+	)
+	r.PUT(
+		"/pairings/:pairing_id",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		validation.UnofficialEventPairingUpdateMiddleware(),
+		c.ReportResult,
+	)
+	r.POST(
+		"/rounds",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		c.CreateNextRound,
+	)
+	r.POST(
+		"/top_cut",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UnofficialEventTournamentAuthorizationMiddleware(c.repository),
+		validation.UnofficialEventTopCutCreateMiddleware(),
+		c.StartTopCut,
+	)
+	r.GET(
+		"/standings",
+		c.GetStandings,
+	)
+}
+
+// tournamentErrorJSON は大会の操作で起きたエラーを応答にする。
+func tournamentErrorJSON(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apperror.ErrRecordNotFound):
+		apierror.ErrNotFound.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrAlreadyExists):
+		apierror.ErrConflict.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrInvalidMatch):
+		apierror.ErrBadRequest.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrTournamentRoundInProgress):
+		apierror.ErrTournamentRoundInProgress.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrTournamentClosed):
+		apierror.ErrTournamentClosed.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrNotEnoughParticipants):
+		apierror.ErrNotEnoughParticipants.JSON(ctx, err)
+	case errors.Is(err, apperror.ErrTooManyParticipants):
+		apierror.ErrTooManyParticipants.JSON(ctx, err)
+	default:
+		apierror.ErrInternalServerError.JSON(ctx, err)
+	}
+}
+
+func (c *UnofficialEventTournament) Get(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	tournament, err := c.usecase.FindByUnofficialEventId(ctx.Request.Context(), id)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventTournamentResponse(tournament)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventTournament) Create(ctx *gin.Context) {
+	req := helper.GetUnofficialEventTournamentCreateRequest(ctx)
+	id := helper.GetId(ctx)
+
+	tournament, err := c.usecase.Create(ctx.Request.Context(), id, req.BO3Flg)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventTournamentResponse(tournament)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UnofficialEventTournament) GetParticipants(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	participants, err := c.usecase.FindParticipants(ctx.Request.Context(), id)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventParticipantGetResponse(participants)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventTournament) AddParticipant(ctx *gin.Context) {
+	req := helper.GetUnofficialEventParticipantCreateRequest(ctx)
+	id := helper.GetId(ctx)
+
+	participant, err := c.usecase.AddParticipant(ctx.Request.Context(), id, req.UserId, req.GuestName)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventParticipantResponse(participant)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UnofficialEventTournament) RemoveParticipant(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	participantId := ctx.Param("participant_id")
+
+	if err := c.usecase.RemoveParticipant(ctx.Request.Context(), id, participantId); err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+func (c *UnofficialEventTournament) GetPairings(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	round := helper.GetRound(ctx)
+
+	pairings, err := c.usecase.FindPairings(ctx.Request.Context(), id, round)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventPairingGetResponse(pairings)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventTournament) ReportResult(ctx *gin.Context) {
+	req := helper.GetUnofficialEventPairingUpdateRequest(ctx)
+	id := helper.GetId(ctx)
+	pairingId := ctx.Param("pairing_id")
+
+	pairing, err := c.usecase.ReportResult(ctx.Request.Context(), id, pairingId, req.Player1Wins, req.Player2Wins)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventPairingResponse(pairing)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UnofficialEventTournament) CreateNextRound(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	pairings, err := c.usecase.CreateNextRound(ctx.Request.Context(), id)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventPairingGetResponse(pairings)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UnofficialEventTournament) StartTopCut(ctx *gin.Context) {
+	req := helper.GetUnofficialEventTopCutCreateRequest(ctx)
+	id := helper.GetId(ctx)
+
+	pairings, err := c.usecase.StartTopCut(ctx.Request.Context(), id, req.Size)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventPairingGetResponse(pairings)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UnofficialEventTournament) GetStandings(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+
+	standings, err := c.usecase.FindStandings(ctx.Request.Context(), id)
+	if err != nil {
+		tournamentErrorJSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUnofficialEventStandingGetResponse(standings)
+
+	ctx.JSON(http.StatusOK, res)
+}
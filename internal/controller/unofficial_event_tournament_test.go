@@ -0,0 +1,263 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestUnofficialEventTournamentController(
+	t *testing.T,
+	repo *stubUnofficialEventRepository,
+) (*UnofficialEventTournament, *mock_usecase.MockUnofficialEventTournamentInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockUnofficialEventTournamentInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewUnofficialEventTournament(r, repo, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestUnofficialEventTournamentController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherUid := "Q8qU2m0aBcXyZ1234567890abcd"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TN2"
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+	event := entity.NewUnofficialEvent(id, uid, "身内大会", now)
+	basePath := UnofficialEventsPath + "/" + id + TournamentPath
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_イベントの作成者が大会にする", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().Create(gomock.Any(), id, true).Return(entity.NewUnofficialEventTournament(id, now, true), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath, strings.NewReader(`{"bo3_flg":true}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventTournamentResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusCreated, w.Code)
+			require.Equal(t, id, res.UnofficialEventId)
+			require.True(t, res.BO3Flg)
+		})
+
+		t.Run("異常系_作成者以外は403を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath, strings.NewReader(`{"bo3_flg":true}`))
+			setJWTAuthHeader(t, req, otherUid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_既に大会なら409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().Create(gomock.Any(), id, false).Return(nil, apperror.ErrAlreadyExists)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath, strings.NewReader(`{}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+	})
+
+	t.Run("AddParticipant", func(t *testing.T) {
+		t.Run("正常系_ゲストを登録する", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().AddParticipant(gomock.Any(), id, "", "ゲストA").Return(
+				entity.NewUnofficialEventParticipant("01JTESTPARTICIPANT00000000", now, id, "", "ゲストA"), nil,
+			)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/participants", strings.NewReader(`{"guest_name":" ゲストA "}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventParticipantResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusCreated, w.Code)
+			require.Equal(t, "ゲストA", res.Name)
+		})
+
+		t.Run("異常系_登録ユーザとゲストの両方を指定すると400を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/participants", strings.NewReader(`{"user_id":"`+otherUid+`","guest_name":"ゲストA"}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_参加者が上限に達していれば409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().AddParticipant(gomock.Any(), id, "", "ゲストA").Return(nil, apperror.ErrTooManyParticipants)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/participants", strings.NewReader(`{"guest_name":"ゲストA"}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+	})
+
+	t.Run("GetPairings", func(t *testing.T) {
+		t.Run("正常系_未認証でも回戦を指定して組み合わせを返す", func(t *testing.T) {
+			c, mockUsecase, _ := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			pairing := entity.NewUnofficialEventPairing("01JTESTPAIRING000000000000", now, id, entity.TournamentStageSwiss, 2, 1, "a", "")
+			pairing.Player1 = entity.NewUnofficialEventParticipant("a", now, id, "", "ゲストA")
+			mockUsecase.EXPECT().FindPairings(gomock.Any(), id, 2).Return([]*entity.UnofficialEventPairing{pairing}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", basePath+"/pairings?round=2", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventPairingGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, res.Pairings, 1)
+			require.True(t, res.Pairings[0].ByeFlg)
+			require.Equal(t, "ゲストA", res.Pairings[0].Player1.Name)
+			require.Nil(t, res.Pairings[0].Player2)
+		})
+
+		t.Run("異常系_回戦が正の整数でなければ400を返す", func(t *testing.T) {
+			c, _, _ := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", basePath+"/pairings?round=0", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	})
+
+	t.Run("CreateNextRound", func(t *testing.T) {
+		t.Run("異常系_未入力の結果があれば409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().CreateNextRound(gomock.Any(), id).Return(nil, apperror.ErrTournamentRoundInProgress)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/rounds", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_参加者が足りなければ422を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().CreateNextRound(gomock.Any(), id).Return(nil, apperror.ErrNotEnoughParticipants)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/rounds", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		})
+	})
+
+	t.Run("ReportResult", func(t *testing.T) {
+		t.Run("異常系_形式に合わないゲーム数は400を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().ReportResult(gomock.Any(), id, "01JTESTPAIRING000000000000", 2, 2).Return(nil, apperror.ErrInvalidMatch)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", basePath+"/pairings/01JTESTPAIRING000000000000", strings.NewReader(`{"player1_wins":2,"player2_wins":2}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	})
+
+	t.Run("StartTopCut", func(t *testing.T) {
+		t.Run("異常系_2の累乗でない人数は400を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", basePath+"/top_cut", strings.NewReader(`{"size":6}`))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	})
+
+	t.Run("GetStandings", func(t *testing.T) {
+		t.Run("正常系_順位表を返す", func(t *testing.T) {
+			c, mockUsecase, _ := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			participant := entity.NewUnofficialEventParticipant("a", now, id, "", "ゲストA")
+			mockUsecase.EXPECT().FindStandings(gomock.Any(), id).Return([]*entity.UnofficialEventStanding{
+				{Rank: 1, Participant: participant, Wins: 3, MatchPoints: 9, OpponentWinPercentage: 0.5},
+			}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", basePath+"/standings", nil)
+			c.router.ServeHTTP(w, req)
+
+			var res dto.UnofficialEventStandingGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, 9, res.Standings[0].MatchPoints)
+			require.Equal(t, "ゲストA", res.Standings[0].Participant.Name)
+		})
+
+		t.Run("異常系_大会にしていなければ404を返す", func(t *testing.T) {
+			c, mockUsecase, _ := setup4TestUnofficialEventTournamentController(t, &stubUnofficialEventRepository{event: event})
+
+			mockUsecase.EXPECT().FindStandings(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", basePath+"/standings", nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+}
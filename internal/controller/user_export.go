@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	UserExportPath         = "/export"
+	UserExportDownloadPath = "/download"
+)
+
+type UserExport struct {
+	router       *gin.Engine
+	usecase      usecase.UserExportInterface
+	relativePath string
+}
+
+func NewUserExport(
+	router *gin.Engine,
+	usecase usecase.UserExportInterface,
+) *UserExport {
+	return &UserExport{router: router, usecase: usecase}
+}
+
+func (c *UserExport) RegisterRoute(relativePath string) {
+	// ダウンロード先のURLを組み立てるために保持する。
+	c.relativePath = relativePath
+
+	r := c.router.Group(relativePath + UsersPath)
+	r.POST(
+		"/:id"+UserExportPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UserExportAuthorizationMiddleware(),
+		c.Create,
+	)
+	r.GET(
+		"/:id"+UserExportPath+"/:export_id",
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UserExportAuthorizationMiddleware(),
+		c.GetById,
+	)
+	r.GET(
+		"/:id"+UserExportPath+"/:export_id"+UserExportDownloadPath,
+		authentication.RequiredAuthenticationMiddleware(),
+		authorization.UserExportAuthorizationMiddleware(),
+		c.Download,
+	)
+}
+
+func (c *UserExport) downloadURL(userId string, exportId string) string {
+	return c.relativePath + UsersPath + "/" + userId + UserExportPath + "/" + exportId + UserExportDownloadPath
+}
+
+// Create はユーザの全データのエクスポートを受け付ける。アーカイブは非同期に作るため、
+// 202 で受け付けたエクスポートを返し、呼び出し側は GET で完了を待つ。
+func (c *UserExport) Create(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+
+	export, err := c.usecase.Request(ctx.Request.Context(), userId)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUserExportResponse(export, c.downloadURL(userId, export.ID))
+
+	ctx.JSON(http.StatusAccepted, res)
+}
+
+func (c *UserExport) GetById(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	exportId := ctx.Param("export_id")
+
+	export, err := c.usecase.FindById(ctx.Request.Context(), userId, exportId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUserExportResponse(export, c.downloadURL(userId, export.ID))
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// Download はアーカイブ(zip)を返す。アーカイブはストレージに非公開で置いているため、
+// 公開URLへリダイレクトせずAPIが本人に直接返す。
+func (c *UserExport) Download(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	exportId := ctx.Param("export_id")
+
+	body, err := c.usecase.Download(ctx.Request.Context(), userId, exportId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		if errors.Is(err, apperror.ErrNotReady) {
+			apierror.ErrUserExportNotReady.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="vsrecorder-export-`+exportId+`.zip"`)
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.Data(http.StatusOK, "application/zip", body)
+}
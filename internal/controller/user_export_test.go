@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestUserExportController(t *testing.T) (*UserExport, *mock_usecase.MockUserExportInterface, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockUserExportInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewUserExport(r, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestUserExportController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	exportId := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	exportPath := UsersPath + "/" + uid + UserExportPath
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_受け付けたエクスポートを202で返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			mockUsecase.EXPECT().Request(gomock.Any(), uid).Return(entity.NewUserExport(exportId, now, uid), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", exportPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusAccepted, w.Code)
+
+			var res dto.UserExportResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, exportId, res.ID)
+			require.Equal(t, "pending", res.Status)
+			require.Nil(t, res.CompletedAt)
+			require.Empty(t, res.DownloadURL)
+		})
+
+		t.Run("異常系_他人のエクスポートは作れない", func(t *testing.T) {
+			c, _, secretKey := setup4TestUserExportController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", exportPath, nil)
+			setJWTAuthHeader(t, req, "KBp7roRDZobZg1t0OPzFR1kvLeO2", secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+
+		t.Run("異常系_未認証なら401を返す", func(t *testing.T) {
+			c, _, _ := setup4TestUserExportController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", exportPath, nil)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	})
+
+	t.Run("GetById", func(t *testing.T) {
+		t.Run("正常系_完了したエクスポートはダウンロード先を含めて返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			export := entity.NewUserExport(exportId, now, uid)
+			export.Succeed(now)
+			mockUsecase.EXPECT().FindById(gomock.Any(), uid, exportId).Return(export, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", exportPath+"/"+exportId, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.UserExportResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "succeeded", res.Status)
+			require.NotNil(t, res.CompletedAt)
+			require.Equal(t, exportPath+"/"+exportId+UserExportDownloadPath, res.DownloadURL)
+		})
+
+		t.Run("異常系_存在しなければ404を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			mockUsecase.EXPECT().FindById(gomock.Any(), uid, exportId).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", exportPath+"/"+exportId, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		t.Run("正常系_アーカイブを添付ファイルとして返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			mockUsecase.EXPECT().Download(gomock.Any(), uid, exportId).Return([]byte("zip"), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", exportPath+"/"+exportId+UserExportDownloadPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+			require.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+			require.Equal(t, "zip", w.Body.String())
+		})
+
+		t.Run("異常系_完了していなければ409を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			mockUsecase.EXPECT().Download(gomock.Any(), uid, exportId).Return(nil, apperror.ErrNotReady)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", exportPath+"/"+exportId+UserExportDownloadPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+		})
+
+		t.Run("異常系_ユースケースのエラーで500を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserExportController(t)
+
+			mockUsecase.EXPECT().Download(gomock.Any(), uid, exportId).Return(nil, errors.New(""))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", exportPath+"/"+exportId+UserExportDownloadPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusInternalServerError, w.Code)
+		})
+	})
+}
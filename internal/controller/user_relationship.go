@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authorization"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/controller/presenter"
+	"github.com/vsrecorder/core-apiserver/internal/controller/validation"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/usecase"
+)
+
+const (
+	FollowRequestsPath = "/follow_requests"
+	FriendsPath        = "/friends"
+	BlocksPath         = "/blocks"
+)
+
+type UserRelationship struct {
+	router  *gin.Engine
+	usecase usecase.UserRelationshipInterface
+}
+
+func NewUserRelationship(
+	router *gin.Engine,
+	usecase usecase.UserRelationshipInterface,
+) *UserRelationship {
+	return &UserRelationship{router, usecase}
+}
+
+func (c *UserRelationship) RegisterRoute(relativePath string) {
+	// 申請への回答は、申請先の本人かを usecase 側で確かめる。
+	{
+		r := c.router.Group(relativePath + FollowRequestsPath)
+		r.POST(
+			"",
+			authentication.RequiredAuthenticationMiddleware(),
+			validation.FollowRequestCreateMiddleware(),
+			c.Request,
+		)
+		r.POST(
+			"/:id/accept",
+			authentication.RequiredAuthenticationMiddleware(),
+			c.Accept,
+		)
+		r.POST(
+			"/:id/decline",
+			authentication.RequiredAuthenticationMiddleware(),
+			c.Decline,
+		)
+	}
+
+	{
+		r := c.router.Group(relativePath + UsersPath)
+		r.GET(
+			"/:id"+FollowRequestsPath,
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.UserRelationshipAuthorizationMiddleware(),
+			validation.UserRelationshipGetMiddleware(),
+			c.GetRequests,
+		)
+		r.GET(
+			"/:id"+FriendsPath,
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.UserRelationshipAuthorizationMiddleware(),
+			validation.UserRelationshipGetMiddleware(),
+			c.GetFriends,
+		)
+		r.DELETE(
+			"/:id"+FriendsPath+"/:friend_id",
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.UserRelationshipAuthorizationMiddleware(),
+			c.Unfriend,
+		)
+		r.POST(
+			"/:id"+BlocksPath,
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.UserRelationshipAuthorizationMiddleware(),
+			validation.UserBlockCreateMiddleware(),
+			c.Block,
+		)
+		r.DELETE(
+			"/:id"+BlocksPath+"/:target_user_id",
+			authentication.RequiredAuthenticationMiddleware(),
+			authorization.UserRelationshipAuthorizationMiddleware(),
+			c.Unblock,
+		)
+	}
+}
+
+func (c *UserRelationship) Request(ctx *gin.Context) {
+	req := helper.GetFollowRequestCreateRequest(ctx)
+	uid := helper.GetUID(ctx)
+
+	relationship, err := c.usecase.Request(ctx.Request.Context(), uid, req.TargetUserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrRecordNotFound):
+			apierror.ErrNotFound.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrUserRelationshipExists):
+			apierror.ErrUserRelationshipExists.JSON(ctx, err)
+		case errors.Is(err, apperror.ErrUserBlocked):
+			apierror.ErrUserBlocked.JSON(ctx, err)
+		default:
+			apierror.ErrInternalServerError.JSON(ctx, err)
+		}
+		return
+	}
+
+	res := presenter.NewUserRelationshipResponse(relationship)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UserRelationship) GetRequests(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+
+	relationships, err := c.usecase.FindRequests(ctx.Request.Context(), userId, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewFollowRequestGetResponse(limit, offset, relationships)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UserRelationship) Accept(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	relationship, err := c.usecase.Accept(ctx.Request.Context(), uid, id)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUserRelationshipResponse(relationship)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UserRelationship) Decline(ctx *gin.Context) {
+	id := helper.GetId(ctx)
+	uid := helper.GetUID(ctx)
+
+	if err := c.usecase.Decline(ctx.Request.Context(), uid, id); err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+func (c *UserRelationship) GetFriends(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	limit := helper.GetLimit(ctx)
+	offset := helper.GetOffset(ctx)
+
+	relationships, err := c.usecase.FindFriends(ctx.Request.Context(), userId, limit, offset)
+	if err != nil {
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewFriendGetResponse(limit, offset, relationships)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *UserRelationship) Unfriend(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	friendUserId := ctx.Param("friend_id")
+
+	if err := c.usecase.Unfriend(ctx.Request.Context(), userId, friendUserId); err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+func (c *UserRelationship) Block(ctx *gin.Context) {
+	req := helper.GetUserBlockCreateRequest(ctx)
+	userId := helper.GetId(ctx)
+
+	relationship, err := c.usecase.Block(ctx.Request.Context(), userId, req.TargetUserId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	res := presenter.NewUserRelationshipResponse(relationship)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+func (c *UserRelationship) Unblock(ctx *gin.Context) {
+	userId := helper.GetId(ctx)
+	targetUserId := ctx.Param("target_user_id")
+
+	if err := c.usecase.Unblock(ctx.Request.Context(), userId, targetUserId); err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			apierror.ErrNotFound.JSON(ctx, err)
+			return
+		}
+
+		apierror.ErrInternalServerError.JSON(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
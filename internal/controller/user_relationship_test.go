@@ -0,0 +1,277 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_usecase"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
+)
+
+func setup4TestUserRelationshipController(t *testing.T) (
+	*UserRelationship,
+	*mock_usecase.MockUserRelationshipInterface,
+	string,
+) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	mockCtrl := gomock.NewController(t)
+	mockUsecase := mock_usecase.NewMockUserRelationshipInterface(mockCtrl)
+
+	r := gin.Default()
+	c := NewUserRelationship(r, mockUsecase)
+	c.RegisterRoute("")
+
+	return c, mockUsecase, secretKey
+}
+
+func TestUserRelationshipController(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	targetUserId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	id := "01JTESTRELATIONSHIP0000000"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Request", func(t *testing.T) {
+		newBody := func(t *testing.T, targetUserId string) *bytes.Buffer {
+			body, err := json.Marshal(dto.FollowRequestCreateRequest{TargetUserId: targetUserId})
+			require.NoError(t, err)
+			return bytes.NewBuffer(body)
+		}
+
+		t.Run("正常系_フレンドを申請する", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			relationship := entity.NewUserRelationship(id, now, uid, targetUserId, entity.UserRelationshipStatusPending)
+			relationship.User = &entity.User{ID: targetUserId, Name: "テスト"}
+
+			mockUsecase.EXPECT().Request(gomock.Any(), uid, targetUserId).Return(relationship, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", FollowRequestsPath, newBody(t, targetUserId))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			var res dto.UserRelationshipResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "pending", res.Status)
+			require.Equal(t, "テスト", res.User.Name)
+		})
+
+		t.Run("異常系_自分自身には申請できず400を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUserRelationshipController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", FollowRequestsPath, newBody(t, uid))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		for name, tc := range map[string]struct {
+			err      error
+			expected int
+		}{
+			"異常系_相手がいなければ404を返す":       {apperror.ErrRecordNotFound, http.StatusNotFound},
+			"異常系_既にフレンドか申請中なら409を返す":   {apperror.ErrUserRelationshipExists, http.StatusConflict},
+			"異常系_どちらかがブロックしていれば403を返す": {apperror.ErrUserBlocked, http.StatusForbidden},
+		} {
+			t.Run(name, func(t *testing.T) {
+				c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+				mockUsecase.EXPECT().Request(gomock.Any(), uid, targetUserId).Return(nil, tc.err)
+
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequest("POST", FollowRequestsPath, newBody(t, targetUserId))
+				setJWTAuthHeader(t, req, uid, secretKey)
+				c.router.ServeHTTP(w, req)
+
+				require.Equal(t, tc.expected, w.Code)
+			})
+		}
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		t.Run("正常系_届いた申請を承認する", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			relationship := entity.NewUserRelationship(id, now, targetUserId, uid, entity.UserRelationshipStatusPending)
+			relationship.Accept(now)
+			relationship.User = &entity.User{ID: targetUserId}
+
+			mockUsecase.EXPECT().Accept(gomock.Any(), uid, id).Return(relationship, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", FollowRequestsPath+"/"+id+"/accept", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.UserRelationshipResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "accepted", res.Status)
+		})
+
+		t.Run("異常系_自分宛ての申請でなければ404を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			mockUsecase.EXPECT().Accept(gomock.Any(), uid, id).Return(nil, apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", FollowRequestsPath+"/"+id+"/accept", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("Decline", func(t *testing.T) {
+		t.Run("正常系_届いた申請を断る", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			mockUsecase.EXPECT().Decline(gomock.Any(), uid, id).Return(nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", FollowRequestsPath+"/"+id+"/decline", nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNoContent, w.Code)
+		})
+	})
+
+	t.Run("GetRequests", func(t *testing.T) {
+		t.Run("正常系_届いた申請の一覧を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			relationship := entity.NewUserRelationship(id, now, targetUserId, uid, entity.UserRelationshipStatusPending)
+			relationship.User = &entity.User{ID: targetUserId}
+
+			mockUsecase.EXPECT().FindRequests(gomock.Any(), uid, 10, 0).Return([]*entity.UserRelationship{relationship}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+FollowRequestsPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.FollowRequestGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res.FollowRequests, 1)
+			require.Equal(t, targetUserId, res.FollowRequests[0].User.ID)
+		})
+
+		t.Run("異常系_他人の申請一覧は403を返す", func(t *testing.T) {
+			c, _, secretKey := setup4TestUserRelationshipController(t)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+FollowRequestsPath, nil)
+			setJWTAuthHeader(t, req, targetUserId, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	})
+
+	t.Run("GetFriends", func(t *testing.T) {
+		t.Run("正常系_フレンドになった日時とともに返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			relationship := entity.NewUserRelationship(id, now.Add(-time.Hour), uid, targetUserId, entity.UserRelationshipStatusPending)
+			relationship.Accept(now)
+			relationship.User = &entity.User{ID: targetUserId}
+
+			mockUsecase.EXPECT().FindFriends(gomock.Any(), uid, 10, 0).Return([]*entity.UserRelationship{relationship}, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", UsersPath+"/"+uid+FriendsPath, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var res dto.FriendGetResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res.Friends, 1)
+			require.Equal(t, targetUserId, res.Friends[0].User.ID)
+			require.True(t, now.Equal(res.Friends[0].Since))
+		})
+	})
+
+	t.Run("Unfriend", func(t *testing.T) {
+		t.Run("異常系_フレンドでなければ404を返す", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			mockUsecase.EXPECT().Unfriend(gomock.Any(), uid, targetUserId).Return(apperror.ErrRecordNotFound)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", UsersPath+"/"+uid+FriendsPath+"/"+targetUserId, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+		})
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		t.Run("正常系_ユーザをブロックする", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			relationship := entity.NewUserRelationship(id, now, uid, targetUserId, entity.UserRelationshipStatusBlocked)
+			relationship.User = &entity.User{ID: targetUserId}
+
+			mockUsecase.EXPECT().Block(gomock.Any(), uid, targetUserId).Return(relationship, nil)
+
+			body, err := json.Marshal(dto.UserBlockCreateRequest{TargetUserId: targetUserId})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", UsersPath+"/"+uid+BlocksPath, bytes.NewBuffer(body))
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			var res dto.UserRelationshipResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Equal(t, "blocked", res.Status)
+		})
+	})
+
+	t.Run("Unblock", func(t *testing.T) {
+		t.Run("正常系_ブロックを解く", func(t *testing.T) {
+			c, mockUsecase, secretKey := setup4TestUserRelationshipController(t)
+
+			mockUsecase.EXPECT().Unblock(gomock.Any(), uid, targetUserId).Return(nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", UsersPath+"/"+uid+BlocksPath+"/"+targetUserId, nil)
+			setJWTAuthHeader(t, req, uid, secretKey)
+			c.router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNoContent, w.Code)
+		})
+	})
+}
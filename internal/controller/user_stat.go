@@ -60,8 +60,9 @@ func (c *UserStat) GetByUserId(ctx *gin.Context) {
 	season := helper.GetSeason(ctx)
 	standardRegulationId := helper.GetStandardRegulationId(ctx)
 	regulationId := helper.GetRegulationId(ctx)
+	recordTagId := helper.GetRecordTagId(ctx)
 
-	stats, err := c.usecase.GetUserStat(ctx.Request.Context(), uid, yearMonth, environmentId, season, standardRegulationId, regulationId)
+	stats, err := c.usecase.GetUserStat(ctx.Request.Context(), uid, yearMonth, environmentId, season, standardRegulationId, regulationId, recordTagId)
 	if err != nil {
 		if errors.Is(err, apperror.ErrRecordNotFound) {
 			apierror.ErrNotFound.JSON(ctx, err)
@@ -72,7 +73,7 @@ func (c *UserStat) GetByUserId(ctx *gin.Context) {
 		return
 	}
 
-	res := presenter.NewUserStatResponse(stats, yearMonth, environmentId, season, standardRegulationId, regulationId)
+	res := presenter.NewUserStatResponse(stats, yearMonth, environmentId, season, standardRegulationId, regulationId, recordTagId)
 
 	ctx.JSON(http.StatusOK, res)
 }
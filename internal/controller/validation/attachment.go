@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// AttachmentFormField は写真を載せる multipart/form-data のフィールド名。
+const AttachmentFormField = "file"
+
+// AttachmentCreateMiddleware は multipart/form-data の file を読み込む。
+// 写真として扱えるかは中身を見て usecase(infrastructure)が判定するため、ここでは大きさだけを確かめる。
+func AttachmentCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header, err := ctx.FormFile(AttachmentFormField)
+		if err != nil {
+			// ボディ全体が BodySizeLimitMiddleware の上限を超えた場合は、読み込み途中で打ち切られる。
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				apierror.ErrAttachmentTooLarge.JSON(ctx, err)
+				return
+			}
+
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if header.Size > entity.MaxAttachmentBytes {
+			apierror.ErrAttachmentTooLarge.JSON(ctx)
+			return
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+		defer file.Close()
+
+		body, err := io.ReadAll(file)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if len(body) == 0 {
+			apierror.ErrBadRequest.JSON(ctx, errors.New("file is empty"))
+			return
+		}
+
+		helper.SetAttachmentBody(ctx, body)
+	}
+}
@@ -43,6 +43,26 @@ func DeckGetMiddleware() gin.HandlerFunc {
 	}
 }
 
+// UserDeckGetMiddleware は /users/:id/decks のクエリを検証する。カーソルには対応しない。
+func UserDeckGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		limit, err := helper.ParseQueryLimit(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetLimit(ctx, limit)
+		helper.SetOffset(ctx, offset)
+	}
+}
+
 func DeckCreateMiddleware(logger *slog.Logger) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		req := dto.DeckCreateRequest{}
@@ -56,8 +76,14 @@ func DeckCreateMiddleware(logger *slog.Logger) gin.HandlerFunc {
 			return
 		}
 
+		// デッキコードとテキスト形式のデッキリストは同時には指定できない(どちらも省略は可)。
+		if req.DeckCode != "" && req.DeckList != "" {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
 		// 長さの確認は外部APIへの問い合わせ前に行う。
-		if exceedsLength(req.DeckCode, MaxDeckCodeLength) {
+		if exceedsLength(req.DeckCode, MaxDeckCodeLength) || exceedsLength(req.DeckList, MaxDeckListLength) {
 			apierror.ErrBadRequest.JSON(ctx)
 			return
 		}
@@ -18,7 +18,13 @@ func DeckCodeCreateMiddleware(logger *slog.Logger) gin.HandlerFunc {
 			return
 		}
 
-		if req.Code == "" || exceedsLength(req.Code, MaxDeckCodeLength) {
+		// デッキコードとテキスト形式のデッキリストはどちらか一方だけを受け付ける。
+		if (req.Code == "") == (req.DeckList == "") {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		if exceedsLength(req.Code, MaxDeckCodeLength) || exceedsLength(req.DeckList, MaxDeckListLength) {
 			apierror.ErrBadRequest.JSON(ctx)
 			return
 		}
@@ -31,7 +37,9 @@ func DeckCodeCreateMiddleware(logger *slog.Logger) gin.HandlerFunc {
 		// フロントエンド側でデッキコードの有効性を確認しているのでチェックは不要だが、念のためサーバ側でも確認したいが、
 		// 大量のリクエストが来ると外部APIに負荷がかかるので、現状はコメントアウトしている。
 		// もし外部APIの負荷が問題ない場合は、コメントアウトを解除してチェックを有効化する。
-		//checkDeckCode(ctx, logger, req.Code)
+		//if req.Code != "" {
+		//	checkDeckCode(ctx, logger, req.Code)
+		//}
 
 		if !validateTagIds(req.TagIds) {
 			apierror.ErrBadRequest.JSON(ctx)
@@ -63,3 +71,34 @@ func DeckCodeUpdateMiddleware() gin.HandlerFunc {
 		helper.SetDeckCodeUpdateRequest(ctx, req)
 	}
 }
+
+// DeckCodeLegalityGetMiddleware は GET /deckcodes/:id/legality の date(YYYY-MM-DD) と
+// regulation_id を読み取る。どちらも省略でき、usecase 側で今日・スタンダードとして扱う。
+func DeckCodeLegalityGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		date, err := helper.ParseQueryDate(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetDate(ctx, date)
+		helper.SetRegulationId(ctx, helper.ParseQueryRegulationId(ctx))
+	}
+}
+
+// DeckCodeExportGetMiddleware はデッキリストのエクスポート形式を確認する。未指定は text。
+func DeckCodeExportGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		format := helper.GetQueryFormat(ctx)
+		switch format {
+		case "text", "json":
+		case "":
+			format = "text"
+		default:
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+		helper.SetFormat(ctx, format)
+	}
+}
@@ -101,7 +101,7 @@ func TestDeckCodeValidation(t *testing.T) {
 			require.Equal(t, http.StatusBadRequest, w.Code)
 		})
 
-		t.Run("異常系_コードが空なら400を返す", func(t *testing.T) {
+		t.Run("異常系_コードもデッキリストも空なら400を返す", func(t *testing.T) {
 			b, err := json.Marshal(dto.DeckCodeCreateRequest{Code: ""})
 			require.NoError(t, err)
 
@@ -112,6 +112,45 @@ func TestDeckCodeValidation(t *testing.T) {
 			require.Equal(t, http.StatusBadRequest, w.Code)
 		})
 
+		t.Run("正常系_コードの代わりにデッキリストを受理する", func(t *testing.T) {
+			expected := dto.DeckCodeCreateRequest{
+				DeckId:   "01HD7Y3K8D6FDHMHTZ2GT41TD1",
+				DeckList: "4 ナンジャモ",
+			}
+
+			b, err := json.Marshal(expected)
+			require.NoError(t, err)
+
+			ctx, w := newValidationJSONContext(t, string(b))
+
+			DeckCodeCreateMiddleware(slog.Default())(ctx)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, expected, helper.GetDeckCodeCreateRequest(ctx))
+		})
+
+		t.Run("異常系_コードとデッキリストを両方指定したら400を返す", func(t *testing.T) {
+			b, err := json.Marshal(dto.DeckCodeCreateRequest{Code: "5dbFbk-uBwjqP-VVk5Vv", DeckList: "4 ナンジャモ"})
+			require.NoError(t, err)
+
+			ctx, w := newValidationJSONContext(t, string(b))
+
+			DeckCodeCreateMiddleware(slog.Default())(ctx)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
+		t.Run("異常系_デッキリストが上限を超えたら400を返す", func(t *testing.T) {
+			b, err := json.Marshal(dto.DeckCodeCreateRequest{DeckList: strings.Repeat("あ", MaxDeckListLength+1)})
+			require.NoError(t, err)
+
+			ctx, w := newValidationJSONContext(t, string(b))
+
+			DeckCodeCreateMiddleware(slog.Default())(ctx)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+
 		t.Run("異常系_コードが上限を超えたら400を返す", func(t *testing.T) {
 			b, err := json.Marshal(dto.DeckCodeCreateRequest{Code: strings.Repeat("x", MaxDeckCodeLength+1)})
 			require.NoError(t, err)
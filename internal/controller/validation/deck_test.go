@@ -212,6 +212,29 @@ func test_DeckCreateMiddleware(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 
+	t.Run("異常系_デッキコードとデッキリストを両方指定したら400を返す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+
+		dataBytes, err := json.Marshal(dto.DeckCreateRequest{
+			Name:     "test",
+			DeckCode: "48Yx8x-cJUK50-xxcxKJ",
+			DeckList: "4 ナンジャモ",
+		})
+		require.NoError(t, err)
+
+		// Middlewareのテストのためpathは何でもよい
+		req, err := http.NewRequest("POST", "/", strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+
+		ginContext.Request = req
+
+		middleware := DeckCreateMiddleware(slog.Default())
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("異常系_JSONとして不正なボディなら400を返す", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		ginContext, _ := gin.CreateTestContext(w)
@@ -21,6 +21,12 @@ func isValidMatchRequest(req dto.MatchRequest) bool {
 		return false
 	}
 
+	return isValidMatchBody(req)
+}
+
+// isValidMatchBody は RecordId 以外の対戦結果の中身を検証する。記録の一括取り込みでは
+// RecordId を取り込み時に採番するため、こちらだけを使う。
+func isValidMatchBody(req dto.MatchRequest) bool {
 	// 自由入力欄が上限を超えている。memoはDB上TEXTで上限が無いため歯止めをかける
 	if exceedsLength(req.Memo, MaxMemoLength) {
 		return false
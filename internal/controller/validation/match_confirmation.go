@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func MatchConfirmationGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		limit, err := helper.ParseQueryLimit(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetLimit(ctx, limit)
+		helper.SetOffset(ctx, offset)
+	}
+}
+
+// MatchConfirmationConfirmMiddleware は承認のリクエストを検証する。record_id の記録が
+// 本人のものかは usecase 側で確かめる。
+func MatchConfirmationConfirmMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.MatchConfirmationConfirmRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if req.RecordId == "" || exceedsLength(req.RecordId, 26) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetMatchConfirmationConfirmRequest(ctx, req)
+	}
+}
+
+func MatchConfirmationDisputeMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.MatchConfirmationDisputeRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if exceedsLength(req.Reason, entity.MaxMatchConfirmationDisputeReasonLength) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetMatchConfirmationDisputeRequest(ctx, req)
+	}
+}
@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+func MemoSearchMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		terms, err := helper.ParseQuerySearch(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		limit, err := helper.ParseQueryLimit(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetSearchTerms(ctx, terms)
+		helper.SetLimit(ctx, limit)
+		helper.SetOffset(ctx, offset)
+	}
+}
@@ -55,6 +55,33 @@ func OfficialEventGetMiddleware() gin.HandlerFunc {
 	}
 }
 
+func OfficialEventSearchMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		criteria, err := helper.ParseQueryOfficialEventSearchCriteria(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		limit, err := helper.ParseQueryLimit(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		criteria.Limit = limit
+		criteria.Offset = offset
+
+		helper.SetOfficialEventSearchCriteria(ctx, criteria)
+	}
+}
+
 func OfficialEventGetByIdMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		id := helper.GetId(ctx)
@@ -9,6 +9,10 @@ import (
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
+// MaxEmbeddedMatchCount は記録の作成時に一緒に作成できる対戦結果の上限。
+// 大会1回分の対戦数(予選+決勝トーナメント)に十分な緩い値にしている。
+const MaxEmbeddedMatchCount = 32
+
 func RecordGetMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		limit, err := helper.ParseQueryLimit(ctx)
@@ -31,6 +35,12 @@ func RecordGetMiddleware() gin.HandlerFunc {
 
 		eventType, err := helper.ParseQueryEventType(ctx)
 
+		criteria, err := helper.ParseQueryRecordCriteria(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
 		helper.SetLimit(ctx, limit)
 		helper.SetOffset(ctx, offset)
 		helper.SetCursorEventDate(ctx, cursorEventDate)
@@ -38,6 +48,7 @@ func RecordGetMiddleware() gin.HandlerFunc {
 		helper.SetEventType(ctx, eventType)
 
 		helper.SetDeckId(ctx, helper.GetQueryDeckId(ctx))
+		helper.SetRecordCriteria(ctx, criteria)
 	}
 }
 
@@ -69,10 +80,36 @@ func RecordCreateMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !validateTagIds(req.TagIds) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		if !isValidEmbeddedMatches(req.Matches) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
 		helper.SetRecordCreateRequest(ctx, req)
 	}
 }
 
+// isValidEmbeddedMatches は記録と一緒に作成する対戦結果を、1件ずつ作成する場合と
+// 同じ基準で検証する。record_id は記録の作成時に設定するため指定できない。
+func isValidEmbeddedMatches(matches []*dto.MatchRequest) bool {
+	if len(matches) > MaxEmbeddedMatchCount {
+		return false
+	}
+
+	for _, match := range matches {
+		if match == nil || match.RecordId != "" || !isValidMatchBody(*match) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func RecordUpdateMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		req := dto.RecordUpdateRequest{}
@@ -101,6 +138,11 @@ func RecordUpdateMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !validateTagIds(req.TagIds) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
 		helper.SetRecordUpdateRequest(ctx, req)
 	}
 }
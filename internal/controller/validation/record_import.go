@@ -0,0 +1,410 @@
+package validation
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// MaxRecordImportCount は1回の一括取り込みで受け付ける記録数の上限。
+// 全件を1つのトランザクションで保存するため、長時間ロックを握らない程度に抑える。
+const MaxRecordImportCount = 500
+
+// RecordImportCSVContentType は CSV で一括取り込みするときの Content-Type。
+// それ以外は JSON ({"records": [...]}) として扱う。
+const RecordImportCSVContentType = "text/csv"
+
+// recordImportMaxGames は CSV の1行に書ける対戦のゲーム数(BO3の最大3本)。
+const recordImportMaxGames = 3
+
+// CSV の列。1行が1対戦で、record_no が同じ行を1件の記録にまとめる。記録の列は各記録の
+// 最初の行の値を使う。victory_flg が空の行は対戦結果を持たない(記録だけの)行として扱う。
+// ゲームは game1_〜game3_ の列で表し、gameN_winning_flg が空ならそのゲームは無いものとする。
+var (
+	recordImportCSVRecordColumns = []string{
+		"record_no", "event_date",
+		"official_event_id", "tonamel_event_id", "friend_id", "unofficial_event_id",
+		"deck_id", "deck_code_id", "private_flg", "ignore_stats_flg",
+		"regulation_id", "tcg_meister_url", "memo",
+	}
+	recordImportCSVMatchColumns = []string{
+		"bo3_flg", "group_match_flg", "qualifying_round_flg", "final_tournament_flg",
+		"default_victory_flg", "default_defeat_flg", "victory_flg", "draw_flg",
+		"group_match_victory_flg", "opponents_deck_info", "match_memo",
+	}
+	recordImportCSVGameColumns = []string{
+		"go_first", "winning_flg", "your_prize_cards", "opponents_prize_cards", "memo",
+	}
+	recordImportCSVRequiredColumns = []string{"record_no", "event_date"}
+)
+
+func RecordImportMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		dryRun := false
+		if v := ctx.Query("dry_run"); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				apierror.ErrBadRequest.JSON(ctx, err)
+				return
+			}
+			dryRun = b
+		}
+
+		req := dto.RecordImportRequest{}
+		var labels []string
+		if ctx.ContentType() == RecordImportCSVContentType {
+			var err error
+			req, labels, err = parseRecordImportCSV(ctx.Request.Body)
+			if err != nil {
+				// どの行が読めなかったかを直せるよう、メッセージごと返す
+				apierror.New(http.StatusBadRequest, err).JSON(ctx, err)
+				return
+			}
+		} else {
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				apierror.ErrBadRequest.JSON(ctx, err)
+				return
+			}
+
+			for i := range req.Records {
+				labels = append(labels, fmt.Sprintf("records[%d]", i))
+			}
+		}
+
+		if err := validateRecordImportRequest(req, labels); err != nil {
+			apierror.New(http.StatusBadRequest, err).JSON(ctx, err)
+			return
+		}
+
+		helper.SetRecordImportRequest(ctx, req)
+		helper.SetDryRun(ctx, dryRun)
+	}
+}
+
+// validateRecordImportRequest は取り込む各記録・対戦結果を、1件ずつ作成する場合と
+// 同じ基準で検証する。labels は記録ごとの位置(JSON なら records[i]、CSV なら
+// record_no と行番号)で、どの記録が不正かをエラーメッセージに含めるために使う。
+func validateRecordImportRequest(req dto.RecordImportRequest, labels []string) error {
+	if len(req.Records) == 0 {
+		return errors.New("no records")
+	}
+
+	if len(req.Records) > MaxRecordImportCount {
+		return fmt.Errorf("too many records: up to %d", MaxRecordImportCount)
+	}
+
+	for i, record := range req.Records {
+		if record == nil ||
+			!isValidRecordEventSource(record.RecordRequest) ||
+			!isValidRecordLength(record.RecordRequest) ||
+			!isValidTCGMeisterURL(record.TCGMeisterURL) ||
+			!isValidRecordRegulation(record.RecordRequest) ||
+			// タグの付与は取り込みでは扱わない(対戦結果と同じ)
+			len(record.TagIds) != 0 {
+			return fmt.Errorf("invalid record: %s", labels[i])
+		}
+
+		for j, match := range record.Matches {
+			// record_id は取り込み時に採番する。タグの付与は取り込みでは扱わない
+			if match == nil || match.RecordId != "" || len(match.TagIds) != 0 || !isValidMatchBody(*match) {
+				return fmt.Errorf("invalid match: %s.matches[%d]", labels[i], j)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseRecordImportCSV は CSV を一括取り込みのリクエストに変換する。記録ごとのラベルも返す。
+// 値の解釈に失敗した場合は行番号と列名を含むエラーを返す。
+func parseRecordImportCSV(r io.Reader) (dto.RecordImportRequest, []string, error) {
+	req := dto.RecordImportRequest{}
+
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return req, nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns, err := recordImportCSVColumnIndexes(header)
+	if err != nil {
+		return req, nil, err
+	}
+
+	var labels []string
+	recordIndexes := map[string]int{}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return req, nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		p := &recordImportCSVRow{columns: columns, row: row, line: line}
+
+		recordNo := p.get("record_no")
+		if recordNo == "" {
+			return req, nil, fmt.Errorf("line %d: record_no is required", line)
+		}
+
+		idx, ok := recordIndexes[recordNo]
+		if !ok {
+			record, err := p.record()
+			if err != nil {
+				return req, nil, err
+			}
+
+			idx = len(req.Records)
+			recordIndexes[recordNo] = idx
+			req.Records = append(req.Records, record)
+			labels = append(labels, fmt.Sprintf("record_no %s (line %d)", recordNo, line))
+		}
+
+		if p.get("victory_flg") == "" {
+			continue
+		}
+
+		match, err := p.match()
+		if err != nil {
+			return req, nil, err
+		}
+		req.Records[idx].Matches = append(req.Records[idx].Matches, match)
+	}
+
+	return req, labels, nil
+}
+
+// recordImportCSVColumnIndexes はヘッダから列名→列番号の対応を作る。
+// 打ち間違いに気付けるよう、知らない列名はエラーにする。
+func recordImportCSVColumnIndexes(header []string) (map[string]int, error) {
+	known := map[string]bool{}
+	for _, name := range recordImportCSVRecordColumns {
+		known[name] = true
+	}
+	for _, name := range recordImportCSVMatchColumns {
+		known[name] = true
+	}
+	for n := 1; n <= recordImportMaxGames; n++ {
+		for _, name := range recordImportCSVGameColumns {
+			known[fmt.Sprintf("game%d_%s", n, name)] = true
+		}
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		// Excel で保存した CSV は先頭に BOM が付くため取り除く
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.TrimSpace(name)
+
+		if !known[name] {
+			return nil, fmt.Errorf("unknown csv column: %q", name)
+		}
+		columns[name] = i
+	}
+
+	for _, name := range recordImportCSVRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv column is required: %q", name)
+		}
+	}
+
+	return columns, nil
+}
+
+// recordImportCSVRow は CSV の1行。列が無い・空のセルは未指定(ゼロ値)として扱う。
+type recordImportCSVRow struct {
+	columns map[string]int
+	row     []string
+	line    int
+}
+
+func (p *recordImportCSVRow) get(name string) string {
+	i, ok := p.columns[name]
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(p.row[i])
+}
+
+func (p *recordImportCSVRow) invalid(name string) error {
+	return fmt.Errorf("line %d: invalid %s: %q", p.line, name, p.get(name))
+}
+
+func (p *recordImportCSVRow) bool(name string) (bool, error) {
+	v := p.get(name)
+	if v == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, p.invalid(name)
+	}
+
+	return b, nil
+}
+
+func (p *recordImportCSVRow) uint(name string) (uint, error) {
+	v := p.get(name)
+	if v == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, p.invalid(name)
+	}
+
+	return uint(n), nil
+}
+
+// eventDate は YYYY-MM-DD(ローカル時刻の0時)か、JSON と同じ RFC3339 を受け付ける。
+func (p *recordImportCSVRow) eventDate() (time.Time, error) {
+	v := p.get("event_date")
+
+	if t, err := time.ParseInLocation(DateLayout, v, time.Local); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, p.invalid("event_date")
+}
+
+func (p *recordImportCSVRow) record() (*dto.RecordImportRecordRequest, error) {
+	eventDate, err := p.eventDate()
+	if err != nil {
+		return nil, err
+	}
+
+	officialEventId, err := p.uint("official_event_id")
+	if err != nil {
+		return nil, err
+	}
+
+	regulationId, err := p.uint("regulation_id")
+	if err != nil {
+		return nil, err
+	}
+
+	privateFlg, err := p.bool("private_flg")
+	if err != nil {
+		return nil, err
+	}
+
+	ignoreStatsFlg, err := p.bool("ignore_stats_flg")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RecordImportRecordRequest{
+		RecordRequest: dto.RecordRequest{
+			OfficialEventId:   officialEventId,
+			TonamelEventId:    p.get("tonamel_event_id"),
+			FriendId:          p.get("friend_id"),
+			UnofficialEventId: p.get("unofficial_event_id"),
+			DeckId:            p.get("deck_id"),
+			DeckCodeId:        p.get("deck_code_id"),
+			EventDate:         eventDate,
+			PrivateFlg:        privateFlg,
+			IgnoreStatsFlg:    ignoreStatsFlg,
+			RegulationId:      regulationId,
+			TCGMeisterURL:     p.get("tcg_meister_url"),
+			Memo:              p.get("memo"),
+		},
+	}, nil
+}
+
+func (p *recordImportCSVRow) match() (*dto.MatchRequest, error) {
+	flgs := map[string]bool{}
+	for _, name := range []string{
+		"bo3_flg", "group_match_flg", "qualifying_round_flg", "final_tournament_flg",
+		"default_victory_flg", "default_defeat_flg", "victory_flg", "draw_flg",
+		"group_match_victory_flg",
+	} {
+		b, err := p.bool(name)
+		if err != nil {
+			return nil, err
+		}
+		flgs[name] = b
+	}
+
+	var games []*dto.GameRequest
+	for n := 1; n <= recordImportMaxGames; n++ {
+		prefix := fmt.Sprintf("game%d_", n)
+		if p.get(prefix+"winning_flg") == "" {
+			continue
+		}
+
+		game, err := p.game(prefix)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, game)
+	}
+
+	return &dto.MatchRequest{
+		BO3Flg:               flgs["bo3_flg"],
+		GroupMatchFlg:        flgs["group_match_flg"],
+		QualifyingRoundFlg:   flgs["qualifying_round_flg"],
+		FinalTournamentFlg:   flgs["final_tournament_flg"],
+		DefaultVictoryFlg:    flgs["default_victory_flg"],
+		DefaultDefeatFlg:     flgs["default_defeat_flg"],
+		VictoryFlg:           flgs["victory_flg"],
+		DrawFlg:              flgs["draw_flg"],
+		GroupMatchVictoryFlg: flgs["group_match_victory_flg"],
+		OpponentsDeckInfo:    p.get("opponents_deck_info"),
+		Memo:                 p.get("match_memo"),
+		Games:                games,
+	}, nil
+}
+
+func (p *recordImportCSVRow) game(prefix string) (*dto.GameRequest, error) {
+	goFirst, err := p.bool(prefix + "go_first")
+	if err != nil {
+		return nil, err
+	}
+
+	winningFlg, err := p.bool(prefix + "winning_flg")
+	if err != nil {
+		return nil, err
+	}
+
+	yourPrizeCards, err := p.uint(prefix + "your_prize_cards")
+	if err != nil {
+		return nil, err
+	}
+
+	opponentsPrizeCards, err := p.uint(prefix + "opponents_prize_cards")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GameRequest{
+		GoFirst:             goFirst,
+		WinningFlg:          winningFlg,
+		YourPrizeCards:      yourPrizeCards,
+		OpponentsPrizeCards: opponentsPrizeCards,
+		Memo:                p.get(prefix + "memo"),
+	}, nil
+}
@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+func runRecordImportMiddleware(t *testing.T, target string, contentType string, body string) (*httptest.ResponseRecorder, *gin.Context) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+
+	// Middlewareのテストのためpathは何でもよい
+	req, err := http.NewRequest("POST", target, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+
+	ginContext.Request = req
+
+	middleware := RecordImportMiddleware()
+	middleware(ginContext)
+
+	return w, ginContext
+}
+
+func TestRecordImportMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	eventDate := time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local)
+
+	t.Run("正常系_JSONの記録と対戦結果を受け付ける", func(t *testing.T) {
+		b, err := json.Marshal(dto.RecordImportRequest{
+			Records: []*dto.RecordImportRecordRequest{
+				{
+					RecordRequest: dto.RecordRequest{OfficialEventId: 1, EventDate: eventDate},
+					Matches: []*dto.MatchRequest{
+						{VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: true}}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		w, ginContext := runRecordImportMiddleware(t, "/?dry_run=true", "application/json", string(b))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.True(t, helper.GetDryRun(ginContext))
+		req := helper.GetRecordImportRequest(ginContext)
+		require.Len(t, req.Records, 1)
+		require.Len(t, req.Records[0].Matches, 1)
+	})
+
+	t.Run("正常系_CSVはrecord_noごとに記録をまとめる", func(t *testing.T) {
+		body := "\ufeffrecord_no,event_date,friend_id,victory_flg,opponents_deck_info,game1_go_first,game1_winning_flg,game2_winning_flg\n" +
+			"1,2026-06-07,friend,true,サーナイトex,true,true,\n" +
+			"1,2026-06-07,friend,false,リザードンex,false,false,\n" +
+			"2,2026-06-14,friend,,,,,\n"
+
+		w, ginContext := runRecordImportMiddleware(t, "/", "text/csv; charset=utf-8", body)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.False(t, helper.GetDryRun(ginContext))
+
+		req := helper.GetRecordImportRequest(ginContext)
+		require.Len(t, req.Records, 2)
+		require.Equal(t, eventDate, req.Records[0].EventDate)
+		require.Len(t, req.Records[0].Matches, 2)
+		require.Equal(t, "サーナイトex", req.Records[0].Matches[0].OpponentsDeckInfo)
+		require.Len(t, req.Records[0].Matches[0].Games, 1)
+		require.True(t, req.Records[0].Matches[0].Games[0].GoFirst)
+		// victory_flg が空の行は記録だけの行
+		require.Empty(t, req.Records[1].Matches)
+	})
+
+	t.Run("異常系_CSVに知らない列があれば400を返す", func(t *testing.T) {
+		body := "record_no,event_date,friend,victory_flg\n1,2026-06-07,friend,true\n"
+
+		w, _ := runRecordImportMiddleware(t, "/", "text/csv", body)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unknown csv column")
+	})
+
+	t.Run("異常系_CSVの値を解釈できなければ行番号付きで400を返す", func(t *testing.T) {
+		body := "record_no,event_date,friend_id,victory_flg\n1,2026-06-07,friend,true\n2,2026-06-14,friend,maybe\n"
+
+		w, _ := runRecordImportMiddleware(t, "/", "text/csv", body)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "line 3: invalid victory_flg")
+	})
+
+	t.Run("異常系_不正な記録があればどの記録かを含めて400を返す", func(t *testing.T) {
+		body := "record_no,event_date,friend_id,official_event_id\n1,2026-06-07,friend,\n2,2026-06-14,friend,1\n"
+
+		w, _ := runRecordImportMiddleware(t, "/", "text/csv", body)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid record: record_no 2 (line 3)")
+	})
+
+	t.Run("異常系_対戦結果にrecord_idを指定していれば400を返す", func(t *testing.T) {
+		b, err := json.Marshal(dto.RecordImportRequest{
+			Records: []*dto.RecordImportRecordRequest{
+				{
+					RecordRequest: dto.RecordRequest{FriendId: "friend", EventDate: eventDate},
+					Matches: []*dto.MatchRequest{
+						{RecordId: "01JMPK4VF04QX714CG4PHYJ88K", VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: true}}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		w, _ := runRecordImportMiddleware(t, "/", "application/json", string(b))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid match: records[0].matches[0]")
+	})
+
+	t.Run("異常系_記録が0件なら400を返す", func(t *testing.T) {
+		w, _ := runRecordImportMiddleware(t, "/", "application/json", `{"records":[]}`)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系_記録数が上限を超えれば400を返す", func(t *testing.T) {
+		records := make([]*dto.RecordImportRecordRequest, 0, MaxRecordImportCount+1)
+		for i := 0; i <= MaxRecordImportCount; i++ {
+			records = append(records, &dto.RecordImportRecordRequest{
+				RecordRequest: dto.RecordRequest{FriendId: "friend", EventDate: eventDate},
+			})
+		}
+		b, err := json.Marshal(dto.RecordImportRequest{Records: records})
+		require.NoError(t, err)
+
+		w, _ := runRecordImportMiddleware(t, "/", "application/json", string(b))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "too many records")
+	})
+
+	t.Run("異常系_dry_runが真偽値でなければ400を返す", func(t *testing.T) {
+		w, _ := runRecordImportMiddleware(t, "/?dry_run=yes", "application/json", `{"records":[]}`)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
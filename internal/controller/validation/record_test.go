@@ -648,6 +648,102 @@ func test_RecordCreateMiddleware(t *testing.T) {
 			require.Equal(t, expected, helper.GetRecordCreateRequest(ginContext))
 		})
 	}
+
+	t.Run("正常系_対戦結果を埋め込んだリクエストを受理する", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+
+		expected := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+			},
+			Matches: []*dto.MatchRequest{
+				{
+					VictoryFlg: true,
+					Games:      []*dto.GameRequest{{GoFirst: true, WinningFlg: true}},
+					TagIds:     []string{"01JQ7T1V3T0Y2R3X9M5F8K6W4Z"},
+				},
+			},
+		}
+
+		dataBytes, err := json.Marshal(expected)
+		require.NoError(t, err)
+
+		// Middlewareのテストのためpathは何でもよい
+		req, err := http.NewRequest("POST", "/", strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+
+		ginContext.Request = req
+
+		middleware := RecordCreateMiddleware()
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, expected, helper.GetRecordCreateRequest(ginContext))
+	})
+
+	for name, match := range map[string]*dto.MatchRequest{
+		"record_idを指定した": {RecordId: "01JMPK4VF04QX714CG4PHYJ88K", VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: true}}},
+		"勝敗とゲーム結果が矛盾する":  {VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: false}}},
+		"空の": nil,
+	} {
+		t.Run("異常系_"+name+"対戦結果を埋め込むと400を返す", func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(w)
+
+			data := dto.RecordCreateRequest{
+				RecordRequest: dto.RecordRequest{
+					OfficialEventId: 10000,
+				},
+				Matches: []*dto.MatchRequest{match},
+			}
+
+			dataBytes, err := json.Marshal(data)
+			require.NoError(t, err)
+
+			// Middlewareのテストのためpathは何でもよい
+			req, err := http.NewRequest("POST", "/", strings.NewReader(string(dataBytes)))
+			require.NoError(t, err)
+
+			ginContext.Request = req
+
+			middleware := RecordCreateMiddleware()
+			middleware(ginContext)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	t.Run("異常系_上限を超える対戦結果を埋め込むと400を返す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+
+		matches := make([]*dto.MatchRequest, 0, MaxEmbeddedMatchCount+1)
+		for range MaxEmbeddedMatchCount + 1 {
+			matches = append(matches, &dto.MatchRequest{VictoryFlg: true, Games: []*dto.GameRequest{{WinningFlg: true}}})
+		}
+
+		data := dto.RecordCreateRequest{
+			RecordRequest: dto.RecordRequest{
+				OfficialEventId: 10000,
+			},
+			Matches: matches,
+		}
+
+		dataBytes, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		// Middlewareのテストのためpathは何でもよい
+		req, err := http.NewRequest("POST", "/", strings.NewReader(string(dataBytes)))
+		require.NoError(t, err)
+
+		ginContext.Request = req
+
+		middleware := RecordCreateMiddleware()
+		middleware(ginContext)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func test_RecordUpdateMiddleware(t *testing.T) {
@@ -67,7 +67,7 @@ func TagUpdateMiddleware() gin.HandlerFunc {
 	}
 }
 
-// validateTagIds はデッキ/デッキコードのリクエストに埋め込まれた tag_ids を検証する。
+// validateTagIds はデッキ/デッキコード/記録/対戦結果のリクエストに埋め込まれた tag_ids を検証する。
 // 個々のIDの実在・所有権チェックは usecase 層(所有者で絞り込み)に任せ、ここでは
 // 件数と各IDの長さ(ULID=26文字)だけを確認して極端な値を弾く。
 func validateTagIds(tagIds []string) bool {
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+func TonamelMatchImportMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.TonamelMatchImportRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		// Tonamel のプレイヤー名と突き合わせるため、前後の空白だけ取り除く
+		req.PlayerName = strings.TrimSpace(req.PlayerName)
+
+		if req.PlayerName == "" || exceedsLength(req.PlayerName, 64) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetTonamelMatchImportRequest(ctx, req)
+	}
+}
@@ -1,11 +1,14 @@
 package validation
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
 	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
 func UnofficialEventCreateMiddleware() gin.HandlerFunc {
@@ -53,3 +56,28 @@ func UnofficialEventUpdateMiddleware() gin.HandlerFunc {
 		helper.SetUnofficialEventUpdateRequest(ctx, req)
 	}
 }
+
+func UnofficialEventJoinMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UnofficialEventJoinRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		// 参加コードは口頭で伝えることもあるため、小文字や前後の空白は受け付けて揃える
+		req.JoinCode = strings.ToUpper(strings.TrimSpace(req.JoinCode))
+
+		if len(req.JoinCode) != entity.JoinCodeLength || req.RecordId == "" {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		if exceedsLength(req.RecordId, 32) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetUnofficialEventJoinRequest(ctx, req)
+	}
+}
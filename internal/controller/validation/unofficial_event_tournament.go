@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func UnofficialEventTournamentCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UnofficialEventTournamentCreateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetUnofficialEventTournamentCreateRequest(ctx, req)
+	}
+}
+
+func UnofficialEventParticipantCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UnofficialEventParticipantCreateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		req.GuestName = strings.TrimSpace(req.GuestName)
+
+		// 登録ユーザかゲストのどちらか一方
+		if (req.UserId == "") == (req.GuestName == "") {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		if exceedsLength(req.UserId, 32) || exceedsLength(req.GuestName, entity.MaxTournamentGuestNameLength) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetUnofficialEventParticipantCreateRequest(ctx, req)
+	}
+}
+
+func UnofficialEventPairingGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		round, err := helper.ParseQueryRound(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetRound(ctx, round)
+	}
+}
+
+func UnofficialEventPairingUpdateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UnofficialEventPairingUpdateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		// 大会の形式に合うかは usecase 側で確かめる。
+		if req.Player1Wins < 0 || req.Player2Wins < 0 {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetUnofficialEventPairingUpdateRequest(ctx, req)
+	}
+}
+
+func UnofficialEventTopCutCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UnofficialEventTopCutCreateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if !entity.IsValidTopCutSize(req.Size) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetUnofficialEventTopCutCreateRequest(ctx, req)
+	}
+}
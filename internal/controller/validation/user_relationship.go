@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/dto"
+	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+)
+
+// isValidTargetUserId は申請・ブロックの相手として受け付けるIDかを返す。
+// 自分自身は相手にできない。ユーザIDは users.id と同じく32文字まで。
+func isValidTargetUserId(targetUserId string, uid string) bool {
+	return targetUserId != "" && !exceedsLength(targetUserId, 32) && targetUserId != uid
+}
+
+func UserRelationshipGetMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		limit, err := helper.ParseQueryLimit(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		offset, err := helper.ParseQueryOffset(ctx)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		helper.SetLimit(ctx, limit)
+		helper.SetOffset(ctx, offset)
+	}
+}
+
+func FollowRequestCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.FollowRequestCreateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if !isValidTargetUserId(req.TargetUserId, helper.GetUID(ctx)) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetFollowRequestCreateRequest(ctx, req)
+	}
+}
+
+func UserBlockCreateMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := dto.UserBlockCreateRequest{}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apierror.ErrBadRequest.JSON(ctx, err)
+			return
+		}
+
+		if !isValidTargetUserId(req.TargetUserId, helper.GetUID(ctx)) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+
+		helper.SetUserBlockCreateRequest(ctx, req)
+	}
+}
@@ -32,5 +32,13 @@ func UserStatGetMiddleware() gin.HandlerFunc {
 
 		// レギュレーション区分(スタンダード/エクストラ/殿堂)での絞り込み
 		helper.SetRegulationId(ctx, helper.ParseQueryRegulationId(ctx))
+
+		// 記録に付いたタグでの絞り込み
+		recordTagId := helper.GetQueryRecordTagId(ctx)
+		if exceedsLength(recordTagId, 26) {
+			apierror.ErrBadRequest.JSON(ctx)
+			return
+		}
+		helper.SetRecordTagId(ctx, recordTagId)
 	}
 }
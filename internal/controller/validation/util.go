@@ -32,6 +32,9 @@ const (
 
 	MaxDeckNameLength = 32 // decks.name VARCHAR(32)
 	MaxDeckCodeLength = 21 // deck_codes.code VARCHAR(21)
+	// MaxDeckListLength はテキスト形式のデッキリストの上限。保存はせず解析のみに使うため、
+	// 60枚のデッキに見出しや収録弾・コメントを付けても収まる緩い値にしている。
+	MaxDeckListLength = 10000
 
 	MaxTagNameLength = 32 // tags.name VARCHAR(32)
 	// MaxTagsPerEntity は1つのデッキ/デッキコードに付与できるタグ数の上限。
@@ -55,4 +55,70 @@ var (
 	// 1つも含まれていない場合に返す。未知のカテゴリが混ざっているだけでは返さない
 	// (既知のぶんだけ記録する)。HTTP では 400 Bad Request に対応する。
 	ErrNoKnownActivityCategory = errors.New("no known activity category")
+
+	// ErrInvalidDeckList はテキスト形式のデッキリストを解釈できない場合に返す。
+	// 例: 枚数の無い行、マスタ(cards)に無いカード名、区分を判定できないカード。
+	// どの行が原因かを示すため、行番号付きでラップして返す。HTTP では 400 Bad Request に対応する。
+	ErrInvalidDeckList = errors.New("invalid deck list")
+
+	// ErrNotReady は非同期に作る結果(エクスポートのアーカイブ等)がまだ完成しておらず、
+	// 返せない場合に返す。HTTP では 409 Conflict に対応する。
+	ErrNotReady = errors.New("not ready")
+
+	// ErrParentDeleted は復元しようとした対戦結果・デッキコードの親(記録・デッキ)が
+	// 削除されたままで、単独では復元できない場合に返す。HTTP では 409 Conflict に対応する。
+	ErrParentDeleted = errors.New("parent is deleted")
+
+	// ErrPreconditionFailed は更新・削除しようとしたリソースが、クライアントが最後に
+	// 取得した版(If-Match で指定した ETag)から既に変更されている場合に返す。
+	// HTTP では 412 Precondition Failed に対応する。
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// ErrInvalidAttachment はアップロードされたファイルを写真として扱えない場合に返す。
+	// 例: JPEG/PNG 以外の形式、壊れた画像、大きすぎる画素数。
+	// HTTP では 415 Unsupported Media Type に対応する。
+	ErrInvalidAttachment = errors.New("invalid attachment")
+
+	// ErrTooManyAttachments は記録・対戦結果に添付できる写真の上限
+	// (entity.MaxAttachmentsPerOwner)に達している場合に返す。HTTP では 409 Conflict に対応する。
+	ErrTooManyAttachments = errors.New("too many attachments")
+
+	// ErrNoMatchOpponent は確認を依頼しようとした対戦結果に、対戦相手の登録ユーザ
+	// (opponents_user_id、無ければ記録の friend_id)が無い・自分自身・存在しない場合に返す。
+	// HTTP では 422 Unprocessable Entity に対応する。
+	ErrNoMatchOpponent = errors.New("no registered opponent")
+
+	// ErrMatchConfirmationClosed は既に回答済みの確認依頼に回答しようとした場合や、
+	// 回答待ち・承認済みの対戦結果にもう一度確認を依頼しようとした場合に返す。
+	// HTTP では 409 Conflict に対応する。
+	ErrMatchConfirmationClosed = errors.New("match confirmation is closed")
+
+	// ErrUserRelationshipExists はフレンド申請しようとした相手と、既にフレンドか申請中の場合に返す。
+	// HTTP では 409 Conflict に対応する。
+	ErrUserRelationshipExists = errors.New("user relationship already exists")
+
+	// ErrUserBlocked はフレンド申請や対戦結果の確認依頼・回答の相手との間に、どちらかからのブロックがある場合に返す。
+	// HTTP では 403 Forbidden に対応する。
+	ErrUserBlocked = errors.New("user is blocked")
+
+	// ErrTournamentRoundInProgress は大会の今の回戦に結果の入力されていない組み合わせが残っていて、
+	// 次の回戦を組めない場合に返す。HTTP では 409 Conflict に対応する。
+	ErrTournamentRoundInProgress = errors.New("tournament round is in progress")
+
+	// ErrTournamentClosed は大会の進み具合からもうできない操作の場合に返す。
+	// 例: トップカット開始後の参加登録、前の回戦の結果の修正、決勝の後の次の回戦。
+	// HTTP では 409 Conflict に対応する。
+	ErrTournamentClosed = errors.New("tournament is closed")
+
+	// ErrNotEnoughParticipants は大会の組み合わせ・トップカットを組むのに参加者が足りない場合に返す。
+	// HTTP では 422 Unprocessable Entity に対応する。
+	ErrNotEnoughParticipants = errors.New("not enough participants")
+
+	// ErrTooManyParticipants は大会の参加者が上限(entity.MaxUnofficialEventParticipants)に
+	// 達している場合に返す。HTTP では 409 Conflict に対応する。
+	ErrTooManyParticipants = errors.New("too many participants")
+
+	// ErrTonamelPlayerNotFound は Tonamel の大会の組み合わせに、指定したプレイヤー名の
+	// 終了済みの試合が見つからない場合に返す。HTTP では 422 Unprocessable Entity に対応する。
+	ErrTonamelPlayerNotFound = errors.New("tonamel player not found")
 )
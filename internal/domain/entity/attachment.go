@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"time"
+)
+
+// AttachmentOwnerType は写真を添付する先(記録・対戦結果)。
+type AttachmentOwnerType string
+
+const (
+	AttachmentOwnerTypeRecord AttachmentOwnerType = "record"
+	AttachmentOwnerTypeMatch  AttachmentOwnerType = "match"
+)
+
+const (
+	// MaxAttachmentBytes はアップロードできる写真1枚の上限。スマートフォンのカメラで
+	// 撮った写真(数MB)がそのまま収まる大きさにしている。
+	MaxAttachmentBytes = 10 << 20
+
+	// MaxAttachmentsPerOwner は記録・対戦結果1件に添付できる写真の上限。
+	// 組み合わせ表・スコアシート・盤面を数枚ずつ撮っても足りる緩い値にしている。
+	MaxAttachmentsPerOwner = 10
+)
+
+// Attachment は記録・対戦結果に添付した写真(組み合わせ表・スコアシート・相手の盤面など)。
+// RecordId と MatchId はどちらか一方だけを持つ。本体とサムネイルはオブジェクトストレージに
+// 非公開で置き、APIを通して記録・対戦結果を見られる人にだけ返す。
+type Attachment struct {
+	ID        string
+	CreatedAt time.Time
+	UserId    string
+	RecordId  string
+	MatchId   string
+	// ContentType・Size・Width・Height は保存した本体(EXIFを除いて再エンコードしたもの)の値で、
+	// アップロードされたファイルの値ではない。
+	ContentType string
+	Size        int
+	Width       int
+	Height      int
+}
+
+func NewAttachment(
+	id string,
+	createdAt time.Time,
+	userId string,
+	ownerType AttachmentOwnerType,
+	ownerId string,
+) *Attachment {
+	attachment := &Attachment{
+		ID:        id,
+		CreatedAt: createdAt,
+		UserId:    userId,
+	}
+
+	switch ownerType {
+	case AttachmentOwnerTypeRecord:
+		attachment.RecordId = ownerId
+	case AttachmentOwnerTypeMatch:
+		attachment.MatchId = ownerId
+	}
+
+	return attachment
+}
+
+// OwnerType は添付先の種類を返す。
+func (e *Attachment) OwnerType() AttachmentOwnerType {
+	if e.MatchId != "" {
+		return AttachmentOwnerTypeMatch
+	}
+
+	return AttachmentOwnerTypeRecord
+}
+
+// OwnerId は添付先(記録・対戦結果)のIDを返す。
+func (e *Attachment) OwnerId() string {
+	if e.MatchId != "" {
+		return e.MatchId
+	}
+
+	return e.RecordId
+}
+
+// BelongsTo は ownerType/ownerId に添付した写真かを返す。
+func (e *Attachment) BelongsTo(ownerType AttachmentOwnerType, ownerId string) bool {
+	return e.OwnerType() == ownerType && e.OwnerId() == ownerId
+}
+
+// ObjectKey は本体を置くオブジェクトストレージのキー。
+func (e *Attachment) ObjectKey() string {
+	return "attachments/" + e.UserId + "/" + e.ID + ".jpg"
+}
+
+// ThumbnailKey はサムネイルを置くオブジェクトストレージのキー。
+func (e *Attachment) ThumbnailKey() string {
+	return "attachments/" + e.UserId + "/" + e.ID + "_thumbnail.jpg"
+}
+
+// AttachmentImage はアップロードされた写真を、保存できる形に加工した結果。
+type AttachmentImage struct {
+	ContentType string
+	Size        int
+	Width       int
+	Height      int
+}
+
+// SetImage は保存した本体の形式・大きさを反映する。
+func (e *Attachment) SetImage(image *AttachmentImage) {
+	e.ContentType = image.ContentType
+	e.Size = image.Size
+	e.Width = image.Width
+	e.Height = image.Height
+}
@@ -0,0 +1,28 @@
+package entity
+
+// Card はカードのマスタ(cards)のうち、デッキリストの取り込みに使う項目。
+type Card struct {
+	ID             uint
+	CardName       string
+	CollectionCode string
+	RegulationMark string
+	// Category は同名カードが公式サイトのデッキ結果ページで分類されていた区分。
+	// cards には公式サイトの区分が無いため、まだどのデッキコードにも現れていないカードは空文字。
+	Category DeckCardCategory
+}
+
+func NewCard(
+	id uint,
+	cardName string,
+	collectionCode string,
+	regulationMark string,
+	category DeckCardCategory,
+) *Card {
+	return &Card{
+		ID:             id,
+		CardName:       cardName,
+		CollectionCode: collectionCode,
+		RegulationMark: regulationMark,
+		Category:       category,
+	}
+}
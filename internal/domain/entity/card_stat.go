@@ -0,0 +1,180 @@
+package entity
+
+import "sort"
+
+// CardStatDeckCodeAggregate はカード単位の成績を算出するための、デッキコード(バージョン)ごとの
+// 集計済みの素の値。点数付け(勝率などの算出)は CalculateUserCardStat が行う。
+type CardStatDeckCodeAggregate struct {
+	DeckCodeId   string
+	MatchCount   uint
+	WinCount     uint
+	DrawCount    uint
+	GameCount    uint
+	GoFirstCount uint
+	GoFirstWins  uint
+	GoSecondWins uint
+}
+
+func NewCardStatDeckCodeAggregate(
+	deckCodeId string,
+	matchCount uint,
+	winCount uint,
+	drawCount uint,
+	gameCount uint,
+	goFirstCount uint,
+	goFirstWins uint,
+	goSecondWins uint,
+) *CardStatDeckCodeAggregate {
+	return &CardStatDeckCodeAggregate{
+		DeckCodeId:   deckCodeId,
+		MatchCount:   matchCount,
+		WinCount:     winCount,
+		DrawCount:    drawCount,
+		GameCount:    gameCount,
+		GoFirstCount: goFirstCount,
+		GoFirstWins:  goFirstWins,
+		GoSecondWins: goSecondWins,
+	}
+}
+
+// CardStatSplit は「そのカードを採用していた対戦」または「採用していなかった対戦」の成績。
+// 勝率は対戦(match)単位、先攻/後攻はゲーム(game)単位で数える(deck_usage_stat と同じ)。
+type CardStatSplit struct {
+	MatchCount      uint
+	WinCount        uint
+	LoseCount       uint
+	DrawCount       uint
+	WinRate         float64
+	GoFirstCount    uint
+	GoFirstWins     uint
+	GoFirstWinRate  float64
+	GoSecondCount   uint
+	GoSecondWins    uint
+	GoSecondWinRate float64
+}
+
+// CardStat は1種類のカードについての、採用時/不採用時の成績。
+type CardStat struct {
+	CardId   uint
+	CardName string
+	Category DeckCardCategory
+	With     *CardStatSplit
+	Without  *CardStatSplit
+}
+
+// UserCardStat はユーザーのカード単位の成績。
+type UserCardStat struct {
+	UserId string
+	// MatchCount はカード構成が分かっている対戦の総数(With + Without の母数)。
+	// デッキコード未登録の対戦や、カード構成を読み取れなかったデッキコードの対戦は含めない。
+	MatchCount uint
+	Cards      []*CardStat
+}
+
+// CalculateUserCardStat はデッキコードごとの集計値とカード構成から、カード単位の成績を算出する。
+// cards には aggregates のデッキコードのカード構成を渡す。カード構成が1件も無いデッキコードは
+// 採用/不採用を判定できないため母数から除く。
+func CalculateUserCardStat(
+	userId string,
+	aggregates []*CardStatDeckCodeAggregate,
+	cards []*DeckCodeCard,
+) *UserCardStat {
+	cardsByDeckCodeId := map[string][]*DeckCodeCard{}
+	for _, card := range cards {
+		cardsByDeckCodeId[card.DeckCodeId] = append(cardsByDeckCodeId[card.DeckCodeId], card)
+	}
+
+	total := &CardStatDeckCodeAggregate{}
+	withByCardId := map[uint]*CardStatDeckCodeAggregate{}
+	cardStats := []*CardStat{}
+
+	for _, aggregate := range aggregates {
+		deckCards, ok := cardsByDeckCodeId[aggregate.DeckCodeId]
+		if !ok {
+			continue
+		}
+
+		addCardStatDeckCodeAggregate(total, aggregate)
+
+		for _, card := range deckCards {
+			with, ok := withByCardId[card.CardId]
+			if !ok {
+				with = &CardStatDeckCodeAggregate{}
+				withByCardId[card.CardId] = with
+				cardStats = append(cardStats, &CardStat{
+					CardId:   card.CardId,
+					CardName: card.CardName,
+					Category: card.Category,
+				})
+			}
+			addCardStatDeckCodeAggregate(with, aggregate)
+		}
+	}
+
+	for _, cardStat := range cardStats {
+		with := withByCardId[cardStat.CardId]
+		without := &CardStatDeckCodeAggregate{
+			MatchCount:   total.MatchCount - with.MatchCount,
+			WinCount:     total.WinCount - with.WinCount,
+			DrawCount:    total.DrawCount - with.DrawCount,
+			GameCount:    total.GameCount - with.GameCount,
+			GoFirstCount: total.GoFirstCount - with.GoFirstCount,
+			GoFirstWins:  total.GoFirstWins - with.GoFirstWins,
+			GoSecondWins: total.GoSecondWins - with.GoSecondWins,
+		}
+
+		cardStat.With = newCardStatSplit(with)
+		cardStat.Without = newCardStatSplit(without)
+	}
+
+	// 採用していた対戦数の多い順。同数ならカードIDの昇順で並びを安定させる。
+	sort.SliceStable(cardStats, func(i, j int) bool {
+		if cardStats[i].With.MatchCount != cardStats[j].With.MatchCount {
+			return cardStats[i].With.MatchCount > cardStats[j].With.MatchCount
+		}
+		return cardStats[i].CardId < cardStats[j].CardId
+	})
+
+	return &UserCardStat{
+		UserId:     userId,
+		MatchCount: total.MatchCount,
+		Cards:      cardStats,
+	}
+}
+
+func addCardStatDeckCodeAggregate(dst *CardStatDeckCodeAggregate, src *CardStatDeckCodeAggregate) {
+	dst.MatchCount += src.MatchCount
+	dst.WinCount += src.WinCount
+	dst.DrawCount += src.DrawCount
+	dst.GameCount += src.GameCount
+	dst.GoFirstCount += src.GoFirstCount
+	dst.GoFirstWins += src.GoFirstWins
+	dst.GoSecondWins += src.GoSecondWins
+}
+
+func newCardStatSplit(a *CardStatDeckCodeAggregate) *CardStatSplit {
+	// 引き分けは負けに数えない。勝率も分母から除外する(勝ち/(勝ち+負け))。
+	losses := a.MatchCount - a.WinCount - a.DrawCount
+	goSecondCount := a.GameCount - a.GoFirstCount
+
+	return &CardStatSplit{
+		MatchCount:      a.MatchCount,
+		WinCount:        a.WinCount,
+		LoseCount:       losses,
+		DrawCount:       a.DrawCount,
+		WinRate:         cardStatRate(a.WinCount, a.WinCount+losses),
+		GoFirstCount:    a.GoFirstCount,
+		GoFirstWins:     a.GoFirstWins,
+		GoFirstWinRate:  cardStatRate(a.GoFirstWins, a.GoFirstCount),
+		GoSecondCount:   goSecondCount,
+		GoSecondWins:    a.GoSecondWins,
+		GoSecondWinRate: cardStatRate(a.GoSecondWins, goSecondCount),
+	}
+}
+
+func cardStatRate(numerator uint, denominator uint) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return float64(numerator) / float64(denominator)
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateUserCardStat(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+
+	aggregates := []*CardStatDeckCodeAggregate{
+		// v1: 4戦2勝1分、ゲーム5(先攻3で2勝、後攻2で1勝)
+		NewCardStatDeckCodeAggregate("v1", 4, 2, 1, 5, 3, 2, 1),
+		// v2: 2戦2勝、ゲーム2(先攻1で1勝、後攻1で1勝)
+		NewCardStatDeckCodeAggregate("v2", 2, 2, 0, 2, 1, 1, 1),
+		// カード構成不明のバージョンは母数から除く
+		NewCardStatDeckCodeAggregate("unknown", 10, 0, 0, 10, 5, 0, 0),
+	}
+	cards := []*DeckCodeCard{
+		NewDeckCodeCard("v1", 1, "ピカチュウex", DeckCardCategoryPokemon, 2),
+		NewDeckCodeCard("v1", 2, "ネストボール", DeckCardCategoryGoods, 4),
+		NewDeckCodeCard("v2", 1, "ピカチュウex", DeckCardCategoryPokemon, 3),
+		NewDeckCodeCard("v2", 3, "基本雷エネルギー", DeckCardCategoryEnergy, 8),
+	}
+
+	t.Run("正常系_採用時と不採用時の成績に分ける", func(t *testing.T) {
+		stat := CalculateUserCardStat(uid, aggregates, cards)
+
+		require.Equal(t, uint(6), stat.MatchCount)
+		require.Len(t, stat.Cards, 3)
+
+		// 全バージョンに入っているカードは不採用時の母数が0
+		pikachu := stat.Cards[0]
+		require.Equal(t, uint(1), pikachu.CardId)
+		require.Equal(t, uint(6), pikachu.With.MatchCount)
+		require.Equal(t, uint(0), pikachu.Without.MatchCount)
+		require.Equal(t, 0.0, pikachu.Without.WinRate)
+
+		nest := stat.Cards[1]
+		require.Equal(t, uint(2), nest.CardId)
+		require.Equal(t, uint(4), nest.With.MatchCount)
+		require.Equal(t, uint(1), nest.With.LoseCount)
+		// 引き分けは勝率の分母から除く: 2/(2+1)
+		require.InDelta(t, 2.0/3, nest.With.WinRate, 0.0001)
+		require.InDelta(t, 2.0/3, nest.With.GoFirstWinRate, 0.0001)
+		require.Equal(t, uint(2), nest.With.GoSecondCount)
+		require.Equal(t, uint(2), nest.Without.MatchCount)
+		require.Equal(t, 1.0, nest.Without.WinRate)
+
+		energy := stat.Cards[2]
+		require.Equal(t, uint(3), energy.CardId)
+		require.Equal(t, uint(4), energy.Without.MatchCount)
+	})
+
+	t.Run("正常系_対戦が無ければ空で返す", func(t *testing.T) {
+		stat := CalculateUserCardStat(uid, nil, nil)
+
+		require.Equal(t, uint(0), stat.MatchCount)
+		require.Empty(t, stat.Cards)
+	})
+}
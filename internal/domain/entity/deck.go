@@ -16,6 +16,8 @@ type Deck struct {
 	// 付与の書き込みは Deck.Save ではなく TagRepository.ReplaceDeckTags が担うため、
 	// コンストラクタ引数には含めない(アーカイブ等の別経路で誤って空へ上書きしないため)。
 	Tags []*Tag
+	// UpdatedAt は If-Match の照合に使う版(最終更新日時)。FindById で詰める。
+	UpdatedAt time.Time
 }
 
 func NewDeck(
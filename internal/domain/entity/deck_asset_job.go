@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"time"
+)
+
+// DeckAssetJobKind はデッキコードのリソースのうち、ジョブが取得・配置するもの。
+type DeckAssetJobKind string
+
+const (
+	// DeckAssetJobKindResultHTML は公式サイトのデッキ結果HTML。配置後にカード構成を解析して保存する。
+	DeckAssetJobKindResultHTML DeckAssetJobKind = "result_html"
+	// DeckAssetJobKindImage はデッキ画像。
+	DeckAssetJobKindImage DeckAssetJobKind = "image"
+)
+
+// DeckAssetJobKinds はデッキコード1件の登録で作るジョブ。結果HTMLを先に処理する
+// (結果HTMLの取得でデッキコードの正しさが分かるため)。
+var DeckAssetJobKinds = []DeckAssetJobKind{
+	DeckAssetJobKindResultHTML,
+	DeckAssetJobKindImage,
+}
+
+type DeckAssetJobStatus string
+
+const (
+	DeckAssetJobStatusPending   DeckAssetJobStatus = "pending"
+	DeckAssetJobStatusRunning   DeckAssetJobStatus = "running"
+	DeckAssetJobStatusSucceeded DeckAssetJobStatus = "succeeded"
+	// DeckAssetJobStatusDead はリトライを諦めたジョブ(デッドレター)。自動では再実行しない。
+	DeckAssetJobStatusDead DeckAssetJobStatus = "dead"
+	// DeckAssetJobStatusInvalid はデッキコードが正しくないと分かったジョブ。
+	// 何度取得しても結果が変わらないため、デッドレターと同じく自動では再実行しない。
+	DeckAssetJobStatusInvalid DeckAssetJobStatus = "invalid"
+)
+
+const (
+	// DeckAssetJobMaxAttempts を超えて失敗したジョブはデッドレターにする。
+	// 待ち時間は 30秒 から倍々で伸び、8回目の失敗までに合計でおよそ1時間待つ。
+	DeckAssetJobMaxAttempts = 8
+
+	deckAssetJobBaseBackoff = 30 * time.Second
+	deckAssetJobMaxBackoff  = 30 * time.Minute
+)
+
+// DeckAssetJob はデッキコードのリソース(結果HTML・画像)を公式サイトから取得して
+// オブジェクトストレージへ配置するジョブ。デッキコードの登録と同じトランザクションで作り、
+// ワーカーが非同期に処理する。
+type DeckAssetJob struct {
+	ID         string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeckCodeId string
+	Code       string
+	Kind       DeckAssetJobKind
+	Status     DeckAssetJobStatus
+	// Attempts は実行を始めた回数(取り出した時点で数える)。
+	Attempts  uint
+	NextRunAt time.Time
+	LastError string
+}
+
+func NewDeckAssetJob(
+	id string,
+	createdAt time.Time,
+	deckCodeId string,
+	code string,
+	kind DeckAssetJobKind,
+) *DeckAssetJob {
+	return &DeckAssetJob{
+		ID:         id,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+		DeckCodeId: deckCodeId,
+		Code:       code,
+		Kind:       kind,
+		Status:     DeckAssetJobStatusPending,
+		Attempts:   0,
+		NextRunAt:  createdAt,
+	}
+}
+
+// Succeed はジョブを完了にする。
+func (j *DeckAssetJob) Succeed(now time.Time) {
+	j.Status = DeckAssetJobStatusSucceeded
+	j.UpdatedAt = now
+	j.LastError = ""
+}
+
+// Fail は失敗を記録し、次の実行を指数バックオフで予約する。
+// 試行回数が上限に達した場合はデッドレターにする。
+func (j *DeckAssetJob) Fail(now time.Time, err error) {
+	j.UpdatedAt = now
+	j.LastError = err.Error()
+
+	if j.Attempts >= DeckAssetJobMaxAttempts {
+		j.Status = DeckAssetJobStatusDead
+		return
+	}
+
+	j.Status = DeckAssetJobStatusPending
+	j.NextRunAt = now.Add(DeckAssetJobBackoff(j.Attempts))
+}
+
+// Invalidate はデッキコードが正しくないことを記録し、以降は実行しない。
+func (j *DeckAssetJob) Invalidate(now time.Time, err error) {
+	j.Status = DeckAssetJobStatusInvalid
+	j.UpdatedAt = now
+	j.LastError = err.Error()
+}
+
+// DeckAssetJobBackoff は attempts 回目の失敗の後、次に実行するまでの待ち時間。
+func DeckAssetJobBackoff(attempts uint) time.Duration {
+	backoff := deckAssetJobBaseBackoff
+	for i := uint(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= deckAssetJobMaxBackoff {
+			return deckAssetJobMaxBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeckAssetJobBackoff(t *testing.T) {
+	t.Run("正常系_30秒から倍々で伸び30分で頭打ちになる", func(t *testing.T) {
+		require.Equal(t, 30*time.Second, DeckAssetJobBackoff(1))
+		require.Equal(t, 60*time.Second, DeckAssetJobBackoff(2))
+		require.Equal(t, 4*time.Minute, DeckAssetJobBackoff(4))
+		require.Equal(t, 30*time.Minute, DeckAssetJobBackoff(7))
+		require.Equal(t, 30*time.Minute, DeckAssetJobBackoff(100))
+	})
+}
+
+func TestDeckAssetJobFail(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("正常系_上限未満の失敗はバックオフして再実行を待つ", func(t *testing.T) {
+		job := NewDeckAssetJob("id", now, "deck_code_id", "code", DeckAssetJobKindImage)
+		job.Attempts = 2
+
+		job.Fail(now, errors.New("timeout"))
+
+		require.Equal(t, DeckAssetJobStatusPending, job.Status)
+		require.Equal(t, now.Add(60*time.Second), job.NextRunAt)
+		require.Equal(t, "timeout", job.LastError)
+	})
+
+	t.Run("正常系_上限に達した失敗はデッドレターにする", func(t *testing.T) {
+		job := NewDeckAssetJob("id", now, "deck_code_id", "code", DeckAssetJobKindImage)
+		job.Attempts = DeckAssetJobMaxAttempts
+
+		job.Fail(now, errors.New("timeout"))
+
+		require.Equal(t, DeckAssetJobStatusDead, job.Status)
+	})
+}
+
+func TestDeckAssetJobInvalidate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("正常系_試行回数に関わらず不正として以降は実行しない", func(t *testing.T) {
+		job := NewDeckAssetJob("id", now, "deck_code_id", "code", DeckAssetJobKindResultHTML)
+		job.Attempts = 1
+
+		job.Invalidate(now, errors.New("invalid"))
+
+		require.Equal(t, DeckAssetJobStatusInvalid, job.Status)
+		require.Equal(t, "invalid", job.LastError)
+	})
+}
@@ -12,6 +12,8 @@ type DeckCode struct {
 	Memo           string
 	// Tags は付与されたタグ。読み込み時にインフラ層が詰める。詳細は Deck.Tags と同様。
 	Tags []*Tag
+	// Cards はカード構成。カード一覧を返す経路(DeckCode.FindByIdWithCards)でだけ詰める。
+	Cards []*DeckCodeCard
 }
 
 func NewDeckCode(
@@ -0,0 +1,68 @@
+package entity
+
+// DeckCardCategory は公式サイトのデッキ結果ページでの、カードの区分。
+// 値は deck_code_cards.category にそのまま保存するため、一度使った値は変更しない。
+type DeckCardCategory string
+
+const (
+	DeckCardCategoryPokemon          DeckCardCategory = "pokemon"
+	DeckCardCategoryGoods            DeckCardCategory = "goods"
+	DeckCardCategoryTool             DeckCardCategory = "tool"
+	DeckCardCategoryTechnicalMachine DeckCardCategory = "technical_machine"
+	DeckCardCategorySupporter        DeckCardCategory = "supporter"
+	DeckCardCategoryStadium          DeckCardCategory = "stadium"
+	DeckCardCategoryAceSpec          DeckCardCategory = "ace_spec"
+	DeckCardCategoryEnergy           DeckCardCategory = "energy"
+)
+
+// DeckCardGroup はデッキリストの大分類(ポケモン/トレーナーズ/エネルギー)。
+type DeckCardGroup string
+
+const (
+	DeckCardGroupPokemon DeckCardGroup = "pokemon"
+	DeckCardGroupTrainer DeckCardGroup = "trainer"
+	DeckCardGroupEnergy  DeckCardGroup = "energy"
+)
+
+// Group は区分が属する大分類を返す。グッズ・どうぐ・サポート等はすべてトレーナーズ。
+func (c DeckCardCategory) Group() DeckCardGroup {
+	switch c {
+	case DeckCardCategoryPokemon:
+		return DeckCardGroupPokemon
+	case DeckCardCategoryEnergy:
+		return DeckCardGroupEnergy
+	default:
+		return DeckCardGroupTrainer
+	}
+}
+
+// DeckCodeCard はデッキコード(バージョン)に含まれる1種類のカードとその枚数。
+type DeckCodeCard struct {
+	DeckCodeId string
+	// CardId は公式サイトのカードID(cards.id と同じ採番)。
+	CardId uint
+	// CardName は cards.card_name。cards は外部で同期しているため、
+	// 新弾直後などでマスタに未反映のカードは空文字になる。
+	CardName string
+	// RegulationMark は cards.regulation_mark。基本エネルギーなどマークの無いカードや、
+	// マスタに未反映のカードは空文字になる。
+	RegulationMark string
+	Category       DeckCardCategory
+	Count          uint
+}
+
+func NewDeckCodeCard(
+	deckCodeId string,
+	cardId uint,
+	cardName string,
+	category DeckCardCategory,
+	count uint,
+) *DeckCodeCard {
+	return &DeckCodeCard{
+		DeckCodeId: deckCodeId,
+		CardId:     cardId,
+		CardName:   cardName,
+		Category:   category,
+		Count:      count,
+	}
+}
@@ -0,0 +1,110 @@
+package entity
+
+// DeckCodeStat はデッキコード(バージョン)ごとの対戦成績。
+// 集計対象外(ignore_stats_flg)の記録は含めない(deck_usage_stat と同じ方針)。
+type DeckCodeStat struct {
+	DeckCodeId  string
+	RecordCount uint
+	MatchCount  uint
+	WinCount    uint
+	LoseCount   uint
+	DrawCount   uint
+	WinRate     float64
+}
+
+func NewDeckCodeStat(
+	deckCodeId string,
+	recordCount uint,
+	matchCount uint,
+	winCount uint,
+	loseCount uint,
+	drawCount uint,
+	winRate float64,
+) *DeckCodeStat {
+	return &DeckCodeStat{
+		DeckCodeId:  deckCodeId,
+		RecordCount: recordCount,
+		MatchCount:  matchCount,
+		WinCount:    winCount,
+		LoseCount:   loseCount,
+		DrawCount:   drawCount,
+		WinRate:     winRate,
+	}
+}
+
+// DeckCodeCardChange は2つのバージョン間での1種類のカードの枚数の変化。
+// 追加されたカードは FromCount が 0、抜けたカードは ToCount が 0 になる。
+type DeckCodeCardChange struct {
+	CardId    uint
+	CardName  string
+	Category  DeckCardCategory
+	FromCount uint
+	ToCount   uint
+}
+
+// DeckCodeDiff は同じデッキの2つのデッキコード(バージョン)の差分。
+type DeckCodeDiff struct {
+	From     *DeckCode
+	To       *DeckCode
+	FromStat *DeckCodeStat
+	ToStat   *DeckCodeStat
+	// Added / Removed / Changed は To 側の表示順(抜けたカードは From 側の表示順)に並ぶ。
+	Added   []*DeckCodeCardChange
+	Removed []*DeckCodeCardChange
+	Changed []*DeckCodeCardChange
+}
+
+// NewDeckCodeDiff は from / to のカード構成(Cards)を突き合わせて差分を作る。
+// カードはカードIDで同一視する(同名でも収録弾が違えば別カードとして扱う)。
+func NewDeckCodeDiff(
+	from *DeckCode,
+	to *DeckCode,
+	fromStat *DeckCodeStat,
+	toStat *DeckCodeStat,
+) *DeckCodeDiff {
+	diff := &DeckCodeDiff{
+		From:     from,
+		To:       to,
+		FromStat: fromStat,
+		ToStat:   toStat,
+		Added:    []*DeckCodeCardChange{},
+		Removed:  []*DeckCodeCardChange{},
+		Changed:  []*DeckCodeCardChange{},
+	}
+
+	fromCards := make(map[uint]*DeckCodeCard, len(from.Cards))
+	for _, card := range from.Cards {
+		fromCards[card.CardId] = card
+	}
+
+	toCards := make(map[uint]*DeckCodeCard, len(to.Cards))
+	for _, card := range to.Cards {
+		toCards[card.CardId] = card
+
+		before, ok := fromCards[card.CardId]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, newDeckCodeCardChange(card, 0, card.Count))
+		case before.Count != card.Count:
+			diff.Changed = append(diff.Changed, newDeckCodeCardChange(card, before.Count, card.Count))
+		}
+	}
+
+	for _, card := range from.Cards {
+		if _, ok := toCards[card.CardId]; !ok {
+			diff.Removed = append(diff.Removed, newDeckCodeCardChange(card, card.Count, 0))
+		}
+	}
+
+	return diff
+}
+
+func newDeckCodeCardChange(card *DeckCodeCard, fromCount uint, toCount uint) *DeckCodeCardChange {
+	return &DeckCodeCardChange{
+		CardId:    card.CardId,
+		CardName:  card.CardName,
+		Category:  card.Category,
+		FromCount: fromCount,
+		ToCount:   toCount,
+	}
+}
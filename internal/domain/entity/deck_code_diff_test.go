@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeckCodeDiff(t *testing.T) {
+	from := &DeckCode{ID: "from", Cards: []*DeckCodeCard{
+		NewDeckCodeCard("from", 1, "ピカチュウex", DeckCardCategoryPokemon, 2),
+		NewDeckCodeCard("from", 2, "ネストボール", DeckCardCategoryGoods, 4),
+		NewDeckCodeCard("from", 3, "ナンジャモ", DeckCardCategorySupporter, 4),
+	}}
+	to := &DeckCode{ID: "to", Cards: []*DeckCodeCard{
+		NewDeckCodeCard("to", 1, "ピカチュウex", DeckCardCategoryPokemon, 3),
+		NewDeckCodeCard("to", 3, "ナンジャモ", DeckCardCategorySupporter, 4),
+		NewDeckCodeCard("to", 4, "基本雷エネルギー", DeckCardCategoryEnergy, 8),
+	}}
+
+	t.Run("正常系_追加・削除・枚数変更に振り分ける", func(t *testing.T) {
+		diff := NewDeckCodeDiff(from, to, nil, nil)
+
+		require.Len(t, diff.Added, 1)
+		require.Equal(t, uint(4), diff.Added[0].CardId)
+		require.Equal(t, uint(0), diff.Added[0].FromCount)
+		require.Equal(t, uint(8), diff.Added[0].ToCount)
+
+		require.Len(t, diff.Removed, 1)
+		require.Equal(t, uint(2), diff.Removed[0].CardId)
+		require.Equal(t, uint(4), diff.Removed[0].FromCount)
+		require.Equal(t, uint(0), diff.Removed[0].ToCount)
+
+		require.Len(t, diff.Changed, 1)
+		require.Equal(t, uint(1), diff.Changed[0].CardId)
+		require.Equal(t, uint(2), diff.Changed[0].FromCount)
+		require.Equal(t, uint(3), diff.Changed[0].ToCount)
+	})
+
+	t.Run("正常系_同じカード構成なら差分は空", func(t *testing.T) {
+		diff := NewDeckCodeDiff(from, from, nil, nil)
+
+		require.Empty(t, diff.Added)
+		require.Empty(t, diff.Removed)
+		require.Empty(t, diff.Changed)
+	})
+}
@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"time"
+)
+
+// BannedCard はレギュレーションごとの禁止カード(banned_cards)。
+// 公式の禁止カードは収録弾に関係なく同名カードすべてが対象のため、カード名で持つ。
+type BannedCard struct {
+	RegulationId uint
+	CardName     string
+	FromDate     time.Time
+}
+
+func NewBannedCard(
+	regulationId uint,
+	cardName string,
+	fromDate time.Time,
+) *BannedCard {
+	return &BannedCard{
+		RegulationId: regulationId,
+		CardName:     cardName,
+		FromDate:     fromDate,
+	}
+}
+
+// DeckIllegalReason はカードが使用できない理由。API でそのまま返すため、一度使った値は変更しない。
+type DeckIllegalReason string
+
+const (
+	// DeckIllegalReasonRotated はスタンダードで使えないレギュレーションマーク(落ちたマーク)のカード。
+	DeckIllegalReasonRotated DeckIllegalReason = "rotated"
+	// DeckIllegalReasonBanned は禁止カード(殿堂の禁止カードなど)。
+	DeckIllegalReasonBanned DeckIllegalReason = "banned"
+)
+
+// DeckIllegalCard は使用できないカード1種類とその理由。
+type DeckIllegalCard struct {
+	CardId         uint
+	CardName       string
+	RegulationMark string
+	Count          uint
+	Reason         DeckIllegalReason
+}
+
+// DeckLegality はデッキコードが、ある日付・レギュレーションで使用できるかの判定結果。
+type DeckLegality struct {
+	DeckCodeId   string
+	RegulationId uint
+	Date         time.Time
+	// StandardRegulation は判定に使ったスタンダードのレギュレーション。
+	// スタンダード以外の判定や、日付に該当する期間が登録されていない場合は nil。
+	StandardRegulation *StandardRegulation
+	IllegalCards       []*DeckIllegalCard
+	// UncheckedCardIds はマスタ(cards)に未反映で判定できなかったカード。
+	// 新弾直後に起こりうるもので、使用できないとは限らないため IllegalCards には含めない。
+	UncheckedCardIds []uint
+}
+
+// IsLegal は使用できないカードが1枚も見つからなかったかを返す。
+// 判定できなかったカード(UncheckedCardIds)があっても true になりうる。
+func (l *DeckLegality) IsLegal() bool {
+	return len(l.IllegalCards) == 0
+}
+
+// NewDeckLegality は cards(デッキコードのカード構成)を判定する。
+//
+//   - スタンダードでは standardRegulation のマークに含まれないカードを rotated とする。
+//     マークの無いカードは基本エネルギーのみ使用できる(それ以外は旧いカードのため使えない)。
+//   - いずれのレギュレーションでも bannedCards に名前があるカードは banned とする。
+//     rotated にも当てはまる場合は、より根本的な理由である rotated を優先する。
+//
+// エクストラ・殿堂のマークの範囲はシリーズ単位で判定が複雑なため、禁止カードのみを見る。
+// その他(独自ルール)はカードの範囲が決まっていないため何も判定しない。
+func NewDeckLegality(
+	deckCodeId string,
+	regulationId uint,
+	date time.Time,
+	cards []*DeckCodeCard,
+	standardRegulation *StandardRegulation,
+	bannedCards []*BannedCard,
+) *DeckLegality {
+	legality := &DeckLegality{
+		DeckCodeId:         deckCodeId,
+		RegulationId:       regulationId,
+		Date:               date,
+		StandardRegulation: standardRegulation,
+		IllegalCards:       []*DeckIllegalCard{},
+		UncheckedCardIds:   []uint{},
+	}
+
+	if regulationId == RegulationIdOther {
+		return legality
+	}
+
+	marks := map[string]struct{}{}
+	if regulationId == RegulationIdStandard && standardRegulation != nil {
+		for _, mark := range standardRegulation.MarkList() {
+			marks[mark] = struct{}{}
+		}
+	}
+
+	banned := make(map[string]struct{}, len(bannedCards))
+	for _, bannedCard := range bannedCards {
+		banned[bannedCard.CardName] = struct{}{}
+	}
+
+	for _, card := range cards {
+		if card.CardName == "" {
+			legality.UncheckedCardIds = append(legality.UncheckedCardIds, card.CardId)
+			continue
+		}
+
+		var reason DeckIllegalReason
+		if regulationId == RegulationIdStandard && standardRegulation != nil && !isStandardLegalMark(card, marks) {
+			reason = DeckIllegalReasonRotated
+		} else if _, ok := banned[card.CardName]; ok {
+			reason = DeckIllegalReasonBanned
+		}
+
+		if reason == "" {
+			continue
+		}
+
+		legality.IllegalCards = append(legality.IllegalCards, &DeckIllegalCard{
+			CardId:         card.CardId,
+			CardName:       card.CardName,
+			RegulationMark: card.RegulationMark,
+			Count:          card.Count,
+			Reason:         reason,
+		})
+	}
+
+	return legality
+}
+
+func isStandardLegalMark(card *DeckCodeCard, marks map[string]struct{}) bool {
+	if card.RegulationMark == "" {
+		// 特殊エネルギーはマーク付きで収録されるため、マーク無しのエネルギーは基本エネルギー
+		return card.Category == DeckCardCategoryEnergy
+	}
+
+	_, ok := marks[card.RegulationMark]
+	return ok
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeckLegality(t *testing.T) {
+	date := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+	standardRegulation := NewStandardRegulation(
+		"HIJ", "H・I・J", time.Date(2026, 1, 23, 0, 0, 0, 0, time.Local), time.Date(2027, 1, 21, 0, 0, 0, 0, time.Local),
+	)
+
+	newCard := func(cardId uint, cardName string, mark string, category DeckCardCategory, count uint) *DeckCodeCard {
+		card := NewDeckCodeCard("deckcode", cardId, cardName, category, count)
+		card.RegulationMark = mark
+		return card
+	}
+
+	cards := []*DeckCodeCard{
+		newCard(1, "ピカチュウex", "I", DeckCardCategoryPokemon, 2),
+		newCard(2, "クイックボール", "G", DeckCardCategoryGoods, 4),
+		newCard(3, "ナンジャモ", "H", DeckCardCategorySupporter, 4),
+		newCard(4, "基本雷エネルギー", "", DeckCardCategoryEnergy, 8),
+		newCard(5, "", "", DeckCardCategoryGoods, 1),
+	}
+	bannedCards := []*BannedCard{
+		NewBannedCard(RegulationIdStandard, "ナンジャモ", date),
+		NewBannedCard(RegulationIdStandard, "クイックボール", date),
+	}
+
+	t.Run("正常系_スタンダードはマーク落ちと禁止カードを返す", func(t *testing.T) {
+		legality := NewDeckLegality("deckcode", RegulationIdStandard, date, cards, standardRegulation, bannedCards)
+
+		require.False(t, legality.IsLegal())
+		require.Len(t, legality.IllegalCards, 2)
+		// マーク落ちかつ禁止のカードはマーク落ちを優先する
+		require.Equal(t, uint(2), legality.IllegalCards[0].CardId)
+		require.Equal(t, DeckIllegalReasonRotated, legality.IllegalCards[0].Reason)
+		require.Equal(t, uint(3), legality.IllegalCards[1].CardId)
+		require.Equal(t, DeckIllegalReasonBanned, legality.IllegalCards[1].Reason)
+		// マスタ未反映のカードは判定できないものとして分けて返す
+		require.Equal(t, []uint{5}, legality.UncheckedCardIds)
+	})
+
+	t.Run("正常系_マークの無いエネルギー以外のカードはマーク落ちとして扱う", func(t *testing.T) {
+		legality := NewDeckLegality("deckcode", RegulationIdStandard, date, []*DeckCodeCard{
+			newCard(6, "ポケモンいれかえ", "", DeckCardCategoryGoods, 1),
+		}, standardRegulation, nil)
+
+		require.Len(t, legality.IllegalCards, 1)
+		require.Equal(t, DeckIllegalReasonRotated, legality.IllegalCards[0].Reason)
+	})
+
+	t.Run("正常系_殿堂はマークを見ず禁止カードのみ判定する", func(t *testing.T) {
+		legality := NewDeckLegality("deckcode", RegulationIdHallOfFame, date, cards, nil, []*BannedCard{
+			NewBannedCard(RegulationIdHallOfFame, "クイックボール", date),
+		})
+
+		require.Len(t, legality.IllegalCards, 1)
+		require.Equal(t, uint(2), legality.IllegalCards[0].CardId)
+		require.Equal(t, DeckIllegalReasonBanned, legality.IllegalCards[0].Reason)
+	})
+
+	t.Run("正常系_スタンダードの期間が分からなければ禁止カードのみ判定する", func(t *testing.T) {
+		legality := NewDeckLegality("deckcode", RegulationIdStandard, date, cards, nil, nil)
+
+		require.True(t, legality.IsLegal())
+	})
+
+	t.Run("正常系_その他は判定しない", func(t *testing.T) {
+		legality := NewDeckLegality("deckcode", RegulationIdOther, date, cards, standardRegulation, bannedCards)
+
+		require.True(t, legality.IsLegal())
+		require.Empty(t, legality.UncheckedCardIds)
+	})
+}
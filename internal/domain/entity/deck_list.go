@@ -0,0 +1,218 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Label はテキスト形式のデッキリストでの区分の見出し(公式サイトの表記)。
+func (c DeckCardCategory) Label() string {
+	switch c {
+	case DeckCardCategoryPokemon:
+		return "ポケモン"
+	case DeckCardCategoryGoods:
+		return "グッズ"
+	case DeckCardCategoryTool:
+		return "ポケモンのどうぐ"
+	case DeckCardCategoryTechnicalMachine:
+		return "わざマシン"
+	case DeckCardCategorySupporter:
+		return "サポート"
+	case DeckCardCategoryStadium:
+		return "スタジアム"
+	case DeckCardCategoryAceSpec:
+		return "ACE SPEC"
+	case DeckCardCategoryEnergy:
+		return "エネルギー"
+	default:
+		return string(c)
+	}
+}
+
+// deckListHeaders はテキスト形式のデッキリストで区分の見出しとして受け付ける表記。
+// 値が空文字の見出し(トレーナーズ)は区分を特定しないため、カードごとに判定する。
+var deckListHeaders = map[string]DeckCardCategory{
+	"ポケモン":     DeckCardCategoryPokemon,
+	"pokemon":  DeckCardCategoryPokemon,
+	"pokémon":  DeckCardCategoryPokemon,
+	"グッズ":      DeckCardCategoryGoods,
+	"ポケモンのどうぐ": DeckCardCategoryTool,
+	"どうぐ":      DeckCardCategoryTool,
+	"わざマシン":    DeckCardCategoryTechnicalMachine,
+	"サポート":     DeckCardCategorySupporter,
+	"スタジアム":    DeckCardCategoryStadium,
+	"ace spec": DeckCardCategoryAceSpec,
+	"エネルギー":    DeckCardCategoryEnergy,
+	"energy":   DeckCardCategoryEnergy,
+	"トレーナーズ":   "",
+	"trainer":  "",
+}
+
+var (
+	// deckListHeaderCountPattern は見出しの後ろに付く枚数("(12)" / "（12）" / ": 12")。
+	deckListHeaderCountPattern = regexp.MustCompile(`\s*([:：]\s*\d*|[(（]\s*\d+\s*[)）])\s*$`)
+	// deckListCountPattern は行頭の枚数("4" / "4x" / "4枚")。
+	deckListCountPattern = regexp.MustCompile(`^(\d+)(x|×|枚)?$`)
+	// deckListCollectionCodePattern / deckListCollectionNumberPattern は行末の収録弾と番号("SV4a 123")。
+	deckListCollectionCodePattern   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*$`)
+	deckListCollectionNumberPattern = regexp.MustCompile(`^\d+(/\d+)?$`)
+)
+
+// DeckListLine はテキスト形式のデッキリストの1行(カード1種類)。
+type DeckListLine struct {
+	// LineNumber は入力の何行目か(1始まり)。エラーの報告に使う。
+	LineNumber int
+	Count      uint
+	CardName   string
+	// CollectionCode / CollectionNumber は行末に書かれた収録弾と番号。省略された場合は空文字。
+	CollectionCode   string
+	CollectionNumber string
+	// Category は直前の見出しが示す区分。見出しが無い・トレーナーズの場合は空文字。
+	Category DeckCardCategory
+}
+
+// DeckListError はテキスト形式のデッキリストを解釈できなかった行とその理由。
+type DeckListError struct {
+	LineNumber int
+	Message    string
+}
+
+func (e *DeckListError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.LineNumber, e.Message)
+}
+
+// ParseDeckList はテキスト形式のデッキリストを行ごとに読み取る。
+//
+// カードの行は「枚数 カード名 [収録弾 番号]」(例:"4 ナンジャモ SV4a 123")。
+// 区分の見出し(例:"グッズ (12)")の行があれば、以降のカードの区分として使う。
+// 空行と "#" / "//" で始まる行は読み飛ばす。
+func ParseDeckList(text string) ([]*DeckListLine, error) {
+	lines := []*DeckListLine{}
+	var category DeckCardCategory
+
+	for idx, raw := range strings.Split(text, "\n") {
+		lineNumber := idx + 1
+		line := strings.TrimSpace(raw)
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		match := deckListCountPattern.FindStringSubmatch(fields[0])
+		if match == nil {
+			header := strings.ToLower(deckListHeaderCountPattern.ReplaceAllString(line, ""))
+			c, ok := deckListHeaders[header]
+			if !ok {
+				return nil, &DeckListError{LineNumber: lineNumber, Message: "count is missing"}
+			}
+			category = c
+			continue
+		}
+
+		count, err := strconv.Atoi(match[1])
+		if err != nil || count <= 0 {
+			return nil, &DeckListError{LineNumber: lineNumber, Message: "count must be positive"}
+		}
+
+		rest := fields[1:]
+		var collectionCode, collectionNumber string
+		if len(rest) >= 3 &&
+			deckListCollectionCodePattern.MatchString(rest[len(rest)-2]) &&
+			deckListCollectionNumberPattern.MatchString(rest[len(rest)-1]) {
+			collectionCode = rest[len(rest)-2]
+			collectionNumber = rest[len(rest)-1]
+			rest = rest[:len(rest)-2]
+		}
+
+		if len(rest) == 0 {
+			return nil, &DeckListError{LineNumber: lineNumber, Message: "card name is missing"}
+		}
+
+		lines = append(lines, &DeckListLine{
+			LineNumber:       lineNumber,
+			Count:            uint(count),
+			CardName:         strings.Join(rest, " "),
+			CollectionCode:   collectionCode,
+			CollectionNumber: collectionNumber,
+			Category:         category,
+		})
+	}
+
+	if len(lines) == 0 {
+		return nil, &DeckListError{LineNumber: 0, Message: "no cards"}
+	}
+
+	return lines, nil
+}
+
+// NewDeckCodeCardsFromDeckList はデッキリストの各行をマスタのカード(cards。カード名で引いたもの)と
+// 突き合わせ、デッキコードのカード構成にする。
+//
+//   - 同名カードが複数ある場合は、行に書かれた収録弾のものを優先し、次にカードIDの小さいものを選ぶ
+//     (番号は cards に無いため使わない。同じ収録弾の同名カードは性能が同じ)。
+//   - 区分は見出し → 同名カードの既知の区分 → 名前が「〜エネルギー」ならエネルギー の順に決める。
+//   - 同じカードに解決された行は枚数を合算する。
+func NewDeckCodeCardsFromDeckList(
+	deckCodeId string,
+	lines []*DeckListLine,
+	cards []*Card,
+) ([]*DeckCodeCard, error) {
+	cardsByName := map[string][]*Card{}
+	for _, card := range cards {
+		cardsByName[card.CardName] = append(cardsByName[card.CardName], card)
+	}
+
+	ret := []*DeckCodeCard{}
+	byCardId := map[uint]*DeckCodeCard{}
+
+	for _, line := range lines {
+		card := selectDeckListCard(cardsByName[line.CardName], line.CollectionCode)
+		if card == nil {
+			return nil, &DeckListError{LineNumber: line.LineNumber, Message: fmt.Sprintf("unknown card %q", line.CardName)}
+		}
+
+		category := line.Category
+		if category == "" {
+			category = card.Category
+		}
+		if category == "" && strings.HasSuffix(card.CardName, "エネルギー") {
+			category = DeckCardCategoryEnergy
+		}
+		if category == "" {
+			return nil, &DeckListError{LineNumber: line.LineNumber, Message: fmt.Sprintf("category of %q is unknown; add a section header", line.CardName)}
+		}
+
+		if existing, ok := byCardId[card.ID]; ok {
+			existing.Count += line.Count
+			continue
+		}
+
+		deckCodeCard := NewDeckCodeCard(deckCodeId, card.ID, card.CardName, category, line.Count)
+		deckCodeCard.RegulationMark = card.RegulationMark
+		byCardId[card.ID] = deckCodeCard
+		ret = append(ret, deckCodeCard)
+	}
+
+	return ret, nil
+}
+
+func selectDeckListCard(candidates []*Card, collectionCode string) *Card {
+	var selected *Card
+	selectedMatches := false
+
+	for _, card := range candidates {
+		matches := collectionCode != "" && strings.EqualFold(card.CollectionCode, collectionCode)
+
+		switch {
+		case selected == nil,
+			matches && !selectedMatches,
+			matches == selectedMatches && card.ID < selected.ID:
+			selected, selectedMatches = card, matches
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeckList(t *testing.T) {
+	t.Run("正常系_見出しと収録弾付きの行を読み取る", func(t *testing.T) {
+		lines, err := ParseDeckList(`# メモ
+ポケモン (6)
+4 ピカチュウex SV8 033
+2 ミュウex
+
+グッズ: 4
+4x ネストボール SV1S 068
+トレーナーズ
+4 ナンジャモ SV4a 123/190
+エネルギー
+8 基本雷エネルギー`)
+
+		require.NoError(t, err)
+		require.Len(t, lines, 5)
+
+		require.Equal(t, 3, lines[0].LineNumber)
+		require.Equal(t, uint(4), lines[0].Count)
+		require.Equal(t, "ピカチュウex", lines[0].CardName)
+		require.Equal(t, "SV8", lines[0].CollectionCode)
+		require.Equal(t, "033", lines[0].CollectionNumber)
+		require.Equal(t, DeckCardCategoryPokemon, lines[0].Category)
+
+		require.Empty(t, lines[1].CollectionCode)
+		require.Equal(t, DeckCardCategoryGoods, lines[2].Category)
+		// トレーナーズの見出しは区分を特定しない
+		require.Equal(t, DeckCardCategory(""), lines[3].Category)
+		require.Equal(t, "123/190", lines[3].CollectionNumber)
+		require.Equal(t, DeckCardCategoryEnergy, lines[4].Category)
+	})
+
+	t.Run("正常系_末尾が収録弾と番号でなければカード名の一部として扱う", func(t *testing.T) {
+		lines, err := ParseDeckList("1 ポケモン回収サイクロン")
+
+		require.NoError(t, err)
+		require.Equal(t, "ポケモン回収サイクロン", lines[0].CardName)
+	})
+
+	t.Run("異常系_枚数の無い行は行番号付きのエラーを返す", func(t *testing.T) {
+		_, err := ParseDeckList("4 ピカチュウex\nナンジャモ")
+
+		var deckListErr *DeckListError
+		require.True(t, errors.As(err, &deckListErr))
+		require.Equal(t, 2, deckListErr.LineNumber)
+	})
+
+	t.Run("異常系_枚数が0の行はエラーを返す", func(t *testing.T) {
+		_, err := ParseDeckList("0 ピカチュウex")
+
+		require.Error(t, err)
+	})
+
+	t.Run("異常系_カードが1枚も無ければエラーを返す", func(t *testing.T) {
+		_, err := ParseDeckList("ポケモン\n\n# なし")
+
+		require.Error(t, err)
+	})
+}
+
+func TestNewDeckCodeCardsFromDeckList(t *testing.T) {
+	cards := []*Card{
+		NewCard(10, "ピカチュウex", "SV1", "G", DeckCardCategoryPokemon),
+		NewCard(20, "ピカチュウex", "SV8", "H", DeckCardCategoryPokemon),
+		NewCard(30, "ナンジャモ", "SV4a", "H", ""),
+		NewCard(40, "基本雷エネルギー", "", "", ""),
+	}
+
+	t.Run("正常系_収録弾を優先してカードを選び区分を補う", func(t *testing.T) {
+		lines, err := ParseDeckList("2 ピカチュウex SV8 033\n1 ピカチュウex sv8 033\n8 基本雷エネルギー\nサポート\n4 ナンジャモ")
+		require.NoError(t, err)
+
+		ret, err := NewDeckCodeCardsFromDeckList("deckcode", lines, cards)
+
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		// 同じカードに解決された行は合算する
+		require.Equal(t, uint(20), ret[0].CardId)
+		require.Equal(t, uint(3), ret[0].Count)
+		require.Equal(t, "H", ret[0].RegulationMark)
+		require.Equal(t, DeckCardCategoryEnergy, ret[1].Category)
+		require.Equal(t, DeckCardCategorySupporter, ret[2].Category)
+	})
+
+	t.Run("正常系_収録弾の指定が無ければカードIDの小さいものを選ぶ", func(t *testing.T) {
+		lines, err := ParseDeckList("2 ピカチュウex")
+		require.NoError(t, err)
+
+		ret, err := NewDeckCodeCardsFromDeckList("deckcode", lines, cards)
+
+		require.NoError(t, err)
+		require.Equal(t, uint(10), ret[0].CardId)
+	})
+
+	t.Run("異常系_マスタに無いカードは行番号付きのエラーを返す", func(t *testing.T) {
+		lines, err := ParseDeckList("2 ピカチュウex\n1 未知のカード")
+		require.NoError(t, err)
+
+		_, err = NewDeckCodeCardsFromDeckList("deckcode", lines, cards)
+
+		var deckListErr *DeckListError
+		require.True(t, errors.As(err, &deckListErr))
+		require.Equal(t, 2, deckListErr.LineNumber)
+	})
+
+	t.Run("異常系_区分を判定できないカードはエラーを返す", func(t *testing.T) {
+		lines, err := ParseDeckList("4 ナンジャモ")
+		require.NoError(t, err)
+
+		_, err = NewDeckCodeCardsFromDeckList("deckcode", lines, cards)
+
+		require.Error(t, err)
+	})
+}
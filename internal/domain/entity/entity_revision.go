@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EntityRevisionType string
+
+const (
+	EntityRevisionTypeRecord EntityRevisionType = "record"
+	EntityRevisionTypeMatch  EntityRevisionType = "match"
+)
+
+type EntityRevisionAction string
+
+const (
+	EntityRevisionActionUpdate  EntityRevisionAction = "update"
+	EntityRevisionActionDelete  EntityRevisionAction = "delete"
+	EntityRevisionActionReorder EntityRevisionAction = "reorder"
+)
+
+// EntityRevision は記録・対戦結果の変更履歴の1件(追記のみで更新・削除しない)。
+// 「勝率がなぜ変わったか」を後から説明できるよう、変更前後のスナップショットと
+// 変更した人(ActorUid)・リクエスト(RequestId)を残す。
+type EntityRevision struct {
+	ID         string
+	CreatedAt  time.Time
+	EntityType EntityRevisionType
+	EntityId   string
+	Action     EntityRevisionAction
+	// UserId は変更された記録・対戦結果の持ち主。
+	UserId    string
+	ActorUid  string
+	RequestId string
+	// Before / After は変更前後のエンティティをJSONにしたもの。削除では After は nil。
+	Before []byte
+	After  []byte
+}
+
+// NewEntityRevision は変更前後のエンティティ(before / after)をJSONにして履歴を作る。
+// 削除では after に nil を渡し、After を nil のままにする。
+func NewEntityRevision(
+	id string,
+	createdAt time.Time,
+	entityType EntityRevisionType,
+	entityId string,
+	action EntityRevisionAction,
+	userId string,
+	actorUid string,
+	requestId string,
+	before any,
+	after any,
+) (*EntityRevision, error) {
+	beforeJSON, err := marshalSnapshot(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterJSON, err := marshalSnapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntityRevision{
+		ID:         id,
+		CreatedAt:  createdAt,
+		EntityType: entityType,
+		EntityId:   entityId,
+		Action:     action,
+		UserId:     userId,
+		ActorUid:   actorUid,
+		RequestId:  requestId,
+		Before:     beforeJSON,
+		After:      afterJSON,
+	}, nil
+}
+
+func marshalSnapshot(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(v)
+}
+
+// DecodeSnapshots は Before / After を before / after(エンティティへのポインタ)へ戻す。
+// 戻り値の hasBefore / hasAfter は、それぞれのスナップショットがあったかどうか
+// (削除の After は無い)を表す。
+func (r *EntityRevision) DecodeSnapshots(before any, after any) (hasBefore bool, hasAfter bool, err error) {
+	if len(r.Before) > 0 {
+		if err := json.Unmarshal(r.Before, before); err != nil {
+			return false, false, err
+		}
+		hasBefore = true
+	}
+
+	if len(r.After) > 0 {
+		if err := json.Unmarshal(r.After, after); err != nil {
+			return false, false, err
+		}
+		hasAfter = true
+	}
+
+	return hasBefore, hasAfter, nil
+}
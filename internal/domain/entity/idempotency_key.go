@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+)
+
+// IdempotencyKeyTTL は Idempotency-Key を覚えておく期間。過ぎたキーは同じ値でも
+// 新しいリクエストとして扱う(クライアントのリトライは長くても数時間で止む想定)。
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyKeyInProgressTimeout を過ぎても処理中のままのキーは、処理中にプロセスが落ちた等で
+// 応答を保存できなかったものとみなし、同じキーのリトライを新しいリクエストとして受け付ける。
+// 作成系のリクエストは通常数秒で終わるため、十分に長くとっている。
+const IdempotencyKeyInProgressTimeout = 2 * time.Minute
+
+// IdempotencyKey は作成系のリクエストに付けられた Idempotency-Key と、その応答。
+// キーはユーザごとに一意で、同じキーのリトライには保存した応答をそのまま返す。
+// StatusCode が 0 の間は最初のリクエストを処理中であることを表す。
+type IdempotencyKey struct {
+	UserId string
+	Key    string
+	// RequestHash はリクエスト(メソッド・パス・ボディ)のSHA-256。同じキーで
+	// 別の内容が送られてきたことを見分けるために使う。
+	RequestHash  string
+	StatusCode   int
+	ResponseBody []byte
+	CreatedAt    time.Time
+}
+
+func NewIdempotencyKey(
+	userId string,
+	key string,
+	requestHash string,
+	createdAt time.Time,
+) *IdempotencyKey {
+	return &IdempotencyKey{
+		UserId:      userId,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   createdAt,
+	}
+}
+
+// Completed は最初のリクエストの処理が終わり、応答を保存済みなら true を返す。
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
+
+// IdempotencyKeyExpiredBefore は now 時点で期限切れとなる作成日時の境界を返す。
+// これより前に作られたキーは覚えていないものとして扱う。
+func IdempotencyKeyExpiredBefore(now time.Time) time.Time {
+	return now.Add(-IdempotencyKeyTTL)
+}
+
+// IdempotencyKeyStaleBefore は now 時点で、処理中のまま放置されたとみなす作成日時の境界を返す。
+func IdempotencyKeyStaleBefore(now time.Time) time.Time {
+	return now.Add(-IdempotencyKeyInProgressTimeout)
+}
+
+// Expired は now 時点でキーの保持期間を過ぎていれば true を返す。
+func (k *IdempotencyKey) Expired(now time.Time) bool {
+	return k.CreatedAt.Before(IdempotencyKeyExpiredBefore(now))
+}
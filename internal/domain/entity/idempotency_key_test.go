@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Expired", func(t *testing.T) {
+		t.Run("正常系_保持期間ちょうどまでは期限切れにならない", func(t *testing.T) {
+			key := NewIdempotencyKey("user-1", "key-1", "hash", now.Add(-IdempotencyKeyTTL))
+			require.False(t, key.Expired(now))
+		})
+
+		t.Run("正常系_保持期間を過ぎたら期限切れになる", func(t *testing.T) {
+			key := NewIdempotencyKey("user-1", "key-1", "hash", now.Add(-IdempotencyKeyTTL-time.Second))
+			require.True(t, key.Expired(now))
+		})
+	})
+
+	t.Run("Completed", func(t *testing.T) {
+		t.Run("正常系_応答を保存するまでは処理中として扱う", func(t *testing.T) {
+			key := NewIdempotencyKey("user-1", "key-1", "hash", now)
+			require.False(t, key.Completed())
+
+			key.StatusCode = 201
+			require.True(t, key.Completed())
+		})
+	})
+}
@@ -34,6 +34,9 @@ type Match struct {
 	// Position は record 内での表示順序。Reorder によってのみ更新されるため、
 	// NewMatch のコンストラクタ引数には含めず、必要な箇所で個別に設定する。
 	Position int
+	// UpdatedAt は最終更新日時(ETag の元)。Position と同じく読み込み時に
+	// インフラ層が詰める。
+	UpdatedAt time.Time
 }
 
 // MatchResult は対戦全体の結果(勝ち/負け/引き分け)を表す3値。
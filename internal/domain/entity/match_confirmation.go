@@ -0,0 +1,156 @@
+package entity
+
+import (
+	"time"
+)
+
+type MatchConfirmationStatus string
+
+const (
+	MatchConfirmationStatusPending   MatchConfirmationStatus = "pending"
+	MatchConfirmationStatusConfirmed MatchConfirmationStatus = "confirmed"
+	// MatchConfirmationStatusDisputed は対戦相手が内容に異議を申し立てた依頼。
+	// 依頼した側が対戦結果を直して、もう一度依頼できる。
+	MatchConfirmationStatusDisputed MatchConfirmationStatus = "disputed"
+)
+
+// MaxMatchConfirmationDisputeReasonLength は異議の理由として受け付ける最大文字数。
+const MaxMatchConfirmationDisputeReasonLength = 255
+
+// MatchConfirmation は登録ユーザ同士の対戦結果の確認依頼。
+//
+// MatchId の対戦結果を記録したユーザ(RequesterUserId)が、対戦相手(OpponentUserId)に
+// 結果の確認を依頼する。相手が承認すると、相手の記録に勝敗・先攻後攻・サイドの枚数を
+// 入れ替えた対戦結果を作り、MirroredMatchId で結び付ける。
+type MatchConfirmation struct {
+	ID              string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	MatchId         string
+	RequesterUserId string
+	OpponentUserId  string
+	Status          MatchConfirmationStatus
+	MirroredMatchId string
+	DisputeReason   string
+	RespondedAt     time.Time
+	// Match・MirroredMatch は依頼した側・相手側の対戦結果。読み込み時に usecase が詰める
+	// (削除済み・未承認なら nil)。
+	Match         *Match
+	MirroredMatch *Match
+}
+
+func NewMatchConfirmation(
+	id string,
+	createdAt time.Time,
+	matchId string,
+	requesterUserId string,
+	opponentUserId string,
+) *MatchConfirmation {
+	return &MatchConfirmation{
+		ID:              id,
+		CreatedAt:       createdAt,
+		UpdatedAt:       createdAt,
+		MatchId:         matchId,
+		RequesterUserId: requesterUserId,
+		OpponentUserId:  opponentUserId,
+		Status:          MatchConfirmationStatusPending,
+	}
+}
+
+// IsPending は対戦相手の回答を待っているかを返す。
+func (e *MatchConfirmation) IsPending() bool {
+	return e.Status == MatchConfirmationStatusPending
+}
+
+// IsParticipant は userId が依頼した側・対戦相手のどちらかかを返す。
+func (e *MatchConfirmation) IsParticipant(userId string) bool {
+	return userId != "" && (userId == e.RequesterUserId || userId == e.OpponentUserId)
+}
+
+// Confirm は相手側に作った対戦結果を結び付けて承認にする。
+func (e *MatchConfirmation) Confirm(mirroredMatchId string, now time.Time) {
+	e.Status = MatchConfirmationStatusConfirmed
+	e.MirroredMatchId = mirroredMatchId
+	e.DisputeReason = ""
+	e.RespondedAt = now
+	e.UpdatedAt = now
+}
+
+// Dispute は異議ありにする。
+func (e *MatchConfirmation) Dispute(reason string, now time.Time) {
+	e.Status = MatchConfirmationStatusDisputed
+	e.DisputeReason = reason
+	e.RespondedAt = now
+	e.UpdatedAt = now
+}
+
+// Reopen は異議を申し立てられた依頼を、回答待ちに戻す。直した結果、対戦相手を
+// 付け替えていることもあるため、依頼先も改める。
+func (e *MatchConfirmation) Reopen(opponentUserId string, now time.Time) {
+	e.OpponentUserId = opponentUserId
+	e.Status = MatchConfirmationStatusPending
+	e.RespondedAt = time.Time{}
+	e.UpdatedAt = now
+}
+
+// InSync は承認後の両側の対戦結果が、いまも互いに裏返しの内容になっているかを返す。
+// 承認前や、どちらかが削除されている場合は false。
+func (e *MatchConfirmation) InSync() bool {
+	if e.Status != MatchConfirmationStatusConfirmed || e.Match == nil || e.MirroredMatch == nil {
+		return false
+	}
+
+	return e.Match.IsMirrorOf(e.MirroredMatch)
+}
+
+// IsMirrorOf は other が m を対戦相手の側から記録したもの(勝敗・不戦勝/不戦敗・
+// 各ゲームの先攻後攻と勝敗・サイドの枚数が入れ替わったもの)かを返す。
+// デッキ・メモ・ポケモンのスプライトはそれぞれが自分で記録するため比べない。
+func (m *Match) IsMirrorOf(other *Match) bool {
+	if m.BO3Flg != other.BO3Flg ||
+		m.GroupMatchFlg != other.GroupMatchFlg ||
+		m.QualifyingRoundFlg != other.QualifyingRoundFlg ||
+		m.FinalTournamentFlg != other.FinalTournamentFlg {
+		return false
+	}
+
+	if m.DefaultVictoryFlg != other.DefaultDefeatFlg || m.DefaultDefeatFlg != other.DefaultVictoryFlg {
+		return false
+	}
+
+	if m.Result().Opposite() != other.Result() {
+		return false
+	}
+
+	if m.GroupMatchFlg && !m.BO3Flg && m.GroupMatchVictoryFlg == other.GroupMatchVictoryFlg {
+		return false
+	}
+
+	if len(m.Games) != len(other.Games) {
+		return false
+	}
+
+	for i, game := range m.Games {
+		o := other.Games[i]
+		if game.GoFirst == o.GoFirst ||
+			game.WinningFlg == o.WinningFlg ||
+			game.YourPrizeCards != o.OpponentsPrizeCards ||
+			game.OpponentsPrizeCards != o.YourPrizeCards {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Opposite は対戦相手から見た結果を返す。引き分けは引き分けのまま。
+func (r MatchResult) Opposite() MatchResult {
+	switch r {
+	case MatchResultWin:
+		return MatchResultLose
+	case MatchResultLose:
+		return MatchResultWin
+	default:
+		return r
+	}
+}
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newMatchConfirmationTestMatches() (*Match, *Match) {
+	match := &Match{
+		ID:         "01JTESTMATCH0000000000000A",
+		UserId:     "requester",
+		BO3Flg:     true,
+		VictoryFlg: true,
+		Memo:       "先攻を取れた",
+		Games: []*Game{
+			{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 2},
+			{GoFirst: false, WinningFlg: false, YourPrizeCards: 3, OpponentsPrizeCards: 6},
+			{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 5},
+		},
+	}
+
+	mirrored := &Match{
+		ID:         "01JTESTMATCH0000000000000B",
+		UserId:     "opponent",
+		BO3Flg:     true,
+		VictoryFlg: false,
+		Games: []*Game{
+			{GoFirst: false, WinningFlg: false, YourPrizeCards: 2, OpponentsPrizeCards: 6},
+			{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 3},
+			{GoFirst: false, WinningFlg: false, YourPrizeCards: 5, OpponentsPrizeCards: 6},
+		},
+	}
+
+	return match, mirrored
+}
+
+func TestMatchIsMirrorOf(t *testing.T) {
+	t.Run("正常系_勝敗・先攻後攻・サイドの枚数が入れ替わっていれば true", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+
+		require.True(t, match.IsMirrorOf(mirrored))
+		require.True(t, mirrored.IsMirrorOf(match))
+	})
+
+	t.Run("正常系_メモやデッキの違いは比べない", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		mirrored.Memo = "サイド落ちが痛かった"
+		mirrored.DeckId = "01JTESTDECK00000000000000A"
+
+		require.True(t, match.IsMirrorOf(mirrored))
+	})
+
+	t.Run("正常系_引き分けは両側とも引き分け", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		match.VictoryFlg = false
+		match.DrawFlg = true
+		mirrored.DrawFlg = true
+
+		require.True(t, match.IsMirrorOf(mirrored))
+	})
+
+	t.Run("異常系_勝敗が同じなら false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		mirrored.VictoryFlg = true
+
+		require.False(t, match.IsMirrorOf(mirrored))
+	})
+
+	t.Run("異常系_サイドの枚数が食い違えば false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		mirrored.Games[0].OpponentsPrizeCards = 5
+
+		require.False(t, match.IsMirrorOf(mirrored))
+	})
+
+	t.Run("異常系_ゲーム数が食い違えば false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		mirrored.Games = mirrored.Games[:2]
+
+		require.False(t, match.IsMirrorOf(mirrored))
+	})
+
+	t.Run("異常系_不戦勝が入れ替わっていなければ false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		match.DefaultVictoryFlg = true
+
+		require.False(t, match.IsMirrorOf(mirrored))
+	})
+}
+
+func TestMatchConfirmation(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+
+	t.Run("正常系_承認後に両側が裏返しなら InSync は true", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		confirmation := NewMatchConfirmation("01JTESTCONFIRM000000000000", now, match.ID, "requester", "opponent")
+		confirmation.Confirm(mirrored.ID, now)
+		confirmation.Match = match
+		confirmation.MirroredMatch = mirrored
+
+		require.Equal(t, MatchConfirmationStatusConfirmed, confirmation.Status)
+		require.True(t, confirmation.InSync())
+	})
+
+	t.Run("正常系_承認後に片側を編集すると InSync は false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		confirmation := NewMatchConfirmation("01JTESTCONFIRM000000000000", now, match.ID, "requester", "opponent")
+		confirmation.Confirm(mirrored.ID, now)
+		confirmation.Match = match
+		confirmation.MirroredMatch = mirrored
+		mirrored.Games[2].WinningFlg = true
+
+		require.False(t, confirmation.InSync())
+	})
+
+	t.Run("正常系_承認後に片側が削除されると InSync は false", func(t *testing.T) {
+		match, mirrored := newMatchConfirmationTestMatches()
+		confirmation := NewMatchConfirmation("01JTESTCONFIRM000000000000", now, match.ID, "requester", "opponent")
+		confirmation.Confirm(mirrored.ID, now)
+		confirmation.Match = match
+
+		require.False(t, confirmation.InSync())
+	})
+
+	t.Run("正常系_異議の後に依頼し直すと回答待ちに戻る", func(t *testing.T) {
+		confirmation := NewMatchConfirmation("01JTESTCONFIRM000000000000", now, "match", "requester", "opponent")
+		confirmation.Dispute("2戦目は私の勝ちです", now)
+
+		require.False(t, confirmation.IsPending())
+		require.Equal(t, "2戦目は私の勝ちです", confirmation.DisputeReason)
+
+		confirmation.Reopen("opponent2", now.Add(time.Hour))
+
+		require.True(t, confirmation.IsPending())
+		require.Equal(t, "opponent2", confirmation.OpponentUserId)
+		require.True(t, confirmation.RespondedAt.IsZero())
+	})
+
+	t.Run("正常系_当事者かを返す", func(t *testing.T) {
+		confirmation := NewMatchConfirmation("01JTESTCONFIRM000000000000", now, "match", "requester", "opponent")
+
+		require.True(t, confirmation.IsParticipant("requester"))
+		require.True(t, confirmation.IsParticipant("opponent"))
+		require.False(t, confirmation.IsParticipant("other"))
+		require.False(t, confirmation.IsParticipant(""))
+	})
+}
@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// MemoSearchMaxQueryLength は検索語全体の最大文字数。
+	MemoSearchMaxQueryLength = 100
+	// MemoSearchMaxTerms は空白で区切った検索語の最大数。
+	MemoSearchMaxTerms = 5
+
+	// memoSnippetLength はスニペットの最大文字数(省略記号を除く)。
+	memoSnippetLength = 120
+	// memoSnippetLeading は最初に一致した位置より前に残す文字数。
+	memoSnippetLeading = 30
+
+	memoSnippetEllipsis = "…"
+)
+
+type MemoSearchHitType string
+
+const (
+	MemoSearchHitTypeRecord MemoSearchHitType = "record"
+	MemoSearchHitTypeMatch  MemoSearchHitType = "match"
+	MemoSearchHitTypeGame   MemoSearchHitType = "game"
+)
+
+// MemoSearchHit は記録・対戦結果・対局のメモの検索で一致した1件。
+// 対局のメモは対局単体の画面が無いため、親の対戦結果・記録へ辿れるよう MatchId / RecordId を持つ。
+type MemoSearchHit struct {
+	Type MemoSearchHitType
+	ID   string
+	// RecordId は一致したメモを持つ記録、または対戦結果・対局が属する記録のID。
+	RecordId string
+	// MatchId は対戦結果・対局のメモなら対戦結果のID。記録のメモでは空。
+	MatchId   string
+	EventDate time.Time
+	CreatedAt time.Time
+	Memo      string
+	Snippet   *MemoSnippet
+}
+
+// MemoSnippet はメモのうち検索語の周辺を切り出したもの。
+// Highlights は Text 内で検索語に一致した範囲(文字(rune)単位)で、
+// HTML などへの埋め込み方は表示側に任せる。
+type MemoSnippet struct {
+	Text       string
+	Highlights []*MemoHighlight
+}
+
+type MemoHighlight struct {
+	Offset int
+	Length int
+}
+
+// ParseMemoSearchQuery は検索語を空白(全角空白を含む)で区切る。
+// 同じ語の重複は1つにまとめる。
+func ParseMemoSearchQuery(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+
+	for _, term := range strings.Fields(query) {
+		key := strings.ToLower(term)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// NewMemoSnippet は memo のうち最初に検索語が現れる位置の周辺を切り出し、
+// 切り出した範囲にある全ての検索語の位置を Highlights に入れる。
+// 大文字・小文字は区別せず(検索の ILIKE と同じ)、改行は空白に置き換える。
+func NewMemoSnippet(memo string, terms []string) *MemoSnippet {
+	text := []rune(memo)
+	for i, r := range text {
+		if r == '\n' || r == '\r' || r == '\t' {
+			text[i] = ' '
+		}
+	}
+
+	// unicode.ToLower は1文字を1文字へ写すため、位置は元の text とそのまま対応する。
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var highlights []*MemoHighlight
+	for _, term := range terms {
+		t := []rune(strings.ToLower(term))
+		for i := 0; i+len(t) <= len(lower); {
+			if len(t) > 0 && runesHasPrefix(lower[i:], t) {
+				highlights = append(highlights, &MemoHighlight{Offset: i, Length: len(t)})
+				i += len(t)
+				continue
+			}
+			i++
+		}
+	}
+
+	first := len(text)
+	for _, h := range highlights {
+		if h.Offset < first {
+			first = h.Offset
+		}
+	}
+	if first == len(text) {
+		first = 0
+	}
+
+	start := max(first-memoSnippetLeading, 0)
+	end := min(start+memoSnippetLength, len(text))
+	// 末尾まで入る場合は、その分だけ前を多めに残す。
+	start = max(end-memoSnippetLength, 0)
+
+	prefix := ""
+	if start > 0 {
+		prefix = memoSnippetEllipsis
+	}
+	suffix := ""
+	if end < len(text) {
+		suffix = memoSnippetEllipsis
+	}
+	shift := len([]rune(prefix)) - start
+
+	snippet := &MemoSnippet{
+		Text:       prefix + string(text[start:end]) + suffix,
+		Highlights: []*MemoHighlight{},
+	}
+
+	for _, h := range highlights {
+		if h.Offset < start || h.Offset+h.Length > end {
+			continue
+		}
+		snippet.Highlights = append(snippet.Highlights, &MemoHighlight{Offset: h.Offset + shift, Length: h.Length})
+	}
+
+	sort.Slice(snippet.Highlights, func(i, j int) bool {
+		return snippet.Highlights[i].Offset < snippet.Highlights[j].Offset
+	})
+
+	return snippet
+}
+
+func runesHasPrefix(s []rune, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+
+	return true
+}
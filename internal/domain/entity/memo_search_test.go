@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemoSearchQuery(t *testing.T) {
+	t.Run("正常系_全角空白でも区切り重複はまとめる", func(t *testing.T) {
+		require.Equal(t, []string{"ドラパルト", "先攻", "Miss"}, ParseMemoSearchQuery(" ドラパルト　先攻 Miss miss "))
+	})
+
+	t.Run("正常系_空白だけなら語は無い", func(t *testing.T) {
+		require.Empty(t, ParseMemoSearchQuery(" 　"))
+	})
+}
+
+func TestNewMemoSnippet(t *testing.T) {
+	t.Run("正常系_短いメモは全体を返し一致箇所を文字単位で示す", func(t *testing.T) {
+		snippet := NewMemoSnippet("先攻でドラパルトに負け。\nドラパルト対策が必要", []string{"ドラパルト"})
+
+		require.Equal(t, "先攻でドラパルトに負け。 ドラパルト対策が必要", snippet.Text)
+		require.Equal(t, []*MemoHighlight{{Offset: 3, Length: 5}, {Offset: 13, Length: 5}}, snippet.Highlights)
+	})
+
+	t.Run("正常系_大文字小文字を区別しない", func(t *testing.T) {
+		snippet := NewMemoSnippet("Prize miss", []string{"MISS"})
+
+		require.Equal(t, []*MemoHighlight{{Offset: 6, Length: 4}}, snippet.Highlights)
+	})
+
+	t.Run("正常系_長いメモは一致箇所の周辺を省略記号付きで切り出す", func(t *testing.T) {
+		memo := strings.Repeat("あ", 200) + "リザードン" + strings.Repeat("い", 200)
+		snippet := NewMemoSnippet(memo, []string{"リザードン"})
+
+		text := []rune(snippet.Text)
+		require.Equal(t, "…", string(text[0]))
+		require.Equal(t, "…", string(text[len(text)-1]))
+		require.Len(t, snippet.Highlights, 1)
+
+		h := snippet.Highlights[0]
+		require.Equal(t, "リザードン", string(text[h.Offset:h.Offset+h.Length]))
+	})
+
+	t.Run("正常系_末尾付近の一致では前を多めに残す", func(t *testing.T) {
+		memo := strings.Repeat("あ", 200) + "リザードン"
+		snippet := NewMemoSnippet(memo, []string{"リザードン"})
+
+		text := []rune(snippet.Text)
+		require.Equal(t, memoSnippetLength+1, len(text))
+		require.True(t, strings.HasSuffix(snippet.Text, "リザードン"))
+	})
+}
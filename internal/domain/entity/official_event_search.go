@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+const (
+	// OfficialEventSearchMaxQueryLength は検索語全体の最大文字数。
+	OfficialEventSearchMaxQueryLength = 100
+	// OfficialEventSearchMaxTerms は空白で区切った検索語の最大数。
+	OfficialEventSearchMaxTerms = 5
+
+	// OfficialEventSearchDefaultDistanceKm は位置を指定して距離を省略したときの半径(km)。
+	OfficialEventSearchDefaultDistanceKm = 10
+	// OfficialEventSearchMaxDistanceKm は指定できる半径の上限(km)。
+	OfficialEventSearchMaxDistanceKm = 300
+
+	// MaxPrefectureId は都道府県ID(prefectures.id、北海道=1〜沖縄県=47)の最大値。
+	MaxPrefectureId = 47
+)
+
+// GeoPoint は緯度・経度(度)。
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// OfficialEventSearchCriteria は公式イベントの検索条件。ゼロ値の項目は絞り込まない。
+type OfficialEventSearchCriteria struct {
+	// Terms は全てが、イベント名・店舗名のいずれかに含まれる(大文字・小文字を区別しない部分一致)。
+	Terms        []string
+	PrefectureId uint
+	// FromDate / ToDate は開催日の範囲で、両端を含む。
+	FromDate time.Time
+	ToDate   time.Time
+	// Near を指定すると、店舗の位置(shops.geo_coding)が Near から DistanceKm 以内のイベントに絞る。
+	// 位置の登録されていない店舗のイベントは含めない。
+	Near       *GeoPoint
+	DistanceKm float64
+
+	Limit  int
+	Offset int
+}
+
+// OfficialEventSearchHit は公式イベントの検索で見つかった1件。
+// DistanceKm は検索条件に Near を指定したときだけ入る、店舗までの距離(km)。
+type OfficialEventSearchHit struct {
+	OfficialEvent *OfficialEvent
+	DistanceKm    *float64
+}
@@ -26,6 +26,13 @@ type Record struct {
 	// (称号判定のasOf集計で使う。usecase.Record.Create/Updateが設定する)。
 	// nil = 未設定。
 	DeckRegisteredAt *time.Time
+	// UpdatedAt は最終更新日時。ETag(版)として使い、更新・削除の前提条件
+	// (If-Match)の照合に用いる。読み込み時にインフラ層が詰めるため、
+	// コンストラクタ引数には含めない。
+	UpdatedAt time.Time
+	// Tags は付与されたタグ。読み込み時にインフラ層が詰め、付与の書き込みは
+	// TagRepository.ReplaceRecordTags が担う(match の Tags と同じ扱い)。
+	Tags []*Tag
 }
 
 func NewRecord(
@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// officialEventTypeIds は絞り込みに使う公式イベントの種類名と official_events.type_id の対応。
+// 番号は KizunaStageCityLeague 等と同じ。
+var officialEventTypeIds = map[string]uint{
+	"city":     uint(KizunaStageCityLeague),
+	"trainers": uint(KizunaStageTrainersLeague),
+	"gym":      uint(KizunaStageGymBattle),
+}
+
+// OfficialEventTypeId は公式イベントの種類名(city / trainers / gym)を official_events.type_id へ変換する。
+func OfficialEventTypeId(name string) (uint, bool) {
+	typeId, ok := officialEventTypeIds[name]
+	return typeId, ok
+}
+
+// RecordCriteria は記録・対戦結果の絞り込み条件。ゼロ値の項目は絞り込まない。
+// 記録の検索では、対戦結果の条件(OpponentFingerprint 以降)を満たす対戦結果を
+// 1件以上持つ記録を返し、対戦結果の検索では記録の条件も親の記録に対して適用する。
+//
+// 条件を増やすたびに FindByXOnCursor を増やさずに済むよう、絞り込みは全てこの1つの構造体で渡す。
+type RecordCriteria struct {
+	UserId string
+	// EventType は "official" / "tonamel" / "unofficial"。空なら絞り込まない。
+	EventType string
+	// FromDate / ToDate は開催日(event_date)の範囲で、両端を含む。
+	FromDate      time.Time
+	ToDate        time.Time
+	RegulationId  uint
+	EnvironmentId string
+	// OfficialEventTypeIds は official_events.type_id のいずれかに一致する公式イベントの記録に絞る。
+	OfficialEventTypeIds []uint
+	DeckId               string
+	DeckCodeId           string
+	// RecordTagIds は全てのタグが付いた記録に絞る(record_tags)。
+	RecordTagIds []string
+	// ExcludePrivate は非公開(private_flg)の記録を除く。本人以外(フレンド)に見せるときに使う。
+	ExcludePrivate bool
+
+	// OpponentFingerprint は対戦相手のスプライトIDをカンマで区切ったもの(並び順・重複は問わない)。
+	OpponentFingerprint string
+	// OpponentDeckName は対戦相手のデッキ名(opponents_deck_info)の部分一致。
+	OpponentDeckName string
+	Result           MatchResult
+	BO3Flg           *bool
+	// GoFirst は対戦結果の1戦目で先攻だったか。
+	GoFirst *bool
+	// TagIds は全てのタグが付いた対戦結果に絞る。
+	TagIds []string
+
+	Limit  int
+	Offset int
+	// CursorEventDate / CursorCreatedAt が指定されていれば Offset の代わりにカーソルで続きを返す
+	// (記録の検索のみ)。
+	CursorEventDate time.Time
+	CursorCreatedAt time.Time
+}
+
+// HasMatchConditions は対戦結果に対する条件を含むかを返す。
+func (c *RecordCriteria) HasMatchConditions() bool {
+	return c.OpponentFingerprint != "" ||
+		c.OpponentDeckName != "" ||
+		c.Result != "" ||
+		c.BO3Flg != nil ||
+		c.GoFirst != nil ||
+		len(c.TagIds) > 0
+}
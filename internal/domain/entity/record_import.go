@@ -0,0 +1,17 @@
+package entity
+
+// ImportedRecord は一括取り込みした(dry-run では取り込む予定の)記録と、それに紐づく対戦結果。
+type ImportedRecord struct {
+	Record  *Record
+	Matches []*Match
+}
+
+func NewImportedRecord(
+	record *Record,
+	matches []*Match,
+) *ImportedRecord {
+	return &ImportedRecord{
+		Record:  record,
+		Matches: matches,
+	}
+}
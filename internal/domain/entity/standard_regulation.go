@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type StandardRegulation struct {
 	ID       string
@@ -22,3 +25,10 @@ func NewStandardRegulation(
 		ToDate:   toDate,
 	}
 }
+
+// MarkList は Marks(例:"H・I・J")をレギュレーションマークごとに分けて返す。
+func (r *StandardRegulation) MarkList() []string {
+	return strings.FieldsFunc(r.Marks, func(c rune) bool {
+		return c == '・' || c == ','
+	})
+}
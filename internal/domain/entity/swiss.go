@@ -0,0 +1,260 @@
+package entity
+
+import (
+	"sort"
+)
+
+const (
+	// 勝ち点。バイは勝ちとして数える。
+	tournamentWinPoints  = 3
+	tournamentDrawPoints = 1
+
+	// minTournamentWinPercentage はオポネントの勝率を出すときの各参加者の勝率の下限。
+	// 早い回戦で負けが込んだ相手と当たっただけで大きく不利にならないようにする。
+	minTournamentWinPercentage = 0.25
+)
+
+// UnofficialEventStanding はスイスドローの順位表の1行。
+type UnofficialEventStanding struct {
+	Rank        int
+	Participant *UnofficialEventParticipant
+	Wins        int
+	Losses      int
+	Draws       int
+	MatchPoints int
+	// OpponentWinPercentage は対戦相手の勝率の平均(オポネント)。バイは相手に数えない。
+	OpponentWinPercentage float64
+	// OpponentOpponentWinPercentage は対戦相手のオポネントの平均。
+	OpponentOpponentWinPercentage float64
+}
+
+type tournamentRecord struct {
+	wins        int
+	losses      int
+	draws       int
+	opponentIds []string
+}
+
+func (r *tournamentRecord) matchPoints() int {
+	return r.wins*tournamentWinPoints + r.draws*tournamentDrawPoints
+}
+
+func (r *tournamentRecord) winPercentage() float64 {
+	played := r.wins + r.losses + r.draws
+	if played == 0 {
+		return minTournamentWinPercentage
+	}
+
+	p := float64(r.matchPoints()) / float64(played*tournamentWinPoints)
+	if p < minTournamentWinPercentage {
+		return minTournamentWinPercentage
+	}
+
+	return p
+}
+
+// NewUnofficialEventStandings はスイスドローの結果から順位表を作る。勝ち点・オポネント・
+// オポネントのオポネントの順に比べ、並んだら先に登録した参加者を上にする。
+// トップカットの結果と未入力の組み合わせは数えない。棄権した参加者も順位表には残す。
+func NewUnofficialEventStandings(
+	participants []*UnofficialEventParticipant,
+	pairings []*UnofficialEventPairing,
+) []*UnofficialEventStanding {
+	records := make(map[string]*tournamentRecord, len(participants))
+	for _, participant := range participants {
+		records[participant.ID] = &tournamentRecord{}
+	}
+
+	for _, pairing := range pairings {
+		if pairing.Stage != TournamentStageSwiss || !pairing.IsReported() {
+			continue
+		}
+
+		for _, id := range []string{pairing.Player1Id, pairing.Player2Id} {
+			record, ok := records[id]
+			if !ok {
+				continue
+			}
+
+			switch pairing.ResultOf(id) {
+			case MatchResultWin:
+				record.wins++
+			case MatchResultLose:
+				record.losses++
+			default:
+				record.draws++
+			}
+
+			if opponentId := pairing.OpponentIdOf(id); opponentId != "" {
+				record.opponentIds = append(record.opponentIds, opponentId)
+			}
+		}
+	}
+
+	owp := func(record *tournamentRecord) float64 {
+		if len(record.opponentIds) == 0 {
+			return 0
+		}
+
+		sum := 0.0
+		for _, id := range record.opponentIds {
+			sum += records[id].winPercentage()
+		}
+
+		return sum / float64(len(record.opponentIds))
+	}
+
+	standings := make([]*UnofficialEventStanding, 0, len(participants))
+	for _, participant := range participants {
+		record := records[participant.ID]
+
+		oowp := 0.0
+		if len(record.opponentIds) > 0 {
+			for _, id := range record.opponentIds {
+				oowp += owp(records[id])
+			}
+			oowp /= float64(len(record.opponentIds))
+		}
+
+		standings = append(standings, &UnofficialEventStanding{
+			Participant:                   participant,
+			Wins:                          record.wins,
+			Losses:                        record.losses,
+			Draws:                         record.draws,
+			MatchPoints:                   record.matchPoints(),
+			OpponentWinPercentage:         owp(record),
+			OpponentOpponentWinPercentage: oowp,
+		})
+	}
+
+	// participants は登録順のため、安定ソートで並んだ参加者は登録順のままになる。
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.MatchPoints != b.MatchPoints {
+			return a.MatchPoints > b.MatchPoints
+		}
+		if a.OpponentWinPercentage != b.OpponentWinPercentage {
+			return a.OpponentWinPercentage > b.OpponentWinPercentage
+		}
+		return a.OpponentOpponentWinPercentage > b.OpponentOpponentWinPercentage
+	})
+
+	for i, standing := range standings {
+		standing.Rank = i + 1
+	}
+
+	return standings
+}
+
+// PairSwissRound はスイスドローの次の回戦の組み合わせを返す。participantIds は組み合わせる
+// 参加者を上位から並べたもの、pairings はこれまでの組み合わせ。
+//
+// 上位から順に、まだ当たっていない中で最も近い順位の相手と組む。どう組んでも再戦を
+// 避けられないとき(探す手間が swissPairingSearchLimit を超えたときも)は、上位から順に
+// 残りの中で最も近い順位のまだ当たっていない相手と、いなければ最も近い順位の相手と組む。
+// 人数が奇数なら、まだバイを受けていない最も下位の参加者をバイ(byeId)にする。
+func PairSwissRound(
+	participantIds []string,
+	pairings []*UnofficialEventPairing,
+) (pairs [][2]string, byeId string) {
+	played := make(map[[2]string]bool)
+	hadBye := make(map[string]bool)
+	for _, pairing := range pairings {
+		if pairing.IsBye() {
+			hadBye[pairing.Player1Id] = true
+			continue
+		}
+		played[[2]string{pairing.Player1Id, pairing.Player2Id}] = true
+		played[[2]string{pairing.Player2Id, pairing.Player1Id}] = true
+	}
+
+	ids := append([]string{}, participantIds...)
+	if len(ids)%2 == 1 {
+		bye := len(ids) - 1
+		for i := len(ids) - 1; i >= 0; i-- {
+			if !hadBye[ids[i]] {
+				bye = i
+				break
+			}
+		}
+		byeId = ids[bye]
+		ids = append(ids[:bye], ids[bye+1:]...)
+	}
+
+	budget := swissPairingSearchLimit
+	if pairs, ok := pairWithoutRematch(ids, played, &budget); ok {
+		return pairs, byeId
+	}
+
+	return pairAvoidingRematch(ids, played), byeId
+}
+
+// swissPairingSearchLimit は再戦の無い組み合わせを探すときに試す組の数の上限。
+// 再戦を避けられない回戦では探す手間が人数に対して指数的に増えるため、ここで打ち切る。
+const swissPairingSearchLimit = 100_000
+
+// pairWithoutRematch は ids の先頭から、再戦にならない相手を順位の近い順に試して組む。
+// 行き詰まったら1つ前の組を組み直す。試した組の数が budget に達したら諦める。
+func pairWithoutRematch(ids []string, played map[[2]string]bool, budget *int) ([][2]string, bool) {
+	if len(ids) == 0 {
+		return [][2]string{}, true
+	}
+
+	for i := 1; i < len(ids); i++ {
+		if played[[2]string{ids[0], ids[i]}] {
+			continue
+		}
+
+		if *budget <= 0 {
+			return nil, false
+		}
+		*budget--
+
+		rest := make([]string, 0, len(ids)-2)
+		rest = append(rest, ids[1:i]...)
+		rest = append(rest, ids[i+1:]...)
+
+		if pairs, ok := pairWithoutRematch(rest, played, budget); ok {
+			return append([][2]string{{ids[0], ids[i]}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
+
+// pairAvoidingRematch は ids の先頭から、残りの中でまだ当たっていない最も近い順位の相手と
+// 組み直さずに組む。そのような相手がいなければ、最も近い順位の相手と再戦にする。
+func pairAvoidingRematch(ids []string, played map[[2]string]bool) [][2]string {
+	rest := append([]string{}, ids...)
+	pairs := make([][2]string, 0, len(ids)/2)
+	for len(rest) >= 2 {
+		opponent := 1
+		for i := 1; i < len(rest); i++ {
+			if !played[[2]string{rest[0], rest[i]}] {
+				opponent = i
+				break
+			}
+		}
+
+		pairs = append(pairs, [2]string{rest[0], rest[opponent]})
+		rest = append(rest[1:opponent], rest[opponent+1:]...)
+	}
+
+	return pairs
+}
+
+// TopCutSeedOrder はトップカットの1回戦の卓順に並べたシード順位(1始まり)を返す。
+// 隣り合う卓の勝者同士が次の回戦で当たり、上位シードほど後半まで当たらない並び
+// (8人なら 1-8, 4-5, 2-7, 3-6)になる。
+func TopCutSeedOrder(size int) []int {
+	order := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+
+	return order
+}
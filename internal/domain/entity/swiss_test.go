@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newSwissTestParticipants(ids ...string) []*UnofficialEventParticipant {
+	ret := make([]*UnofficialEventParticipant, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, &UnofficialEventParticipant{ID: id, GuestName: id})
+	}
+
+	return ret
+}
+
+func newSwissTestPairing(round int, player1Id string, player2Id string, player1Wins int, player2Wins int) *UnofficialEventPairing {
+	pairing := NewUnofficialEventPairing("", time.Now(), "", TournamentStageSwiss, round, 1, player1Id, player2Id)
+	if !pairing.IsBye() {
+		pairing.Report(player1Wins, player2Wins, time.Now())
+	}
+
+	return pairing
+}
+
+func TestNewUnofficialEventStandings(t *testing.T) {
+	t.Run("正常系_勝ち点の順に並べ、バイは勝ちとして数える", func(t *testing.T) {
+		participants := newSwissTestParticipants("a", "b", "c")
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 0, 1),
+			newSwissTestPairing(1, "c", "", 0, 0),
+		}
+
+		standings := NewUnofficialEventStandings(participants, pairings)
+
+		require.Equal(t, "b", standings[0].Participant.ID)
+		require.Equal(t, 1, standings[0].Rank)
+		require.Equal(t, 3, standings[0].MatchPoints)
+		require.Equal(t, "c", standings[1].Participant.ID)
+		require.Equal(t, 1, standings[1].Wins)
+		// バイは対戦相手に数えない
+		require.Equal(t, 0.0, standings[1].OpponentWinPercentage)
+		require.Equal(t, "a", standings[2].Participant.ID)
+		require.Equal(t, 1, standings[2].Losses)
+	})
+
+	t.Run("正常系_勝ち点が並んだらオポネントの高い方を上にする", func(t *testing.T) {
+		participants := newSwissTestParticipants("a", "b", "c", "d")
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 0),
+			newSwissTestPairing(1, "c", "d", 1, 0),
+			newSwissTestPairing(2, "a", "c", 1, 0),
+			newSwissTestPairing(2, "d", "b", 1, 0),
+		}
+
+		standings := NewUnofficialEventStandings(participants, pairings)
+
+		// c と d は1勝1敗で並ぶが、c の相手(a: 2勝, d: 1勝)の方が d の相手(c: 1勝, b: 0勝)より強い
+		require.Equal(t, []string{"a", "c", "d", "b"}, []string{
+			standings[0].Participant.ID,
+			standings[1].Participant.ID,
+			standings[2].Participant.ID,
+			standings[3].Participant.ID,
+		})
+		require.InDelta(t, (1.0+0.5)/2, standings[1].OpponentWinPercentage, 1e-9)
+		require.InDelta(t, (0.5+0.25)/2, standings[2].OpponentWinPercentage, 1e-9)
+	})
+
+	t.Run("正常系_引き分けは勝ち点1で、トップカットと未入力は数えない", func(t *testing.T) {
+		participants := newSwissTestParticipants("a", "b")
+		unreported := NewUnofficialEventPairing("", time.Now(), "", TournamentStageSwiss, 2, 1, "a", "b")
+		topCut := NewUnofficialEventPairing("", time.Now(), "", TournamentStageTopCut, 3, 1, "a", "b")
+		topCut.Report(2, 0, time.Now())
+
+		standings := NewUnofficialEventStandings(participants, []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 1),
+			unreported,
+			topCut,
+		})
+
+		for _, standing := range standings {
+			require.Equal(t, 1, standing.Draws)
+			require.Equal(t, 1, standing.MatchPoints)
+		}
+	})
+}
+
+func TestPairSwissRound(t *testing.T) {
+	t.Run("正常系_上位から順に組む", func(t *testing.T) {
+		pairs, byeId := PairSwissRound([]string{"a", "b", "c", "d"}, nil)
+
+		require.Equal(t, [][2]string{{"a", "b"}, {"c", "d"}}, pairs)
+		require.Empty(t, byeId)
+	})
+
+	t.Run("正常系_再戦を避けて近い順位の相手と組む", func(t *testing.T) {
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 0),
+			newSwissTestPairing(1, "c", "d", 1, 0),
+		}
+
+		pairs, _ := PairSwissRound([]string{"a", "b", "c", "d"}, pairings)
+
+		require.Equal(t, [][2]string{{"a", "c"}, {"b", "d"}}, pairs)
+	})
+
+	t.Run("正常系_バイはまだ受けていない最も下位の参加者にする", func(t *testing.T) {
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 0),
+			newSwissTestPairing(1, "c", "", 0, 0),
+		}
+
+		pairs, byeId := PairSwissRound([]string{"a", "b", "c"}, pairings)
+
+		require.Equal(t, "b", byeId)
+		require.Equal(t, [][2]string{{"a", "c"}}, pairs)
+	})
+
+	t.Run("正常系_再戦を避けられなければ上から順に組む", func(t *testing.T) {
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 0),
+		}
+
+		pairs, _ := PairSwissRound([]string{"a", "b"}, pairings)
+
+		require.Equal(t, [][2]string{{"a", "b"}}, pairs)
+	})
+
+	t.Run("正常系_再戦を避けられなければ残りの中でまだ当たっていない相手と組む", func(t *testing.T) {
+		pairings := []*UnofficialEventPairing{
+			newSwissTestPairing(1, "a", "b", 1, 0),
+			newSwissTestPairing(1, "c", "d", 1, 0),
+			newSwissTestPairing(2, "a", "c", 1, 0),
+			newSwissTestPairing(3, "a", "d", 1, 0),
+			newSwissTestPairing(4, "a", "e", 1, 0),
+			newSwissTestPairing(5, "a", "f", 1, 0),
+		}
+
+		pairs, _ := PairSwissRound([]string{"a", "b", "c", "d", "e", "f"}, pairings)
+
+		require.Equal(t, [][2]string{{"a", "b"}, {"c", "e"}, {"d", "f"}}, pairs)
+	})
+
+	t.Run("正常系_探す手間が上限を超えても組み合わせを返す", func(t *testing.T) {
+		// 最下位の参加者が全員と当たっているため再戦を避けられず、全ての組み方を試すと終わらない。
+		ids := make([]string, MaxUnofficialEventParticipants)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("p%03d", i)
+		}
+		last := ids[len(ids)-1]
+
+		var pairings []*UnofficialEventPairing
+		for i, id := range ids[:len(ids)-1] {
+			pairings = append(pairings, newSwissTestPairing(i+1, id, last, 1, 0))
+		}
+
+		pairs, byeId := PairSwissRound(ids, pairings)
+
+		require.Empty(t, byeId)
+		require.Len(t, pairs, len(ids)/2)
+		require.Equal(t, [2]string{ids[len(ids)-2], last}, pairs[len(pairs)-1])
+	})
+}
+
+func TestTopCutSeedOrder(t *testing.T) {
+	require.Equal(t, []int{1, 2}, TopCutSeedOrder(2))
+	require.Equal(t, []int{1, 4, 2, 3}, TopCutSeedOrder(4))
+	require.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, TopCutSeedOrder(8))
+}
+
+func TestUnofficialEventTournamentIsValidScore(t *testing.T) {
+	bo1 := NewUnofficialEventTournament("", time.Now(), false)
+	bo3 := NewUnofficialEventTournament("", time.Now(), true)
+
+	for _, tc := range []struct {
+		name       string
+		tournament *UnofficialEventTournament
+		stage      TournamentStage
+		p1, p2     int
+		expected   bool
+	}{
+		{"正常系_BO1の勝ち", bo1, TournamentStageSwiss, 1, 0, true},
+		{"異常系_BO1の引き分け", bo1, TournamentStageSwiss, 0, 0, false},
+		{"正常系_BO3の2-1", bo3, TournamentStageTopCut, 2, 1, true},
+		{"正常系_BO3のスイスドローでの1-1", bo3, TournamentStageSwiss, 1, 1, true},
+		{"異常系_BO3のトップカットでの1-1", bo3, TournamentStageTopCut, 1, 1, false},
+		{"異常系_BO3の2-2", bo3, TournamentStageSwiss, 2, 2, false},
+		{"異常系_負のゲーム数", bo3, TournamentStageSwiss, -1, 2, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.tournament.IsValidScore(tc.stage, tc.p1, tc.p2))
+		})
+	}
+}
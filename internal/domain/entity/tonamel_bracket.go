@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"sort"
+)
+
+// TonamelBracketStage は Tonamel の大会のどの段階の試合かを表す。
+type TonamelBracketStage string
+
+const (
+	// TonamelBracketStageQualifying は予選(スイスドロー・予選リーグ)。
+	TonamelBracketStageQualifying TonamelBracketStage = "qualifying"
+	// TonamelBracketStageFinal は決勝トーナメント。
+	TonamelBracketStageFinal TonamelBracketStage = "final"
+)
+
+// TonamelBracket は Tonamel の大会ページから取得した、組み合わせと結果の一覧。
+type TonamelBracket struct {
+	TonamelEventId string
+	Matches        []*TonamelBracketMatch
+}
+
+// TonamelBracketMatch は Tonamel の大会の1試合。Player2Name が空ならバイ(不戦勝)。
+type TonamelBracketMatch struct {
+	Stage        TonamelBracketStage
+	Round        int
+	RoundName    string
+	Player1Name  string
+	Player2Name  string
+	Player1Score int
+	Player2Score int
+	WinnerName   string
+	Finished     bool
+}
+
+func (m *TonamelBracketMatch) IsBye() bool {
+	return m.Player2Name == ""
+}
+
+// Has は playerName がこの試合の当事者かを返す。
+func (m *TonamelBracketMatch) Has(playerName string) bool {
+	return playerName != "" && (m.Player1Name == playerName || m.Player2Name == playerName)
+}
+
+// OpponentOf は playerName の対戦相手の名前を返す。バイなら空。
+func (m *TonamelBracketMatch) OpponentOf(playerName string) string {
+	if m.Player1Name == playerName {
+		return m.Player2Name
+	}
+	return m.Player1Name
+}
+
+// ScoresOf は playerName から見た (自分の取得ゲーム数, 相手の取得ゲーム数) を返す。
+func (m *TonamelBracketMatch) ScoresOf(playerName string) (int, int) {
+	if m.Player1Name == playerName {
+		return m.Player1Score, m.Player2Score
+	}
+	return m.Player2Score, m.Player1Score
+}
+
+// ResultOf は playerName から見た試合の結果を返す。勝者の無い終了済みの試合は引き分け。
+func (m *TonamelBracketMatch) ResultOf(playerName string) MatchResult {
+	if m.IsBye() || m.WinnerName == playerName {
+		return MatchResultWin
+	}
+	if m.WinnerName == "" {
+		return MatchResultDraw
+	}
+	return MatchResultLose
+}
+
+// MatchesOf は playerName が当事者の終了済みの試合を、予選→決勝・回戦順に並べて返す。
+func (b *TonamelBracket) MatchesOf(playerName string) []*TonamelBracketMatch {
+	ret := []*TonamelBracketMatch{}
+	for _, match := range b.Matches {
+		if match.Finished && match.Has(playerName) {
+			ret = append(ret, match)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Stage != ret[j].Stage {
+			return ret[i].Stage == TonamelBracketStageQualifying
+		}
+		return ret[i].Round < ret[j].Round
+	})
+
+	return ret
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// TrashRetention は削除した記録・対戦結果・デッキ・デッキコードをゴミ箱に残しておく期間。
+// これを過ぎたものは一覧にも出さず復元もできず、cmd/purge-trash が物理削除する。
+const TrashRetention = 30 * 24 * time.Hour
+
+// TrashCascadeWindow は、親と一緒に削除された子(記録に対する対戦結果・対局、デッキに対する
+// デッキコード等)を見分けるための幅。削除は子→親の順に1トランザクションで論理削除するため、
+// 子の deleted_at は親の deleted_at の直前になる。親より前に個別に削除していた子まで
+// 復元しないよう、親の deleted_at の前後この幅に収まる子だけを一緒に復元する。
+const TrashCascadeWindow = 10 * time.Second
+
+type TrashItemType string
+
+const (
+	TrashItemTypeRecord   TrashItemType = "record"
+	TrashItemTypeMatch    TrashItemType = "match"
+	TrashItemTypeDeck     TrashItemType = "deck"
+	TrashItemTypeDeckCode TrashItemType = "deck_code"
+)
+
+// TrashItem はゴミ箱に入っている(論理削除済みの)1件を表す。
+// 親と一緒に削除された子は親を復元すれば戻るため、ゴミ箱には親だけを載せる。
+type TrashItem struct {
+	ID     string
+	Type   TrashItemType
+	UserId string
+	// ParentId は対戦結果なら記録のID、デッキコードならデッキのID。記録・デッキは空。
+	ParentId string
+	// Name は一覧で見分けるための表示名。記録は開催日、対戦結果は相手のデッキ、
+	// デッキはデッキ名、デッキコードはコードを入れる。
+	Name      string
+	DeletedAt time.Time
+}
+
+// TrashCutoff は now 時点でゴミ箱に残っている削除日時の下限を返す。
+// これより前に削除されたものは保持期間を過ぎている。
+func TrashCutoff(now time.Time) time.Time {
+	return now.Add(-TrashRetention)
+}
+
+// Restorable は now 時点でまだ保持期間内(復元できる)かを返す。
+func (t *TrashItem) Restorable(now time.Time) bool {
+	return !t.DeletedAt.Before(TrashCutoff(now))
+}
+
+// TrashPurgeResult は物理削除した(dry-run では削除対象の)件数を表す。
+type TrashPurgeResult struct {
+	Records          int64
+	Matches          int64
+	Games            int64
+	UnofficialEvents int64
+	Decks            int64
+	DeckCodes        int64
+	// Attachments は記録・対戦結果に添付した写真。ストレージに置いた本体とサムネイルも一緒に消す。
+	Attachments int64
+}
+
+func (r *TrashPurgeResult) Total() int64 {
+	return r.Records + r.Matches + r.Games + r.UnofficialEvents + r.Decks + r.DeckCodes + r.Attachments
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashItem(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Restorable", func(t *testing.T) {
+		t.Run("正常系_保持期間ちょうどまでは復元できる", func(t *testing.T) {
+			item := &TrashItem{DeletedAt: now.Add(-TrashRetention)}
+			require.True(t, item.Restorable(now))
+		})
+
+		t.Run("正常系_保持期間を過ぎたら復元できない", func(t *testing.T) {
+			item := &TrashItem{DeletedAt: now.Add(-TrashRetention - time.Second)}
+			require.False(t, item.Restorable(now))
+		})
+	})
+}
@@ -16,6 +16,18 @@ type UnofficialEvent struct {
 	// 既存レコードのcreated_atが更新時刻で上書きされてしまう。
 	// 新規作成時はゼロ値のままにしておき、GORMのautoCreateTimeに任せる。
 	CreatedAt time.Time
+	// 更新日時。ETag として返し、更新・削除時に If-Match と照合する。
+	UpdatedAt time.Time
+	// JoinCode は他のユーザが自分の記録をこのイベントに付けるための参加コード。共有していなければ空。
+	JoinCode string
+}
+
+// JoinCodeLength は参加コードの文字数。
+const JoinCodeLength = 8
+
+// IsShared は参加コードを発行して他のユーザと共有しているかを返す。
+func (e *UnofficialEvent) IsShared() bool {
+	return e.JoinCode != ""
 }
 
 func NewUnofficialEvent(
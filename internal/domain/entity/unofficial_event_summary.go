@@ -0,0 +1,35 @@
+package entity
+
+// UnofficialEventSummary は参加コードで共有した自由形式イベントに付いた記録の集計。
+// 非公開の記録と退会済みユーザの記録は含めない。
+type UnofficialEventSummary struct {
+	UnofficialEventId string
+	Participants      []*UnofficialEventSummaryParticipant
+	// Archetypes は参加者のデッキをスプライトの指紋でまとめたもの。使った人数の多い順。
+	// デッキ・スプライトが未登録の参加者は含めない。
+	Archetypes   []*UnofficialEventArchetype
+	TotalMatches int
+}
+
+// UnofficialEventSummaryParticipant はイベントに記録を付けた1人分の成績。
+type UnofficialEventSummaryParticipant struct {
+	RecordId       string
+	UserId         string
+	UserName       string
+	UserImageURL   string
+	Wins           int
+	Losses         int
+	Draws          int
+	PokemonSprites []*PokemonSprite
+}
+
+// UnofficialEventArchetype は同じ指紋のデッキを使った参加者の成績の合計。
+type UnofficialEventArchetype struct {
+	// Fingerprint は重複を除いてソートしたスプライトIDのカンマ区切り。
+	Fingerprint    string
+	Count          int
+	Wins           int
+	Losses         int
+	Draws          int
+	PokemonSprites []*PokemonSprite
+}
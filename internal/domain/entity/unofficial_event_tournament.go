@@ -0,0 +1,225 @@
+package entity
+
+import (
+	"time"
+)
+
+type TournamentStage string
+
+const (
+	TournamentStageSwiss  TournamentStage = "swiss"
+	TournamentStageTopCut TournamentStage = "top_cut"
+)
+
+// MaxTournamentGuestNameLength はゲスト参加者の名前として受け付ける最大文字数。
+const MaxTournamentGuestNameLength = 32
+
+// MaxUnofficialEventParticipants は大会に参加できる最大人数。スイスドローの組み合わせを
+// 探す手間と、参加者ごとに作る記録・対戦結果の数を抑えるための上限。
+const MaxUnofficialEventParticipants = 256
+
+// UnofficialEventTournament は自由形式イベントをスイスドローの大会として運営するときの設定。
+// 大会にしていない自由形式イベントには存在しない。
+type UnofficialEventTournament struct {
+	UnofficialEventId string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	// BO3Flg は各対戦を2本先取で行うか。参加者の対戦結果もこの形式で作る。
+	BO3Flg bool
+	// TopCutSize はトップカットに進む人数。0 ならトップカットはまだ始めていない。
+	TopCutSize int
+}
+
+func NewUnofficialEventTournament(
+	unofficialEventId string,
+	createdAt time.Time,
+	bo3Flg bool,
+) *UnofficialEventTournament {
+	return &UnofficialEventTournament{
+		UnofficialEventId: unofficialEventId,
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+		BO3Flg:            bo3Flg,
+	}
+}
+
+// IsValidScore は1つの対戦の取ったゲーム数の組み合わせが、大会の形式で起こりうるものかを返す。
+// 引き分けは2本先取の1勝1敗(時間切れ)だけで、トップカットでは決着を付ける。
+func (e *UnofficialEventTournament) IsValidScore(stage TournamentStage, player1Wins int, player2Wins int) bool {
+	if player1Wins < 0 || player2Wins < 0 {
+		return false
+	}
+
+	if !e.BO3Flg {
+		return player1Wins+player2Wins == 1
+	}
+
+	if player1Wins == 1 && player2Wins == 1 {
+		return stage == TournamentStageSwiss
+	}
+
+	return (player1Wins == 2 && player2Wins <= 1) || (player2Wins == 2 && player1Wins <= 1)
+}
+
+// IsValidTopCutSize は size がトップカットの人数として使えるか(2以上の2の累乗)を返す。
+func IsValidTopCutSize(size int) bool {
+	return size >= 2 && size&(size-1) == 0
+}
+
+// UnofficialEventParticipant は大会の参加者。登録ユーザ(UserId)かゲスト(GuestName)のどちらか。
+type UnofficialEventParticipant struct {
+	ID                string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	UnofficialEventId string
+	UserId            string
+	GuestName         string
+	// RecordId は登録ユーザの対戦結果を書き込む記録。最初の結果を書き込むときに作る。
+	RecordId string
+	// DroppedAt は途中で棄権した日時。棄権した参加者は次の回戦から組み合わせない。
+	DroppedAt time.Time
+	// User は登録ユーザ。読み込み時に usecase が詰める(ゲスト・退会済みなら nil)。
+	User *User
+}
+
+func NewUnofficialEventParticipant(
+	id string,
+	createdAt time.Time,
+	unofficialEventId string,
+	userId string,
+	guestName string,
+) *UnofficialEventParticipant {
+	return &UnofficialEventParticipant{
+		ID:                id,
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+		UnofficialEventId: unofficialEventId,
+		UserId:            userId,
+		GuestName:         guestName,
+	}
+}
+
+func (e *UnofficialEventParticipant) IsDropped() bool {
+	return !e.DroppedAt.IsZero()
+}
+
+// Drop は棄権にする。
+func (e *UnofficialEventParticipant) Drop(now time.Time) {
+	e.DroppedAt = now
+	e.UpdatedAt = now
+}
+
+// Name は組み合わせ表や対戦結果のメモに出す名前を返す。
+func (e *UnofficialEventParticipant) Name() string {
+	if e.User != nil {
+		return e.User.Name
+	}
+
+	return e.GuestName
+}
+
+// UnofficialEventPairing は1回戦分の1卓の組み合わせと結果。Player2Id が空なら Player1Id の不戦勝(バイ)。
+type UnofficialEventPairing struct {
+	ID                string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	UnofficialEventId string
+	Stage             TournamentStage
+	// Round はスイスドローから通しの回戦番号。
+	Round       int
+	TableNo     int
+	Player1Id   string
+	Player2Id   string
+	Player1Wins int
+	Player2Wins int
+	// ReportedAt は結果を入力した日時。バイは組み合わせた時点で入る。
+	ReportedAt time.Time
+	// Player1MatchId・Player2MatchId は登録ユーザの記録に作った対戦結果。
+	Player1MatchId string
+	Player2MatchId string
+	// Player1・Player2 は参加者。読み込み時に usecase が詰める(バイの Player2 は nil)。
+	Player1 *UnofficialEventParticipant
+	Player2 *UnofficialEventParticipant
+}
+
+func NewUnofficialEventPairing(
+	id string,
+	createdAt time.Time,
+	unofficialEventId string,
+	stage TournamentStage,
+	round int,
+	tableNo int,
+	player1Id string,
+	player2Id string,
+) *UnofficialEventPairing {
+	e := &UnofficialEventPairing{
+		ID:                id,
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+		UnofficialEventId: unofficialEventId,
+		Stage:             stage,
+		Round:             round,
+		TableNo:           tableNo,
+		Player1Id:         player1Id,
+		Player2Id:         player2Id,
+	}
+
+	if e.IsBye() {
+		e.ReportedAt = createdAt
+	}
+
+	return e
+}
+
+func (e *UnofficialEventPairing) IsBye() bool {
+	return e.Player2Id == ""
+}
+
+func (e *UnofficialEventPairing) IsReported() bool {
+	return !e.ReportedAt.IsZero()
+}
+
+// Report は取ったゲーム数を結果として入力する。
+func (e *UnofficialEventPairing) Report(player1Wins int, player2Wins int, now time.Time) {
+	e.Player1Wins = player1Wins
+	e.Player2Wins = player2Wins
+	e.ReportedAt = now
+	e.UpdatedAt = now
+}
+
+// WinnerId は勝った参加者を返す。未入力・引き分けなら空文字。
+func (e *UnofficialEventPairing) WinnerId() string {
+	switch {
+	case e.IsBye():
+		return e.Player1Id
+	case !e.IsReported():
+		return ""
+	case e.Player1Wins > e.Player2Wins:
+		return e.Player1Id
+	case e.Player2Wins > e.Player1Wins:
+		return e.Player2Id
+	default:
+		return ""
+	}
+}
+
+// ResultOf は participantId から見た結果を返す。
+func (e *UnofficialEventPairing) ResultOf(participantId string) MatchResult {
+	switch e.WinnerId() {
+	case participantId:
+		return MatchResultWin
+	case "":
+		return MatchResultDraw
+	default:
+		return MatchResultLose
+	}
+}
+
+// OpponentIdOf は participantId の対戦相手を返す。バイなら空文字。
+func (e *UnofficialEventPairing) OpponentIdOf(participantId string) string {
+	if e.Player1Id == participantId {
+		return e.Player2Id
+	}
+
+	return e.Player1Id
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"time"
+)
+
+type UserExportStatus string
+
+const (
+	UserExportStatusPending   UserExportStatus = "pending"
+	UserExportStatusRunning   UserExportStatus = "running"
+	UserExportStatusSucceeded UserExportStatus = "succeeded"
+	// UserExportStatusFailed はリトライを諦めたエクスポート。自動では再実行しない
+	// (利用者がエクスポートをやり直す)。
+	UserExportStatusFailed UserExportStatus = "failed"
+)
+
+const (
+	// UserExportMaxAttempts を超えて失敗したエクスポートは失敗にする。
+	// アーカイブの作成はDBとストレージにしか依存しないため、数回で諦める。
+	UserExportMaxAttempts = 3
+
+	userExportRetryInterval = time.Minute
+)
+
+// UserExport はユーザの全データのエクスポート(テイクアウト)のジョブ。
+// 受け付けた時点で作り、ワーカーが非同期にアーカイブを作ってオブジェクトストレージへ置く。
+type UserExport struct {
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	UserId    string
+	Status    UserExportStatus
+	// Attempts は実行を始めた回数(取り出した時点で数える)。
+	Attempts    uint
+	NextRunAt   time.Time
+	LastError   string
+	CompletedAt time.Time
+}
+
+func NewUserExport(
+	id string,
+	createdAt time.Time,
+	userId string,
+) *UserExport {
+	return &UserExport{
+		ID:        id,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		UserId:    userId,
+		Status:    UserExportStatusPending,
+		Attempts:  0,
+		NextRunAt: createdAt,
+	}
+}
+
+// ObjectKey はアーカイブを置くオブジェクトストレージのキー。
+func (e *UserExport) ObjectKey() string {
+	return "user-exports/" + e.UserId + "/" + e.ID + ".zip"
+}
+
+// Finished は完了・失敗のいずれかで、これ以上処理しないかを返す。
+func (e *UserExport) Finished() bool {
+	return e.Status == UserExportStatusSucceeded || e.Status == UserExportStatusFailed
+}
+
+// Succeed はエクスポートを完了にする。
+func (e *UserExport) Succeed(now time.Time) {
+	e.Status = UserExportStatusSucceeded
+	e.UpdatedAt = now
+	e.CompletedAt = now
+	e.LastError = ""
+}
+
+// Fail は失敗を記録し、試行回数が上限に達していなければ一定時間後の再実行を予約する。
+func (e *UserExport) Fail(now time.Time, err error) {
+	e.UpdatedAt = now
+	e.LastError = err.Error()
+
+	if e.Attempts >= UserExportMaxAttempts {
+		e.Status = UserExportStatusFailed
+		e.CompletedAt = now
+		return
+	}
+
+	e.Status = UserExportStatusPending
+	e.NextRunAt = now.Add(userExportRetryInterval)
+}
+
+// UserExportData はエクスポートのアーカイブに含める、あるユーザの全データ。
+//
+// 記録(対戦結果・対局を含む)とデッキ(デッキコードを含む)はカレンダーと同じ形で持つ。
+// DesignationHistory は称号・ランクの変化の履歴で、専用のテーブルを持たないため
+// 称号・ランクの通知から取り出したもの(Notifications にも同じものが含まれる)。
+type UserExportData struct {
+	UserId                string
+	ExportedAt            time.Time
+	Records               []*CalendarRecord
+	Decks                 []*CalendarDeck
+	Tags                  []*Tag
+	UnofficialEvents      []*UnofficialEvent
+	UserBadges            []*UserBadge
+	UserEnvironmentBadges []*UserEnvironmentBadge
+	DesignationHistory    []*Notification
+	Notifications         []*Notification
+}
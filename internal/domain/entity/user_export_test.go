@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserExportFail(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("正常系_上限未満の失敗は一定時間後の再実行を待つ", func(t *testing.T) {
+		export := NewUserExport("id", now, "user_id")
+		export.Attempts = 1
+
+		export.Fail(now, errors.New("timeout"))
+
+		require.Equal(t, UserExportStatusPending, export.Status)
+		require.Equal(t, now.Add(time.Minute), export.NextRunAt)
+		require.Equal(t, "timeout", export.LastError)
+		require.False(t, export.Finished())
+	})
+
+	t.Run("正常系_上限に達した失敗は失敗にする", func(t *testing.T) {
+		export := NewUserExport("id", now, "user_id")
+		export.Attempts = UserExportMaxAttempts
+
+		export.Fail(now, errors.New("timeout"))
+
+		require.Equal(t, UserExportStatusFailed, export.Status)
+		require.Equal(t, now, export.CompletedAt)
+		require.True(t, export.Finished())
+	})
+}
+
+func TestUserExportObjectKey(t *testing.T) {
+	t.Run("正常系_ユーザごとのプレフィックスの下にzipとして置く", func(t *testing.T) {
+		export := NewUserExport("01JMPK4VF04QX714CG4PHYJ88K", time.Now(), "zor5SLfEfwfZ90yRVXzlxBEFARy2")
+
+		require.Equal(t, "user-exports/zor5SLfEfwfZ90yRVXzlxBEFARy2/01JMPK4VF04QX714CG4PHYJ88K.zip", export.ObjectKey())
+	})
+}
@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+type UserRelationshipStatus string
+
+const (
+	// UserRelationshipStatusPending は UserId が TargetUserId にフレンドを申請している状態。
+	UserRelationshipStatusPending  UserRelationshipStatus = "pending"
+	UserRelationshipStatusAccepted UserRelationshipStatus = "accepted"
+	// UserRelationshipStatusBlocked は UserId が TargetUserId をブロックしている状態。
+	UserRelationshipStatusBlocked UserRelationshipStatus = "blocked"
+)
+
+// UserRelationship はユーザ同士の関係(フレンド申請・フレンド・ブロック)。
+// UserId から TargetUserId への向きを持ち、同じ2人の間には向きごとに1件まで。
+type UserRelationship struct {
+	ID           string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	UserId       string
+	TargetUserId string
+	Status       UserRelationshipStatus
+	// User は一覧で返す相手のユーザ(申請した人・フレンド)。読み込み時に usecase が詰める。
+	User *User
+}
+
+func NewUserRelationship(
+	id string,
+	createdAt time.Time,
+	userId string,
+	targetUserId string,
+	status UserRelationshipStatus,
+) *UserRelationship {
+	return &UserRelationship{
+		ID:           id,
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+		UserId:       userId,
+		TargetUserId: targetUserId,
+		Status:       status,
+	}
+}
+
+// Accept はフレンド申請を承認してフレンドにする。
+func (e *UserRelationship) Accept(now time.Time) {
+	e.Status = UserRelationshipStatusAccepted
+	e.UpdatedAt = now
+}
+
+// OtherUserId は userId から見た相手を返す。
+func (e *UserRelationship) OtherUserId(userId string) string {
+	if e.UserId == userId {
+		return e.TargetUserId
+	}
+
+	return e.UserId
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type AttachmentInterface interface {
+	Create(
+		ctx context.Context,
+		attachment *entity.Attachment,
+	) error
+
+	// FindById は1件取得する。存在しなければ apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		id string,
+	) (*entity.Attachment, error)
+
+	// FindByOwner は記録・対戦結果に添付した写真を添付した順に返す。無ければ空のスライスを返す。
+	FindByOwner(
+		ctx context.Context,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+	) ([]*entity.Attachment, error)
+
+	// CountByOwner は記録・対戦結果に添付した写真の数を返す。
+	CountByOwner(
+		ctx context.Context,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+	) (int64, error)
+
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+
+	// FindPurgeable はゴミ箱の物理削除(TrashInterface.Purge)で一緒に消える写真、
+	// つまり deletedBefore より前に削除された記録・対戦結果に添付した写真を返す。
+	// 行は Purge が消すため、ストレージに置いた本体とサムネイルを消すのに使う。
+	FindPurgeable(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) ([]*entity.Attachment, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// AttachmentStorageInterface は添付した写真を加工してオブジェクトストレージへ非公開で置く操作と、
+// 置いた写真を読み出す・消す操作を提供する。
+type AttachmentStorageInterface interface {
+	// Put はアップロードされたファイルの形式を中身から判定し、EXIF等のメタデータを除いて
+	// 再エンコードした本体とサムネイルを attachment のキーに置く。写真として扱えなければ
+	// apperror.ErrInvalidAttachment を返す。
+	Put(
+		ctx context.Context,
+		attachment *entity.Attachment,
+		body []byte,
+	) (*entity.AttachmentImage, error)
+
+	// Get は置いた写真(本体またはサムネイル)を読み出す。無ければ apperror.ErrRecordNotFound を返す。
+	Get(
+		ctx context.Context,
+		key string,
+	) ([]byte, error)
+
+	// Delete は本体とサムネイルを消す。既に無いものは消えたものとして扱う。
+	Delete(
+		ctx context.Context,
+		attachment *entity.Attachment,
+	) error
+}
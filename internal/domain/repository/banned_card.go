@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type BannedCardInterface interface {
+	// FindByRegulationIdAndDate は date 時点で regulationId において禁止されているカードを返す。
+	FindByRegulationIdAndDate(
+		ctx context.Context,
+		regulationId uint,
+		date time.Time,
+	) ([]*entity.BannedCard, error)
+}
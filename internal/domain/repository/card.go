@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type CardInterface interface {
+	// FindByNames はカード名が cardNames のいずれかに一致するカード(再録・別収録弾を含む)を
+	// カードIDの昇順で返す。
+	FindByNames(
+		ctx context.Context,
+		cardNames []string,
+	) ([]*entity.Card, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type CardStatInterface interface {
+	// FindDeckCodeAggregates はユーザーの対戦を、記録に紐づくデッキコードごとに集計して返す。
+	// デッキコード未登録の記録と、集計対象外(ignore_stats_flg)の記録は含めない。
+	// regulationId が 0 ならレギュレーションで絞り込まない(FindUserStat と同じ)。
+	FindDeckCodeAggregates(
+		ctx context.Context,
+		userId string,
+		fromDate time.Time,
+		toDate time.Time,
+		regulationId uint,
+	) ([]*entity.CardStatDeckCodeAggregate, error)
+}
@@ -46,6 +46,14 @@ type DeckInterface interface {
 		cursor time.Time,
 	) ([]*entity.Deck, error)
 
+	// FindPublicByUserId は uid のデッキのうち、非公開・アーカイブ済みでないものを返す(フレンド向け)。
+	FindPublicByUserId(
+		ctx context.Context,
+		uid string,
+		limit int,
+		offset int,
+	) ([]*entity.Deck, error)
+
 	// DeleteByUserId は退会時に、そのユーザのデッキ(アーカイブ済みを含む)と、
 	// それらのデッキに紐づくデッキコードをまとめて論理削除する。
 	// デッキを1件ずつ Delete するとデッキ数に比例してクエリが増えるため、
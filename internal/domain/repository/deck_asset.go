@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
 // DeckAssetInterface はデッキコードに紐づく外部リソース(公式サイトのデッキ結果HTML、デッキ画像)を
-// 取得してオブジェクトストレージへ配置する操作を提供する。
+// 取得してオブジェクトストレージへ配置する操作と、配置済みのリソースを読み出す操作を提供する。
 type DeckAssetInterface interface {
 	UploadDeckResultHTML(
 		ctx context.Context,
@@ -16,4 +18,12 @@ type DeckAssetInterface interface {
 		ctx context.Context,
 		deckCode string,
 	) error
+
+	// FindDeckCards はアップロード済みのデッキ結果HTMLを解析し、カード構成を返す。
+	// 戻り値の DeckCodeId・CardName は空(呼び出し側・DBが詰める)。
+	// HTMLが未アップロードなら apperror.ErrRecordNotFound を返す。
+	FindDeckCards(
+		ctx context.Context,
+		deckCode string,
+	) ([]*entity.DeckCodeCard, error)
 }
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type DeckAssetJobInterface interface {
+	// Enqueue はジョブを登録する。同じデッキコード・種類のジョブが既にあれば何もしない。
+	Enqueue(
+		ctx context.Context,
+		jobs []*entity.DeckAssetJob,
+	) error
+
+	// Claim は now 時点で実行できるジョブを最大 limit 件取り出し、実行中にして返す。
+	// 実行中のまま staleBefore より前から更新の無いジョブ(処理中にプロセスが落ちたもの)も取り出す。
+	// 複数のワーカーが同時に呼んでも、同じジョブを重ねて取り出さない。
+	Claim(
+		ctx context.Context,
+		now time.Time,
+		staleBefore time.Time,
+		limit int,
+	) ([]*entity.DeckAssetJob, error)
+
+	// Save は実行結果(状態・試行回数・次回実行日時・エラー)を反映する。
+	Save(
+		ctx context.Context,
+		job *entity.DeckAssetJob,
+	) error
+
+	// InvalidateByDeckCodeId は deckCodeId の実行待ちのジョブを、デッキコードが正しくないものとして
+	// 以降実行しないようにする。
+	InvalidateByDeckCodeId(
+		ctx context.Context,
+		deckCodeId string,
+		now time.Time,
+		lastError string,
+	) error
+
+	FindByDeckCodeId(
+		ctx context.Context,
+		deckCodeId string,
+	) ([]*entity.DeckAssetJob, error)
+}
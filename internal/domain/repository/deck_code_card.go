@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type DeckCodeCardInterface interface {
+	// FindByDeckCodeId はデッキコードのカード構成を、公式サイトの掲載順で返す。
+	// 未解析のデッキコードは空スライスを返す(エラーにはしない)。
+	FindByDeckCodeId(
+		ctx context.Context,
+		deckCodeId string,
+	) ([]*entity.DeckCodeCard, error)
+
+	// FindByDeckCodeIds は複数のデッキコードのカード構成をまとめて返す。
+	// 並びはデッキコードIDごとに公式サイトの掲載順。
+	FindByDeckCodeIds(
+		ctx context.Context,
+		deckCodeIds []string,
+	) ([]*entity.DeckCodeCard, error)
+
+	// Replace は deckCodeId のカード構成を cards に一致させる(全削除→再INSERT)。
+	// 並び順(cards の順)は position として保持する。
+	Replace(
+		ctx context.Context,
+		deckCodeId string,
+		cards []*entity.DeckCodeCard,
+	) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type DeckCodeStatInterface interface {
+	// FindByDeckCodeIds は指定したデッキコードごとの対戦成績を返す。
+	// 記録が1件も無いデッキコードもゼロ値で含め、並びは deckCodeIds の順にする。
+	FindByDeckCodeIds(
+		ctx context.Context,
+		deckCodeIds []string,
+	) ([]*entity.DeckCodeStat, error)
+}
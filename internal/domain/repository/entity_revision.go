@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// EntityRevisionInterface は記録・対戦結果の変更履歴(entity_revisions)を扱う。
+// 履歴は追記のみで、更新・削除の手段は持たせない。
+type EntityRevisionInterface interface {
+	// Append は履歴を1件追加する。ctx にトランザクションがあればそれに参加し、
+	// 変更本体と同じトランザクションで書き込む。
+	Append(
+		ctx context.Context,
+		revision *entity.EntityRevision,
+	) error
+
+	// FindByEntity は記録・対戦結果1件の変更履歴を新しい順に返す。
+	FindByEntity(
+		ctx context.Context,
+		entityType entity.EntityRevisionType,
+		entityId string,
+		limit int,
+		offset int,
+	) ([]*entity.EntityRevision, error)
+}
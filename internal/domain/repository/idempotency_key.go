@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type IdempotencyKeyInterface interface {
+	// FindByKey はユーザのキーを返す。無ければ apperror.ErrRecordNotFound を返す。
+	// 期限切れのキーも返すため、期限は呼び出し側で確かめる。
+	FindByKey(
+		ctx context.Context,
+		userId string,
+		key string,
+	) (*entity.IdempotencyKey, error)
+
+	// Reserve はキーを処理中として登録し、登録できたら true を返す。同じキーが既にあれば
+	// (expiredBefore より前に作られた期限切れのもの・staleBefore より前から処理中のままのものを除き)
+	// 何もせず false を返す。同じキーのリクエストが同時に届いても、どちらか一方しか登録できない。
+	Reserve(
+		ctx context.Context,
+		key *entity.IdempotencyKey,
+		expiredBefore time.Time,
+		staleBefore time.Time,
+	) (bool, error)
+
+	// Complete は処理を終えたキーに応答(ステータスコード・ボディ)を保存する。
+	Complete(
+		ctx context.Context,
+		key *entity.IdempotencyKey,
+	) error
+
+	// Delete はキーを消す。応答を保存せずにリトライを受け付け直す場合に使う。
+	Delete(
+		ctx context.Context,
+		userId string,
+		key string,
+	) error
+
+	// DeleteExpired は before より前に作られたキーを消し、消した件数を返す。
+	DeleteExpired(
+		ctx context.Context,
+		before time.Time,
+	) (int64, error)
+}
@@ -23,6 +23,13 @@ type MatchInterface interface {
 		limit int,
 	) ([]*entity.Match, error)
 
+	// FindByCriteria は criteria.UserId の対戦結果のうち、対戦結果と親の記録が
+	// criteria の条件を全て満たすものを作成日時の新しい順に返す。
+	FindByCriteria(
+		ctx context.Context,
+		criteria *entity.RecordCriteria,
+	) ([]*entity.Match, error)
+
 	FindLatest(
 		ctx context.Context,
 		limit int,
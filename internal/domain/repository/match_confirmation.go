@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type MatchConfirmationInterface interface {
+	Create(
+		ctx context.Context,
+		confirmation *entity.MatchConfirmation,
+	) error
+
+	// FindById は1件取得する。存在しなければ apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		id string,
+	) (*entity.MatchConfirmation, error)
+
+	// FindByMatchId は依頼した側の対戦結果への確認依頼を返す。
+	// 無ければ apperror.ErrRecordNotFound を返す。
+	FindByMatchId(
+		ctx context.Context,
+		matchId string,
+	) (*entity.MatchConfirmation, error)
+
+	// FindByLinkedMatchId は matchId を依頼した側の対戦結果(match_id)か、相手側の
+	// 対戦結果(mirrored_match_id)として結び付けている確認依頼を返す。
+	FindByLinkedMatchId(
+		ctx context.Context,
+		matchId string,
+	) ([]*entity.MatchConfirmation, error)
+
+	// FindByOpponentUserId は userId に届いた確認依頼を新しい順に返す。
+	FindByOpponentUserId(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.MatchConfirmation, error)
+
+	// Save は回答の結果(状態・相手側の対戦結果・異議の理由・回答日時)と、
+	// 再依頼で改めた依頼先を反映する。読み込んでから保存するまでに他のリクエストが
+	// 状態を変えていないよう、保存されている状態が status のときだけ反映し、
+	// 変わっていれば apperror.ErrMatchConfirmationClosed を返す。
+	Save(
+		ctx context.Context,
+		confirmation *entity.MatchConfirmation,
+		status entity.MatchConfirmationStatus,
+	) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type MemoSearchInterface interface {
+	// Search はユーザの記録・対戦結果・対局のうち、メモが terms の全てを含む(大文字・小文字を
+	// 区別しない部分一致)ものを作成日時の新しい順に返す。削除済みのもの、削除済みの記録・
+	// 対戦結果に属するものは含めない。Snippet は設定しない。
+	Search(
+		ctx context.Context,
+		userId string,
+		terms []string,
+		limit int,
+		offset int,
+	) ([]*entity.MemoSearchHit, error)
+}
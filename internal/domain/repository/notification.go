@@ -31,6 +31,12 @@ type NotificationInterface interface {
 		limit int,
 	) ([]*entity.Notification, error)
 
+	// FindAllByUserId は指定ユーザーの全ての通知を created_at 昇順で返す(エクスポート用)。
+	FindAllByUserId(
+		ctx context.Context,
+		userId string,
+	) ([]*entity.Notification, error)
+
 	CountUnreadByUserId(
 		ctx context.Context,
 		userId string,
@@ -20,4 +20,10 @@ type OfficialEventInterface interface {
 		ctx context.Context,
 		id uint,
 	) (*entity.OfficialEvent, error)
+
+	// Search は条件に合う公式イベントを開催日の新しい順に返す。
+	Search(
+		ctx context.Context,
+		criteria *entity.OfficialEventSearchCriteria,
+	) ([]*entity.OfficialEventSearchHit, error)
 }
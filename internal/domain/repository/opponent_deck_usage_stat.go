@@ -8,6 +8,8 @@ import (
 )
 
 type OpponentDeckUsageStatInterface interface {
+	// FindOpponentDeckUsageStat は userId の対戦相手のデッキを集計する。excludePrivate なら
+	// 非公開(private_flg)の記録の対戦結果を除く。本人以外(フレンド)に見せるときに使う。
 	FindOpponentDeckUsageStat(
 		ctx context.Context,
 		userId string,
@@ -15,5 +17,6 @@ type OpponentDeckUsageStatInterface interface {
 		toDate time.Time,
 		deckId string,
 		regulationId uint,
+		excludePrivate bool,
 	) (*entity.OpponentDeckUsageStat, error)
 }
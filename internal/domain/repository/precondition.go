@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type preconditionCtxKey struct{}
+
+type precondition struct {
+	id        string
+	updatedAt time.Time
+}
+
+// WithExpectedUpdatedAt は、id のリソースをクライアントが最後に取得した版
+// (updated_at)を ctx に埋め込む。If-Match を受け取ったコントローラが設定し、
+// 各リポジトリの Save / Update / Delete が WHERE updated_at = ? の条件として使う。
+// TransactionManager と同様に ctx で伝播させることで、既存のメソッドシグネチャを
+// 変えずに全ての集約へ同じ前提条件を適用できる。
+//
+// id を一緒に保持するのは、1リクエスト内で別のリソース(記録の更新に伴う
+// 自由形式イベント等)を保存した際に、無関係な版で弾いてしまわないようにするため。
+func WithExpectedUpdatedAt(ctx context.Context, id string, updatedAt time.Time) context.Context {
+	return context.WithValue(ctx, preconditionCtxKey{}, precondition{id, updatedAt})
+}
+
+// ExpectedUpdatedAt は ctx に埋め込まれた id のリソースの版を返す。
+// 埋め込まれていない、または別のリソースの版であれば ok=false を返す。
+func ExpectedUpdatedAt(ctx context.Context, id string) (time.Time, bool) {
+	p, ok := ctx.Value(preconditionCtxKey{}).(precondition)
+	if !ok || p.id != id {
+		return time.Time{}, false
+	}
+
+	return p.updatedAt, true
+}
@@ -83,6 +83,13 @@ type RecordInterface interface {
 		offset int,
 	) ([]*entity.Record, error)
 
+	// FindByCriteria は criteria.UserId の記録のうち、criteria の条件を全て満たすものを
+	// 開催日の新しい順に返す。
+	FindByCriteria(
+		ctx context.Context,
+		criteria *entity.RecordCriteria,
+	) ([]*entity.Record, error)
+
 	// DeleteByUserId は退会時に、そのユーザの記録と、記録に紐づく対戦結果・対局・
 	// 自由形式イベントをまとめて論理削除する。
 	// 記録を1件ずつ Delete すると記録数(と対戦数)に比例してクエリが増え、
@@ -92,6 +99,13 @@ type RecordInterface interface {
 		uid string,
 	) error
 
+	// LockById はトランザクションの中で記録 id の行を終わりまでロックする。
+	// 同じ記録への対戦結果の取り込みを1つずつにするために使う。
+	LockById(
+		ctx context.Context,
+		id string,
+	) error
+
 	Save(
 		ctx context.Context,
 		entity *entity.Record,
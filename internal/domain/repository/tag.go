@@ -77,4 +77,11 @@ type TagInterface interface {
 		matchId string,
 		tagIds []string,
 	) error
+
+	// ReplaceRecordTags は recordId(記録)の付与タグを tagIds の集合に一致させる。
+	ReplaceRecordTags(
+		ctx context.Context,
+		recordId string,
+		tagIds []string,
+	) error
 }
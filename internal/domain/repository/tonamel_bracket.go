@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type TonamelBracketInterface interface {
+	FindById(
+		ctx context.Context,
+		tonamelEventId string,
+	) (*entity.TonamelBracket, error)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// TrashInterface は論理削除した記録・対戦結果・デッキ・デッキコードの一覧・復元と、
+// 保持期間を過ぎたものの物理削除を提供する。
+type TrashInterface interface {
+	// FindByUserId は deletedAfter 以降に削除されたユーザの記録・対戦結果・デッキ・デッキコードを、
+	// 削除日時の新しい順に返す。親と一緒に削除された子(削除済みの記録の対戦結果、
+	// 削除済みのデッキのデッキコード)は含めない。
+	FindByUserId(
+		ctx context.Context,
+		userId string,
+		deletedAfter time.Time,
+	) ([]*entity.TrashItem, error)
+
+	// FindById は削除済みのものを1件返す。存在しないか削除されていなければ
+	// apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		itemType entity.TrashItemType,
+		id string,
+	) (*entity.TrashItem, error)
+
+	// Restore は item を、一緒に削除された子(entity.TrashCascadeWindow 参照)とともに
+	// 1トランザクションで復元する。親が削除されたままなら apperror.ErrParentDeleted を返す。
+	Restore(
+		ctx context.Context,
+		item *entity.TrashItem,
+	) error
+
+	// CountPurgeable は deletedBefore より前に削除された行の件数を返す(Purge の dry-run 用)。
+	CountPurgeable(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) (*entity.TrashPurgeResult, error)
+
+	// Purge は deletedBefore より前に削除された行を、それを参照する中間テーブル等の行とともに
+	// 物理削除し、消した件数を返す。
+	Purge(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) (*entity.TrashPurgeResult, error)
+}
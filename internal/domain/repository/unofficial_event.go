@@ -12,6 +12,19 @@ type UnofficialEventInterface interface {
 		id string,
 	) (*entity.UnofficialEvent, error)
 
+	// FindByJoinCode は参加コードで共有している自由形式イベントを返す。
+	FindByJoinCode(
+		ctx context.Context,
+		joinCode string,
+	) (*entity.UnofficialEvent, error)
+
+	// FindByUserId はユーザの全ての自由形式イベントを作成順に返す。
+	FindByUserId(
+		ctx context.Context,
+		userId string,
+	) ([]*entity.UnofficialEvent, error)
+
+	// Save はイベントを保存する。参加コードが他のイベントと重なれば apperror.ErrAlreadyExists を返す。
 	Save(
 		ctx context.Context,
 		entity *entity.UnofficialEvent,
@@ -21,4 +34,12 @@ type UnofficialEventInterface interface {
 		ctx context.Context,
 		id string,
 	) error
+
+	// DeleteIfUnreferenced は userId が作ったイベント id を、どの記録からも大会からも
+	// 参照されていなければ削除する。参照が残っていれば何もせず nil を返す。
+	DeleteIfUnreferenced(
+		ctx context.Context,
+		id string,
+		userId string,
+	) error
 }
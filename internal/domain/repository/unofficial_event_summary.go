@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type UnofficialEventSummaryInterface interface {
+	// FindByUnofficialEventId はイベントに付いた公開の記録を参加者・デッキの指紋ごとに集計する。
+	FindByUnofficialEventId(
+		ctx context.Context,
+		unofficialEventId string,
+	) (*entity.UnofficialEventSummary, error)
+}
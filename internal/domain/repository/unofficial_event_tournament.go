@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type UnofficialEventTournamentInterface interface {
+	FindByUnofficialEventId(
+		ctx context.Context,
+		unofficialEventId string,
+	) (*entity.UnofficialEventTournament, error)
+
+	// LockByUnofficialEventId は大会の行を、トランザクションが終わるまで SELECT ... FOR UPDATE で
+	// ロックする。大会にしていなければ apperror.ErrRecordNotFound を返す。
+	LockByUnofficialEventId(
+		ctx context.Context,
+		unofficialEventId string,
+	) error
+
+	Create(
+		ctx context.Context,
+		tournament *entity.UnofficialEventTournament,
+	) error
+
+	Save(
+		ctx context.Context,
+		tournament *entity.UnofficialEventTournament,
+	) error
+
+	// FindParticipants は大会の参加者を登録順に返す。棄権した参加者も含む。
+	FindParticipants(
+		ctx context.Context,
+		unofficialEventId string,
+	) ([]*entity.UnofficialEventParticipant, error)
+
+	FindParticipantById(
+		ctx context.Context,
+		id string,
+	) (*entity.UnofficialEventParticipant, error)
+
+	CreateParticipant(
+		ctx context.Context,
+		participant *entity.UnofficialEventParticipant,
+	) error
+
+	SaveParticipant(
+		ctx context.Context,
+		participant *entity.UnofficialEventParticipant,
+	) error
+
+	DeleteParticipant(
+		ctx context.Context,
+		id string,
+	) error
+
+	// FindPairings は大会の組み合わせを回戦・卓番号の順に返す。
+	FindPairings(
+		ctx context.Context,
+		unofficialEventId string,
+	) ([]*entity.UnofficialEventPairing, error)
+
+	FindPairingById(
+		ctx context.Context,
+		id string,
+	) (*entity.UnofficialEventPairing, error)
+
+	CreatePairings(
+		ctx context.Context,
+		pairings []*entity.UnofficialEventPairing,
+	) error
+
+	SavePairing(
+		ctx context.Context,
+		pairing *entity.UnofficialEventPairing,
+	) error
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type UserExportInterface interface {
+	Create(
+		ctx context.Context,
+		export *entity.UserExport,
+	) error
+
+	// FindById は1件取得する。存在しなければ apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		id string,
+	) (*entity.UserExport, error)
+
+	// FindUnfinishedByUserId はユーザの未完了(実行待ち・実行中)のエクスポートのうち、
+	// 最も新しいものを返す。無ければ apperror.ErrRecordNotFound を返す。
+	FindUnfinishedByUserId(
+		ctx context.Context,
+		userId string,
+	) (*entity.UserExport, error)
+
+	// Claim は now 時点で実行できるエクスポートを最大 limit 件取り出し、実行中にして返す。
+	// 実行中のまま staleBefore より前から更新の無いもの(処理中にプロセスが落ちたもの)も取り出す。
+	// 複数のワーカーが同時に呼んでも、同じエクスポートを重ねて取り出さない。
+	Claim(
+		ctx context.Context,
+		now time.Time,
+		staleBefore time.Time,
+		limit int,
+	) ([]*entity.UserExport, error)
+
+	// Save は実行結果(状態・試行回数・次回実行日時・エラー・完了日時)を反映する。
+	Save(
+		ctx context.Context,
+		export *entity.UserExport,
+	) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// UserExportArchiveInterface はエクスポートしたユーザの全データを、アーカイブ(zip)にして
+// オブジェクトストレージへ非公開で置く操作と、置いたアーカイブを読み出す操作を提供する。
+type UserExportArchiveInterface interface {
+	Put(
+		ctx context.Context,
+		key string,
+		data *entity.UserExportData,
+	) error
+
+	// Get は置いたアーカイブを読み出す。無ければ apperror.ErrRecordNotFound を返す。
+	Get(
+		ctx context.Context,
+		key string,
+	) ([]byte, error)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+type UserRelationshipInterface interface {
+	// FindById は見つからなければ apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		id string,
+	) (*entity.UserRelationship, error)
+
+	// FindBetween は2人の間の関係を、向きを問わず全て返す(最大2件)。
+	FindBetween(
+		ctx context.Context,
+		userId string,
+		otherUserId string,
+	) ([]*entity.UserRelationship, error)
+
+	// FindPendingByTargetUserId は userId に届いているフレンド申請を新しい順に返す。
+	FindPendingByTargetUserId(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.UserRelationship, error)
+
+	// FindAcceptedByUserId は userId のフレンド関係を、向きを問わずフレンドになった新しい順に返す。
+	FindAcceptedByUserId(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.UserRelationship, error)
+
+	// IsFriend は2人がフレンドかを返す。
+	IsFriend(
+		ctx context.Context,
+		userId string,
+		otherUserId string,
+	) (bool, error)
+
+	Create(
+		ctx context.Context,
+		relationship *entity.UserRelationship,
+	) error
+
+	Save(
+		ctx context.Context,
+		relationship *entity.UserRelationship,
+	) error
+
+	// Delete は行を物理削除する。
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+}
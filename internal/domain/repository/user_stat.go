@@ -14,5 +14,6 @@ type UserStatInterface interface {
 		fromDate time.Time,
 		toDate time.Time,
 		regulationId uint,
+		recordTagId string,
 	) (*entity.UserStat, error)
 }
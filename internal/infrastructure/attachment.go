@@ -0,0 +1,158 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type Attachment struct {
+	db *gorm.DB
+}
+
+func NewAttachment(
+	db *gorm.DB,
+) repository.AttachmentInterface {
+	return &Attachment{db}
+}
+
+func newAttachmentEntity(m *model.Attachment) *entity.Attachment {
+	return &entity.Attachment{
+		ID:          m.ID,
+		CreatedAt:   m.CreatedAt,
+		UserId:      m.UserId,
+		RecordId:    m.RecordId.String,
+		MatchId:     m.MatchId.String,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Width:       m.Width,
+		Height:      m.Height,
+	}
+}
+
+func newAttachmentEntities(models []*model.Attachment) []*entity.Attachment {
+	attachments := make([]*entity.Attachment, 0, len(models))
+	for _, m := range models {
+		attachments = append(attachments, newAttachmentEntity(m))
+	}
+
+	return attachments
+}
+
+// attachmentOwnerColumn は添付先の種類に対応する列。
+func attachmentOwnerColumn(ownerType entity.AttachmentOwnerType) string {
+	if ownerType == entity.AttachmentOwnerTypeMatch {
+		return "match_id"
+	}
+
+	return "record_id"
+}
+
+func (i *Attachment) Create(
+	ctx context.Context,
+	attachment *entity.Attachment,
+) error {
+	m := &model.Attachment{
+		ID:          attachment.ID,
+		CreatedAt:   attachment.CreatedAt,
+		UserId:      attachment.UserId,
+		RecordId:    sql.NullString{String: attachment.RecordId, Valid: attachment.RecordId != ""},
+		MatchId:     sql.NullString{String: attachment.MatchId, Valid: attachment.MatchId != ""},
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Width:       attachment.Width,
+		Height:      attachment.Height,
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *Attachment) FindById(
+	ctx context.Context,
+	id string,
+) (*entity.Attachment, error) {
+	var m model.Attachment
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newAttachmentEntity(&m), nil
+}
+
+func (i *Attachment) FindByOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+) ([]*entity.Attachment, error) {
+	var models []*model.Attachment
+
+	if tx := dbFromContext(ctx, i.db).
+		Where(attachmentOwnerColumn(ownerType)+" = ?", ownerId).
+		Order("created_at ASC, id ASC").
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	return newAttachmentEntities(models), nil
+}
+
+func (i *Attachment) CountByOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+) (int64, error) {
+	var count int64
+
+	if tx := dbFromContext(ctx, i.db).
+		Model(&model.Attachment{}).
+		Where(attachmentOwnerColumn(ownerType)+" = ?", ownerId).
+		Count(&count); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return 0, tx.Error
+	}
+
+	return count, nil
+}
+
+func (i *Attachment) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).Delete(&model.Attachment{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *Attachment) FindPurgeable(
+	ctx context.Context,
+	deletedBefore time.Time,
+) ([]*entity.Attachment, error) {
+	var models []*model.Attachment
+
+	// 対象は Trash.Purge が消す行と同じ条件で選ぶ。
+	if tx := dbFromContext(ctx, i.db).
+		Where("id IN ("+trashPurgeAttachments+")", sql.Named("before", deletedBefore)).
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	return newAttachmentEntities(models), nil
+}
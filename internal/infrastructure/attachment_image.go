@@ -0,0 +1,279 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+)
+
+const (
+	attachmentContentType = "image/jpeg"
+
+	// attachmentMaxPixels を超える画素数の画像は受け付けない。加工中はデコードした画像と
+	// RGBA に展開した画像(1画素4バイト)を同時に持つため、2000万画素でも200MB近くになる。
+	// スマートフォンのカメラの標準の設定(1200万画素前後)で撮った写真は収まる。
+	attachmentMaxPixels = 20_000_000
+
+	// 本体は組み合わせ表やスコアシートの文字が読める大きさに縮め、サムネイルは一覧に並べる大きさにする。
+	attachmentMaxEdge          = 2560
+	attachmentThumbnailMaxEdge = 320
+
+	attachmentJPEGQuality          = 85
+	attachmentThumbnailJPEGQuality = 75
+)
+
+// processedAttachmentImage は保存する形に加工した写真。
+type processedAttachmentImage struct {
+	body      []byte
+	thumbnail []byte
+	width     int
+	height    int
+}
+
+// processAttachmentImage はアップロードされたファイルを保存する形に加工する。
+//
+// 形式は Content-Type ヘッダや拡張子を信じず、中身から判定する(JPEG/PNG のみ)。
+// 位置情報などを含む EXIF を残さないよう、デコードした画素から JPEG を作り直す
+// (再エンコードではメタデータを引き継がない)。ただし EXIF の向き(Orientation)を
+// 捨てるとスマートフォンの縦向きの写真が横倒しになるため、向きは画素に反映してから捨てる。
+// 元の大きさの画像の複製を増やさないよう、向きは縮めた後に反映する。
+func processAttachmentImage(body []byte) (*processedAttachmentImage, error) {
+	contentType := http.DetectContentType(body)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, apperror.ErrInvalidAttachment
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil || config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > attachmentMaxPixels {
+		return nil, apperror.ErrInvalidAttachment
+	}
+
+	var src image.Image
+	switch contentType {
+	case "image/jpeg":
+		src, err = jpeg.Decode(bytes.NewReader(body))
+	case "image/png":
+		src, err = png.Decode(bytes.NewReader(body))
+	}
+	if err != nil {
+		return nil, apperror.ErrInvalidAttachment
+	}
+
+	img := resizeToFit(flattenImage(src), attachmentMaxEdge)
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(body))
+	}
+
+	encoded, err := encodeJPEG(img, attachmentJPEGQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail, err := encodeJPEG(resizeToFit(img, attachmentThumbnailMaxEdge), attachmentThumbnailJPEGQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	return &processedAttachmentImage{
+		body:      encoded,
+		thumbnail: thumbnail,
+		width:     img.Bounds().Dx(),
+		height:    img.Bounds().Dy(),
+	}, nil
+}
+
+// encodeJPEG は画像を JPEG にする。デッキ画像の変換(convertPNG2JPG)もこれを使う。
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// flattenImage は画像を原点始まりの RGBA にする。JPEG は透明を持てないため、
+// PNG の透明な部分は黒ではなく白で塗る。
+func flattenImage(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Over)
+
+	return dst
+}
+
+// applyOrientation は EXIF の Orientation(1〜8)どおりに画素を回転・反転する。
+// 反転と180度回転(2〜4)は src をその場で書き換え、90度回転を含むもの(5〜8)は
+// 幅と高さが入れ替わるため新しい画像を作る。
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	if orientation <= 4 {
+		swap := func(x1, y1, x2, y2 int) {
+			c1, c2 := src.RGBAAt(x1, y1), src.RGBAAt(x2, y2)
+			src.SetRGBA(x1, y1, c2)
+			src.SetRGBA(x2, y2, c1)
+		}
+
+		switch orientation {
+		case 2: // 左右反転
+			for y := 0; y < h; y++ {
+				for x := 0; x < w/2; x++ {
+					swap(x, y, w-1-x, y)
+				}
+			}
+		case 3: // 180度回転
+			for i := 0; i < w*h/2; i++ {
+				x, y := i%w, i/w
+				swap(x, y, w-1-x, h-1-y)
+			}
+		case 4: // 上下反転
+			for y := 0; y < h/2; y++ {
+				for x := 0; x < w; x++ {
+					swap(x, y, x, h-1-y)
+				}
+			}
+		}
+
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, h, w))
+	for y := 0; y < w; y++ {
+		for x := 0; x < h; x++ {
+			var sx, sy int
+			switch orientation {
+			case 5: // 左上と右下を結ぶ軸で反転
+				sx, sy = y, x
+			case 6: // 時計回りに90度回転
+				sx, sy = y, h-1-x
+			case 7: // 右上と左下を結ぶ軸で反転
+				sx, sy = w-1-y, h-1-x
+			case 8: // 反時計回りに90度回転
+				sx, sy = w-1-y, x
+			}
+
+			dst.SetRGBA(x, y, src.RGBAAt(sx, sy))
+		}
+	}
+
+	return dst
+}
+
+// resizeToFit は長辺が maxEdge を超える画像を、縦横比を保って縮める。
+// 縮小先の1画素に対応する範囲の画素を平均する(面積平均法)ため、文字の細い線も潰れにくい。
+func resizeToFit(src *image.RGBA, maxEdge int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w <= maxEdge && h <= maxEdge {
+		return src
+	}
+
+	dw, dh := maxEdge, h*maxEdge/w
+	if h > w {
+		dw, dh = w*maxEdge/h, maxEdge
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					c := src.RGBAAt(sx, sy)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					b += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: uint8(a / n)})
+		}
+	}
+
+	return dst
+}
+
+// jpegOrientation は JPEG の EXIF(APP1)から Orientation を読む。読めなければ 1(そのまま)を返す。
+func jpegOrientation(body []byte) int {
+	// SOI の後に APP セグメントが続く。画像データ(SOS)より後に EXIF は無い。
+	for i := 2; i+4 <= len(body); {
+		if body[i] != 0xFF {
+			return 1
+		}
+
+		marker := body[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(body[i+2 : i+4]))
+		if size < 2 || i+2+size > len(body) {
+			return 1
+		}
+
+		segment := body[i+4 : i+2+size]
+		if marker == 0xE1 && len(segment) >= 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+
+		i += 2 + size
+	}
+
+	return 1
+}
+
+// exifOrientation は EXIF の TIFF ヘッダ以降から、0th IFD の Orientation(0x0112)を読む。
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for n := 0; n < count; n++ {
+		entry := offset + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			// 型は SHORT で、値は値フィールドの先頭2バイトに入る。
+			return int(order.Uint16(tiff[entry+8 : entry+10]))
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,242 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// newTestJPEG は w×h の JPEG を作る。orientation が 0 でなければ、その向きと
+// 位置情報に見立てた文字列を持つ EXIF(APP1)を SOI の直後に差し込む。
+func newTestJPEG(t *testing.T, w int, h int, orientation uint16) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, jpeg.Encode(buf, img, nil))
+	body := buf.Bytes()
+
+	if orientation == 0 {
+		return body
+	}
+
+	// 0th IFD に Orientation(SHORT)を1つだけ持つビッグエンディアンの TIFF。
+	tiff := []byte("MM\x00\x2A\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)
+	tiff = binary.BigEndian.AppendUint32(tiff, 0)
+	tiff = append(tiff, []byte("GPS 35.6812N 139.7671E")...)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	ret := append([]byte{}, body[:2]...)
+	ret = append(ret, app1...)
+	return append(ret, body[2:]...)
+}
+
+func TestProcessAttachmentImage(t *testing.T) {
+	t.Run("正常系_EXIFを除いてJPEGに作り直す", func(t *testing.T) {
+		ret, err := processAttachmentImage(newTestJPEG(t, 40, 20, 1))
+
+		require.NoError(t, err)
+		require.Equal(t, 40, ret.width)
+		require.Equal(t, 20, ret.height)
+		require.Equal(t, "image/jpeg", attachmentContentType)
+		require.NotContains(t, string(ret.body), "Exif")
+		require.NotContains(t, string(ret.body), "GPS")
+		require.NotContains(t, string(ret.thumbnail), "Exif")
+	})
+
+	t.Run("正常系_EXIFの向きを画素に反映する", func(t *testing.T) {
+		// 6 は時計回りに90度回転して表示する向きのため、幅と高さが入れ替わる。
+		ret, err := processAttachmentImage(newTestJPEG(t, 40, 20, 6))
+
+		require.NoError(t, err)
+		require.Equal(t, 20, ret.width)
+		require.Equal(t, 40, ret.height)
+
+		config, err := jpeg.DecodeConfig(bytes.NewReader(ret.body))
+		require.NoError(t, err)
+		require.Equal(t, 20, config.Width)
+		require.Equal(t, 40, config.Height)
+	})
+
+	t.Run("正常系_PNGの透明な部分は白で塗る", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+
+		buf := new(bytes.Buffer)
+		require.NoError(t, png.Encode(buf, img))
+
+		ret, err := processAttachmentImage(buf.Bytes())
+
+		require.NoError(t, err)
+
+		decoded, err := jpeg.Decode(bytes.NewReader(ret.body))
+		require.NoError(t, err)
+
+		r, g, b, _ := decoded.At(8, 8).RGBA()
+		require.Greater(t, r>>8, uint32(240))
+		require.Greater(t, g>>8, uint32(240))
+		require.Greater(t, b>>8, uint32(240))
+	})
+
+	t.Run("正常系_大きな写真は長辺を縮め、サムネイルはさらに小さくする", func(t *testing.T) {
+		ret, err := processAttachmentImage(newTestJPEG(t, attachmentMaxEdge*2, attachmentMaxEdge, 0))
+
+		require.NoError(t, err)
+		require.Equal(t, attachmentMaxEdge, ret.width)
+		require.Equal(t, attachmentMaxEdge/2, ret.height)
+
+		config, err := jpeg.DecodeConfig(bytes.NewReader(ret.thumbnail))
+		require.NoError(t, err)
+		require.Equal(t, attachmentThumbnailMaxEdge, config.Width)
+		require.Equal(t, attachmentThumbnailMaxEdge/2, config.Height)
+	})
+
+	t.Run("異常系_画素数が多すぎる画像はErrInvalidAttachmentを返す", func(t *testing.T) {
+		body := newTestJPEG(t, 40, 20, 0)
+
+		// 展開せずに弾くことを確かめるため、SOF0 の高さと幅だけを書き換える。
+		sof := bytes.Index(body, []byte{0xFF, 0xC0})
+		require.NotEqual(t, -1, sof)
+		binary.BigEndian.PutUint16(body[sof+5:sof+7], 4000)
+		binary.BigEndian.PutUint16(body[sof+7:sof+9], attachmentMaxPixels/4000+1)
+
+		ret, err := processAttachmentImage(body)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidAttachment)
+		require.Nil(t, ret)
+	})
+
+	t.Run("異常系_画像でないファイルはErrInvalidAttachmentを返す", func(t *testing.T) {
+		ret, err := processAttachmentImage([]byte("%PDF-1.7\n"))
+
+		require.ErrorIs(t, err, apperror.ErrInvalidAttachment)
+		require.Nil(t, ret)
+	})
+
+	t.Run("異常系_JPEGを装った壊れたファイルはErrInvalidAttachmentを返す", func(t *testing.T) {
+		ret, err := processAttachmentImage(newTestJPEG(t, 40, 20, 0)[:64])
+
+		require.ErrorIs(t, err, apperror.ErrInvalidAttachment)
+		require.Nil(t, ret)
+	})
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 左上から順に 0, 1, 2, ... の値を R に持つ 3×2 の画像。
+	newImage := func() *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+		for y := 0; y < 2; y++ {
+			for x := 0; x < 3; x++ {
+				img.SetRGBA(x, y, color.RGBA{R: uint8(y*3 + x), A: 255})
+			}
+		}
+
+		return img
+	}
+
+	pixels := func(img *image.RGBA) [][]uint8 {
+		ret := [][]uint8{}
+		for y := 0; y < img.Bounds().Dy(); y++ {
+			row := []uint8{}
+			for x := 0; x < img.Bounds().Dx(); x++ {
+				row = append(row, img.RGBAAt(x, y).R)
+			}
+			ret = append(ret, row)
+		}
+
+		return ret
+	}
+
+	for orientation, expected := range map[int][][]uint8{
+		1: {{0, 1, 2}, {3, 4, 5}},
+		2: {{2, 1, 0}, {5, 4, 3}},
+		3: {{5, 4, 3}, {2, 1, 0}},
+		4: {{3, 4, 5}, {0, 1, 2}},
+		5: {{0, 3}, {1, 4}, {2, 5}},
+		6: {{3, 0}, {4, 1}, {5, 2}},
+		7: {{5, 2}, {4, 1}, {3, 0}},
+		8: {{2, 5}, {1, 4}, {0, 3}},
+	} {
+		t.Run(fmt.Sprintf("正常系_Orientation%dどおりに並べ替える", orientation), func(t *testing.T) {
+			src := newImage()
+			ret := applyOrientation(src, orientation)
+
+			require.Equal(t, expected, pixels(ret))
+
+			// 幅と高さが変わらない向きは、複製せずにその場で書き換える。
+			if orientation <= 4 {
+				require.Same(t, src, ret)
+			}
+		})
+	}
+}
+
+func TestAttachmentStorage(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	attachment := &entity.Attachment{ID: "01HD7Y3K8D6FDHMHTZ2GT41TR1", UserId: uid, RecordId: "01HD7Y3K8D6FDHMHTZ2GT41TR0"}
+
+	t.Run("正常系_本体とサムネイルを置き、両方を消す", func(t *testing.T) {
+		storage := NewInMemoryDeckAssetStorage()
+		r := NewAttachmentStorage(storage)
+
+		image, err := r.Put(context.Background(), attachment, newTestJPEG(t, 40, 20, 0))
+
+		require.NoError(t, err)
+		require.Equal(t, &entity.AttachmentImage{ContentType: "image/jpeg", Size: image.Size, Width: 40, Height: 20}, image)
+
+		body, err := r.Get(context.Background(), attachment.ObjectKey())
+		require.NoError(t, err)
+		require.Len(t, body, image.Size)
+
+		_, err = r.Get(context.Background(), attachment.ThumbnailKey())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Delete(context.Background(), attachment))
+
+		for _, key := range []string{attachment.ObjectKey(), attachment.ThumbnailKey()} {
+			exists, err := storage.Exists(context.Background(), key)
+			require.NoError(t, err)
+			require.False(t, exists)
+		}
+	})
+
+	t.Run("異常系_画像でないファイルは置かない", func(t *testing.T) {
+		storage := NewInMemoryDeckAssetStorage()
+		r := NewAttachmentStorage(storage)
+
+		image, err := r.Put(context.Background(), attachment, []byte("not an image"))
+
+		require.ErrorIs(t, err, apperror.ErrInvalidAttachment)
+		require.Nil(t, image)
+
+		exists, err := storage.Exists(context.Background(), attachment.ObjectKey())
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// 写真は記録・対戦結果を見られる人にだけAPIを通して返すもので、共有キャッシュ(CDN)には残さない。
+const attachmentCacheControl = "private, no-store"
+
+// AttachmentStorage は添付した写真を加工して DeckAssetStorage へ置く。ストレージは公開しないもの
+// (NewPrivateS3DeckAssetStorage 等)を渡す。
+type AttachmentStorage struct {
+	storage DeckAssetStorage
+}
+
+func NewAttachmentStorage(storage DeckAssetStorage) repository.AttachmentStorageInterface {
+	return &AttachmentStorage{storage: storage}
+}
+
+func (i *AttachmentStorage) Put(
+	ctx context.Context,
+	attachment *entity.Attachment,
+	body []byte,
+) (*entity.AttachmentImage, error) {
+	processed, err := processAttachmentImage(body)
+	if err != nil {
+		if errors.Is(err, apperror.ErrInvalidAttachment) {
+			return nil, err
+		}
+
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if err := i.storage.Put(ctx, attachment.ObjectKey(), processed.body, attachmentContentType, attachmentCacheControl); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if err := i.storage.Put(ctx, attachment.ThumbnailKey(), processed.thumbnail, attachmentContentType, attachmentCacheControl); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return &entity.AttachmentImage{
+		ContentType: attachmentContentType,
+		Size:        len(processed.body),
+		Width:       processed.width,
+		Height:      processed.height,
+	}, nil
+}
+
+func (i *AttachmentStorage) Get(
+	ctx context.Context,
+	key string,
+) ([]byte, error) {
+	body, err := i.storage.Get(ctx, key)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (i *AttachmentStorage) Delete(
+	ctx context.Context,
+	attachment *entity.Attachment,
+) error {
+	for _, key := range []string{attachment.ObjectKey(), attachment.ThumbnailKey()} {
+		if err := i.storage.Delete(ctx, key); err != nil {
+			logError(ctx, err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var attachmentColumns = []string{
+	"id", "created_at", "user_id", "record_id", "match_id", "content_type", "size", "width", "height",
+}
+
+func TestAttachmentInfrastructure(t *testing.T) {
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TR0"
+	matchId := "01HD7Y3K8D6FDHMHTZ2GT41TR2"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_対戦結果の写真はrecord_idをNULLにする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			attachment := entity.NewAttachment(id, now, uid, entity.AttachmentOwnerTypeMatch, matchId)
+			attachment.SetImage(&entity.AttachmentImage{ContentType: "image/jpeg", Size: 1024, Width: 40, Height: 20})
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "attachments"`)).
+				WithArgs(id, now, uid, nil, matchId, "image/jpeg", 1024, 40, 20).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Create(context.Background(), attachment))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("正常系_添付先を含めて返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "attachments" WHERE id = $1 ORDER BY "attachments"."id" LIMIT $2`,
+			)).WithArgs(id, 1).WillReturnRows(
+				sqlmock.NewRows(attachmentColumns).AddRow(id, now, uid, recordId, nil, "image/jpeg", 1024, 40, 20),
+			)
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.NoError(t, err)
+			require.True(t, ret.BelongsTo(entity.AttachmentOwnerTypeRecord, recordId))
+			require.Equal(t, 1024, ret.Size)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_存在しないIDはErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectQuery(`SELECT \* FROM "attachments"`).
+				WithArgs(id, 1).WillReturnRows(sqlmock.NewRows(attachmentColumns))
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByOwner", func(t *testing.T) {
+		t.Run("正常系_対戦結果の写真を添付した順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "attachments" WHERE match_id = $1 ORDER BY created_at ASC, id ASC`,
+			)).WithArgs(matchId).WillReturnRows(
+				sqlmock.NewRows(attachmentColumns).AddRow(id, now, uid, nil, matchId, "image/jpeg", 1024, 40, 20),
+			)
+
+			ret, err := r.FindByOwner(context.Background(), entity.AttachmentOwnerTypeMatch, matchId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, matchId, ret[0].MatchId)
+			require.Empty(t, ret[0].RecordId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_写真が無ければ空のスライスを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachments" WHERE record_id = $1`)).
+				WithArgs(recordId).WillReturnRows(sqlmock.NewRows(attachmentColumns))
+
+			ret, err := r.FindByOwner(context.Background(), entity.AttachmentOwnerTypeRecord, recordId)
+
+			require.NoError(t, err)
+			require.NotNil(t, ret)
+			require.Empty(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("CountByOwner", func(t *testing.T) {
+		t.Run("正常系_記録に添付した枚数を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attachments" WHERE record_id = $1`)).
+				WithArgs(recordId).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+			count, err := r.CountByOwner(context.Background(), entity.AttachmentOwnerTypeRecord, recordId)
+
+			require.NoError(t, err)
+			require.Equal(t, int64(3), count)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("正常系_行を物理削除する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "attachments" WHERE id = $1`)).
+				WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Delete(context.Background(), id))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindPurgeable", func(t *testing.T) {
+		t.Run("正常系_ゴミ箱の物理削除で一緒に消える写真を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewAttachment(db)
+
+			before := now.Add(-entity.TrashRetention)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachments" WHERE id IN (`)).
+				WillReturnRows(
+					sqlmock.NewRows(attachmentColumns).AddRow(id, now, uid, recordId, nil, "image/jpeg", 1024, 40, 20),
+				)
+
+			ret, err := r.FindPurgeable(context.Background(), before)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, id, ret[0].ID)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
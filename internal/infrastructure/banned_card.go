@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type BannedCard struct {
+	db *gorm.DB
+}
+
+func NewBannedCard(
+	db *gorm.DB,
+) repository.BannedCardInterface {
+	return &BannedCard{db}
+}
+
+func (i *BannedCard) FindByRegulationIdAndDate(
+	ctx context.Context,
+	regulationId uint,
+	date time.Time,
+) ([]*entity.BannedCard, error) {
+	var models []*model.BannedCard
+
+	// 禁止が解除されたカードは to_date(解除前日)までを禁止期間とする。
+	if tx := dbFromContext(ctx, i.db).
+		Where("regulation_id = ? AND from_date <= ? AND (to_date IS NULL OR to_date >= ?)", regulationId, date, date).
+		Order("id ASC").
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.BannedCard, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, entity.NewBannedCard(m.RegulationId, m.CardName, m.FromDate))
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestBannedCardInfrastructure(t *testing.T) {
+	date := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+
+	t.Run("FindByRegulationIdAndDate", func(t *testing.T) {
+		t.Run("正常系_日付時点で禁止中のカードを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewBannedCard(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "banned_cards" WHERE regulation_id = $1 AND from_date <= $2 AND (to_date IS NULL OR to_date >= $3) ORDER BY id ASC`,
+			)).WithArgs(entity.RegulationIdHallOfFame, date, date).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "regulation_id", "card_name", "from_date", "to_date"}).
+					AddRow(1, entity.RegulationIdHallOfFame, "ネクロズマ", date.AddDate(-1, 0, 0), nil),
+			)
+
+			ret, err := r.FindByRegulationIdAndDate(context.Background(), entity.RegulationIdHallOfFame, date)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, "ネクロズマ", ret[0].CardName)
+			require.Equal(t, entity.RegulationIdHallOfFame, ret[0].RegulationId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_該当が無ければ空で返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewBannedCard(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM "banned_cards"`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "regulation_id", "card_name", "from_date", "to_date"}))
+
+			ret, err := r.FindByRegulationIdAndDate(context.Background(), entity.RegulationIdStandard, date)
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
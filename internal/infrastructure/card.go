@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type Card struct {
+	db *gorm.DB
+}
+
+func NewCard(
+	db *gorm.DB,
+) repository.CardInterface {
+	return &Card{db}
+}
+
+// cardRow は cards に、同名カードの既知の区分(deck_code_cards.category)を付けた結果を受けるための構造体。
+type cardRow struct {
+	Id             uint
+	CardName       string
+	CollectionCode string
+	RegulationMark string
+	Category       string
+}
+
+func (i *Card) FindByNames(
+	ctx context.Context,
+	cardNames []string,
+) ([]*entity.Card, error) {
+	if len(cardNames) == 0 {
+		return []*entity.Card{}, nil
+	}
+
+	var rows []*cardRow
+
+	// cards には公式サイトのデッキ結果ページでの区分が無いため、同名カードが
+	// いずれかのデッキコードで分類されていればその区分を使う。
+	if tx := dbFromContext(ctx, i.db).Raw(
+		`SELECT c.id, c.card_name, c.collection_code, c.regulation_mark,
+		        COALESCE((
+		            SELECT dcc.category
+		            FROM deck_code_cards AS dcc
+		            JOIN cards AS known ON known.id = dcc.card_id
+		            WHERE known.card_name = c.card_name
+		            LIMIT 1
+		        ), '') AS category
+		 FROM cards AS c
+		 WHERE c.card_name IN ?
+		 ORDER BY c.id ASC`,
+		cardNames,
+	).Scan(&rows); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.Card, 0, len(rows))
+	for _, row := range rows {
+		ret = append(ret, entity.NewCard(
+			row.Id,
+			row.CardName,
+			row.CollectionCode,
+			row.RegulationMark,
+			entity.DeckCardCategory(row.Category),
+		))
+	}
+
+	return ret, nil
+}
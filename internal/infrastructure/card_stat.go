@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type CardStat struct {
+	db *gorm.DB
+}
+
+func NewCardStat(
+	db *gorm.DB,
+) repository.CardStatInterface {
+	return &CardStat{db}
+}
+
+type cardStatDeckCodeResult struct {
+	DeckCodeId   string
+	MatchCount   uint
+	Wins         uint
+	Draws        uint
+	GameCount    uint
+	GoFirstCount uint
+	GoFirstWins  uint
+	GoSecondWins uint
+}
+
+/*
+ * カード単位の成績の素材を、デッキコード(バージョン)ごとに集計する。
+ *
+ * カードごとに対戦を引く(カード数ぶんのクエリ)のではなく、バージョンごとに1回で集計し、
+ * 採用/不採用の振り分けは entity.CalculateUserCardStat が deck_code_cards と突き合わせて行う。
+ * 1つのバージョンには数十種類のカードが入るため、SQL で cards を JOIN して展開すると
+ * 行数がカード種類数倍に膨らむ。
+ */
+func (i *CardStat) FindDeckCodeAggregates(
+	ctx context.Context,
+	userId string,
+	fromDate time.Time,
+	toDate time.Time,
+	regulationId uint,
+) ([]*entity.CardStatDeckCodeAggregate, error) {
+	var results []cardStatDeckCodeResult
+
+	// records.deck_code_id を正としてバージョンに紐付ける(deck_usage_stat.go の records.deck_id と同じ方針)。
+	// games は1対戦(match)につき複数行になりうる(BO3)ため、対戦数・勝敗は matches.id の
+	// DISTINCT で数え、先攻/後攻は games 行をそのまま数える。
+	query := i.db.WithContext(ctx).Table("matches").
+		Select("records.deck_code_id AS deck_code_id, COUNT(DISTINCT matches.id) AS match_count, COUNT(DISTINCT CASE WHEN matches.victory_flg THEN matches.id END) AS wins, COUNT(DISTINCT CASE WHEN matches.draw_flg THEN matches.id END) AS draws, COUNT(games.id) AS game_count, SUM(CASE WHEN games.go_first THEN 1 ELSE 0 END) AS go_first_count, SUM(CASE WHEN games.go_first AND games.winning_flg THEN 1 ELSE 0 END) AS go_first_wins, SUM(CASE WHEN games.go_first = false AND games.winning_flg THEN 1 ELSE 0 END) AS go_second_wins").
+		Joins("JOIN records ON matches.record_id = records.id").
+		Joins("LEFT JOIN games ON games.match_id = matches.id AND games.deleted_at IS NULL").
+		Where("records.user_id = ? AND records.deleted_at IS NULL AND records.ignore_stats_flg = false AND matches.deleted_at IS NULL AND records.deck_code_id != ''", userId)
+
+	// レギュレーション(スタンダード/エクストラ/殿堂)での絞り込み。0 は絞り込みなし。
+	if regulationId != 0 {
+		query = query.Where("records.regulation_id = ?", regulationId)
+	}
+
+	if !fromDate.IsZero() {
+		query = query.Where("records.event_date >= ?", fromDate)
+	}
+	if !toDate.IsZero() {
+		query = query.Where("records.event_date < ?", toDate)
+	}
+
+	if tx := query.Group("records.deck_code_id").Order("records.deck_code_id").Scan(&results); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.CardStatDeckCodeAggregate, 0, len(results))
+	for _, r := range results {
+		ret = append(ret, entity.NewCardStatDeckCodeAggregate(
+			r.DeckCodeId,
+			r.MatchCount,
+			r.Wins,
+			r.Draws,
+			r.GameCount,
+			r.GoFirstCount,
+			r.GoFirstWins,
+			r.GoSecondWins,
+		))
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const cardStatDeckCodeQuery = `SELECT records.deck_code_id AS deck_code_id, COUNT(DISTINCT matches.id) AS match_count, COUNT(DISTINCT CASE WHEN matches.victory_flg THEN matches.id END) AS wins, COUNT(DISTINCT CASE WHEN matches.draw_flg THEN matches.id END) AS draws, COUNT(games.id) AS game_count, SUM(CASE WHEN games.go_first THEN 1 ELSE 0 END) AS go_first_count, SUM(CASE WHEN games.go_first AND games.winning_flg THEN 1 ELSE 0 END) AS go_first_wins, SUM(CASE WHEN games.go_first = false AND games.winning_flg THEN 1 ELSE 0 END) AS go_second_wins FROM "matches" JOIN records ON matches.record_id = records.id LEFT JOIN games ON games.match_id = matches.id AND games.deleted_at IS NULL WHERE records.user_id = $1 AND records.deleted_at IS NULL AND records.ignore_stats_flg = false AND matches.deleted_at IS NULL AND records.deck_code_id != ''`
+
+var cardStatDeckCodeColumns = []string{"deck_code_id", "match_count", "wins", "draws", "game_count", "go_first_count", "go_first_wins", "go_second_wins"}
+
+func TestCardStatInfrastructure(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+
+	t.Run("FindDeckCodeAggregates", func(t *testing.T) {
+		t.Run("正常系_デッキコードごとの集計値を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewCardStat(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(cardStatDeckCodeQuery + ` GROUP BY "records"."deck_code_id" ORDER BY records.deck_code_id`)).
+				WithArgs(userId).
+				WillReturnRows(sqlmock.NewRows(cardStatDeckCodeColumns).AddRow("v1", 4, 2, 1, 5, 3, 2, 1))
+
+			ret, err := r.FindDeckCodeAggregates(context.Background(), userId, time.Time{}, time.Time{}, 0)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, "v1", ret[0].DeckCodeId)
+			require.Equal(t, uint(4), ret[0].MatchCount)
+			require.Equal(t, uint(1), ret[0].DrawCount)
+			require.Equal(t, uint(1), ret[0].GoSecondWins)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_レギュレーションと期間で絞り込む", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewCardStat(db)
+
+			fromDate := time.Date(2026, 7, 1, 0, 0, 0, 0, time.Local)
+			toDate := time.Date(2026, 8, 1, 0, 0, 0, 0, time.Local)
+
+			// 条件を追加すると基本条件は括弧で囲まれる
+			mock.ExpectQuery(regexp.QuoteMeta(`records.deck_code_id != '') AND records.regulation_id = $2 AND records.event_date >= $3 AND records.event_date < $4`)).
+				WithArgs(userId, 1, fromDate, toDate).
+				WillReturnRows(sqlmock.NewRows(cardStatDeckCodeColumns))
+
+			ret, err := r.FindDeckCodeAggregates(context.Background(), userId, fromDate, toDate, 1)
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -0,0 +1,50 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestCardInfrastructure(t *testing.T) {
+	t.Run("FindByNames", func(t *testing.T) {
+		t.Run("正常系_同名カードの既知の区分を付けて返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewCard(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE c.card_name IN ($1,$2)`)).
+				WithArgs("ピカチュウex", "ナンジャモ").
+				WillReturnRows(
+					sqlmock.NewRows([]string{"id", "card_name", "collection_code", "regulation_mark", "category"}).
+						AddRow(47003, "ピカチュウex", "SV8", "H", "pokemon").
+						AddRow(48000, "ナンジャモ", "SV4a", "H", ""),
+				)
+
+			ret, err := r.FindByNames(context.Background(), []string{"ピカチュウex", "ナンジャモ"})
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, uint(47003), ret[0].ID)
+			require.Equal(t, "SV8", ret[0].CollectionCode)
+			require.Equal(t, entity.DeckCardCategoryPokemon, ret[0].Category)
+			require.Equal(t, entity.DeckCardCategory(""), ret[1].Category)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_カード名が空ならクエリせず空で返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewCard(db)
+
+			ret, err := r.FindByNames(context.Background(), []string{})
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -167,6 +167,125 @@ func (i *Deck) Find(
 	return ret, nil
 }
 
+// FindPublicByUserId は uid のデッキのうち、公開中(非公開・アーカイブ済みでない)のものを返す。
+func (i *Deck) FindPublicByUserId(
+	ctx context.Context,
+	uid string,
+	limit int,
+	offset int,
+) ([]*entity.Deck, error) {
+	var deckJoinDeckCodes []*model.DeckJoinDeckCode
+
+	tx := i.db.Table(
+		"decks",
+	).Select(`
+		decks.id AS deck_id,
+		decks.created_at AS deck_created_at,
+		decks.updated_at AS deck_updated_at,
+		decks.deleted_at AS deck_deleted_at,
+		decks.archived_at AS deck_archived_at,
+		user_favorite_decks.created_at AS deck_favorited_at,
+		decks.user_id AS deck_user_id,
+		decks.name AS deck_name,
+		decks.private_flg AS deck_private_flg,
+		deck_codes.id AS deck_code_id,
+		deck_codes.created_at AS deck_code_created_at,
+		deck_codes.updated_at AS deck_code_updated_at,
+		deck_codes.deleted_at AS deck_code_deleted_at,
+		deck_codes.user_id AS deck_code_user_id,
+		deck_codes.deck_id AS deck_code_deck_id,
+		deck_codes.code AS deck_code_code,
+		deck_codes.private_code_flg AS deck_code_private_code_flg,
+		deck_codes.memo AS deck_code_memo
+	`,
+	).Joins(`
+		LEFT JOIN (
+			SELECT DISTINCT ON (deck_id)
+				id,
+				created_at,
+				updated_at,
+				deleted_at,
+				user_id,
+				deck_id,
+				code,
+				private_code_flg,
+				memo
+			FROM deck_codes
+			WHERE user_id = ? AND deleted_at IS NULL
+			ORDER BY deck_id, created_at DESC, updated_at DESC
+		) AS deck_codes ON decks.id = deck_codes.deck_id
+			LEFT JOIN user_favorite_decks
+				ON user_favorite_decks.deck_id = decks.id
+				AND user_favorite_decks.user_id = decks.user_id
+	`, uid,
+	).Where(
+		"decks.user_id = ? AND decks.private_flg = false AND decks.archived_at IS NULL AND decks.deleted_at IS NULL", uid,
+	).Order(
+		"decks.created_at DESC",
+	).Limit(
+		limit,
+	).Offset(
+		offset,
+	).Scan(&deckJoinDeckCodes)
+
+	if tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	if len(deckJoinDeckCodes) == 0 {
+		return []*entity.Deck{}, nil
+	}
+
+	spritesByDeckId, err := findDeckPokemonSpritesByDeckIds(ctx, i.db, deckIdsOf(deckJoinDeckCodes))
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	tagsByDeckId, err := findTagsByDeckIds(ctx, i.db, deckIdsOf(deckJoinDeckCodes))
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var ret []*entity.Deck
+
+	for _, djdc := range deckJoinDeckCodes {
+		pokemonSprites := spritesByDeckId[djdc.DeckID]
+
+		deck := entity.NewDeck(
+			djdc.DeckID,
+			djdc.DeckCreatedAt,
+			djdc.DeckArchivedAt.Time,
+			djdc.DeckFavoritedAt.Time,
+			djdc.DeckUserId,
+			djdc.DeckName,
+			djdc.DeckPrivateFlg,
+			entity.NewDeckCode(
+				djdc.DeckCodeID,
+				djdc.DeckCodeCreatedAt,
+				djdc.DeckCodeUserId,
+				djdc.DeckCodeDeckId,
+				djdc.DeckCodeCode,
+				djdc.DeckCodePrivateCodeFlg,
+				djdc.DeckCodeMemo,
+			),
+			pokemonSprites,
+		)
+		deck.Tags = tagsByDeckId[djdc.DeckID]
+		ret = append(ret, deck)
+	}
+
+	// latest_deck_code の付与タグをまとめてロードして載せる。
+	if err := attachLatestDeckCodeTags(ctx, i.db, ret); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return ret, nil
+}
+
 func (i *Deck) FindAll(
 	ctx context.Context,
 	uid string,
@@ -498,6 +617,7 @@ func (i *Deck) FindById(
 		pokemonSprites,
 	)
 	ret.Tags = tagsByDeckId[deckJoinDeckCodes.DeckID]
+	ret.UpdatedAt = deckJoinDeckCodes.DeckUpdatedAt
 
 	// latest_deck_code の付与タグをロードして載せる。
 	if err := attachLatestDeckCodeTags(ctx, i.db, []*entity.Deck{ret}); err != nil {
@@ -934,6 +1054,7 @@ func (i *Deck) Save(
 		deckPokemonSpriteModals = append(deckPokemonSpriteModals, model.NewDeckPokemonSprite(entity.ID, position, pokemonSprite.ID))
 	}
 
+	var err error
 	if entity.LatestDeckCode != nil {
 		deckcode := model.NewDeckCode(
 			entity.LatestDeckCode.ID,
@@ -945,9 +1066,9 @@ func (i *Deck) Save(
 			entity.LatestDeckCode.Memo,
 		)
 
-		return i.db.Transaction(func(tx *gorm.DB) error {
+		err = dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
 			// Deck を先に保存して FK 制約を満たす
-			if err := tx.Save(deck).Error; err != nil {
+			if err := saveIfUnmodified(ctx, tx, entity.ID, entity.UpdatedAt, deck); err != nil {
 				logError(ctx, err)
 				return err
 			}
@@ -975,9 +1096,9 @@ func (i *Deck) Save(
 			return nil
 		}, &sql.TxOptions{Isolation: sql.LevelDefault})
 	} else {
-		return i.db.Transaction(func(tx *gorm.DB) error {
+		err = dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
 			// Deck を先に保存して FK 制約を満たす
-			if err := tx.Save(deck).Error; err != nil {
+			if err := saveIfUnmodified(ctx, tx, entity.ID, entity.UpdatedAt, deck); err != nil {
 				logError(ctx, err)
 				return err
 			}
@@ -997,6 +1118,12 @@ func (i *Deck) Save(
 			return nil
 		}, &sql.TxOptions{Isolation: sql.LevelDefault})
 	}
+	if err != nil {
+		return err
+	}
+	entity.UpdatedAt = deck.UpdatedAt
+
+	return nil
 }
 
 func (i *Deck) Delete(
@@ -1026,9 +1153,9 @@ func (i *Deck) Delete(
 			return tx.Error
 		}
 
-		if tx := tx.Where("id = ?", id).Delete(&model.Deck{}); tx.Error != nil {
-			logError(ctx, tx.Error)
-			return tx.Error
+		if err := deleteIfUnmodified(ctx, tx, id, &model.Deck{}); err != nil {
+			logError(ctx, err)
+			return err
 		}
 
 		return nil
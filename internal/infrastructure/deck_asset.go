@@ -12,21 +12,12 @@ import (
 	"log/slog"
 	"net/http"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
-
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/httpclient"
 )
 
-const (
-	deckAssetBaseEndpoint = "https://s3.isk01.sakurastorage.jp"
-	deckAssetBucket       = "vsrecorder"
-)
-
 // アップロード時のメタデータ。明示しないとオブジェクトストレージは Content-Type を
 // application/octet-stream として保存し、CDNもそのまま配信してしまう。
 // またCache-Controlが無いとCDNが付けるs-maxageだけになる。s-maxageは共有キャッシュ用の
@@ -50,96 +41,37 @@ var (
 	deckImageURLFormat      = "https://www.pokemon-card.com/deck/deckView.php/deckID/%s.png"
 )
 
-// deckAssetS3API はDeckAssetが使うS3操作のサブセット。実S3へ接続せずに
-// テストできるよう、*s3.Clientをこのインターフェース越しに扱う。
-type deckAssetS3API interface {
-	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
-	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
-}
-
+// DeckAsset は公式サイトからデッキのリソースを取得し、DeckAssetStorage へ配置する。
 type DeckAsset struct {
-	logger      *slog.Logger
-	newS3Client func(ctx context.Context) (deckAssetS3API, error)
+	logger  *slog.Logger
+	storage DeckAssetStorage
 }
 
-func NewDeckAsset(logger *slog.Logger) repository.DeckAssetInterface {
-	d := &DeckAsset{logger: logger}
-	d.newS3Client = d.defaultS3Client
-
-	return d
-}
-
-func (i *DeckAsset) defaultS3Client(ctx context.Context) (deckAssetS3API, error) {
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		logError(ctx, err)
-		return nil, err
+func NewDeckAsset(logger *slog.Logger, storage DeckAssetStorage) repository.DeckAssetInterface {
+	return &DeckAsset{
+		logger:  logger,
+		storage: storage,
 	}
-
-	baseEndpoint := deckAssetBaseEndpoint
-
-	return s3.NewFromConfig(cfg, func(options *s3.Options) {
-		options.BaseEndpoint = &baseEndpoint
-	}), nil
 }
 
-// isNotFound は指定したキーのオブジェクトが存在するかどうかを返す。
-// オブジェクトが存在する場合(=アップロード済み)はfalseを返す。
-func isNotFound(ctx context.Context, s3client deckAssetS3API, key string) (bool, error) {
-	if _, err := s3client.HeadObject(ctx, &s3.HeadObjectInput{
-		Bucket: aws.String(deckAssetBucket),
-		Key:    aws.String(key),
-	}); err != nil {
-		var notFound *types.NotFound
-		if errors.As(err, &notFound) {
-			return true, nil
-		}
-
-		return false, err
-	}
-
-	return false, nil
-}
-
-func putObject(
-	ctx context.Context,
-	s3client deckAssetS3API,
-	key string,
-	body []byte,
-	contentType string,
-	cacheControl string,
-) error {
-	_, err := s3client.PutObject(ctx, &s3.PutObjectInput{
-		ACL:          "public-read",
-		Bucket:       aws.String(deckAssetBucket),
-		Key:          aws.String(key),
-		Body:         bytes.NewReader(body),
-		ContentType:  aws.String(contentType),
-		CacheControl: aws.String(cacheControl),
-	})
-
-	return err
+// deckResultHTMLKey はデッキ結果HTMLのオブジェクトキー。
+func deckResultHTMLKey(deckCode string) string {
+	return fmt.Sprintf("deck-result_html/%s", deckCode)
 }
 
 func (i *DeckAsset) UploadDeckResultHTML(
 	ctx context.Context,
 	deckCode string,
 ) error {
-	s3client, err := i.newS3Client(ctx)
-	if err != nil {
-		logError(ctx, err)
-		return err
-	}
-
-	key := fmt.Sprintf("deck-result_html/%s", deckCode)
+	key := deckResultHTMLKey(deckCode)
 
 	// すでにアップロードされている場合はスキップする
-	notFound, err := isNotFound(ctx, s3client, key)
+	exists, err := i.storage.Exists(ctx, key)
 	if err != nil {
 		logError(ctx, err)
 		return err
 	}
-	if !notFound {
+	if exists {
 		return nil
 	}
 
@@ -197,28 +129,22 @@ func (i *DeckAsset) UploadDeckResultHTML(
 		return apperror.ErrDeckCodeInvalid
 	}
 
-	return putObject(ctx, s3client, key, bodyBytes, deckResultHTMLContentType, deckResultHTMLCacheControl)
+	return i.storage.Put(ctx, key, bodyBytes, deckResultHTMLContentType, deckResultHTMLCacheControl)
 }
 
 func (i *DeckAsset) UploadDeckImage(
 	ctx context.Context,
 	deckCode string,
 ) error {
-	s3client, err := i.newS3Client(ctx)
-	if err != nil {
-		logError(ctx, err)
-		return err
-	}
-
 	key := fmt.Sprintf("images/decks/%s.jpg", deckCode)
 
 	// すでにアップロードされている場合はスキップする
-	notFound, err := isNotFound(ctx, s3client, key)
+	exists, err := i.storage.Exists(ctx, key)
 	if err != nil {
 		logError(ctx, err)
 		return err
 	}
-	if !notFound {
+	if exists {
 		return nil
 	}
 
@@ -275,7 +201,39 @@ func (i *DeckAsset) UploadDeckImage(
 		return err
 	}
 
-	return putObject(ctx, s3client, key, imageBytes, deckImageContentType, deckImageCacheControl)
+	return i.storage.Put(ctx, key, imageBytes, deckImageContentType, deckImageCacheControl)
+}
+
+func (i *DeckAsset) FindDeckCards(
+	ctx context.Context,
+	deckCode string,
+) ([]*entity.DeckCodeCard, error) {
+	// 外部サイトは引き直さず、アップロード済みのHTMLを読む。
+	// アップロード時にメンテナンス中・不正なデッキコードのページは弾いているため、
+	// ここにあるHTMLは正常に表示できたページに限られる。
+	bodyBytes, err := i.storage.Get(ctx, deckResultHTMLKey(deckCode))
+	if err != nil {
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		logError(ctx, err)
+		return nil, err
+	}
+
+	cards, err := parseDeckResultHTML(bodyBytes)
+	if err != nil {
+		i.logger.ErrorContext(
+			ctx,
+			"failed to parse deck result HTML",
+			slog.String("deck_code", deckCode),
+			slog.String("error_message", err.Error()),
+		)
+
+		return nil, err
+	}
+
+	return cards, nil
 }
 
 func convertPNG2JPG(imageBytes []byte) ([]byte, error) {
@@ -288,12 +246,7 @@ func convertPNG2JPG(imageBytes []byte) ([]byte, error) {
 			return nil, err
 		}
 
-		buf := new(bytes.Buffer)
-		if err := jpeg.Encode(buf, img, nil); err != nil {
-			return nil, err
-		}
-
-		return buf.Bytes(), nil
+		return encodeJPEG(img, jpeg.DefaultQuality)
 	}
 
 	return nil, fmt.Errorf("unable to convert %#v to jpeg", contentType)
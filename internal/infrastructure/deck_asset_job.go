@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type DeckAssetJob struct {
+	db *gorm.DB
+}
+
+func NewDeckAssetJob(
+	db *gorm.DB,
+) repository.DeckAssetJobInterface {
+	return &DeckAssetJob{db}
+}
+
+func newDeckAssetJobEntity(m *model.DeckAssetJob) *entity.DeckAssetJob {
+	return &entity.DeckAssetJob{
+		ID:         m.ID,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+		DeckCodeId: m.DeckCodeId,
+		Code:       m.Code,
+		Kind:       entity.DeckAssetJobKind(m.Kind),
+		Status:     entity.DeckAssetJobStatus(m.Status),
+		Attempts:   m.Attempts,
+		NextRunAt:  m.NextRunAt,
+		LastError:  m.LastError,
+	}
+}
+
+func (i *DeckAssetJob) Enqueue(
+	ctx context.Context,
+	jobs []*entity.DeckAssetJob,
+) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	models := make([]*model.DeckAssetJob, 0, len(jobs))
+	for _, job := range jobs {
+		models = append(models, &model.DeckAssetJob{
+			ID:         job.ID,
+			CreatedAt:  job.CreatedAt,
+			UpdatedAt:  job.UpdatedAt,
+			DeckCodeId: job.DeckCodeId,
+			Code:       job.Code,
+			Kind:       string(job.Kind),
+			Status:     string(job.Status),
+			Attempts:   job.Attempts,
+			NextRunAt:  job.NextRunAt,
+			LastError:  job.LastError,
+		})
+	}
+
+	if tx := dbFromContext(ctx, i.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "deck_code_id"}, {Name: "kind"}},
+		DoNothing: true,
+	}).Create(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *DeckAssetJob) Claim(
+	ctx context.Context,
+	now time.Time,
+	staleBefore time.Time,
+	limit int,
+) ([]*entity.DeckAssetJob, error) {
+	var models []*model.DeckAssetJob
+
+	// FOR UPDATE SKIP LOCKED で、他のワーカーが取り出し中の行を待たずに飛ばす。
+	// 取り出しと実行中への更新を1文で行い、同じジョブを重ねて取り出さないようにする。
+	if tx := dbFromContext(ctx, i.db).Raw(
+		`UPDATE deck_asset_jobs
+		 SET status = ?, attempts = attempts + 1, updated_at = ?
+		 WHERE id IN (
+		     SELECT id FROM deck_asset_jobs
+		     WHERE (status = ? AND next_run_at <= ?)
+		        OR (status = ? AND updated_at < ?)
+		     ORDER BY next_run_at ASC
+		     LIMIT ?
+		     FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING *`,
+		string(entity.DeckAssetJobStatusRunning),
+		now,
+		string(entity.DeckAssetJobStatusPending),
+		now,
+		string(entity.DeckAssetJobStatusRunning),
+		staleBefore,
+		limit,
+	).Scan(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.DeckAssetJob, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newDeckAssetJobEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *DeckAssetJob) Save(
+	ctx context.Context,
+	job *entity.DeckAssetJob,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.DeckAssetJob{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+		"updated_at":  job.UpdatedAt,
+		"status":      string(job.Status),
+		"attempts":    job.Attempts,
+		"next_run_at": job.NextRunAt,
+		"last_error":  job.LastError,
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *DeckAssetJob) InvalidateByDeckCodeId(
+	ctx context.Context,
+	deckCodeId string,
+	now time.Time,
+	lastError string,
+) error {
+	// 実行中のジョブは実行したワーカーが結果を Save するため、実行待ちのものだけを対象にする。
+	if tx := dbFromContext(ctx, i.db).Model(&model.DeckAssetJob{}).Where(
+		"deck_code_id = ? AND status = ?", deckCodeId, string(entity.DeckAssetJobStatusPending),
+	).Updates(map[string]interface{}{
+		"updated_at": now,
+		"status":     string(entity.DeckAssetJobStatusInvalid),
+		"last_error": lastError,
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *DeckAssetJob) FindByDeckCodeId(
+	ctx context.Context,
+	deckCodeId string,
+) ([]*entity.DeckAssetJob, error) {
+	var models []*model.DeckAssetJob
+
+	if tx := dbFromContext(ctx, i.db).Where("deck_code_id = ?", deckCodeId).Order("created_at ASC").Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.DeckAssetJob, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newDeckAssetJobEntity(m))
+	}
+
+	return ret, nil
+}
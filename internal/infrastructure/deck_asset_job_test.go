@@ -0,0 +1,148 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var deckAssetJobColumns = []string{
+	"id", "created_at", "updated_at", "deck_code_id", "code", "kind", "status", "attempts", "next_run_at", "last_error",
+}
+
+func TestDeckAssetJobInfrastructure(t *testing.T) {
+	deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TN9"
+	code := "5dbFbk-uBwjqP-VVk5Vv"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Enqueue", func(t *testing.T) {
+		t.Run("正常系_同じデッキコード・種類のジョブが既にあれば何もしない", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO "deck_asset_jobs" .*ON CONFLICT \("deck_code_id","kind"\) DO NOTHING`).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectCommit()
+
+			jobs := []*entity.DeckAssetJob{
+				entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR1", now, deckCodeId, code, entity.DeckAssetJobKindResultHTML),
+				entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR2", now, deckCodeId, code, entity.DeckAssetJobKindImage),
+			}
+
+			require.NoError(t, r.Enqueue(context.Background(), jobs))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_ジョブが空ならクエリを発行しない", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			require.NoError(t, r.Enqueue(context.Background(), nil))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		t.Run("正常系_実行できるジョブを実行中にして返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			staleBefore := now.Add(-10 * time.Minute)
+
+			mock.ExpectQuery(`UPDATE deck_asset_jobs\s+SET status = \$1, attempts = attempts \+ 1.*FOR UPDATE SKIP LOCKED.*RETURNING \*`).
+				WithArgs("running", now, "pending", now, "running", staleBefore, 10).
+				WillReturnRows(
+					sqlmock.NewRows(deckAssetJobColumns).AddRow(
+						"01HD7Y3K8D6FDHMHTZ2GT41TR1", now, now, deckCodeId, code, "result_html", "running", 1, now, "",
+					),
+				)
+
+			ret, err := r.Claim(context.Background(), now, staleBefore, 10)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.DeckAssetJobKindResultHTML, ret[0].Kind)
+			require.Equal(t, entity.DeckAssetJobStatusRunning, ret[0].Status)
+			require.Equal(t, uint(1), ret[0].Attempts)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_クエリのエラーを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			mock.ExpectQuery(`UPDATE deck_asset_jobs`).WillReturnError(errors.New("connection refused"))
+
+			_, err := r.Claim(context.Background(), now, now, 10)
+
+			require.Error(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("正常系_実行結果を反映する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			job := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR1", now, deckCodeId, code, entity.DeckAssetJobKindImage)
+			job.Attempts = 1
+			job.Fail(now, errors.New("timeout"))
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "deck_asset_jobs" SET`)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Save(context.Background(), job))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("InvalidateByDeckCodeId", func(t *testing.T) {
+		t.Run("正常系_実行待ちのジョブだけを不正にする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "deck_asset_jobs" SET "last_error"=$1,"status"=$2,"updated_at"=$3 WHERE deck_code_id = $4 AND status = $5`)).
+				WithArgs("deck code is invalid", "invalid", now, deckCodeId, "pending").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.InvalidateByDeckCodeId(context.Background(), deckCodeId, now, "deck code is invalid"))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByDeckCodeId", func(t *testing.T) {
+		t.Run("正常系_指定デッキコードのジョブを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckAssetJob(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "deck_asset_jobs" WHERE deck_code_id = $1 ORDER BY created_at ASC`,
+			)).WithArgs(deckCodeId).WillReturnRows(
+				sqlmock.NewRows(deckAssetJobColumns).AddRow(
+					"01HD7Y3K8D6FDHMHTZ2GT41TR1", now, now, deckCodeId, code, "image", "dead", 8, now, "timeout",
+				),
+			)
+
+			ret, err := r.FindByDeckCodeId(context.Background(), deckCodeId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.DeckAssetJobStatusDead, ret[0].Status)
+			require.Equal(t, "timeout", ret[0].LastError)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
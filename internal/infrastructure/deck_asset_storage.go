@@ -0,0 +1,335 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+)
+
+// 本番のオブジェクトストレージ(さくらのクラウド)。S3互換ストレージの接続先を
+// 指定しなかった場合の既定値。
+const (
+	DefaultDeckAssetS3Endpoint = "https://s3.isk01.sakurastorage.jp"
+	DefaultDeckAssetS3Bucket   = "vsrecorder"
+)
+
+// DeckAssetStorage はデッキのリソース(結果HTML・画像)を置くオブジェクトストレージ。
+//
+// 本番はS3互換ストレージ(NewS3DeckAssetStorage)、開発・CIはローカルのファイルシステム
+// (NewLocalDeckAssetStorage)やインメモリ(NewInMemoryDeckAssetStorage)を、起動時の設定で選ぶ。
+// キーは "images/decks/<デッキコード>.jpg" のような "/" 区切りの相対パス。
+type DeckAssetStorage interface {
+	// Exists はキーのオブジェクトが配置済みかを返す。
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Put はオブジェクトを配置する。contentType / cacheControl は配信時のメタデータで、
+	// 保持できない実装では無視してよい。
+	Put(ctx context.Context, key string, body []byte, contentType string, cacheControl string) error
+
+	// Get は配置済みのオブジェクトを読み出す。無ければ apperror.ErrRecordNotFound を返す。
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete はオブジェクトを消す。無いキーはエラーにしない。
+	Delete(ctx context.Context, key string) error
+}
+
+// deckAssetS3API はS3DeckAssetStorageが使うS3操作のサブセット。実S3へ接続せずに
+// テストできるよう、*s3.Clientをこのインターフェース越しに扱う。
+type deckAssetS3API interface {
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+}
+
+// S3DeckAssetStorage はS3互換のオブジェクトストレージ(さくらのクラウド・MinIO等)。
+type S3DeckAssetStorage struct {
+	bucket string
+	// acl は配置するオブジェクトに付けるACL。空ならACLを指定せず、バケットの既定(非公開)に従う。
+	acl         types.ObjectCannedACL
+	newS3Client func(ctx context.Context) (deckAssetS3API, error)
+}
+
+// NewS3DeckAssetStorage は endpoint の bucket を使うストレージを返す。配置したオブジェクトは公開する。
+// 認証情報は AWS SDK の既定の方法(環境変数 AWS_ACCESS_KEY_ID 等)で読み込む。
+// MinIO 等のバケットをサブドメインで解決できないストレージでは usePathStyle を true にする。
+func NewS3DeckAssetStorage(endpoint string, bucket string, usePathStyle bool) DeckAssetStorage {
+	return newS3DeckAssetStorage(endpoint, bucket, usePathStyle, types.ObjectCannedACLPublicRead)
+}
+
+// NewPrivateS3DeckAssetStorage は NewS3DeckAssetStorage と同じバケットに、オブジェクトを公開せずに
+// 置くストレージを返す。ユーザのエクスポートのように、APIを通して本人にだけ渡すものに使う。
+func NewPrivateS3DeckAssetStorage(endpoint string, bucket string, usePathStyle bool) DeckAssetStorage {
+	return newS3DeckAssetStorage(endpoint, bucket, usePathStyle, "")
+}
+
+func newS3DeckAssetStorage(endpoint string, bucket string, usePathStyle bool, acl types.ObjectCannedACL) *S3DeckAssetStorage {
+	return &S3DeckAssetStorage{
+		bucket: bucket,
+		acl:    acl,
+		newS3Client: func(ctx context.Context) (deckAssetS3API, error) {
+			cfg, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return s3.NewFromConfig(cfg, func(options *s3.Options) {
+				options.BaseEndpoint = aws.String(endpoint)
+				options.UsePathStyle = usePathStyle
+			}), nil
+		},
+	}
+}
+
+func (s *S3DeckAssetStorage) Exists(ctx context.Context, key string) (bool, error) {
+	s3client, err := s.newS3Client(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := s3client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *S3DeckAssetStorage) Put(
+	ctx context.Context,
+	key string,
+	body []byte,
+	contentType string,
+	cacheControl string,
+) error {
+	s3client, err := s.newS3Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3client.PutObject(ctx, &s3.PutObjectInput{
+		ACL:          s.acl,
+		Bucket:       aws.String(s.bucket),
+		Key:          aws.String(key),
+		Body:         bytes.NewReader(body),
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String(cacheControl),
+	})
+
+	return err
+}
+
+func (s *S3DeckAssetStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	s3client, err := s.newS3Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s3client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, apperror.ErrRecordNotFound
+		}
+
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return io.ReadAll(out.Body)
+}
+
+// Delete は S3 の DeleteObject が無いキーでも成功するため、そのまま呼ぶ。
+func (s *S3DeckAssetStorage) Delete(ctx context.Context, key string) error {
+	s3client, err := s.newS3Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
+
+// LocalDeckAssetStorage はローカルのディレクトリにオブジェクトを置く開発用のストレージ。
+// 配信は API サーバが dir を静的ファイルとして公開して行う(cmd/core-apiserver)。
+// Content-Type / Cache-Control は保持せず、配信時に拡張子から決まる。
+type LocalDeckAssetStorage struct {
+	dir string
+}
+
+func NewLocalDeckAssetStorage(dir string) DeckAssetStorage {
+	return &LocalDeckAssetStorage{dir: dir}
+}
+
+// path はキーを dir 配下のパスにする。キーにはデッキコード(利用者の入力)が含まれるため、
+// dir の外を指すキーは拒否する。
+func (s *LocalDeckAssetStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid deck asset key: %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalDeckAssetStorage) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *LocalDeckAssetStorage) Put(
+	ctx context.Context,
+	key string,
+	body []byte,
+	contentType string,
+	cacheControl string,
+) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, body, 0o644)
+}
+
+func (s *LocalDeckAssetStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, apperror.ErrRecordNotFound
+		}
+
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *LocalDeckAssetStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// inMemoryDeckAssetObject はインメモリのストレージに置いたオブジェクトとそのメタデータ。
+type inMemoryDeckAssetObject struct {
+	body         []byte
+	contentType  string
+	cacheControl string
+}
+
+// InMemoryDeckAssetStorage はプロセス内のマップにオブジェクトを置くテスト用のストレージ。
+// 再起動で消えるため、配信はしない。
+type InMemoryDeckAssetStorage struct {
+	mu      sync.Mutex
+	objects map[string]inMemoryDeckAssetObject
+}
+
+func NewInMemoryDeckAssetStorage() *InMemoryDeckAssetStorage {
+	return &InMemoryDeckAssetStorage{
+		objects: map[string]inMemoryDeckAssetObject{},
+	}
+}
+
+func (s *InMemoryDeckAssetStorage) Exists(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.objects[key]
+
+	return ok, nil
+}
+
+func (s *InMemoryDeckAssetStorage) Put(
+	ctx context.Context,
+	key string,
+	body []byte,
+	contentType string,
+	cacheControl string,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects[key] = inMemoryDeckAssetObject{
+		body:         bytes.Clone(body),
+		contentType:  contentType,
+		cacheControl: cacheControl,
+	}
+
+	return nil
+}
+
+func (s *InMemoryDeckAssetStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	return bytes.Clone(object.body), nil
+}
+
+func (s *InMemoryDeckAssetStorage) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, key)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+)
+
+func TestLocalDeckAssetStorage(t *testing.T) {
+	t.Run("正常系_配置したオブジェクトをキーのパスに書き込み読み出せる", func(t *testing.T) {
+		dir := t.TempDir()
+		storage := NewLocalDeckAssetStorage(dir)
+
+		exists, err := storage.Exists(context.Background(), "images/decks/5dbFbk-uBwjqP-VVk5Vv.jpg")
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		require.NoError(t, storage.Put(context.Background(), "images/decks/5dbFbk-uBwjqP-VVk5Vv.jpg", []byte("image"), deckImageContentType, deckImageCacheControl))
+
+		// API サーバが dir をそのまま静的ファイルとして配信する
+		written, err := os.ReadFile(filepath.Join(dir, "images", "decks", "5dbFbk-uBwjqP-VVk5Vv.jpg"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("image"), written)
+
+		exists, err = storage.Exists(context.Background(), "images/decks/5dbFbk-uBwjqP-VVk5Vv.jpg")
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		body, err := storage.Get(context.Background(), "images/decks/5dbFbk-uBwjqP-VVk5Vv.jpg")
+		require.NoError(t, err)
+		require.Equal(t, []byte("image"), body)
+	})
+
+	t.Run("異常系_未配置のキーはErrRecordNotFoundを返す", func(t *testing.T) {
+		storage := NewLocalDeckAssetStorage(t.TempDir())
+
+		_, err := storage.Get(context.Background(), "deck-result_html/5dbFbk-uBwjqP-VVk5Vv")
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+
+	t.Run("異常系_ディレクトリの外を指すキーは拒否する", func(t *testing.T) {
+		dir := t.TempDir()
+		storage := NewLocalDeckAssetStorage(filepath.Join(dir, "assets"))
+
+		err := storage.Put(context.Background(), "deck-result_html/../../escaped", []byte("x"), "", "")
+
+		require.Error(t, err)
+		require.NoFileExists(t, filepath.Join(dir, "escaped"))
+	})
+}
+
+func TestInMemoryDeckAssetStorage(t *testing.T) {
+	t.Run("正常系_DeckAssetの配置先として使える", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			fmt.Fprint(w, `<html><body><form>
+<input type="hidden" name="deck_pke" id="deck_pke" value="47003_2_1">
+</form></body></html>`)
+		}))
+		t.Cleanup(server.Close)
+
+		original := deckResultHTMLURLFormat
+		deckResultHTMLURLFormat = server.URL + "/deck/result.html/deckID/%s"
+		t.Cleanup(func() { deckResultHTMLURLFormat = original })
+
+		storage := NewInMemoryDeckAssetStorage()
+		d := NewDeckAsset(slog.New(slog.NewTextHandler(io.Discard, nil)), storage)
+
+		require.NoError(t, d.UploadDeckResultHTML(context.Background(), "5dbFbk-uBwjqP-VVk5Vv"))
+
+		object := storage.objects["deck-result_html/5dbFbk-uBwjqP-VVk5Vv"]
+		require.Equal(t, deckResultHTMLContentType, object.contentType)
+		require.Equal(t, deckResultHTMLCacheControl, object.cacheControl)
+
+		cards, err := d.FindDeckCards(context.Background(), "5dbFbk-uBwjqP-VVk5Vv")
+		require.NoError(t, err)
+		require.Len(t, cards, 1)
+	})
+
+	t.Run("異常系_未配置のキーはErrRecordNotFoundを返す", func(t *testing.T) {
+		storage := NewInMemoryDeckAssetStorage()
+
+		_, err := storage.Get(context.Background(), "deck-result_html/5dbFbk-uBwjqP-VVk5Vv")
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+}
+
+func TestPrivateS3DeckAssetStorage(t *testing.T) {
+	t.Run("正常系_ACLを付けずにバケットの既定(非公開)で配置する", func(t *testing.T) {
+		fakeS3 := newFakeDeckAssetS3()
+		storage := newS3DeckAssetStorage("", DefaultDeckAssetS3Bucket, false, "")
+		storage.newS3Client = func(ctx context.Context) (deckAssetS3API, error) {
+			return fakeS3, nil
+		}
+
+		require.NoError(t, storage.Put(context.Background(), "user-exports/uid/id.zip", []byte("zip"), "application/zip", "private, no-store"))
+
+		require.Equal(t, []byte("zip"), fakeS3.objects["user-exports/uid/id.zip"])
+		require.Empty(t, fakeS3.acls["user-exports/uid/id.zip"])
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 )
 
 // fakeDeckAssetS3 は実S3の代わりに使うインメモリ実装。
@@ -28,6 +29,7 @@ type fakeDeckAssetS3 struct {
 	// CDNがそのまま配信するため、本文だけでなくContent-Type/Cache-Controlも検証できるようにする
 	contentTypes  map[string]string
 	cacheControls map[string]string
+	acls          map[string]types.ObjectCannedACL
 }
 
 func newFakeDeckAssetS3() *fakeDeckAssetS3 {
@@ -35,6 +37,7 @@ func newFakeDeckAssetS3() *fakeDeckAssetS3 {
 		objects:       map[string][]byte{},
 		contentTypes:  map[string]string{},
 		cacheControls: map[string]string{},
+		acls:          map[string]types.ObjectCannedACL{},
 	}
 }
 
@@ -49,6 +52,18 @@ func (f *fakeDeckAssetS3) HeadObject(ctx context.Context, params *s3.HeadObjectI
 	return &s3.HeadObjectOutput{}, nil
 }
 
+func (f *fakeDeckAssetS3) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	body, ok := f.objects[*params.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
 func (f *fakeDeckAssetS3) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -64,10 +79,20 @@ func (f *fakeDeckAssetS3) PutObject(ctx context.Context, params *s3.PutObjectInp
 	if params.CacheControl != nil {
 		f.cacheControls[*params.Key] = *params.CacheControl
 	}
+	f.acls[*params.Key] = params.ACL
 
 	return &s3.PutObjectOutput{}, nil
 }
 
+func (f *fakeDeckAssetS3) DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.objects, *params.Key)
+
+	return &s3.DeleteObjectOutput{}, nil
+}
+
 // setup4DeckAssetInfrastructure はフェイクS3とhttptestサーバを組み込んだDeckAssetを返す。
 func setup4DeckAssetInfrastructure(t *testing.T, handler http.HandlerFunc) (*DeckAsset, *fakeDeckAssetS3) {
 	t.Helper()
@@ -89,8 +114,12 @@ func setup4DeckAssetInfrastructure(t *testing.T, handler http.HandlerFunc) (*Dec
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	d := &DeckAsset{
 		logger: logger,
-		newS3Client: func(ctx context.Context) (deckAssetS3API, error) {
-			return fakeS3, nil
+		storage: &S3DeckAssetStorage{
+			bucket: DefaultDeckAssetS3Bucket,
+			acl:    types.ObjectCannedACLPublicRead,
+			newS3Client: func(ctx context.Context) (deckAssetS3API, error) {
+				return fakeS3, nil
+			},
 		},
 	}
 
@@ -127,6 +156,7 @@ func TestDeckAssetInfrastructure(t *testing.T) {
 			// メタデータを付けないとCDNがapplication/octet-streamのまま配信してしまう
 			require.Equal(t, deckResultHTMLContentType, fakeS3.contentTypes[key])
 			require.Equal(t, deckResultHTMLCacheControl, fakeS3.cacheControls[key])
+			require.Equal(t, types.ObjectCannedACLPublicRead, fakeS3.acls[key])
 		})
 
 		t.Run("正常系_アップロード済みなら取得せずスキップする", func(t *testing.T) {
@@ -175,6 +205,35 @@ func TestDeckAssetInfrastructure(t *testing.T) {
 		})
 	})
 
+	t.Run("FindDeckCards", func(t *testing.T) {
+		t.Run("正常系_アップロード済みのHTMLからカード構成を読み取る", func(t *testing.T) {
+			d, fakeS3 := setup4DeckAssetInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+				t.Fatal("カード構成はS3上のHTMLから読み取り、外部サイトへ取得しに行ってはいけない")
+			})
+
+			fakeS3.objects["deck-result_html/"+deckCode] = []byte(`<html><body><form>
+<input type="hidden" name="deck_pke" id="deck_pke" value="47003_2_1-47004_1_1">
+<input type="hidden" name="deck_ene" id="deck_ene" value="44560_8_1">
+</form></body></html>`)
+
+			cards, err := d.FindDeckCards(context.Background(), deckCode)
+
+			require.NoError(t, err)
+			require.Len(t, cards, 3)
+			require.Equal(t, uint(47003), cards[0].CardId)
+			require.Equal(t, uint(2), cards[0].Count)
+			require.Equal(t, entity.DeckCardCategoryEnergy, cards[2].Category)
+		})
+
+		t.Run("異常系_未アップロードならErrRecordNotFoundを返す", func(t *testing.T) {
+			d, _ := setup4DeckAssetInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {})
+
+			_, err := d.FindDeckCards(context.Background(), deckCode)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
 	t.Run("UploadDeckImage", func(t *testing.T) {
 		t.Run("正常系_取得した画像をJPEGへ変換してアップロードする", func(t *testing.T) {
 			d, fakeS3 := setup4DeckAssetInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type DeckCodeCard struct {
+	db *gorm.DB
+}
+
+func NewDeckCodeCard(
+	db *gorm.DB,
+) repository.DeckCodeCardInterface {
+	return &DeckCodeCard{db}
+}
+
+// deckCodeCardRow は deck_code_cards と cards を JOIN した結果を受けるための構造体。
+type deckCodeCardRow struct {
+	DeckCodeId     string
+	CardId         uint
+	CardName       string
+	RegulationMark string
+	Category       string
+	Count          uint
+}
+
+func (i *DeckCodeCard) FindByDeckCodeId(
+	ctx context.Context,
+	deckCodeId string,
+) ([]*entity.DeckCodeCard, error) {
+	return i.FindByDeckCodeIds(ctx, []string{deckCodeId})
+}
+
+func (i *DeckCodeCard) FindByDeckCodeIds(
+	ctx context.Context,
+	deckCodeIds []string,
+) ([]*entity.DeckCodeCard, error) {
+	if len(deckCodeIds) == 0 {
+		return []*entity.DeckCodeCard{}, nil
+	}
+
+	var rows []*deckCodeCardRow
+
+	// cards は外部で同期しているマスタのため、未反映のカードも落とさないよう LEFT JOIN にする。
+	if tx := dbFromContext(ctx, i.db).Raw(
+		`SELECT dcc.deck_code_id, dcc.card_id, COALESCE(c.card_name, '') AS card_name, COALESCE(c.regulation_mark, '') AS regulation_mark, dcc.category, dcc.count
+		 FROM deck_code_cards AS dcc
+		 LEFT JOIN cards AS c ON c.id = dcc.card_id
+		 WHERE dcc.deck_code_id IN ?
+		 ORDER BY dcc.deck_code_id ASC, dcc.position ASC`,
+		deckCodeIds,
+	).Scan(&rows); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.DeckCodeCard, 0, len(rows))
+	for _, row := range rows {
+		card := entity.NewDeckCodeCard(
+			row.DeckCodeId,
+			row.CardId,
+			row.CardName,
+			entity.DeckCardCategory(row.Category),
+			row.Count,
+		)
+		card.RegulationMark = row.RegulationMark
+		ret = append(ret, card)
+	}
+
+	return ret, nil
+}
+
+func (i *DeckCodeCard) Replace(
+	ctx context.Context,
+	deckCodeId string,
+	cards []*entity.DeckCodeCard,
+) error {
+	return dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("deck_code_id = ?", deckCodeId).Delete(&model.DeckCodeCard{}).Error; err != nil {
+			logError(ctx, err)
+			return err
+		}
+
+		if len(cards) == 0 {
+			return nil
+		}
+
+		models := make([]*model.DeckCodeCard, 0, len(cards))
+		for idx, card := range cards {
+			models = append(models, model.NewDeckCodeCard(
+				deckCodeId,
+				card.CardId,
+				string(card.Category),
+				card.Count,
+				uint(idx+1),
+			))
+		}
+
+		if err := tx.Create(&models).Error; err != nil {
+			logError(ctx, err)
+			return err
+		}
+
+		return nil
+	})
+}
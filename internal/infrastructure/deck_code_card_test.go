@@ -0,0 +1,127 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestDeckCodeCardInfrastructure(t *testing.T) {
+	deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+
+	t.Run("FindByDeckCodeId", func(t *testing.T) {
+		t.Run("正常系_表示順にカード名付きで返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeCard(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`LEFT JOIN cards AS c ON c.id = dcc.card_id`)).
+				WithArgs(deckCodeId).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"deck_code_id", "card_id", "card_name", "regulation_mark", "category", "count"}).
+						AddRow(deckCodeId, 47003, "ピカチュウex", "I", "pokemon", 2).
+						AddRow(deckCodeId, 49999, "", "", "goods", 4),
+				)
+
+			ret, err := r.FindByDeckCodeId(context.Background(), deckCodeId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, "ピカチュウex", ret[0].CardName)
+			require.Equal(t, entity.DeckCardCategoryPokemon, ret[0].Category)
+			require.Equal(t, uint(2), ret[0].Count)
+			require.Equal(t, "I", ret[0].RegulationMark)
+			// マスタ未反映のカードもカード名空で返す
+			require.Equal(t, uint(49999), ret[1].CardId)
+			require.Empty(t, ret[1].CardName)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByDeckCodeIds", func(t *testing.T) {
+		t.Run("正常系_複数のデッキコードのカード構成をまとめて返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeCard(db)
+
+			otherId := "01HD7Y3K8D6FDHMHTZ2GT41TC2"
+
+			mock.ExpectQuery(regexp.QuoteMeta(`WHERE dcc.deck_code_id IN ($1,$2)`)).
+				WithArgs(deckCodeId, otherId).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"deck_code_id", "card_id", "card_name", "category", "count"}).
+						AddRow(deckCodeId, 47003, "ピカチュウex", "pokemon", 2).
+						AddRow(otherId, 47003, "ピカチュウex", "pokemon", 3),
+				)
+
+			ret, err := r.FindByDeckCodeIds(context.Background(), []string{deckCodeId, otherId})
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, otherId, ret[1].DeckCodeId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_IDが空ならクエリせず空で返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeCard(db)
+
+			ret, err := r.FindByDeckCodeIds(context.Background(), nil)
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		t.Run("正常系_既存行を削除して表示順付きで挿入する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeCard(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "deck_code_cards" WHERE deck_code_id = $1`)).
+				WithArgs(deckCodeId).
+				WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "deck_code_cards"`)).
+				WithArgs(
+					deckCodeId, 47003, "pokemon", 2, 1,
+					deckCodeId, 44560, "energy", 8, 2,
+				).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectCommit()
+
+			cards := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(deckCodeId, 47003, "", entity.DeckCardCategoryPokemon, 2),
+				entity.NewDeckCodeCard(deckCodeId, 44560, "", entity.DeckCardCategoryEnergy, 8),
+			}
+
+			require.NoError(t, r.Replace(context.Background(), deckCodeId, cards))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_挿入に失敗したらロールバックする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeCard(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "deck_code_cards" WHERE deck_code_id = $1`)).
+				WithArgs(deckCodeId).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "deck_code_cards"`)).
+				WillReturnError(errors.New(""))
+			mock.ExpectRollback()
+
+			cards := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(deckCodeId, 47003, "", entity.DeckCardCategoryPokemon, 2),
+			}
+
+			require.Error(t, r.Replace(context.Background(), deckCodeId, cards))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -0,0 +1,82 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type DeckCodeStat struct {
+	db *gorm.DB
+}
+
+func NewDeckCodeStat(
+	db *gorm.DB,
+) repository.DeckCodeStatInterface {
+	return &DeckCodeStat{db}
+}
+
+type deckCodeStatResult struct {
+	DeckCodeId  string
+	RecordCount uint
+	MatchCount  uint
+	Wins        uint
+	Draws       uint
+}
+
+func (i *DeckCodeStat) FindByDeckCodeIds(
+	ctx context.Context,
+	deckCodeIds []string,
+) ([]*entity.DeckCodeStat, error) {
+	if len(deckCodeIds) == 0 {
+		return []*entity.DeckCodeStat{}, nil
+	}
+
+	var results []deckCodeStatResult
+
+	// 記録(records)の deck_code_id を正としてバージョンに紐付ける。
+	// matches.deck_code_id は記録後にデッキコードを付け替えても更新されないため使用しない
+	// (deck_usage_stat.go の records.deck_id と同じ方針)。
+	// 対戦の無い記録も記録件数に数えるため matches は LEFT JOIN にする。
+	if tx := i.db.WithContext(ctx).Table("records").
+		Select("records.deck_code_id AS deck_code_id, COUNT(DISTINCT records.id) AS record_count, COUNT(DISTINCT matches.id) AS match_count, COUNT(DISTINCT CASE WHEN matches.victory_flg THEN matches.id END) AS wins, COUNT(DISTINCT CASE WHEN matches.draw_flg THEN matches.id END) AS draws").
+		Joins("LEFT JOIN matches ON matches.record_id = records.id AND matches.deleted_at IS NULL").
+		Where("records.deck_code_id IN ? AND records.deleted_at IS NULL AND records.ignore_stats_flg = false", deckCodeIds).
+		Group("records.deck_code_id").
+		Scan(&results); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	resultMap := make(map[string]deckCodeStatResult, len(results))
+	for _, r := range results {
+		resultMap[r.DeckCodeId] = r
+	}
+
+	ret := make([]*entity.DeckCodeStat, 0, len(deckCodeIds))
+	for _, deckCodeId := range deckCodeIds {
+		r := resultMap[deckCodeId]
+
+		// 引き分けは負けに数えず、勝率の分母からも除外する(deck_usage_stat.go と同じ)。
+		losses := r.MatchCount - r.Wins - r.Draws
+		var winRate float64
+		if decided := r.Wins + losses; decided > 0 {
+			winRate = float64(r.Wins) / float64(decided)
+		}
+
+		ret = append(ret, entity.NewDeckCodeStat(
+			deckCodeId,
+			r.RecordCount,
+			r.MatchCount,
+			r.Wins,
+			losses,
+			r.Draws,
+			winRate,
+		))
+	}
+
+	return ret, nil
+}
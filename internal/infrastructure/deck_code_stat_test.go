@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeckCodeStatInfrastructure(t *testing.T) {
+	fromId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+	toId := "01HD7Y3K8D6FDHMHTZ2GT41TC2"
+
+	t.Run("FindByDeckCodeIds", func(t *testing.T) {
+		t.Run("正常系_指定順に返し記録の無いデッキコードはゼロ値で含める", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeStat(db)
+
+			mock.ExpectQuery(`FROM "records" LEFT JOIN matches .* WHERE records.deck_code_id IN \(\$1,\$2\) AND records.deleted_at IS NULL AND records.ignore_stats_flg = false GROUP BY "records"."deck_code_id"`).
+				WithArgs(toId, fromId).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"deck_code_id", "record_count", "match_count", "wins", "draws"}).
+						AddRow(fromId, 2, 6, 3, 1),
+				)
+
+			ret, err := r.FindByDeckCodeIds(context.Background(), []string{toId, fromId})
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, toId, ret[0].DeckCodeId)
+			require.Equal(t, uint(0), ret[0].MatchCount)
+			require.Equal(t, fromId, ret[1].DeckCodeId)
+			require.Equal(t, uint(2), ret[1].RecordCount)
+			require.Equal(t, uint(3), ret[1].WinCount)
+			require.Equal(t, uint(2), ret[1].LoseCount)
+			require.Equal(t, uint(1), ret[1].DrawCount)
+			require.InDelta(t, 0.6, ret[1].WinRate, 0.0001)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_クエリのエラーを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewDeckCodeStat(db)
+
+			mock.ExpectQuery(`FROM "records"`).WillReturnError(errors.New(""))
+
+			_, err := r.FindByDeckCodeIds(context.Background(), []string{fromId})
+
+			require.Error(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
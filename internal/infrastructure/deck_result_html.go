@@ -0,0 +1,111 @@
+package infrastructure
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// deckResultHiddenInputCategories は公式サイトのデッキ結果HTMLで、カード構成を保持している
+// hidden input の id と、その区分の対応。並びは公式サイトの表示順(ポケモン→トレーナーズ→エネルギー)。
+//
+// 各 input の value は "{カードID}_{枚数}_{表示列}" をハイフンで連結した文字列
+// (例: "47003_2_1-47004_1_1")。表示列は画面上の配置用なので使わない。
+var deckResultHiddenInputCategories = []struct {
+	id       string
+	category entity.DeckCardCategory
+}{
+	{"deck_pke", entity.DeckCardCategoryPokemon},
+	{"deck_gds", entity.DeckCardCategoryGoods},
+	{"deck_tool", entity.DeckCardCategoryTool},
+	{"deck_tech", entity.DeckCardCategoryTechnicalMachine},
+	{"deck_sup", entity.DeckCardCategorySupporter},
+	{"deck_sta", entity.DeckCardCategoryStadium},
+	{"deck_ajs", entity.DeckCardCategoryAceSpec},
+	{"deck_ene", entity.DeckCardCategoryEnergy},
+}
+
+// errDeckResultHTMLNoCards はデッキ結果HTMLからカード構成を1枚も読み取れなかった場合に返す。
+// 公式サイトのページ構成が変わった可能性が高いため、空のカード構成として保存せずにエラーにする。
+var errDeckResultHTMLNoCards = errors.New("no cards found in deck result html")
+
+// parseDeckResultHTML は公式サイトのデッキ結果HTMLからカード構成を取り出す。
+// 戻り値は公式サイトの表示順。DeckCodeId・CardName は詰めない。
+func parseDeckResultHTML(body []byte) ([]*entity.DeckCodeCard, error) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "input" {
+			var id, value string
+			for _, a := range n.Attr {
+				switch strings.ToLower(a.Key) {
+				case "id":
+					id = a.Val
+				case "value":
+					value = a.Val
+				}
+			}
+			if id != "" {
+				values[id] = value
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	var cards []*entity.DeckCodeCard
+	for _, input := range deckResultHiddenInputCategories {
+		value := strings.TrimSpace(values[input.id])
+		if value == "" {
+			continue
+		}
+
+		for _, item := range strings.Split(value, "-") {
+			card, err := parseDeckResultCardItem(item, input.category)
+			if err != nil {
+				return nil, err
+			}
+			cards = append(cards, card)
+		}
+	}
+
+	if len(cards) == 0 {
+		return nil, errDeckResultHTMLNoCards
+	}
+
+	return cards, nil
+}
+
+// parseDeckResultCardItem は "{カードID}_{枚数}_{表示列}" の1要素を解析する。
+func parseDeckResultCardItem(item string, category entity.DeckCardCategory) (*entity.DeckCodeCard, error) {
+	fields := strings.Split(item, "_")
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid deck result card item: %q", item)
+	}
+
+	cardId, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil || cardId == 0 {
+		return nil, fmt.Errorf("invalid deck result card id: %q", item)
+	}
+
+	count, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil || count == 0 {
+		return nil, fmt.Errorf("invalid deck result card count: %q", item)
+	}
+
+	return entity.NewDeckCodeCard("", uint(cardId), "", category, uint(count)), nil
+}
@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestParseDeckResultHTML(t *testing.T) {
+	t.Run("正常系_hidden inputから区分ごとのカード構成を表示順で取り出す", func(t *testing.T) {
+		body := []byte(`<html><body><form id="inputArea">
+<input type="hidden" name="deck_pke" id="deck_pke" value="47003_2_1-47004_1_1">
+<input type="hidden" name="deck_gds" id="deck_gds" value="45000_4_1">
+<input type="hidden" name="deck_tool" id="deck_tool" value="">
+<input type="hidden" name="deck_sup" id="deck_sup" value="46000_3_2">
+<input type="hidden" name="deck_ene" id="deck_ene" value="44560_8_1">
+</form></body></html>`)
+
+		cards, err := parseDeckResultHTML(body)
+
+		require.NoError(t, err)
+		require.Len(t, cards, 5)
+		require.Equal(t, uint(47003), cards[0].CardId)
+		require.Equal(t, uint(2), cards[0].Count)
+		require.Equal(t, entity.DeckCardCategoryPokemon, cards[1].Category)
+		require.Equal(t, entity.DeckCardCategoryGoods, cards[2].Category)
+		require.Equal(t, entity.DeckCardCategorySupporter, cards[3].Category)
+		require.Equal(t, entity.DeckCardGroupTrainer, cards[3].Category.Group())
+		require.Equal(t, entity.DeckCardCategoryEnergy, cards[4].Category)
+		require.Equal(t, uint(8), cards[4].Count)
+	})
+
+	t.Run("異常系_カードが1枚も無ければエラーを返す", func(t *testing.T) {
+		_, err := parseDeckResultHTML([]byte(`<html><body>デッキコードが正しくありません</body></html>`))
+
+		require.ErrorIs(t, err, errDeckResultHTMLNoCards)
+	})
+
+	t.Run("異常系_要素の形式が不正ならエラーを返す", func(t *testing.T) {
+		_, err := parseDeckResultHTML([]byte(`<input id="deck_pke" value="47003">`))
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type EntityRevision struct {
+	db *gorm.DB
+}
+
+func NewEntityRevision(
+	db *gorm.DB,
+) repository.EntityRevisionInterface {
+	return &EntityRevision{db}
+}
+
+func (i *EntityRevision) Append(
+	ctx context.Context,
+	revision *entity.EntityRevision,
+) error {
+	m := &model.EntityRevision{
+		ID:         revision.ID,
+		CreatedAt:  revision.CreatedAt,
+		EntityType: string(revision.EntityType),
+		EntityId:   revision.EntityId,
+		Action:     string(revision.Action),
+		UserId:     revision.UserId,
+		ActorUid:   revision.ActorUid,
+		RequestId:  revision.RequestId,
+		Before:     revision.Before,
+		After:      revision.After,
+	}
+
+	// 追記のみのため Save(upsert)ではなく Create で書き込む。
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *EntityRevision) FindByEntity(
+	ctx context.Context,
+	entityType entity.EntityRevisionType,
+	entityId string,
+	limit int,
+	offset int,
+) ([]*entity.EntityRevision, error) {
+	var models []*model.EntityRevision
+
+	if tx := i.db.Where(
+		"entity_type = ? AND entity_id = ?", string(entityType), entityId,
+	).Order(
+		"created_at DESC, id DESC",
+	).Limit(limit).Offset(offset).Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.EntityRevision, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, &entity.EntityRevision{
+			ID:         m.ID,
+			CreatedAt:  m.CreatedAt,
+			EntityType: entity.EntityRevisionType(m.EntityType),
+			EntityId:   m.EntityId,
+			Action:     entity.EntityRevisionAction(m.Action),
+			UserId:     m.UserId,
+			ActorUid:   m.ActorUid,
+			RequestId:  m.RequestId,
+			Before:     m.Before,
+			After:      m.After,
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestEntityRevisionInfrastructure(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01JMPK4VF04QX714CG4PHYJ88K"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Append", func(t *testing.T) {
+		t.Run("正常系_追記する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewEntityRevision(db)
+
+			revision, err := entity.NewEntityRevision(
+				"01JMPK4VF04QX714CG4PHYJ88M", now, entity.EntityRevisionTypeRecord, recordId,
+				entity.EntityRevisionActionDelete, uid, uid, "request-1",
+				&entity.Record{ID: recordId}, nil,
+			)
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "entity_revisions"`)).
+				WithArgs(revision.ID, now, "record", recordId, "delete", uid, uid, "request-1", revision.Before, []byte(nil)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Append(context.Background(), revision))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_書き込みのエラーを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewEntityRevision(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "entity_revisions"`)).
+				WillReturnError(errors.New(""))
+			mock.ExpectRollback()
+
+			err := r.Append(context.Background(), &entity.EntityRevision{ID: "01JMPK4VF04QX714CG4PHYJ88M"})
+
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("FindByEntity", func(t *testing.T) {
+		t.Run("正常系_新しい順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewEntityRevision(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "entity_revisions" WHERE entity_type = $1 AND entity_id = $2 ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4`,
+			)).WithArgs("record", recordId, 10, 20).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "created_at", "entity_type", "entity_id", "action", "user_id", "actor_uid", "request_id", "before", "after"}).
+					AddRow("01JMPK4VF04QX714CG4PHYJ88M", now, "record", recordId, "update", uid, uid, "request-1", []byte(`{"Memo":"before"}`), []byte(`{"Memo":"after"}`)),
+			)
+
+			ret, err := r.FindByEntity(context.Background(), entity.EntityRevisionTypeRecord, recordId, 10, 20)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.EntityRevisionActionUpdate, ret[0].Action)
+			require.Equal(t, "request-1", ret[0].RequestId)
+			require.Equal(t, []byte(`{"Memo":"after"}`), ret[0].After)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -0,0 +1,118 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type IdempotencyKey struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKey(
+	db *gorm.DB,
+) repository.IdempotencyKeyInterface {
+	return &IdempotencyKey{db}
+}
+
+func (i *IdempotencyKey) FindByKey(
+	ctx context.Context,
+	userId string,
+	key string,
+) (*entity.IdempotencyKey, error) {
+	var m model.IdempotencyKey
+
+	if tx := dbFromContext(ctx, i.db).Where("user_id = ? AND key = ?", userId, key).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return &entity.IdempotencyKey{
+		UserId:       m.UserId,
+		Key:          m.Key,
+		RequestHash:  m.RequestHash,
+		StatusCode:   m.StatusCode,
+		ResponseBody: m.ResponseBody,
+		CreatedAt:    m.CreatedAt,
+	}, nil
+}
+
+func (i *IdempotencyKey) Reserve(
+	ctx context.Context,
+	key *entity.IdempotencyKey,
+	expiredBefore time.Time,
+	staleBefore time.Time,
+) (bool, error) {
+	// 主キー(user_id, key)の一意制約で、同時に届いた同じキーのリクエストの片方だけを登録する。
+	// 期限切れの行は purge-idempotency-keys が消すまで残るため、その場合は新しいキーとして上書きする。
+	// 応答を保存できないまま処理中で残った行も、staleBefore を過ぎていれば上書きする。
+	tx := dbFromContext(ctx, i.db).Exec(
+		`INSERT INTO idempotency_keys (user_id, key, request_hash, status_code, response_body, created_at)
+		 VALUES (?, ?, ?, 0, NULL, ?)
+		 ON CONFLICT (user_id, key) DO UPDATE
+		 SET request_hash = EXCLUDED.request_hash, status_code = 0, response_body = NULL, created_at = EXCLUDED.created_at
+		 WHERE idempotency_keys.created_at < ?
+		    OR (idempotency_keys.status_code = 0 AND idempotency_keys.created_at < ?)`,
+		key.UserId,
+		key.Key,
+		key.RequestHash,
+		key.CreatedAt,
+		expiredBefore,
+		staleBefore,
+	)
+	if tx.Error != nil {
+		logError(ctx, tx.Error)
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected == 1, nil
+}
+
+func (i *IdempotencyKey) Complete(
+	ctx context.Context,
+	key *entity.IdempotencyKey,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.IdempotencyKey{}).
+		Where("user_id = ? AND key = ?", key.UserId, key.Key).
+		Updates(map[string]interface{}{
+			"status_code":   key.StatusCode,
+			"response_body": key.ResponseBody,
+		}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *IdempotencyKey) Delete(
+	ctx context.Context,
+	userId string,
+	key string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Where("user_id = ? AND key = ?", userId, key).Delete(&model.IdempotencyKey{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *IdempotencyKey) DeleteExpired(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	tx := dbFromContext(ctx, i.db).Where("created_at < ?", before).Delete(&model.IdempotencyKey{})
+	if tx.Error != nil {
+		logError(ctx, tx.Error)
+		return 0, tx.Error
+	}
+
+	return tx.RowsAffected, nil
+}
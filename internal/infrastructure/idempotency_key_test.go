@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestIdempotencyKeyInfrastructure(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	key := "9f1c2d4e-0b7a-4c55-8d0e-3a6f7b8c9d01"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("FindByKey", func(t *testing.T) {
+		t.Run("正常系_保存した応答を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "idempotency_keys" WHERE user_id = $1 AND key = $2 ORDER BY "idempotency_keys"."user_id" LIMIT $3`,
+			)).WithArgs(uid, key, 1).WillReturnRows(
+				sqlmock.NewRows([]string{"user_id", "key", "request_hash", "status_code", "response_body", "created_at"}).
+					AddRow(uid, key, "hash", 201, []byte(`{"id":"1"}`), now),
+			)
+
+			ret, err := r.FindByKey(context.Background(), uid, key)
+
+			require.NoError(t, err)
+			require.Equal(t, 201, ret.StatusCode)
+			require.Equal(t, []byte(`{"id":"1"}`), ret.ResponseBody)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_無ければErrRecordNotFoundを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "idempotency_keys"`)).
+				WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+			_, err := r.FindByKey(context.Background(), uid, key)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
+	t.Run("Reserve", func(t *testing.T) {
+		t.Run("正常系_登録できればtrueを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			expiredBefore := entity.IdempotencyKeyExpiredBefore(now)
+			staleBefore := entity.IdempotencyKeyStaleBefore(now)
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO idempotency_keys`)).
+				WithArgs(uid, key, "hash", now, expiredBefore, staleBefore).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			ok, err := r.Reserve(context.Background(), entity.NewIdempotencyKey(uid, key, "hash", now), expiredBefore, staleBefore)
+
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_期限内の同じキーがあればfalseを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO idempotency_keys`)).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			ok, err := r.Reserve(context.Background(), entity.NewIdempotencyKey(uid, key, "hash", now), entity.IdempotencyKeyExpiredBefore(now), entity.IdempotencyKeyStaleBefore(now))
+
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+
+		t.Run("異常系_DBのエラーを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO idempotency_keys`)).
+				WillReturnError(errors.New("db error"))
+
+			ok, err := r.Reserve(context.Background(), entity.NewIdempotencyKey(uid, key, "hash", now), entity.IdempotencyKeyExpiredBefore(now), entity.IdempotencyKeyStaleBefore(now))
+
+			require.Error(t, err)
+			require.False(t, ok)
+		})
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		t.Run("正常系_応答を保存する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			k := entity.NewIdempotencyKey(uid, key, "hash", now)
+			k.StatusCode = 201
+			k.ResponseBody = []byte(`{}`)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "idempotency_keys" SET "response_body"=$1,"status_code"=$2 WHERE user_id = $3 AND key = $4`,
+			)).WithArgs([]byte(`{}`), 201, uid, key).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Complete(context.Background(), k))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("DeleteExpired", func(t *testing.T) {
+		t.Run("正常系_期限切れのキーを消して件数を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewIdempotencyKey(db)
+
+			before := entity.IdempotencyKeyExpiredBefore(now)
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "idempotency_keys" WHERE created_at < $1`)).
+				WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectCommit()
+
+			n, err := r.DeleteExpired(context.Background(), before)
+
+			require.NoError(t, err)
+			require.Equal(t, int64(3), n)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
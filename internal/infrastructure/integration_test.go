@@ -936,7 +936,7 @@ func TestIntegrationStatsRegulationFilter(t *testing.T) {
 	standard := entity.RegulationIdStandard
 
 	t.Run("正常系_戦績はスタンダードの対戦だけを数える", func(t *testing.T) {
-		stat, err := NewUserStat(db).FindUserStat(ctx, uid, fromDate, toDate, standard)
+		stat, err := NewUserStat(db).FindUserStat(ctx, uid, fromDate, toDate, standard, "")
 
 		require.NoError(t, err)
 		require.Equal(t, 1, stat.TotalRecords)
@@ -971,7 +971,7 @@ func TestIntegrationStatsRegulationFilter(t *testing.T) {
 	})
 
 	t.Run("正常系_相手デッキ分布はスタンダードの対戦だけを数える", func(t *testing.T) {
-		stat, err := NewOpponentDeckUsageStat(db).FindOpponentDeckUsageStat(ctx, uid, fromDate, toDate, "", standard)
+		stat, err := NewOpponentDeckUsageStat(db).FindOpponentDeckUsageStat(ctx, uid, fromDate, toDate, "", standard, false)
 
 		require.NoError(t, err)
 		require.Equal(t, 2, stat.TotalMatches)
@@ -982,7 +982,7 @@ func TestIntegrationStatsRegulationFilter(t *testing.T) {
 	})
 
 	t.Run("正常系_未指定なら全レギュレーションを数える", func(t *testing.T) {
-		stat, err := NewUserStat(db).FindUserStat(ctx, uid, fromDate, toDate, 0)
+		stat, err := NewUserStat(db).FindUserStat(ctx, uid, fromDate, toDate, 0, "")
 
 		require.NoError(t, err)
 		require.Equal(t, 2, stat.TotalRecords)
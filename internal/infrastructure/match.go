@@ -146,6 +146,7 @@ func (i *Match) FindById(
 	)
 	match.Position = results[0].MatchPosition
 	match.Tags = tagsByMatchId[id]
+	match.UpdatedAt = results[0].MatchUpdatedAt
 
 	return match, nil
 }
@@ -156,7 +157,7 @@ func (i *Match) FindByRecordId(
 ) ([]*entity.Match, error) {
 	var results []*model.MatchJoinGame
 
-	tx := i.db.Table(
+	tx := dbFromContext(ctx, i.db).Table(
 		"records",
 	).Select(`
 		matches.id AS match_id,
@@ -274,6 +275,7 @@ func (i *Match) FindByRecordId(
 			)
 			match.Position = result.MatchPosition
 			match.Tags = tagsByMatchId[result.MatchID]
+			match.UpdatedAt = result.MatchUpdatedAt
 
 			v[result.MatchID] = match
 			keys = append(keys, result.MatchID)
@@ -311,14 +313,49 @@ func (i *Match) FindByUserId(
 	userId string,
 	limit int,
 ) ([]*entity.Match, error) {
-	var results []*model.MatchJoinGame
-
 	subQuery := i.db.Table("matches").
 		Select("id").
 		Where("user_id = ? AND deleted_at IS NULL", userId).
 		Order("created_at DESC").
 		Limit(limit)
 
+	return i.findBySubQuery(ctx, subQuery)
+}
+
+// FindByCriteria は criteria の記録の条件を親の記録に、対戦結果の条件を対戦結果に適用して探す。
+// 論理削除済みの記録に属する対戦結果は含めない。
+func (i *Match) FindByCriteria(
+	ctx context.Context,
+	criteria *entity.RecordCriteria,
+) ([]*entity.Match, error) {
+	recordConds := recordCriteriaConditions(criteria)
+
+	subQuery := i.db.Table("matches").
+		Select("matches.id").
+		Joins("JOIN records ON records.id = matches.record_id").
+		Where("records.deleted_at IS NULL AND matches.deleted_at IS NULL").
+		Where(recordConds.sql(), recordConds.args...)
+
+	if matchConds := matchCriteriaConditions(criteria); matchConds != nil {
+		subQuery = subQuery.Where(matchConds.sql(), matchConds.args...)
+	}
+
+	subQuery = subQuery.
+		Order("matches.created_at DESC").
+		Limit(criteria.Limit).
+		Offset(criteria.Offset)
+
+	return i.findBySubQuery(ctx, subQuery)
+}
+
+// findBySubQuery は subQuery が返すIDの対戦結果を、対局・スプライト・タグと合わせて
+// created_at の新しい順に取得する。1件も無ければ apperror.ErrRecordNotFound を返す。
+func (i *Match) findBySubQuery(
+	ctx context.Context,
+	subQuery *gorm.DB,
+) ([]*entity.Match, error) {
+	var results []*model.MatchJoinGame
+
 	tx := i.db.Table(
 		"matches",
 	).Select(`
@@ -470,157 +507,13 @@ func (i *Match) FindLatest(
 	ctx context.Context,
 	limit int,
 ) ([]*entity.Match, error) {
-	var results []*model.MatchJoinGame
-
 	subQuery := i.db.Table("matches").
 		Select("id").
 		Where("deleted_at IS NULL").
 		Order("created_at DESC").
 		Limit(limit)
 
-	tx := i.db.Table(
-		"matches",
-	).Select(`
-		matches.id AS match_id,
-		matches.created_at AS match_created_at,
-		matches.updated_at AS match_updated_at,
-		matches.deleted_at AS match_deleted_at,
-		matches.record_id AS match_record_id,
-		matches.deck_id AS match_deck_id,
-		matches.deck_code_id AS match_deck_code_id,
-		matches.user_id AS match_user_id,
-		matches.opponents_user_id AS match_opponents_user_id,
-		matches.bo3_flg AS match_bo3_flg,
-		matches.group_match_flg AS match_group_match_flg,
-		matches.qualifying_round_flg AS match_qualifying_round_flg,
-		matches.final_tournament_flg AS match_final_tournament_flg,
-		matches.default_victory_flg AS match_default_victory_flg,
-		matches.default_defeat_flg AS match_default_defeat_flg,
-		matches.victory_flg AS match_victory_flg,
-		matches.draw_flg AS match_draw_flg,
-		matches.group_match_victory_flg AS match_group_match_victory_flg,
-		matches.opponents_deck_info AS match_opponents_deck_info,
-		matches.memo AS match_memo,
-		matches.position AS match_position,
-		games.id AS game_id,
-		games.created_at AS game_created_at,
-		games.updated_at AS game_updated_at,
-		games.deleted_at AS game_deleted_at,
-		games.match_id AS game_match_id,
-		games.user_id AS game_user_id,
-		games.go_first AS game_go_first,
-		games.winning_flg AS game_winning_flg,
-		games.your_prize_cards AS game_your_prize_cards,
-		games.opponents_prize_cards AS game_opponents_prize_cards,
-		games.memo AS game_memo`,
-	).Joins(
-		// 論理削除済みのgamesを取得しないよう、JOIN条件でdeleted_atを除外する。
-		// (Table()+Scan()のためgormのソフトデリートは自動適用されない)
-		"LEFT JOIN games ON matches.id = games.match_id AND games.deleted_at IS NULL",
-	).Where(
-		"matches.id IN (?)", subQuery,
-	).Order(
-		"matches.created_at DESC, games.created_at ASC",
-	).Scan(&results)
-
-	if tx.Error != nil {
-		logError(ctx, tx.Error)
-		return nil, tx.Error
-	}
-
-	if len(results) == 0 {
-		return nil, apperror.ErrRecordNotFound
-	}
-
-	spritesByMatchId, err := findMatchPokemonSpritesByMatchIds(ctx, i.db, matchIdsOf(results))
-	if err != nil {
-		logError(ctx, err)
-		return nil, err
-	}
-
-	tagsByMatchId, err := findTagsByMatchIds(ctx, i.db, matchIdsOf(results))
-	if err != nil {
-		logError(ctx, err)
-		return nil, err
-	}
-
-	v := make(map[string]*entity.Match)
-	var keys []string
-
-	for _, result := range results {
-		match, ok := v[result.MatchID]
-
-		if !ok {
-			var games []*entity.Game
-
-			if result.GameID != "" {
-				game := entity.NewGame(
-					result.GameID,
-					result.GameCreatedAt,
-					result.MatchID,
-					result.MatchUserId,
-					result.GameGoFirst,
-					result.GameWinningFlg,
-					result.GameYourPrizeCards,
-					result.GameOpponentsPrizeCards,
-					result.GameMemo,
-				)
-				games = append(games, game)
-			}
-
-			pokemonSprites := spritesByMatchId[result.MatchID]
-
-			match := entity.NewMatch(
-				result.MatchID,
-				result.MatchCreatedAt,
-				result.MatchRecordId,
-				result.MatchDeckId,
-				result.MatchDeckCodeId,
-				result.MatchUserId,
-				result.MatchOpponentsUserId,
-				result.MatchBO3Flg,
-				result.MatchGroupMatchFlg,
-				result.MatchQualifyingRoundFlg,
-				result.MatchFinalTournamentFlg,
-				result.MatchDefaultVictoryFlg,
-				result.MatchDefaultDefeatFlg,
-				result.MatchVictoryFlg,
-				result.MatchDrawFlg,
-				result.MatchGroupMatchVictoryFlg,
-				result.MatchOpponentsDeckInfo,
-				result.MatchMemo,
-				games,
-				pokemonSprites,
-			)
-			match.Position = result.MatchPosition
-			match.Tags = tagsByMatchId[result.MatchID]
-
-			v[result.MatchID] = match
-			keys = append(keys, result.MatchID)
-		} else {
-			if result.GameID != "" {
-				game := entity.NewGame(
-					result.GameID,
-					result.GameCreatedAt,
-					result.MatchID,
-					result.MatchUserId,
-					result.GameGoFirst,
-					result.GameWinningFlg,
-					result.GameYourPrizeCards,
-					result.GameOpponentsPrizeCards,
-					result.GameMemo,
-				)
-				match.Games = append(match.Games, game)
-			}
-		}
-	}
-
-	var matches []*entity.Match
-	for _, key := range keys {
-		matches = append(matches, v[key])
-	}
-
-	return matches, nil
+	return i.findBySubQuery(ctx, subQuery)
 }
 
 func (i *Match) Create(
@@ -648,9 +541,13 @@ func (i *Match) Create(
 		entity.Memo,
 	)
 
+	// 記録と対戦結果をまとめて取り込む場合など、TransactionManager.Do の中から呼ばれたら
+	// そのトランザクションに参加する(Transaction はセーブポイントとして入れ子になる)。
+	db := dbFromContext(ctx, i.db)
+
 	// 同一 record 内で末尾に追加されるよう、現在の最大 position の次を採番する
 	var maxPosition sql.NullInt64
-	if tx := i.db.Model(&model.Match{}).
+	if tx := db.Model(&model.Match{}).
 		Where("record_id = ? AND deleted_at IS NULL", entity.RecordId).
 		Select("MAX(position)").Scan(&maxPosition); tx.Error != nil {
 		return tx.Error
@@ -686,7 +583,7 @@ func (i *Match) Create(
 		matchPokemonSpriteModals = append(matchPokemonSpriteModals, model.NewMatchPokemonSprite(entity.ID, position, pokemonSprite.ID))
 	}
 
-	return i.db.Transaction(func(tx *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Save(matchModel).Error; err != nil {
 			logError(ctx, err)
 			return err
@@ -755,8 +652,8 @@ func (i *Match) Update(
 		matchPokemonSpriteModals = append(matchPokemonSpriteModals, model.NewMatchPokemonSprite(entity.ID, position, pokemonSprite.ID))
 	}
 
-	return i.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(matchModel).Error; err != nil {
+	err := dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
+		if err := saveIfUnmodified(ctx, tx, entity.ID, entity.UpdatedAt, matchModel); err != nil {
 			logError(ctx, err)
 			return err
 		}
@@ -842,21 +739,27 @@ func (i *Match) Update(
 
 		return nil
 	}, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return err
+	}
+	entity.UpdatedAt = matchModel.UpdatedAt
+
+	return nil
 }
 
 func (i *Match) Delete(
 	ctx context.Context,
 	id string,
 ) error {
-	return i.db.Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
 		if tx := tx.Where("match_id = ?", id).Delete(&model.Game{}); tx.Error != nil {
 			logError(ctx, tx.Error)
 			return tx.Error
 		}
 
-		if tx := tx.Where("id = ?", id).Delete(&model.Match{}); tx.Error != nil {
-			logError(ctx, tx.Error)
-			return tx.Error
+		if err := deleteIfUnmodified(ctx, tx, id, &model.Match{}); err != nil {
+			logError(ctx, err)
+			return err
 		}
 
 		return nil
@@ -868,7 +771,7 @@ func (i *Match) Reorder(
 	recordId string,
 	orders []*entity.MatchOrder,
 ) error {
-	return i.db.Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, i.db).Transaction(func(tx *gorm.DB) error {
 		var count int64
 		if tx := tx.Model(&model.Match{}).
 			Where("record_id = ? AND deleted_at IS NULL", recordId).
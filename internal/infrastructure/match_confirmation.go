@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type MatchConfirmation struct {
+	db *gorm.DB
+}
+
+func NewMatchConfirmation(
+	db *gorm.DB,
+) repository.MatchConfirmationInterface {
+	return &MatchConfirmation{db}
+}
+
+func newMatchConfirmationEntity(m *model.MatchConfirmation) *entity.MatchConfirmation {
+	return &entity.MatchConfirmation{
+		ID:              m.ID,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		MatchId:         m.MatchId,
+		RequesterUserId: m.RequesterUserId,
+		OpponentUserId:  m.OpponentUserId,
+		Status:          entity.MatchConfirmationStatus(m.Status),
+		MirroredMatchId: m.MirroredMatchId.String,
+		DisputeReason:   m.DisputeReason,
+		RespondedAt:     m.RespondedAt.Time,
+	}
+}
+
+func newMatchConfirmationMirroredMatchId(mirroredMatchId string) sql.NullString {
+	return sql.NullString{String: mirroredMatchId, Valid: mirroredMatchId != ""}
+}
+
+func newMatchConfirmationRespondedAt(respondedAt time.Time) sql.NullTime {
+	return sql.NullTime{Time: respondedAt, Valid: !respondedAt.IsZero()}
+}
+
+func (i *MatchConfirmation) Create(
+	ctx context.Context,
+	confirmation *entity.MatchConfirmation,
+) error {
+	m := &model.MatchConfirmation{
+		ID:              confirmation.ID,
+		CreatedAt:       confirmation.CreatedAt,
+		UpdatedAt:       confirmation.UpdatedAt,
+		MatchId:         confirmation.MatchId,
+		RequesterUserId: confirmation.RequesterUserId,
+		OpponentUserId:  confirmation.OpponentUserId,
+		Status:          string(confirmation.Status),
+		MirroredMatchId: newMatchConfirmationMirroredMatchId(confirmation.MirroredMatchId),
+		DisputeReason:   confirmation.DisputeReason,
+		RespondedAt:     newMatchConfirmationRespondedAt(confirmation.RespondedAt),
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *MatchConfirmation) FindById(
+	ctx context.Context,
+	id string,
+) (*entity.MatchConfirmation, error) {
+	var m model.MatchConfirmation
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newMatchConfirmationEntity(&m), nil
+}
+
+func (i *MatchConfirmation) FindByMatchId(
+	ctx context.Context,
+	matchId string,
+) (*entity.MatchConfirmation, error) {
+	var m model.MatchConfirmation
+
+	if tx := dbFromContext(ctx, i.db).Where("match_id = ?", matchId).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newMatchConfirmationEntity(&m), nil
+}
+
+func (i *MatchConfirmation) FindByLinkedMatchId(
+	ctx context.Context,
+	matchId string,
+) ([]*entity.MatchConfirmation, error) {
+	var models []*model.MatchConfirmation
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("match_id = ? OR mirrored_match_id = ?", matchId, matchId).
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.MatchConfirmation, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newMatchConfirmationEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *MatchConfirmation) FindByOpponentUserId(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.MatchConfirmation, error) {
+	var models []*model.MatchConfirmation
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("opponent_user_id = ?", userId).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.MatchConfirmation, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newMatchConfirmationEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *MatchConfirmation) Save(
+	ctx context.Context,
+	confirmation *entity.MatchConfirmation,
+	status entity.MatchConfirmationStatus,
+) error {
+	tx := dbFromContext(ctx, i.db).Model(&model.MatchConfirmation{}).Where("id = ? AND status = ?", confirmation.ID, string(status)).Updates(map[string]interface{}{
+		"updated_at":        confirmation.UpdatedAt,
+		"opponent_user_id":  confirmation.OpponentUserId,
+		"status":            string(confirmation.Status),
+		"mirrored_match_id": newMatchConfirmationMirroredMatchId(confirmation.MirroredMatchId),
+		"dispute_reason":    confirmation.DisputeReason,
+		"responded_at":      newMatchConfirmationRespondedAt(confirmation.RespondedAt),
+	})
+	if tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	// 同時に承認・異議を受け付けたときなど、先に別のリクエストが状態を変えていた。
+	if tx.RowsAffected == 0 {
+		return apperror.ErrMatchConfirmationClosed
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var matchConfirmationColumns = []string{
+	"id", "created_at", "updated_at", "match_id", "requester_user_id", "opponent_user_id",
+	"status", "mirrored_match_id", "dispute_reason", "responded_at",
+}
+
+func TestMatchConfirmationInfrastructure(t *testing.T) {
+	id := "01JTESTCONFIRM000000000000"
+	matchId := "01JTESTMATCH0000000000000A"
+	mirroredMatchId := "01JTESTMATCH0000000000000B"
+	requesterId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	opponentId := "Q8qU2m0aBcXyZ1234567890abcd"
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_回答待ちの依頼は mirrored_match_id・responded_at を NULL で作成する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`INSERT INTO "match_confirmations" ("id","created_at","updated_at","match_id","requester_user_id","opponent_user_id","status","mirrored_match_id","dispute_reason","responded_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+			)).WithArgs(
+				id, now, now, matchId, requesterId, opponentId, "pending", sql.NullString{}, "", sql.NullTime{},
+			).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+
+			require.NoError(t, r.Create(context.Background(), confirmation))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("正常系_承認済みの依頼を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "match_confirmations" WHERE id = $1 ORDER BY "match_confirmations"."id" LIMIT $2`,
+			)).WithArgs(id, 1).WillReturnRows(
+				sqlmock.NewRows(matchConfirmationColumns).AddRow(
+					id, now, now, matchId, requesterId, opponentId, "confirmed", mirroredMatchId, "", now,
+				),
+			)
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusConfirmed, ret.Status)
+			require.Equal(t, mirroredMatchId, ret.MirroredMatchId)
+			require.Equal(t, now, ret.RespondedAt)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_無ければErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			mock.ExpectQuery(`SELECT \* FROM "match_confirmations"`).
+				WithArgs(id, 1).WillReturnRows(sqlmock.NewRows(matchConfirmationColumns))
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByLinkedMatchId", func(t *testing.T) {
+		t.Run("正常系_どちら側の対戦結果からでも結び付いた依頼を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "match_confirmations" WHERE match_id = $1 OR mirrored_match_id = $2`,
+			)).WithArgs(mirroredMatchId, mirroredMatchId).WillReturnRows(
+				sqlmock.NewRows(matchConfirmationColumns).AddRow(
+					id, now, now, matchId, requesterId, opponentId, "confirmed", mirroredMatchId, "", now,
+				),
+			)
+
+			ret, err := r.FindByLinkedMatchId(context.Background(), mirroredMatchId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, matchId, ret[0].MatchId)
+			require.Equal(t, mirroredMatchId, ret[0].MirroredMatchId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByOpponentUserId", func(t *testing.T) {
+		t.Run("正常系_届いた依頼を新しい順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "match_confirmations" WHERE opponent_user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`,
+			)).WithArgs(opponentId, 10, 20).WillReturnRows(
+				sqlmock.NewRows(matchConfirmationColumns).AddRow(
+					id, now, now, matchId, requesterId, opponentId, "pending", nil, "", nil,
+				),
+			)
+
+			ret, err := r.FindByOpponentUserId(context.Background(), opponentId, 10, 20)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Empty(t, ret[0].MirroredMatchId)
+			require.True(t, ret[0].RespondedAt.IsZero())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("正常系_回答を保存する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Confirm(mirroredMatchId, now)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "match_confirmations" SET "dispute_reason"=$1,"mirrored_match_id"=$2,"opponent_user_id"=$3,"responded_at"=$4,"status"=$5,"updated_at"=$6 WHERE id = $7 AND status = $8`,
+			)).WithArgs(
+				"", sql.NullString{String: mirroredMatchId, Valid: true}, opponentId, sql.NullTime{Time: now, Valid: true}, "confirmed", now, id, "pending",
+			).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Save(context.Background(), confirmation, entity.MatchConfirmationStatusPending))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_先に状態が変わっていればErrMatchConfirmationClosedを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewMatchConfirmation(db)
+
+			now := time.Now().Local()
+
+			confirmation := entity.NewMatchConfirmation(id, now, matchId, requesterId, opponentId)
+			confirmation.Dispute("", now)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "match_confirmations" SET "dispute_reason"=$1,"mirrored_match_id"=$2,"opponent_user_id"=$3,"responded_at"=$4,"status"=$5,"updated_at"=$6 WHERE id = $7 AND status = $8`,
+			)).WithArgs(
+				"", sql.NullString{}, opponentId, sql.NullTime{Time: now, Valid: true}, "disputed", now, id, "pending",
+			).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+
+			require.ErrorIs(t, r.Save(context.Background(), confirmation, entity.MatchConfirmationStatusPending), apperror.ErrMatchConfirmationClosed)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
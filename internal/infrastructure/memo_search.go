@@ -0,0 +1,115 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// likeEscaper は LIKE のワイルドカードを通常の文字として扱うためのエスケープ
+// (PostgreSQL の LIKE は既定で \ をエスケープ文字とする)。
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type memoSearchResult struct {
+	HitType   string
+	ID        string
+	RecordId  string
+	MatchId   string
+	EventDate sql.NullTime
+	CreatedAt time.Time
+	Memo      string
+}
+
+type MemoSearch struct {
+	db *gorm.DB
+}
+
+func NewMemoSearch(
+	db *gorm.DB,
+) repository.MemoSearchInterface {
+	return &MemoSearch{db}
+}
+
+// memoConditions は column が terms の全てを含む条件と、その引数を返す。
+func memoConditions(column string, terms []string) (string, []any) {
+	conds := make([]string, 0, len(terms))
+	args := make([]any, 0, len(terms))
+
+	for _, term := range terms {
+		conds = append(conds, column+" ILIKE ?")
+		args = append(args, "%"+likeEscaper.Replace(term)+"%")
+	}
+
+	return strings.Join(conds, " AND "), args
+}
+
+// Search は pg_trgm の GIN 索引(records / matches / games の memo)を使った ILIKE で探す。
+// 日本語は単語の区切りが無く tsvector での分かち書きが効かないため、全文検索の
+// 語彙解析ではなく、語の区切りに依存しない3文字単位(trigram)の索引で部分一致させる。
+// 2文字以下の語は索引を使えないが、user_id で絞り込んだ後の走査になるため許容する。
+func (i *MemoSearch) Search(
+	ctx context.Context,
+	userId string,
+	terms []string,
+	limit int,
+	offset int,
+) ([]*entity.MemoSearchHit, error) {
+	recordConds, recordArgs := memoConditions("r.memo", terms)
+	matchConds, matchArgs := memoConditions("m.memo", terms)
+	gameConds, gameArgs := memoConditions("g.memo", terms)
+
+	query := `SELECT * FROM (
+		SELECT 'record' AS hit_type, r.id, r.id AS record_id, '' AS match_id, r.event_date, r.created_at, r.memo
+		FROM records AS r
+		WHERE r.user_id = ? AND r.deleted_at IS NULL AND ` + recordConds + `
+		UNION ALL
+		SELECT 'match' AS hit_type, m.id, m.record_id, m.id AS match_id, r.event_date, m.created_at, m.memo
+		FROM matches AS m
+		JOIN records AS r ON r.id = m.record_id AND r.deleted_at IS NULL
+		WHERE m.user_id = ? AND m.deleted_at IS NULL AND ` + matchConds + `
+		UNION ALL
+		SELECT 'game' AS hit_type, g.id, m.record_id, m.id AS match_id, r.event_date, g.created_at, g.memo
+		FROM games AS g
+		JOIN matches AS m ON m.id = g.match_id AND m.deleted_at IS NULL
+		JOIN records AS r ON r.id = m.record_id AND r.deleted_at IS NULL
+		WHERE g.user_id = ? AND g.deleted_at IS NULL AND ` + gameConds + `
+	) AS hits
+	ORDER BY created_at DESC, id DESC
+	LIMIT ? OFFSET ?`
+
+	var args []any
+	args = append(args, userId)
+	args = append(args, recordArgs...)
+	args = append(args, userId)
+	args = append(args, matchArgs...)
+	args = append(args, userId)
+	args = append(args, gameArgs...)
+	args = append(args, limit, offset)
+
+	var results []*memoSearchResult
+	if tx := dbFromContext(ctx, i.db).Raw(query, args...).Scan(&results); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.MemoSearchHit, 0, len(results))
+	for _, result := range results {
+		ret = append(ret, &entity.MemoSearchHit{
+			Type:      entity.MemoSearchHitType(result.HitType),
+			ID:        result.ID,
+			RecordId:  result.RecordId,
+			MatchId:   result.MatchId,
+			EventDate: result.EventDate.Time,
+			CreatedAt: result.CreatedAt,
+			Memo:      result.Memo,
+		})
+	}
+
+	return ret, nil
+}
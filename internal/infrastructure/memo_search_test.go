@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestMemoSearchInfrastructure(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01JMPK4VF04QX714CG4PHYJ88K"
+	matchId := "01JMPK4VF04QX714CG4PHYJ88M"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	eventDate := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系_全ての語を含むメモを記録・対戦結果・対局から探す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewMemoSearch(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`r.memo ILIKE $2 AND r.memo ILIKE $3`)).
+			WithArgs(
+				uid, "%ドラパルト%", `%100\%%`,
+				uid, "%ドラパルト%", `%100\%%`,
+				uid, "%ドラパルト%", `%100\%%`,
+				10, 0,
+			).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"hit_type", "id", "record_id", "match_id", "event_date", "created_at", "memo"}).
+					AddRow("game", "01JMPK4VF04QX714CG4PHYJ88N", recordId, matchId, eventDate, now, "ドラパルトに100%負ける").
+					AddRow("record", recordId, recordId, "", nil, now, "ドラパルト 100%"),
+			)
+
+		hits, err := r.Search(context.Background(), uid, []string{"ドラパルト", "100%"}, 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+		require.Equal(t, entity.MemoSearchHitTypeGame, hits[0].Type)
+		require.Equal(t, matchId, hits[0].MatchId)
+		require.Equal(t, recordId, hits[0].RecordId)
+		require.True(t, eventDate.Equal(hits[0].EventDate))
+		require.Equal(t, entity.MemoSearchHitTypeRecord, hits[1].Type)
+		require.True(t, hits[1].EventDate.IsZero())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("異常系_検索のエラーを返す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewMemoSearch(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM records AS r`)).WillReturnError(errors.New(""))
+
+		_, err := r.Search(context.Background(), uid, []string{"ドラパルト"}, 10, 0)
+
+		require.Error(t, err)
+	})
+}
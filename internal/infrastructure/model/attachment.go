@@ -0,0 +1,21 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Attachment は attachments テーブル(記録・対戦結果に添付した写真)。
+// 親(記録・対戦結果)の論理削除中は復元に備えて残し、親を物理削除するときに一緒に消すため、
+// 写真自体は論理削除を持たない。RecordId と MatchId はどちらか一方だけを持つ。
+type Attachment struct {
+	ID          string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UserId      string
+	RecordId    sql.NullString
+	MatchId     sql.NullString
+	ContentType string
+	Size        int
+	Width       int
+	Height      int
+}
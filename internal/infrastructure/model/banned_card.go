@@ -0,0 +1,16 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// BannedCard は banned_cards テーブル(レギュレーションごとの禁止カード)。
+// ToDate が NULL のものは現在も禁止中。
+type BannedCard struct {
+	ID           uint `gorm:"primaryKey"`
+	RegulationId uint
+	CardName     string
+	FromDate     time.Time
+	ToDate       sql.NullTime
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// DeckAssetJob は deck_asset_jobs テーブル(デッキコードのリソースを取得するジョブのキュー)。
+// 完了・デッドレターになった行も状態の参照に使うため残し、論理削除は持たない。
+type DeckAssetJob struct {
+	ID         string `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeckCodeId string
+	Code       string
+	Kind       string
+	Status     string
+	Attempts   uint
+	NextRunAt  time.Time
+	LastError  string
+}
@@ -0,0 +1,27 @@
+package model
+
+// DeckCodeCard は deck_code_cards テーブル(デッキコードのカード構成)。
+// デッキコードの中身は不変で、作り直すときは行ごと入れ替えるため論理削除は持たない。
+type DeckCodeCard struct {
+	DeckCodeId string `gorm:"primaryKey"`
+	CardId     uint   `gorm:"primaryKey"`
+	Category   string
+	Count      uint
+	Position   uint
+}
+
+func NewDeckCodeCard(
+	deckCodeId string,
+	cardId uint,
+	category string,
+	count uint,
+	position uint,
+) *DeckCodeCard {
+	return &DeckCodeCard{
+		DeckCodeId: deckCodeId,
+		CardId:     cardId,
+		Category:   category,
+		Count:      count,
+		Position:   position,
+	}
+}
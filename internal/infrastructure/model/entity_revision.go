@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// EntityRevision は entity_revisions テーブル(記録・対戦結果の変更履歴)。
+// 追記のみのため、更新日時や論理削除は持たない。
+type EntityRevision struct {
+	ID         string `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	EntityType string
+	EntityId   string
+	Action     string
+	UserId     string
+	ActorUid   string
+	RequestId  string
+	Before     []byte
+	After      []byte
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// IdempotencyKey は idempotency_keys テーブル(作成系リクエストの Idempotency-Key と応答)。
+// 期限切れの行は purge-idempotency-keys が消すため、論理削除は持たない。
+type IdempotencyKey struct {
+	UserId       string `gorm:"primaryKey"`
+	Key          string `gorm:"primaryKey"`
+	RequestHash  string
+	StatusCode   int
+	ResponseBody []byte
+	CreatedAt    time.Time
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// MatchConfirmation は match_confirmations テーブル(登録ユーザ同士の対戦結果の確認依頼)。
+// 回答後も両側の対戦結果の結び付きとして残すため、論理削除は持たない。
+type MatchConfirmation struct {
+	ID              string `gorm:"primaryKey"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	MatchId         string
+	RequesterUserId string
+	OpponentUserId  string
+	Status          string
+	MirroredMatchId sql.NullString
+	DisputeReason   string
+	RespondedAt     sql.NullTime
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"time"
 )
 
@@ -27,3 +28,10 @@ type OfficialEvent struct {
 	StandardRegulationId    string
 	StandardRegulationMarks string
 }
+
+// OfficialEventSearchHit は公式イベントの検索結果の1行。
+// DistanceKm は位置を指定しない検索では NULL。
+type OfficialEventSearchHit struct {
+	OfficialEvent
+	DistanceKm sql.NullFloat64
+}
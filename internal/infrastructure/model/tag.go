@@ -86,3 +86,19 @@ func NewMatchTag(
 		TagId:   tagId,
 	}
 }
+
+// RecordTag は record_tags 中間テーブル(記録 ⇔ タグ)。
+type RecordTag struct {
+	RecordId string `gorm:"primaryKey"`
+	TagId    string `gorm:"primaryKey"`
+}
+
+func NewRecordTag(
+	recordId string,
+	tagId string,
+) *RecordTag {
+	return &RecordTag{
+		RecordId: recordId,
+		TagId:    tagId,
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"time"
 
 	"gorm.io/gorm"
@@ -14,6 +15,8 @@ type UnofficialEvent struct {
 	UserId    string
 	Title     string
 	Date      time.Time `gorm:"type:date"`
+	// JoinCode は共有していなければ NULL(一意索引から外すため空文字にしない)。
+	JoinCode sql.NullString
 }
 
 func NewUnofficialEvent(
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UnofficialEventTournament は unofficial_event_tournaments テーブル。主キーは自由形式イベントのID。
+type UnofficialEventTournament struct {
+	UnofficialEventId string `gorm:"primaryKey"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	BO3Flg            bool
+	TopCutSize        int
+}
+
+// UnofficialEventParticipant は unofficial_event_participants テーブル。ゲストは user_id が NULL。
+type UnofficialEventParticipant struct {
+	ID                string `gorm:"primaryKey"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	UnofficialEventId string
+	UserId            sql.NullString
+	GuestName         string
+	RecordId          sql.NullString
+	DroppedAt         sql.NullTime
+}
+
+// UnofficialEventPairing は unofficial_event_pairings テーブル。バイは player2_participant_id が NULL。
+type UnofficialEventPairing struct {
+	ID                   string `gorm:"primaryKey"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	UnofficialEventId    string
+	Stage                string
+	Round                int
+	TableNo              int
+	Player1ParticipantId string
+	Player2ParticipantId sql.NullString
+	Player1Wins          int
+	Player2Wins          int
+	ReportedAt           sql.NullTime
+	Player1MatchId       sql.NullString
+	Player2MatchId       sql.NullString
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserExport は user_exports テーブル(ユーザの全データのエクスポートのジョブ)。
+// 完了・失敗した行もダウンロードと状態の参照に使うため残し、論理削除は持たない。
+type UserExport struct {
+	ID          string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	UserId      string
+	Status      string
+	Attempts    uint
+	NextRunAt   time.Time
+	LastError   string
+	CompletedAt sql.NullTime
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// UserRelationship は user_relationships テーブル。申請の取り下げやブロックの解除は
+// 行ごと消してやり直せるようにするため、論理削除は持たない。
+type UserRelationship struct {
+	ID           string `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	UserId       string
+	TargetUserId string
+	Status       string
+}
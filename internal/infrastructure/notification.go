@@ -98,26 +98,51 @@ func (i *Notification) FindByUserId(
 
 	entities := make([]*entity.Notification, 0, len(models))
 	for _, m := range models {
-		n := entity.NewNotification(
-			m.ID,
-			m.CreatedAt,
-			m.UserId,
-			m.Category,
-			m.Title,
-			m.Body,
-			m.LinkUrl,
-		)
-		n.IsRead = m.IsRead
-		if m.ReadAt != nil {
-			n.ReadAt = *m.ReadAt
-		}
-
-		entities = append(entities, n)
+		entities = append(entities, newNotificationEntity(m))
 	}
 
 	return entities, nil
 }
 
+func (i *Notification) FindAllByUserId(
+	ctx context.Context,
+	userId string,
+) ([]*entity.Notification, error) {
+	var models []*model.Notification
+
+	if tx := i.db.
+		Where("user_id = ?", userId).
+		Order("created_at ASC, id ASC").
+		Find(&models); tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	entities := make([]*entity.Notification, 0, len(models))
+	for _, m := range models {
+		entities = append(entities, newNotificationEntity(m))
+	}
+
+	return entities, nil
+}
+
+func newNotificationEntity(m *model.Notification) *entity.Notification {
+	n := entity.NewNotification(
+		m.ID,
+		m.CreatedAt,
+		m.UserId,
+		m.Category,
+		m.Title,
+		m.Body,
+		m.LinkUrl,
+	)
+	n.IsRead = m.IsRead
+	if m.ReadAt != nil {
+		n.ReadAt = *m.ReadAt
+	}
+
+	return n
+}
+
 func (i *Notification) CountUnreadByUserId(
 	ctx context.Context,
 	userId string,
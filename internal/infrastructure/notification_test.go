@@ -155,6 +155,28 @@ func TestNotificationInfrastructure(t *testing.T) {
 		})
 	})
 
+	t.Run("FindAllByUserId", func(t *testing.T) {
+		t.Run("正常系_件数を絞らず作成日時とIDの昇順で通知を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewNotification(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "notifications" WHERE user_id = $1 ORDER BY created_at ASC, id ASC`,
+			)).WithArgs(uid).WillReturnRows(
+				sqlmock.NewRows(notificationColumns).AddRow(
+					id, createdAt, uid, "badge", "タイトル", "本文", "/badges", false, nil,
+				),
+			)
+
+			ret, err := r.FindAllByUserId(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, id, ret[0].ID)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
 	t.Run("CountUnreadByUserId", func(t *testing.T) {
 		t.Run("正常系_未読の通知数を返す", func(t *testing.T) {
 			db, mock := setupSqlmockDB(t)
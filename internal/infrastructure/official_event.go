@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -28,6 +29,8 @@ func (i *OfficialEvent) Find(
 	startDate time.Time,
 	endDate time.Time,
 ) ([]*entity.OfficialEvent, error) {
+	db := dbFromContext(ctx, i.db)
+
 	var events []*model.OfficialEvent
 
 	if typeId == 0 { // 大会の種類に指定がない場合
@@ -45,7 +48,7 @@ func (i *OfficialEvent) Find(
 				leagueTitle = "マスター"
 			}
 
-			tx := i.db.Table(
+			tx := db.Table(
 				"official_events",
 			).Select(`
 				official_events.id AS id,
@@ -89,7 +92,7 @@ func (i *OfficialEvent) Find(
 				return nil, tx.Error
 			}
 		} else {
-			tx := i.db.Table(
+			tx := db.Table(
 				"official_events",
 			).Select(`
 				official_events.id AS id,
@@ -148,7 +151,7 @@ func (i *OfficialEvent) Find(
 				leagueTitle = "マスター"
 			}
 
-			tx := i.db.Table(
+			tx := db.Table(
 				"official_events",
 			).Select(`
 				official_events.id AS id,
@@ -192,7 +195,7 @@ func (i *OfficialEvent) Find(
 				return nil, tx.Error
 			}
 		} else {
-			tx := i.db.Table(
+			tx := db.Table(
 				"official_events",
 			).Select(`
 				official_events.id AS id,
@@ -275,9 +278,11 @@ func (i *OfficialEvent) FindById(
 	ctx context.Context,
 	id uint,
 ) (*entity.OfficialEvent, error) {
+	db := dbFromContext(ctx, i.db)
+
 	var event model.OfficialEvent
 
-	tx := i.db.Table(
+	tx := db.Table(
 		"official_events",
 	).Select(`
 		official_events.id AS id,
@@ -343,3 +348,149 @@ func (i *OfficialEvent) FindById(
 		event.StandardRegulationMarks,
 	), nil
 }
+
+// shopLatitude / shopLongitude は shops.geo_coding(「緯度,経度」)の緯度・経度。
+// 書式に合わない値は CAST で失敗させずに NULL とし、距離の条件から外す。
+// gorm が ? をプレースホルダとして扱うため、正規表現の省略可能は {0,1} で書く。
+const (
+	shopGeoCodingPattern = `'^\s*-{0,1}[0-9]+(\.[0-9]+){0,1}\s*,\s*-{0,1}[0-9]+(\.[0-9]+){0,1}\s*$'`
+	shopLatitude         = "CASE WHEN shops.geo_coding ~ " + shopGeoCodingPattern + " THEN CAST(split_part(shops.geo_coding, ',', 1) AS DOUBLE PRECISION) END"
+	shopLongitude        = "CASE WHEN shops.geo_coding ~ " + shopGeoCodingPattern + " THEN CAST(split_part(shops.geo_coding, ',', 2) AS DOUBLE PRECISION) END"
+)
+
+// earthRadiusKm は距離の計算(haversine)に使う地球の半径(km)。
+const earthRadiusKm = 6371
+
+// shopDistanceKm は店舗から point までの距離(km)を求める式と、その引数を返す。
+// 丸め誤差で ASIN の定義域を外れないよう、LEAST で 1 に抑える。
+func shopDistanceKm(point *entity.GeoPoint) (string, []any) {
+	expr := fmt.Sprintf(
+		"(%d * 2 * ASIN(LEAST(1, SQRT("+
+			"POWER(SIN(RADIANS((%s) - ?) / 2), 2) + "+
+			"COS(RADIANS(?)) * COS(RADIANS(%s)) * POWER(SIN(RADIANS((%s) - ?) / 2), 2)"+
+			"))))",
+		earthRadiusKm, shopLatitude, shopLatitude, shopLongitude,
+	)
+
+	return expr, []any{point.Latitude, point.Latitude, point.Longitude}
+}
+
+func (i *OfficialEvent) Search(
+	ctx context.Context,
+	criteria *entity.OfficialEventSearchCriteria,
+) ([]*entity.OfficialEventSearchHit, error) {
+	db := dbFromContext(ctx, i.db)
+
+	distance, distanceArgs := "NULL", []any{}
+	if criteria.Near != nil {
+		distance, distanceArgs = shopDistanceKm(criteria.Near)
+	}
+
+	tx := db.Table(
+		"official_events",
+	).Select(`
+		official_events.id AS id,
+		official_events.title AS title,
+		official_events.address AS address,
+		official_events.venue AS venue,
+		official_events.date AS date,
+		official_events.started_at AS started_at,
+		official_events.ended_at AS ended_at,
+		official_events.type_id AS type_id,
+		official_events.type_name AS type_name,
+		official_events.league_title AS league_title,
+		official_events.regulation_title AS regulation_title,
+		official_events.csp_flg AS csp_flg,
+		official_events.capacity AS capacity,
+		official_events.shop_id AS shop_id,
+		official_events.shop_name AS shop_name,
+		prefectures.id AS prefecture_id ,
+		prefectures.name AS prefecture_name,
+		environments.id AS environment_id,
+		environments.title AS environment_title,
+		standard_regulations.id AS standard_regulation_id,
+		standard_regulations.marks AS standard_regulation_marks,
+		`+distance+` AS distance_km
+	`, distanceArgs...,
+	).Joins(
+		"LEFT JOIN shops ON shops.id = official_events.shop_id",
+	).Joins(
+		"LEFT JOIN prefectures ON prefectures.id = shops.prefecture_id",
+	).Joins(
+		"LEFT JOIN environments ON environments.to_date >= official_events.date AND environments.from_date <= official_events.date",
+	).Joins(
+		"LEFT JOIN standard_regulations ON standard_regulations.to_date >= official_events.date AND standard_regulations.from_date <= official_events.date",
+	)
+
+	// 検索語はどれもイベント名・店舗名(イベントに記録された店舗名と店舗マスタの名前)のいずれかに含まれること
+	for _, term := range criteria.Terms {
+		pattern := "%" + likeEscaper.Replace(term) + "%"
+		tx = tx.Where(
+			"official_events.title ILIKE ? OR official_events.shop_name ILIKE ? OR shops.name ILIKE ?",
+			pattern, pattern, pattern,
+		)
+	}
+
+	if criteria.PrefectureId != 0 {
+		tx = tx.Where("shops.prefecture_id = ?", criteria.PrefectureId)
+	}
+	if !criteria.FromDate.IsZero() {
+		tx = tx.Where("official_events.date >= ?", criteria.FromDate)
+	}
+	if !criteria.ToDate.IsZero() {
+		tx = tx.Where("official_events.date <= ?", criteria.ToDate)
+	}
+	if criteria.Near != nil {
+		tx = tx.Where(distance+" <= ?", append(distanceArgs, criteria.DistanceKm)...)
+	}
+
+	var hits []*model.OfficialEventSearchHit
+
+	tx = tx.Order(
+		"official_events.date DESC, official_events.started_at DESC, official_events.id DESC",
+	).Limit(criteria.Limit).Offset(criteria.Offset).Find(&hits)
+
+	if tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.OfficialEventSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		event := hit.OfficialEvent
+
+		var distanceKm *float64
+		if hit.DistanceKm.Valid {
+			distanceKm = &hit.DistanceKm.Float64
+		}
+
+		ret = append(ret, &entity.OfficialEventSearchHit{
+			OfficialEvent: entity.NewOfficialEvent(
+				event.ID,
+				event.Title,
+				event.Address,
+				event.Venue,
+				event.Date,
+				event.StartedAt,
+				event.EndedAt,
+				event.TypeId,
+				event.TypeName,
+				event.LeagueTitle,
+				event.RegulationTitle,
+				event.CSPFlg,
+				event.Capacity,
+				event.ShopId,
+				event.ShopName,
+				event.PrefectureId,
+				event.PrefectureName,
+				event.EnvironmentId,
+				event.EnvironmentTitle,
+				event.StandardRegulationId,
+				event.StandardRegulationMarks,
+			),
+			DistanceKm: distanceKm,
+		})
+	}
+
+	return ret, nil
+}
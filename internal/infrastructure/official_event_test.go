@@ -11,6 +11,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
@@ -83,6 +84,7 @@ func TestOfficialEventInfrastructure(t *testing.T) {
 	){
 		"Find":     test_OfficialEventInfrastructure_Find,
 		"FindById": test_OfficialEventInfrastructure_FindById,
+		"Search":   test_OfficialEventInfrastructure_Search,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -309,3 +311,130 @@ func test_OfficialEventInfrastructure_FindById(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+// officialEventSearchRows は検索結果の行(officialEventRows に店舗までの距離を加えたもの)。
+func officialEventSearchRows(date, startedAt, endedAt time.Time, distanceKm any) *sqlmock.Rows {
+	return sqlmock.NewRows(append(officialEventColumns, "distance_km")).AddRow(
+		uint(606466),
+		"チャンピオンズリーグ2025 福岡 マスターリーグ",
+		"福岡県福岡市博多区沖浜町２−１",
+		"マリンメッセ福岡　A館・B館",
+		date,
+		startedAt,
+		endedAt,
+		uint(1),
+		"大型大会",
+		"マスター",
+		"スタンダード",
+		true,
+		uint(5000),
+		uint(0),
+		"",
+		uint(40),
+		"福岡県",
+		"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+		"レギュレーションG",
+		"01HD7Y3K8D6FDHMHTZ2GT41TN3",
+		"G,H,I",
+		distanceKm,
+	)
+}
+
+func test_OfficialEventInfrastructure_Search(t *testing.T) {
+	date := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+	startedAt := time.Date(2025, 2, 15, 7, 30, 0, 0, time.UTC)
+	endedAt := time.Date(2025, 2, 15, 20, 50, 0, 0, time.UTC)
+
+	t.Run("正常系_検索語と都道府県と期間で絞り込む", func(t *testing.T) {
+		r, mock, err := setup4OfficialEventInfrastructure()
+		require.NoError(t, err)
+
+		fromDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		toDate := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(officialEventQuery(
+			`WHERE (official_events.title ILIKE $1 OR official_events.shop_name ILIKE $2 OR shops.name ILIKE $3) `+
+				`AND (official_events.title ILIKE $4 OR official_events.shop_name ILIKE $5 OR shops.name ILIKE $6) `+
+				`AND shops.prefecture_id = $7 AND official_events.date >= $8 AND official_events.date <= $9 `+
+				`ORDER BY official_events.date DESC, official_events.started_at DESC, official_events.id DESC LIMIT $10 OFFSET $11`,
+		)).WithArgs(
+			"%福岡%", "%福岡%", "%福岡%",
+			// LIKE のワイルドカードは通常の文字として扱う
+			`%100\%%`, `%100\%%`, `%100\%%`,
+			uint(40),
+			fromDate,
+			toDate,
+			10,
+			20,
+		).WillReturnRows(officialEventSearchRows(date, startedAt, endedAt, nil))
+
+		hits, err := r.Search(context.Background(), &entity.OfficialEventSearchCriteria{
+			Terms:        []string{"福岡", "100%"},
+			PrefectureId: 40,
+			FromDate:     fromDate,
+			ToDate:       toDate,
+			Limit:        10,
+			Offset:       20,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		require.Equal(t, uint(606466), hits[0].OfficialEvent.ID)
+		require.Equal(t, "福岡県", hits[0].OfficialEvent.PrefectureName)
+		require.Nil(t, hits[0].DistanceKm)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_位置を指定すると店舗までの距離で絞り込み距離を返す", func(t *testing.T) {
+		r, mock, err := setup4OfficialEventInfrastructure()
+		require.NoError(t, err)
+
+		near := &entity.GeoPoint{Latitude: 33.6045, Longitude: 130.4017}
+
+		mock.ExpectQuery(
+			`(?s)SELECT.*ASIN\(LEAST\(1, SQRT\(.*AS distance_km.*FROM "official_events".*`+
+				`WHERE .*ASIN\(LEAST\(1, SQRT\(.*<= \$7 ORDER BY official_events\.date DESC`,
+		).WithArgs(
+			// SELECT 句の距離の式の引数、WHERE 句の距離の式の引数、半径
+			near.Latitude, near.Latitude, near.Longitude,
+			near.Latitude, near.Latitude, near.Longitude,
+			float64(10),
+			10,
+		).WillReturnRows(officialEventSearchRows(date, startedAt, endedAt, 3.2))
+
+		hits, err := r.Search(context.Background(), &entity.OfficialEventSearchCriteria{
+			Near:       near,
+			DistanceKm: 10,
+			Limit:      10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		require.NotNil(t, hits[0].DistanceKm)
+		require.Equal(t, 3.2, *hits[0].DistanceKm)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_トランザクションの中ではそのトランザクションで検索する", func(t *testing.T) {
+		db, mock, err := setupMock4OfficialEventInfrastructure()
+		require.NoError(t, err)
+
+		r := NewOfficialEvent(db)
+		tm := NewTransactionManager(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT .* FROM "official_events"`).
+			WithArgs(10).
+			WillReturnRows(officialEventSearchRows(date, startedAt, endedAt, nil))
+		mock.ExpectCommit()
+
+		err = tm.Do(context.Background(), func(ctx context.Context) error {
+			hits, err := r.Search(ctx, &entity.OfficialEventSearchCriteria{Limit: 10})
+			require.Len(t, hits, 1)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -46,6 +46,7 @@ func (i *OpponentDeckUsageStat) FindOpponentDeckUsageStat(
 	toDate time.Time,
 	deckId string,
 	regulationId uint,
+	excludePrivate bool,
 ) (*entity.OpponentDeckUsageStat, error) {
 	var rows []opponentMatchResult
 
@@ -59,6 +60,11 @@ func (i *OpponentDeckUsageStat) FindOpponentDeckUsageStat(
 			userId,
 		)
 
+	// フレンドに見せるときは、非公開の記録の対戦相手を集計に含めない。
+	if excludePrivate {
+		query = query.Where("records.private_flg = false")
+	}
+
 	// レギュレーション(スタンダード/エクストラ/殿堂)での絞り込み。0 は絞り込みなし。
 	if regulationId != 0 {
 		query = query.Where("records.regulation_id = ?", regulationId)
@@ -38,6 +38,7 @@ func TestOpponentDeckUsageStatInfrastructure(t *testing.T) {
 		"SameDeckInfoDifferentSpritesAreTreatedAsDifferentDecks": test_OpponentDeckUsageStatInfrastructure_SameDeckInfoDifferentSpritesAreTreatedAsDifferentDecks,
 		"SameDeckInfoSameSpritesAreAggregated":                   test_OpponentDeckUsageStatInfrastructure_SameDeckInfoSameSpritesAreAggregated,
 		"NoMatches":                                              test_OpponentDeckUsageStatInfrastructure_NoMatches,
+		"ExcludePrivateRecords":                                  test_OpponentDeckUsageStatInfrastructure_ExcludePrivateRecords,
 		"FilterByDeckIdUsesRecordsDeckId":                        test_OpponentDeckUsageStatInfrastructure_FilterByDeckIdUsesRecordsDeckId,
 	} {
 		t.Run(scenario, func(t *testing.T) {
@@ -68,7 +69,7 @@ func test_OpponentDeckUsageStatInfrastructure_SameDeckInfoDifferentSpritesAreTre
 		`SELECT * FROM "match_pokemon_sprites" WHERE match_id IN ($1,$2) ORDER BY position ASC`,
 	)).WithArgs("match-01", "match-02").WillReturnRows(spriteRows)
 
-	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0)
+	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0, false)
 
 	require.NoError(t, err)
 	require.Equal(t, 2, stat.TotalMatches)
@@ -102,7 +103,7 @@ func test_OpponentDeckUsageStatInfrastructure_SameDeckInfoSameSpritesAreAggregat
 		`SELECT * FROM "match_pokemon_sprites" WHERE match_id IN ($1,$2) ORDER BY position ASC`,
 	)).WithArgs("match-01", "match-02").WillReturnRows(spriteRows)
 
-	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0)
+	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0, false)
 
 	require.NoError(t, err)
 	require.Equal(t, 2, stat.TotalMatches)
@@ -127,7 +128,7 @@ func test_OpponentDeckUsageStatInfrastructure_NoMatches(t *testing.T) {
 		`SELECT matches.id AS match_id, matches.opponents_deck_info AS deck_info, matches.victory_flg AS victory_flg, matches.draw_flg AS draw_flg FROM "matches" JOIN records ON matches.record_id = records.id WHERE records.user_id = $1 AND records.deleted_at IS NULL AND records.ignore_stats_flg = false AND matches.deleted_at IS NULL AND matches.opponents_deck_info != '' ORDER BY records.event_date ASC`,
 	)).WithArgs(userId).WillReturnRows(matchRows)
 
-	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0)
+	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0, false)
 
 	require.NoError(t, err)
 	require.Equal(t, 0, stat.TotalMatches)
@@ -159,10 +160,37 @@ func test_OpponentDeckUsageStatInfrastructure_FilterByDeckIdUsesRecordsDeckId(t
 		`SELECT * FROM "match_pokemon_sprites" WHERE match_id IN ($1) ORDER BY position ASC`,
 	)).WithArgs("match-01").WillReturnRows(spriteRows)
 
-	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, deckId, 0)
+	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, deckId, 0, false)
 
 	require.NoError(t, err)
 	require.Equal(t, 1, stat.TotalMatches)
 	require.Len(t, stat.Decks, 1)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+// フレンドに見せる集計(excludePrivate)では、非公開の記録の対戦結果を集計に含めない。
+func test_OpponentDeckUsageStatInfrastructure_ExcludePrivateRecords(t *testing.T) {
+	i, mock, err := setup4OpponentDeckUsageStatInfrastructure()
+	require.NoError(t, err)
+
+	userId := "user-05"
+
+	matchRows := sqlmock.NewRows([]string{"match_id", "deck_info", "victory_flg"}).
+		AddRow("match-01", "リザードンex", true)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT matches.id AS match_id, matches.opponents_deck_info AS deck_info, matches.victory_flg AS victory_flg, matches.draw_flg AS draw_flg FROM "matches" JOIN records ON matches.record_id = records.id WHERE (records.user_id = $1 AND records.deleted_at IS NULL AND records.ignore_stats_flg = false AND matches.deleted_at IS NULL AND matches.opponents_deck_info != '') AND records.private_flg = false ORDER BY records.event_date ASC`,
+	)).WithArgs(userId).WillReturnRows(matchRows)
+
+	spriteRows := sqlmock.NewRows([]string{"match_id", "position", "pokemon_sprite_id"})
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "match_pokemon_sprites" WHERE match_id IN ($1) ORDER BY position ASC`,
+	)).WithArgs("match-01").WillReturnRows(spriteRows)
+
+	stat, err := i.FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, "", 0, true)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, stat.TotalMatches)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -35,6 +35,12 @@ func NewDB(
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
+		// updated_at は ETag(版)としてクライアントへ返し、If-Match で照合する。
+		// PostgreSQL の TIMESTAMP はマイクロ秒精度で丸めて保存するため、ナノ秒のまま
+		// だと保存直後に返した ETag と次に読み込んだ値が食い違う。精度を揃えておく。
+		NowFunc: func() time.Time {
+			return time.Now().Local().Truncate(time.Microsecond)
+		},
 	})
 	if err != nil {
 		return nil, err
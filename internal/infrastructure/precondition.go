@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// expectedUpdatedAt は保存の前提とする版(updated_at)を返す。
+// ctx に If-Match 由来の版があればそれを優先し、なければ呼び出し元が読み込んだ
+// 時点の版(current)を使う。どちらも無い(新規作成など)場合は ok=false を返す。
+func expectedUpdatedAt(ctx context.Context, id string, current time.Time) (time.Time, bool) {
+	if version, ok := repository.ExpectedUpdatedAt(ctx, id); ok {
+		return version, true
+	}
+
+	return current, !current.IsZero()
+}
+
+// saveIfUnmodified は value(主キー id のモデル)を保存する。
+//
+// 前提とする版があれば UPDATE ... WHERE id = ? AND updated_at = ? で書き込み、
+// 他の端末などで先に更新・削除されていて1行も更新できなければ
+// apperror.ErrPreconditionFailed を返す(後勝ちで黙って上書きしない)。
+// 版が無ければ従来どおり Save(upsert)する。
+func saveIfUnmodified(ctx context.Context, db *gorm.DB, id string, current time.Time, value any) error {
+	version, ok := expectedUpdatedAt(ctx, id, current)
+	if !ok {
+		return db.Save(value).Error
+	}
+
+	// Save と同じくゼロ値を含む全カラムを書き戻すため Select("*") を付ける。
+	tx := db.Model(value).Where("updated_at = ?", version).Select("*").Updates(value)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return apperror.ErrPreconditionFailed
+	}
+
+	return nil
+}
+
+// deleteIfUnmodified は主キー id の value を削除する。ctx に版があれば
+// WHERE updated_at = ? を加え、1行も削除できなければ apperror.ErrPreconditionFailed を返す。
+func deleteIfUnmodified(ctx context.Context, db *gorm.DB, id string, value any) error {
+	query := db.Where("id = ?", id)
+
+	version, ok := repository.ExpectedUpdatedAt(ctx, id)
+	if ok {
+		query = query.Where("updated_at = ?", version)
+	}
+
+	tx := query.Delete(value)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if ok && tx.RowsAffected == 0 {
+		return apperror.ErrPreconditionFailed
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
@@ -54,6 +55,14 @@ func (i *Record) FindById(
 		model.Memo,
 	)
 	entity.DeckRegisteredAt = model.DeckRegisteredAt
+	entity.UpdatedAt = model.UpdatedAt
+
+	tagsByRecordId, err := findTagsByRecordIds(ctx, i.db, []string{entity.ID})
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	entity.Tags = tagsByRecordId[entity.ID]
 
 	return entity, nil
 }
@@ -112,6 +121,11 @@ func (i *Record) Find(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -182,6 +196,11 @@ func (i *Record) FindOnCursor(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -240,6 +259,11 @@ func (i *Record) FindByUserId(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -296,6 +320,11 @@ func (i *Record) FindByUserIdOnCursor(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -335,6 +364,11 @@ func (i *Record) FindByOfficialEventId(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -374,6 +408,11 @@ func (i *Record) FindByTonamelEventId(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -432,6 +471,11 @@ func (i *Record) FindByDeckId(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -488,6 +532,11 @@ func (i *Record) FindByDeckIdOnCursor(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -527,6 +576,73 @@ func (i *Record) FindByDeckCodeId(
 		entities = append(entities, entity)
 	}
 
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// FindByCriteria は criteria の条件を全て満たす記録を探す。
+// 対戦結果の条件があれば、それを満たす対戦結果を1件以上持つ記録に絞る。
+func (i *Record) FindByCriteria(
+	ctx context.Context,
+	criteria *entity.RecordCriteria,
+) ([]*entity.Record, error) {
+	var models []*model.Record
+
+	recordConds := recordCriteriaConditions(criteria)
+
+	query := i.db.Where(recordConds.sql(), recordConds.args...)
+
+	if matchConds := matchCriteriaConditions(criteria); matchConds != nil {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM matches WHERE matches.record_id = records.id AND matches.deleted_at IS NULL AND "+matchConds.sql()+")",
+			matchConds.args...,
+		)
+	}
+
+	if !criteria.CursorCreatedAt.IsZero() {
+		cursorCond, cursorArgs := buildCursorCondition(criteria.CursorEventDate, criteria.CursorCreatedAt)
+		query = query.Where(cursorCond, cursorArgs...)
+	} else {
+		query = query.Offset(criteria.Offset)
+	}
+
+	if tx := query.Limit(criteria.Limit).Order("event_date DESC NULLS LAST, created_at DESC").Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	var entities []*entity.Record
+	for _, model := range models {
+		entity := entity.NewRecord(
+			model.ID,
+			model.CreatedAt,
+			model.OfficialEventId,
+			model.TonamelEventId,
+			model.FriendId,
+			model.UnofficialEventId,
+			model.UserId,
+			model.DeckId,
+			model.DeckCodeId,
+			model.EventDate,
+			model.PrivateFlg,
+			model.IgnoreStatsFlg,
+			model.RegulationId,
+			model.TCGMeisterURL,
+			model.Memo,
+		)
+		entity.DeckRegisteredAt = model.DeckRegisteredAt
+		entities = append(entities, entity)
+	}
+
+	if err := attachRecordTags(ctx, i.db, entities); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -563,12 +679,17 @@ func (i *Record) DeleteByUserId(
 		}
 
 		// 自由形式イベント(unofficial_events): 記録から参照されているものだけ
-		// (孤立行を残さないため。Delete 単体版と同じ方針)
+		// (孤立行を残さないため。Delete 単体版と同じ方針)。
+		// 参加コードで他のユーザも記録を付けているものは、その記録のために残す。
 		if tx := tx.Where(
-			"id IN (?)",
+			"id IN (?) AND user_id = ? AND NOT EXISTS (?)",
 			tx.Model(&model.Record{}).Select("unofficial_event_id").Where(
 				"user_id = ? AND unofficial_event_id IS NOT NULL AND unofficial_event_id != ''", uid,
 			),
+			uid,
+			tx.Model(&model.Record{}).Select("1").Where(
+				"records.unofficial_event_id = unofficial_events.id AND records.user_id != ?", uid,
+			),
 		).Delete(&model.UnofficialEvent{}); tx.Error != nil {
 			return tx.Error
 		}
@@ -591,6 +712,18 @@ func normalizeRegulationId(record *entity.Record) uint {
 	return entity.NormalizeRegulationId(record.RegulationId)
 }
 
+func (i *Record) LockById(
+	ctx context.Context,
+	id string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", id).First(&model.Record{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return wrapError(tx.Error)
+	}
+
+	return nil
+}
+
 func (i *Record) Save(
 	ctx context.Context,
 	entity *entity.Record,
@@ -614,10 +747,12 @@ func (i *Record) Save(
 	)
 	model.DeckRegisteredAt = entity.DeckRegisteredAt
 
-	if tx := i.db.Save(model); tx.Error != nil {
-		logError(ctx, tx.Error)
-		return tx.Error
+	// 読み込み済みの記録(UpdatedAt あり)の更新は、読み込んだ版のままの場合だけ書き込む。
+	if err := saveIfUnmodified(ctx, dbFromContext(ctx, i.db), entity.ID, entity.UpdatedAt, model); err != nil {
+		logError(ctx, err)
+		return err
 	}
+	entity.UpdatedAt = model.UpdatedAt
 
 	return nil
 }
@@ -653,14 +788,21 @@ func (i *Record) Delete(
 			return tx.Error
 		}
 
-		if tx := tx.Where("id = ?", id).Delete(&model.Record{}); tx.Error != nil {
-			logError(ctx, tx.Error)
-			return tx.Error
+		if err := deleteIfUnmodified(ctx, tx, id, &model.Record{}); err != nil {
+			logError(ctx, err)
+			return err
 		}
 
-		// 自由形式イベントを参照していた場合、紐づく unofficial_event も削除する(孤立行を残さない)
+		// 自由形式イベントを参照していた場合、紐づく unofficial_event も削除する(孤立行を残さない)。
+		// 参加コードで共有されたイベントは他の記録も参照しているため、自分が作ったもので
+		// 他に参照する記録が残っていないときだけ消す。
 		if record.UnofficialEventId != "" {
-			if tx := tx.Where("id = ?", record.UnofficialEventId).Delete(&model.UnofficialEvent{}); tx.Error != nil {
+			if tx := tx.Where(
+				"id = ? AND user_id = ? AND NOT EXISTS (?)",
+				record.UnofficialEventId,
+				record.UserId,
+				tx.Model(&model.Record{}).Select("1").Where("records.unofficial_event_id = unofficial_events.id"),
+			).Delete(&model.UnofficialEvent{}); tx.Error != nil {
 				logError(ctx, tx.Error)
 				return tx.Error
 			}
@@ -669,3 +811,26 @@ func (i *Record) Delete(
 		return nil
 	}, &sql.TxOptions{Isolation: sql.LevelDefault})
 }
+
+// attachRecordTags は records のタグを1クエリでまとめて取得して詰める。
+func attachRecordTags(
+	ctx context.Context,
+	db *gorm.DB,
+	records []*entity.Record,
+) error {
+	recordIds := make([]string, 0, len(records))
+	for _, record := range records {
+		recordIds = append(recordIds, record.ID)
+	}
+
+	tagsByRecordId, err := findTagsByRecordIds(ctx, db, recordIds)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		record.Tags = tagsByRecordId[record.ID]
+	}
+
+	return nil
+}
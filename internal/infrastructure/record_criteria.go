@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"strings"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// criteriaConditions は AND で繋ぐ条件と、その引数を溜めていく。
+type criteriaConditions struct {
+	conds []string
+	args  []any
+}
+
+func (c *criteriaConditions) add(cond string, args ...any) {
+	c.conds = append(c.conds, cond)
+	c.args = append(c.args, args...)
+}
+
+func (c *criteriaConditions) sql() string {
+	return strings.Join(c.conds, " AND ")
+}
+
+// recordCriteriaConditions は criteria のうち記録に対する条件を records テーブルへの条件にする。
+func recordCriteriaConditions(criteria *entity.RecordCriteria) *criteriaConditions {
+	c := &criteriaConditions{}
+
+	c.add("records.user_id = ?", criteria.UserId)
+
+	if criteria.ExcludePrivate {
+		c.add("records.private_flg = false")
+	}
+
+	switch criteria.EventType {
+	case "official":
+		c.add("records.official_event_id != 0")
+	case "tonamel":
+		c.add("records.tonamel_event_id != ''")
+	case "unofficial":
+		c.add("records.unofficial_event_id != ''")
+	}
+
+	if !criteria.FromDate.IsZero() {
+		c.add("records.event_date >= ?", criteria.FromDate)
+	}
+	if !criteria.ToDate.IsZero() {
+		c.add("records.event_date <= ?", criteria.ToDate)
+	}
+
+	if criteria.RegulationId != 0 {
+		c.add("records.regulation_id = ?", criteria.RegulationId)
+	}
+
+	// 環境の期間(from_date〜to_date、両端を含む)に開催された記録。
+	if criteria.EnvironmentId != "" {
+		c.add(
+			"EXISTS (SELECT 1 FROM environments WHERE environments.id = ? AND records.event_date BETWEEN environments.from_date AND environments.to_date)",
+			criteria.EnvironmentId,
+		)
+	}
+
+	if len(criteria.OfficialEventTypeIds) > 0 {
+		c.add(
+			"records.official_event_id IN (SELECT id FROM official_events WHERE type_id IN ?)",
+			criteria.OfficialEventTypeIds,
+		)
+	}
+
+	if criteria.DeckId != "" {
+		c.add("records.deck_id = ?", criteria.DeckId)
+	}
+	if criteria.DeckCodeId != "" {
+		c.add("records.deck_code_id = ?", criteria.DeckCodeId)
+	}
+
+	if len(criteria.RecordTagIds) > 0 {
+		c.add(
+			"records.id IN (SELECT record_id FROM record_tags WHERE tag_id IN ? GROUP BY record_id HAVING COUNT(DISTINCT tag_id) = ?)",
+			criteria.RecordTagIds, len(criteria.RecordTagIds),
+		)
+	}
+
+	return c
+}
+
+// matchCriteriaConditions は criteria のうち対戦結果に対する条件を matches テーブルへの条件にする。
+// 対戦結果の条件が無ければ nil を返す。
+func matchCriteriaConditions(criteria *entity.RecordCriteria) *criteriaConditions {
+	if !criteria.HasMatchConditions() {
+		return nil
+	}
+
+	c := &criteriaConditions{}
+
+	// 指紋は集計(NormalizeFingerprint)と同じく、重複を除いてソートしたスプライトIDの連結で比べる。
+	// Go の sort.Strings と並びを揃えるため、照合順序は C(バイト順)にする。
+	if criteria.OpponentFingerprint != "" {
+		key, _ := NormalizeFingerprint(strings.Split(criteria.OpponentFingerprint, ","))
+		c.add(
+			`(SELECT string_agg(DISTINCT match_pokemon_sprites.pokemon_sprite_id COLLATE "C", ',' ORDER BY match_pokemon_sprites.pokemon_sprite_id COLLATE "C") FROM match_pokemon_sprites WHERE match_pokemon_sprites.match_id = matches.id) = ?`,
+			key,
+		)
+	}
+
+	if criteria.OpponentDeckName != "" {
+		c.add("matches.opponents_deck_info ILIKE ?", "%"+likeEscaper.Replace(criteria.OpponentDeckName)+"%")
+	}
+
+	// Match.Result と同じく引き分けを最優先で判定する。
+	switch criteria.Result {
+	case entity.MatchResultWin:
+		c.add("matches.victory_flg = true AND matches.draw_flg = false")
+	case entity.MatchResultLose:
+		c.add("matches.victory_flg = false AND matches.draw_flg = false")
+	case entity.MatchResultDraw:
+		c.add("matches.draw_flg = true")
+	}
+
+	if criteria.BO3Flg != nil {
+		c.add("matches.bo3_flg = ?", *criteria.BO3Flg)
+	}
+
+	if criteria.GoFirst != nil {
+		c.add(
+			"(SELECT games.go_first FROM games WHERE games.match_id = matches.id AND games.deleted_at IS NULL ORDER BY games.created_at ASC LIMIT 1) = ?",
+			*criteria.GoFirst,
+		)
+	}
+
+	if len(criteria.TagIds) > 0 {
+		c.add(
+			"matches.id IN (SELECT match_id FROM match_tags WHERE tag_id IN ? GROUP BY match_id HAVING COUNT(DISTINCT tag_id) = ?)",
+			criteria.TagIds, len(criteria.TagIds),
+		)
+	}
+
+	return c
+}
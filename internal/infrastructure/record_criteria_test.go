@@ -0,0 +1,196 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestRecordCriteriaInfrastructure(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01JMPK4VF04QX714CG4PHYJ88K"
+	matchId := "01JMPK4VF04QX714CG4PHYJ88M"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	fromDate := time.Date(2026, 7, 1, 0, 0, 0, 0, time.Local)
+
+	t.Run("正常系_記録の条件だけなら対戦結果を見ずに記録を探す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewRecord(db, slog.Default())
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT * FROM "records" WHERE (records.user_id = $1 AND records.official_event_id != 0 AND records.event_date >= $2 AND records.official_event_id IN (SELECT id FROM official_events WHERE type_id IN ($3,$4))) AND "records"."deleted_at" IS NULL ORDER BY event_date DESC NULLS LAST, created_at DESC LIMIT $5 OFFSET $6`,
+		)).
+			WithArgs(uid, fromDate, uint(2), uint(4), 10, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id"}).AddRow(recordId, now, uid))
+		expectRecordTagsQuery(mock)
+
+		records, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId:               uid,
+			EventType:            "official",
+			FromDate:             fromDate,
+			OfficialEventTypeIds: []uint{2, 4},
+			Limit:                10,
+			Offset:               20,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, recordId, records[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_フレンド向けには非公開の記録を除く", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewRecord(db, slog.Default())
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT * FROM "records" WHERE (records.user_id = $1 AND records.private_flg = false) AND "records"."deleted_at" IS NULL ORDER BY event_date DESC NULLS LAST, created_at DESC LIMIT $2 OFFSET $3`,
+		)).
+			WithArgs(uid, 10, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id"}).AddRow(recordId, now, uid))
+		expectRecordTagsQuery(mock)
+
+		records, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId:         uid,
+			ExcludePrivate: true,
+			Limit:          10,
+			Offset:         20,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_対戦結果の条件は条件を満たす対戦結果を持つ記録に絞る", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewRecord(db, slog.Default())
+
+		goFirst := true
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT * FROM "records" WHERE records.user_id = $1 AND (EXISTS (SELECT 1 FROM matches WHERE matches.record_id = records.id AND matches.deleted_at IS NULL AND `+
+				`(SELECT string_agg(DISTINCT match_pokemon_sprites.pokemon_sprite_id COLLATE "C", ',' ORDER BY match_pokemon_sprites.pokemon_sprite_id COLLATE "C") FROM match_pokemon_sprites WHERE match_pokemon_sprites.match_id = matches.id) = $2 AND `+
+				`matches.opponents_deck_info ILIKE $3 AND matches.victory_flg = false AND matches.draw_flg = false AND `+
+				`(SELECT games.go_first FROM games WHERE games.match_id = matches.id AND games.deleted_at IS NULL ORDER BY games.created_at ASC LIMIT 1) = $4 AND `+
+				`matches.id IN (SELECT match_id FROM match_tags WHERE tag_id IN ($5,$6) GROUP BY match_id HAVING COUNT(DISTINCT tag_id) = $7)))`,
+		)).
+			WithArgs(uid, "s1,s2", `%100\%%`, true, "tag-1", "tag-2", 2, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id"}).AddRow(recordId, now, uid))
+		expectRecordTagsQuery(mock)
+
+		records, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId: uid,
+			// 並び順・重複は問わず、集計と同じ指紋に正規化する
+			OpponentFingerprint: "s2,s1,s2",
+			OpponentDeckName:    "100%",
+			Result:              entity.MatchResultLose,
+			GoFirst:             &goFirst,
+			TagIds:              []string{"tag-1", "tag-2"},
+			Limit:               10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_カーソルがあればOFFSETの代わりに使う", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewRecord(db, slog.Default())
+
+		cursorEventDate := time.Date(2026, 7, 20, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`WHERE (records.user_id = $1 AND records.regulation_id = $2) AND (((event_date < $3 AND event_date IS NOT NULL) OR (event_date = $4 AND created_at < $5) OR event_date IS NULL)) AND "records"."deleted_at" IS NULL ORDER BY event_date DESC NULLS LAST, created_at DESC LIMIT $6`,
+		)).
+			WithArgs(uid, uint(1), cursorEventDate, cursorEventDate, now, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id"}))
+
+		records, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId:          uid,
+			RegulationId:    1,
+			Limit:           10,
+			CursorEventDate: cursorEventDate,
+			CursorCreatedAt: now,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, records)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_対戦結果は親の記録にも条件を適用して探す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewMatch(db)
+
+		bo3Flg := true
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`WHERE matches.id IN (SELECT matches.id FROM "matches" JOIN records ON records.id = matches.record_id WHERE (records.deleted_at IS NULL AND matches.deleted_at IS NULL) AND `+
+				`(records.user_id = $1 AND EXISTS (SELECT 1 FROM environments WHERE environments.id = $2 AND records.event_date BETWEEN environments.from_date AND environments.to_date)) AND `+
+				`(matches.victory_flg = true AND matches.draw_flg = false AND matches.bo3_flg = $3) `+
+				`ORDER BY matches.created_at DESC LIMIT $4 OFFSET $5)`,
+		)).
+			WithArgs(uid, "env-1", true, 10, 10).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"match_id", "match_created_at", "match_record_id", "match_user_id", "match_bo3_flg", "match_victory_flg", "game_id"}).
+					AddRow(matchId, now, recordId, uid, true, true, ""),
+			)
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM "match_pokemon_sprites"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id", "position", "pokemon_sprite_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM "match_tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id", "id", "name"}))
+
+		matches, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId:        uid,
+			EnvironmentId: "env-1",
+			Result:        entity.MatchResultWin,
+			BO3Flg:        &bo3Flg,
+			Limit:         10,
+			Offset:        10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Equal(t, matchId, matches[0].ID)
+		require.True(t, matches[0].BO3Flg)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("異常系_該当する対戦結果が無ければErrRecordNotFound", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewMatch(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE matches.id IN (SELECT matches.id FROM "matches"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id"}))
+
+		_, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{
+			UserId:     uid,
+			DeckCodeId: "code-1",
+			Limit:      10,
+		})
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+
+	t.Run("異常系_記録の検索のエラーを返す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewRecord(db, slog.Default())
+
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM "records"`)).WillReturnError(errors.New(""))
+
+		_, err := r.FindByCriteria(context.Background(), &entity.RecordCriteria{UserId: uid, Limit: 10})
+
+		require.Error(t, err)
+	})
+}
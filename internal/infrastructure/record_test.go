@@ -12,6 +12,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
@@ -47,6 +48,13 @@ func setup4RecordInfrastructure() (repository.RecordInterface, sqlmock.Sqlmock,
 	return r, mock, err
 }
 
+// expectRecordTagsQuery は各Findの後に走るタグのバッチ取得(findTagsByRecordIds)に対する
+// 期待を空の結果で登録する。カラム構成は deck_tags と同じため deckTagColumns を流用する。
+func expectRecordTagsQuery(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "record_tags"`)).
+		WillReturnRows(sqlmock.NewRows(deckTagColumns))
+}
+
 func TestRecordInfrastructure(t *testing.T) {
 	for scenario, fn := range map[string]func(
 		t *testing.T,
@@ -60,7 +68,9 @@ func TestRecordInfrastructure(t *testing.T) {
 		"FindByTonamelEventId":  test_RecordInfrastructure_FindByTonamelEventId,
 		"FindByDeckId":          test_RecordInfrastructure_FindByDeckId,
 		"DeleteByUserId":        test_RecordInfrastructure_DeleteByUserId,
+		"LockById":              test_RecordInfrastructure_LockById,
 		"Save":                  test_RecordInfrastructure_Save,
+		"SaveWithUpdatedAt":     test_RecordInfrastructure_SaveWithUpdatedAt,
 		"Delete":                test_RecordInfrastructure_Delete,
 	} {
 		t.Run(scenario, func(t *testing.T) {
@@ -116,6 +126,8 @@ func test_RecordInfrastructure_Find(t *testing.T) {
 			offset,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.Find(context.Background(), limit, offset, eventType)
 
 		require.NoError(t, err)
@@ -147,6 +159,8 @@ func test_RecordInfrastructure_Find(t *testing.T) {
 			offset,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.Find(context.Background(), limit, offset, eventType)
 
 		require.NoError(t, err)
@@ -206,6 +220,8 @@ func test_RecordInfrastructure_FindOnCursor(t *testing.T) {
 			limit,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.FindOnCursor(context.Background(), limit, cursorEventDate, cursorCreatedAt, eventType)
 
 		require.NoError(t, err)
@@ -238,6 +254,8 @@ func test_RecordInfrastructure_FindOnCursor(t *testing.T) {
 			limit,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.FindOnCursor(context.Background(), limit, time.Time{}, cursorCreatedAt, eventType)
 
 		require.NoError(t, err)
@@ -288,6 +306,8 @@ func test_RecordInfrastructure_FindById(t *testing.T) {
 		1,
 	).WillReturnRows(rows)
 
+	expectRecordTagsQuery(mock)
+
 	record, err := r.FindById(context.Background(), "01HD7Y3K8D6FDHMHTZ2GT41TN2")
 
 	require.NoError(t, err)
@@ -341,6 +361,8 @@ func test_RecordInfrastructure_FindByUserId(t *testing.T) {
 		offset,
 	).WillReturnRows(rows)
 
+	expectRecordTagsQuery(mock)
+
 	records, err := r.FindByUserId(context.Background(), "CeQ0Oa9g9uRThL11lj4l45VAg8p1", limit, offset, eventType)
 
 	require.NoError(t, err)
@@ -400,6 +422,8 @@ func test_RecordInfrastructure_FindByUserIdOnCursor(t *testing.T) {
 			limit,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.FindByUserIdOnCursor(context.Background(), "CeQ0Oa9g9uRThL11lj4l45VAg8p1", limit, cursorEventDate, cursorCreatedAt, eventType)
 
 		require.NoError(t, err)
@@ -433,6 +457,8 @@ func test_RecordInfrastructure_FindByUserIdOnCursor(t *testing.T) {
 			limit,
 		).WillReturnRows(rows)
 
+		expectRecordTagsQuery(mock)
+
 		records, err := r.FindByUserIdOnCursor(context.Background(), "CeQ0Oa9g9uRThL11lj4l45VAg8p1", limit, time.Time{}, cursorCreatedAt, eventType)
 
 		require.NoError(t, err)
@@ -487,6 +513,8 @@ func test_RecordInfrastructure_FindByOfficialEventId(t *testing.T) {
 		offset,
 	).WillReturnRows(rows)
 
+	expectRecordTagsQuery(mock)
+
 	records, err := r.FindByOfficialEventId(context.Background(), 236790, limit, offset)
 
 	require.NoError(t, err)
@@ -541,6 +569,8 @@ func test_RecordInfrastructure_FindByTonamelEventId(t *testing.T) {
 		offset,
 	).WillReturnRows(rows)
 
+	expectRecordTagsQuery(mock)
+
 	records, err := r.FindByTonamelEventId(context.Background(), "YFUVY", limit, offset)
 
 	require.NoError(t, err)
@@ -595,6 +625,8 @@ func test_RecordInfrastructure_FindByDeckId(t *testing.T) {
 		offset,
 	).WillReturnRows(rows)
 
+	expectRecordTagsQuery(mock)
+
 	records, err := r.FindByDeckId(context.Background(), "01JHAKSVXZ4XW91TDQ8EDP1N8P", limit, offset, eventType)
 
 	require.NoError(t, err)
@@ -628,10 +660,12 @@ func test_RecordInfrastructure_DeleteByUserId(t *testing.T) {
 	).WillReturnResult(sqlmock.NewResult(0, 2))
 
 	mock.ExpectExec(regexp.QuoteMeta(
-		`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE id IN (SELECT "unofficial_event_id" FROM "records" WHERE (user_id = $2 AND unofficial_event_id IS NOT NULL AND unofficial_event_id != '') AND "records"."deleted_at" IS NULL) AND "unofficial_events"."deleted_at" IS NULL`,
+		`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE (id IN (SELECT "unofficial_event_id" FROM "records" WHERE (user_id = $2 AND unofficial_event_id IS NOT NULL AND unofficial_event_id != '') AND "records"."deleted_at" IS NULL) AND user_id = $3 AND NOT EXISTS (SELECT 1 FROM "records" WHERE (records.unofficial_event_id = unofficial_events.id AND records.user_id != $4) AND "records"."deleted_at" IS NULL)) AND "unofficial_events"."deleted_at" IS NULL`,
 	)).WithArgs(
 		AnyTime{},
 		uid,
+		uid,
+		uid,
 	).WillReturnResult(sqlmock.NewResult(0, 1))
 
 	mock.ExpectExec(regexp.QuoteMeta(
@@ -649,6 +683,37 @@ func test_RecordInfrastructure_DeleteByUserId(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func test_RecordInfrastructure_LockById(t *testing.T) {
+	t.Run("正常系_記録の行をロックする", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT "id" FROM "records" WHERE id = $1 AND "records"."deleted_at" IS NULL ORDER BY "records"."id" LIMIT $2 FOR UPDATE`,
+		)).WithArgs(
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			1,
+		).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("01HD7Y3K8D6FDHMHTZ2GT41TN2"))
+
+		require.NoError(t, r.LockById(context.Background(), "01HD7Y3K8D6FDHMHTZ2GT41TN2"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("異常系_存在しない記録はErrRecordNotFoundへ変換する", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT "id" FROM "records" WHERE id = $1 AND "records"."deleted_at" IS NULL ORDER BY "records"."id" LIMIT $2 FOR UPDATE`,
+		)).WithArgs(
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			1,
+		).WillReturnError(gorm.ErrRecordNotFound)
+
+		require.ErrorIs(t, r.LockById(context.Background(), "01HD7Y3K8D6FDHMHTZ2GT41TN2"), apperror.ErrRecordNotFound)
+	})
+}
+
 func test_RecordInfrastructure_Save(t *testing.T) {
 	r, mock, err := setup4RecordInfrastructure()
 	require.NoError(t, err)
@@ -710,6 +775,89 @@ func test_RecordInfrastructure_Save(t *testing.T) {
 	}
 }
 
+func test_RecordInfrastructure_SaveWithUpdatedAt(t *testing.T) {
+	datetime := time.Now().Local()
+	updatedAt := time.Date(2026, 10, 1, 12, 0, 0, 123456000, time.UTC)
+
+	expectGuardedUpdate := func(mock sqlmock.Sqlmock, version time.Time, rowsAffected int64) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(
+			`UPDATE "records" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"official_event_id"=$4,"tonamel_event_id"=$5,`+
+				`"friend_id"=$6,"user_id"=$7,"deck_id"=$8,"deck_code_id"=$9,"private_flg"=$10,"ignore_stats_flg"=$11,"regulation_id"=$12,`+
+				`"tcg_meister_url"=$13,"memo"=$14,`+
+				`"event_date"=$15,"unofficial_event_id"=$16,"deck_registered_at"=$17 `+
+				`WHERE updated_at = $18 AND "records"."deleted_at" IS NULL AND "id" = $19`,
+		)).WithArgs(
+			datetime,
+			AnyTime{},
+			gorm.DeletedAt{},
+			236790,
+			"",
+			"",
+			"CeQ0Oa9g9uRThL11lj4l45VAg8p1",
+			"",
+			"",
+			false,
+			false,
+			int(entity.RegulationIdStandard),
+			"",
+			"",
+			AnyTime{},
+			"",
+			nil,
+			version,
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+		).WillReturnResult(sqlmock.NewResult(0, rowsAffected))
+		mock.ExpectCommit()
+	}
+
+	newRecord := func() *entity.Record {
+		return &entity.Record{
+			ID:              "01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			CreatedAt:       datetime,
+			OfficialEventId: 236790,
+			UserId:          "CeQ0Oa9g9uRThL11lj4l45VAg8p1",
+			RegulationId:    entity.RegulationIdStandard,
+			UpdatedAt:       updatedAt,
+		}
+	}
+
+	t.Run("正常系_読み込んだ版のままなら更新し_新しい版を返す", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		expectGuardedUpdate(mock, updatedAt, 1)
+
+		record := newRecord()
+		require.NoError(t, r.Save(context.Background(), record))
+		require.NotEqual(t, updatedAt, record.UpdatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_ctxの版(If-Match)を読み込んだ版より優先する", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		ifMatch := updatedAt.Add(-time.Minute)
+		expectGuardedUpdate(mock, ifMatch, 1)
+
+		ctx := repository.WithExpectedUpdatedAt(context.Background(), "01HD7Y3K8D6FDHMHTZ2GT41TN2", ifMatch)
+		require.NoError(t, r.Save(ctx, newRecord()))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("異常系_他で更新済みなら上書きせずErrPreconditionFailed", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		expectGuardedUpdate(mock, updatedAt, 0)
+
+		err = r.Save(context.Background(), newRecord())
+		require.ErrorIs(t, err, apperror.ErrPreconditionFailed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func test_RecordInfrastructure_Delete(t *testing.T) {
 	t.Run("正常系_マッチなしの記録を論理削除する", func(t *testing.T) {
 		r, mock, err := setup4RecordInfrastructure()
@@ -804,6 +952,55 @@ func test_RecordInfrastructure_Delete(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("異常系_If-Matchの版が古ければ削除せずErrPreconditionFailed", func(t *testing.T) {
+		r, mock, err := setup4RecordInfrastructure()
+		require.NoError(t, err)
+
+		ifMatch := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(regexp.QuoteMeta(
+			`SELECT * FROM "records" WHERE id = $1 AND "records"."deleted_at" IS NULL ORDER BY "records"."id" LIMIT $2`,
+		)).WithArgs(
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			1,
+		).WillReturnRows(sqlmock.NewRows([]string{"id", "unofficial_event_id"}).AddRow(
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			"",
+		))
+
+		mock.ExpectBegin()
+
+		mock.ExpectExec(regexp.QuoteMeta(
+			`UPDATE "games" SET "deleted_at"=$1 WHERE match_id IN (SELECT "id" FROM "matches" WHERE record_id = $2 AND "matches"."deleted_at" IS NULL) AND "games"."deleted_at" IS NULL`,
+		)).WithArgs(
+			AnyTime{},
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(
+			`UPDATE "matches" SET "deleted_at"=$1 WHERE record_id = $2 AND "matches"."deleted_at" IS NULL`,
+		)).WithArgs(
+			AnyTime{},
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// 版が一致しないため1行も削除されず、ここまでの削除もロールバックする
+		mock.ExpectExec(regexp.QuoteMeta(
+			`UPDATE "records" SET "deleted_at"=$1 WHERE id = $2 AND updated_at = $3 AND "records"."deleted_at" IS NULL`,
+		)).WithArgs(
+			AnyTime{},
+			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			ifMatch,
+		).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		mock.ExpectRollback()
+
+		ctx := repository.WithExpectedUpdatedAt(context.Background(), "01HD7Y3K8D6FDHMHTZ2GT41TN2", ifMatch)
+		err = r.Delete(ctx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		require.ErrorIs(t, err, apperror.ErrPreconditionFailed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("正常系_自由形式イベント_参照なしで削除", func(t *testing.T) {
 		r, mock, err := setup4RecordInfrastructure()
 		require.NoError(t, err)
@@ -814,8 +1011,9 @@ func test_RecordInfrastructure_Delete(t *testing.T) {
 		)).WithArgs(
 			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
 			1,
-		).WillReturnRows(sqlmock.NewRows([]string{"id", "unofficial_event_id"}).AddRow(
+		).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "unofficial_event_id"}).AddRow(
 			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+			"zor5SLfEfwfZ90yRVXzlxBEFARy2",
 			"01UNOFFICIALEVENT0000000001",
 		))
 
@@ -842,12 +1040,13 @@ func test_RecordInfrastructure_Delete(t *testing.T) {
 			"01HD7Y3K8D6FDHMHTZ2GT41TN2",
 		).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		// unofficial_event をソフトデリート
+		// unofficial_event をソフトデリート(自分が作り、他に参照する記録がないものだけ)
 		mock.ExpectExec(regexp.QuoteMeta(
-			`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE id = $2 AND "unofficial_events"."deleted_at" IS NULL`,
+			`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE (id = $2 AND user_id = $3 AND NOT EXISTS (SELECT 1 FROM "records" WHERE records.unofficial_event_id = unofficial_events.id AND "records"."deleted_at" IS NULL)) AND "unofficial_events"."deleted_at" IS NULL`,
 		)).WithArgs(
 			AnyTime{},
 			"01UNOFFICIALEVENT0000000001",
+			"zor5SLfEfwfZ90yRVXzlxBEFARy2",
 		).WillReturnResult(sqlmock.NewResult(0, 1))
 
 		mock.ExpectCommit()
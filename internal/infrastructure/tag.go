@@ -33,7 +33,7 @@ var (
 	deckTagLink     = tagLinkTable{name: "deck_tags", ownerColumn: "deck_id"}
 	deckCodeTagLink = tagLinkTable{name: "deck_code_tags", ownerColumn: "deck_code_id"}
 	matchTagLink    = tagLinkTable{name: "match_tags", ownerColumn: "match_id"}
-	// 将来: recordTagLink = tagLinkTable{name: "record_tags", ownerColumn: "record_id"}
+	recordTagLink   = tagLinkTable{name: "record_tags", ownerColumn: "record_id"}
 )
 
 func (i *Tag) FindByUserId(
@@ -166,6 +166,11 @@ func (i *Tag) Delete(
 			return err
 		}
 
+		if err := tx.Where("tag_id = ?", id).Delete(&model.RecordTag{}).Error; err != nil {
+			logError(ctx, err)
+			return err
+		}
+
 		return tx.Where("id = ?", id).Delete(&model.Tag{}).Error
 	})
 }
@@ -194,6 +199,14 @@ func (i *Tag) ReplaceMatchTags(
 	return i.replaceTags(ctx, matchTagLink, matchId, tagIds)
 }
 
+func (i *Tag) ReplaceRecordTags(
+	ctx context.Context,
+	recordId string,
+	tagIds []string,
+) error {
+	return i.replaceTags(ctx, recordTagLink, recordId, tagIds)
+}
+
 // replaceTags は ownerId が持つ中間テーブルの行を tagIds の集合に一致させる。
 // 「全削除 → 再INSERT」で表す(件数が小さくデッキごとのタグ付与に十分)。
 // link.name / link.ownerColumn はコード内定数のみのため SQL へ直接埋め込む。
@@ -312,3 +325,11 @@ func findTagsByMatchIds(
 ) (map[string][]*entity.Tag, error) {
 	return findTagsByOwnerIds(ctx, db, matchTagLink, matchIds)
 }
+
+func findTagsByRecordIds(
+	ctx context.Context,
+	db *gorm.DB,
+	recordIds []string,
+) (map[string][]*entity.Tag, error) {
+	return findTagsByOwnerIds(ctx, db, recordTagLink, recordIds)
+}
@@ -0,0 +1,203 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/httpclient"
+)
+
+// tonamelBracketPath は大会ページのうち、組み合わせ(トーナメント表)のページのパス。
+const tonamelBracketPath = "/tournament"
+
+type TonamelBracket struct {
+	logger *slog.Logger
+}
+
+func NewTonamelBracket(logger *slog.Logger) repository.TonamelBracketInterface {
+	return &TonamelBracket{logger}
+}
+
+// tonamelBracketPage はトーナメント表のページ(https://tonamel.com/competition/<id>/tournament)に
+// 埋め込まれている <script type="application/json"> のうち、組み合わせを持つもの。
+// tonamel.com が公開している API ではなく、ページに埋め込まれたデータの形を前提にしている
+// (前提にしている形は tonamel_bracket_test.go のフィクスチャの通り)。ページの作りが変わると
+// 組み合わせが見つからず apperror.ErrRecordNotFound になるため、そのときはここを合わせて直す。
+type tonamelBracketPage struct {
+	Matches []*tonamelBracketMatch `json:"matches"`
+}
+
+type tonamelBracketMatch struct {
+	Stage     string                `json:"stage"`
+	Round     int                   `json:"round"`
+	RoundName string                `json:"round_name"`
+	Status    string                `json:"status"`
+	Player1   *tonamelBracketPlayer `json:"player1"`
+	Player2   *tonamelBracketPlayer `json:"player2"`
+}
+
+type tonamelBracketPlayer struct {
+	Name   string `json:"name"`
+	Score  int    `json:"score"`
+	Winner bool   `json:"winner"`
+}
+
+func (i *TonamelBracket) FindById(
+	ctx context.Context,
+	tonamelEventId string,
+) (*entity.TonamelBracket, error) {
+	url := tonamelEventBaseURL + tonamelEventId + tonamelBracketPath
+
+	res, err := httpclient.Get(url)
+	if err != nil {
+		i.logger.ErrorContext(
+			ctx,
+			"failed to fetch Tonamel bracket page",
+			slog.String("tonamel_id", tonamelEventId),
+			slog.String("request_url", url),
+			slog.String("error_message", err.Error()),
+		)
+
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		i.logger.ErrorContext(
+			ctx,
+			"Tonamel bracket not found",
+			slog.String("tonamel_id", tonamelEventId),
+			slog.String("request_url", url),
+			slog.Int("status_code", res.StatusCode),
+		)
+
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		i.logger.ErrorContext(
+			ctx,
+			"Tonamel bracket page returned non-200 status",
+			slog.String("tonamel_id", tonamelEventId),
+			slog.String("request_url", url),
+			slog.Int("status_code", res.StatusCode),
+		)
+
+		return nil, fmt.Errorf("tonamel bracket page status: %d", res.StatusCode)
+	}
+
+	page, err := extractBracket(res.Body)
+	if err != nil {
+		i.logger.ErrorContext(
+			ctx,
+			"failed to parse Tonamel bracket page HTML",
+			slog.String("tonamel_id", tonamelEventId),
+			slog.String("request_url", url),
+			slog.String("error_message", err.Error()),
+		)
+
+		return nil, err
+	}
+
+	// 組み合わせがまだ公開されていない(または埋め込みデータが無い)ページ
+	if page == nil {
+		i.logger.ErrorContext(
+			ctx,
+			"Tonamel bracket data not found",
+			slog.String("tonamel_id", tonamelEventId),
+			slog.String("request_url", url),
+		)
+
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	ret := &entity.TonamelBracket{
+		TonamelEventId: tonamelEventId,
+		Matches:        []*entity.TonamelBracketMatch{},
+	}
+
+	for _, m := range page.Matches {
+		// バイは空いている側が player1 のこともある。entity ではバイを Player2 の空いた試合として
+		// 扱うため、いる側を Player1 に寄せる。どちらもいない枠は読み飛ばす。
+		player1, player2 := m.Player1, m.Player2
+		if player1 == nil || player1.Name == "" {
+			player1, player2 = player2, nil
+		}
+		if player1 == nil || player1.Name == "" {
+			continue
+		}
+
+		match := &entity.TonamelBracketMatch{
+			Stage:        entity.TonamelBracketStageQualifying,
+			Round:        m.Round,
+			RoundName:    m.RoundName,
+			Player1Name:  player1.Name,
+			Player1Score: player1.Score,
+			Finished:     m.Status == "finished",
+		}
+		if m.Stage == string(entity.TonamelBracketStageFinal) {
+			match.Stage = entity.TonamelBracketStageFinal
+		}
+		if player1.Winner {
+			match.WinnerName = player1.Name
+		}
+		if player2 != nil && player2.Name != "" {
+			match.Player2Name = player2.Name
+			match.Player2Score = player2.Score
+			if player2.Winner {
+				match.WinnerName = player2.Name
+			}
+		}
+
+		ret.Matches = append(ret.Matches, match)
+	}
+
+	return ret, nil
+}
+
+// extractBracket はHTMLに埋め込まれた <script type="application/json"> のうち、
+// matches を持つ最初のものを返す。見つからなければ nil を返す。
+func extractBracket(r io.Reader) (*tonamelBracketPage, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *tonamelBracketPage
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if ret != nil {
+			return
+		}
+		if n.Type == html.ElementNode && n.Data == "script" && n.FirstChild != nil {
+			for _, a := range n.Attr {
+				if strings.ToLower(a.Key) != "type" || strings.ToLower(a.Val) != "application/json" {
+					continue
+				}
+
+				var page tonamelBracketPage
+				// 組み合わせ以外のJSONも埋め込まれているため、解釈できないものは読み飛ばす。
+				if err := json.Unmarshal([]byte(n.FirstChild.Data), &page); err == nil && page.Matches != nil {
+					ret = &page
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return ret, nil
+}
@@ -0,0 +1,158 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// setup4TonamelBracketInfrastructure はhttptestサーバを立て、取得先URLを
+// そのサーバへ差し替えたリポジトリを返す(外部サイトへは通信しない)。
+func setup4TonamelBracketInfrastructure(t *testing.T, handler http.HandlerFunc) repository.TonamelBracketInterface {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := tonamelEventBaseURL
+	tonamelEventBaseURL = server.URL + "/competition/"
+	t.Cleanup(func() { tonamelEventBaseURL = original })
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	return NewTonamelBracket(logger)
+}
+
+func TestTonamelBracketInfrastructure_FindById(t *testing.T) {
+	t.Run("正常系_埋め込まれた組み合わせと結果を取得する", func(t *testing.T) {
+		r := setup4TonamelBracketInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+			require.Equal(t, "/competition/OakZc/tournament", req.URL.Path)
+			fmt.Fprint(w, `<html><head>
+				<script type="application/json">{"competition":{"title":"第23回 ACEカップ"}}</script>
+				<script type="application/json">{"matches":[
+					{"stage":"qualifying","round":1,"round_name":"予選1回戦","status":"finished",
+					 "player1":{"name":"さとし","score":2,"winner":true},"player2":{"name":"かすみ","score":1,"winner":false}},
+					{"stage":"qualifying","round":2,"round_name":"予選2回戦","status":"finished",
+					 "player1":{"name":"さとし","score":0,"winner":true},"player2":null},
+					{"stage":"final","round":1,"round_name":"決勝1回戦","status":"playing",
+					 "player1":{"name":"たけし","score":0,"winner":false},"player2":{"name":"さとし","score":0,"winner":false}}
+				]}</script>
+			</head><body></body></html>`)
+		})
+
+		ret, err := r.FindById(context.Background(), "OakZc")
+
+		require.NoError(t, err)
+		require.Equal(t, "OakZc", ret.TonamelEventId)
+		require.Equal(t, []*entity.TonamelBracketMatch{
+			{
+				Stage:        entity.TonamelBracketStageQualifying,
+				Round:        1,
+				RoundName:    "予選1回戦",
+				Player1Name:  "さとし",
+				Player2Name:  "かすみ",
+				Player1Score: 2,
+				Player2Score: 1,
+				WinnerName:   "さとし",
+				Finished:     true,
+			},
+			{
+				Stage:       entity.TonamelBracketStageQualifying,
+				Round:       2,
+				RoundName:   "予選2回戦",
+				Player1Name: "さとし",
+				WinnerName:  "さとし",
+				Finished:    true,
+			},
+			{
+				Stage:       entity.TonamelBracketStageFinal,
+				Round:       1,
+				RoundName:   "決勝1回戦",
+				Player1Name: "たけし",
+				Player2Name: "さとし",
+			},
+		}, ret.Matches)
+	})
+
+	t.Run("正常系_player1が空いたバイはいる側をPlayer1にする", func(t *testing.T) {
+		r := setup4TonamelBracketInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+			fmt.Fprint(w, `<html><head>
+				<script type="application/json">{"matches":[
+					{"stage":"qualifying","round":2,"round_name":"予選2回戦","status":"finished",
+					 "player1":null,"player2":{"name":"さとし","score":0,"winner":true}},
+					{"stage":"qualifying","round":3,"round_name":"予選3回戦","status":"finished",
+					 "player1":{"name":"","score":0,"winner":false},"player2":{"name":"かすみ","score":0,"winner":true}},
+					{"stage":"qualifying","round":3,"round_name":"予選3回戦","status":"finished",
+					 "player1":null,"player2":null}
+				]}</script>
+			</head><body></body></html>`)
+		})
+
+		ret, err := r.FindById(context.Background(), "OakZc")
+
+		require.NoError(t, err)
+		require.Equal(t, []*entity.TonamelBracketMatch{
+			{
+				Stage:       entity.TonamelBracketStageQualifying,
+				Round:       2,
+				RoundName:   "予選2回戦",
+				Player1Name: "さとし",
+				WinnerName:  "さとし",
+				Finished:    true,
+			},
+			{
+				Stage:       entity.TonamelBracketStageQualifying,
+				Round:       3,
+				RoundName:   "予選3回戦",
+				Player1Name: "かすみ",
+				WinnerName:  "かすみ",
+				Finished:    true,
+			},
+		}, ret.Matches)
+		require.True(t, ret.Matches[0].IsBye())
+	})
+
+	t.Run("異常系_組み合わせが埋め込まれていなければErrRecordNotFoundを返す", func(t *testing.T) {
+		r := setup4TonamelBracketInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+			fmt.Fprint(w, `<html><head>
+				<script type="application/json">{"competition":{"title":"第23回 ACEカップ"}}</script>
+			</head><body></body></html>`)
+		})
+
+		_, err := r.FindById(context.Background(), "OakZc")
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+
+	t.Run("異常系_404はErrRecordNotFoundを返す", func(t *testing.T) {
+		r := setup4TonamelBracketInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		_, err := r.FindById(context.Background(), "ERROR")
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+
+	t.Run("異常系_404以外の異常ステータスはエラーを返す", func(t *testing.T) {
+		r := setup4TonamelBracketInfrastructure(t, func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		_, err := r.FindById(context.Background(), "OakZc")
+
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "503"))
+	})
+}
@@ -34,8 +34,11 @@ func NewTransactionManager(db *gorm.DB) repository.TransactionManager {
 	return &TransactionManager{db}
 }
 
+// Do は fn を1つのトランザクションで実行する。ctx に既にトランザクションがあれば
+// (Do の中から呼ばれたら)新しく始めずにその中のセーブポイントで実行するため、
+// fn が失敗しても外側のトランザクションはそのセーブポイントまで戻るだけで続けられる。
 func (m *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
-	return m.db.Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, m.db).Transaction(func(tx *gorm.DB) error {
 		return fn(contextWithTx(ctx, tx))
 	})
 }
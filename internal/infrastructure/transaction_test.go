@@ -37,9 +37,11 @@ func TestTransactionInfrastructure(t *testing.T) {
 	for scenario, fn := range map[string]func(
 		t *testing.T,
 	){
-		"commit-on-success":             test_TransactionInfrastructure_CommitOnSuccess,
-		"rollback-on-error":             test_TransactionInfrastructure_RollbackOnError,
-		"propagates-tx-bound-db-to-ctx": test_TransactionInfrastructure_PropagatesTxBoundDbToCtx,
+		"commit-on-success":              test_TransactionInfrastructure_CommitOnSuccess,
+		"rollback-on-error":              test_TransactionInfrastructure_RollbackOnError,
+		"propagates-tx-bound-db-to-ctx":  test_TransactionInfrastructure_PropagatesTxBoundDbToCtx,
+		"nested-joins-outer-tx":          test_TransactionInfrastructure_NestedJoinsOuterTx,
+		"nested-rolls-back-to-savepoint": test_TransactionInfrastructure_NestedRollsBackToSavepoint,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t)
@@ -104,3 +106,53 @@ func test_TransactionInfrastructure_PropagatesTxBoundDbToCtx(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+// Do の中から呼んだ Do は2回目の BEGIN を発行せず、外側のトランザクションの
+// セーブポイントとして実行されることを検証する。
+func test_TransactionInfrastructure_NestedJoinsOuterTx(t *testing.T) {
+	db, tm, mock, err := setup4TransactionInfrastructure()
+	require.NoError(t, err)
+
+	userRepo := NewUser(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SAVEPOINT sp\d+`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "users" SET "deleted_at"=$1 WHERE id = $2 AND "users"."deleted_at" IS NULL`,
+	)).WithArgs(
+		AnyTime{},
+		"01HD7Y3K8D6FDHMHTZ2GT41TN2",
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = tm.Do(context.Background(), func(ctx context.Context) error {
+		return tm.Do(ctx, func(ctx context.Context) error {
+			return userRepo.Delete(ctx, "01HD7Y3K8D6FDHMHTZ2GT41TN2")
+		})
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func test_TransactionInfrastructure_NestedRollsBackToSavepoint(t *testing.T) {
+	_, tm, mock, err := setup4TransactionInfrastructure()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SAVEPOINT sp\d+`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT sp\d+`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	wantErr := errors.New("boom")
+	err = tm.Do(context.Background(), func(ctx context.Context) error {
+		require.Equal(t, wantErr, tm.Do(ctx, func(ctx context.Context) error {
+			return wantErr
+		}))
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
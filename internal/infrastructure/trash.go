@@ -0,0 +1,387 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+// 物理削除の対象。親(記録・デッキ)が対象なら、子(対戦結果・デッキコード)は削除日時によらず対象にする
+// (子だけ残すと参照先の無い行になる)。@before には削除日時の下限を渡す。
+const (
+	trashPurgeRecords   = `SELECT id FROM records WHERE deleted_at < @before`
+	trashPurgeMatches   = `SELECT id FROM matches WHERE deleted_at < @before OR record_id IN (` + trashPurgeRecords + `)`
+	trashPurgeDecks     = `SELECT id FROM decks WHERE deleted_at < @before`
+	trashPurgeDeckCodes = `SELECT id FROM deck_codes WHERE deleted_at < @before OR deck_id IN (` + trashPurgeDecks + `)`
+
+	trashPurgeUnofficialEvents = `SELECT id FROM unofficial_events WHERE deleted_at < @before`
+
+	// 写真は論理削除を持たないため、親(記録・対戦結果)が対象のものだけを消す。
+	trashPurgeAttachments = `SELECT id FROM attachments WHERE record_id IN (` + trashPurgeRecords + `) OR match_id IN (` + trashPurgeMatches + `)`
+)
+
+// trashPurgeStep は物理削除する1テーブル分。外部キーで参照する側から順に並べる。
+// count が nil のもの(中間テーブル等)は件数を数えない。
+type trashPurgeStep struct {
+	table string
+	where string
+	count func(*entity.TrashPurgeResult) *int64
+}
+
+var trashPurgeSteps = []trashPurgeStep{
+	{
+		table: "attachments",
+		where: `id IN (` + trashPurgeAttachments + `)`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.Attachments },
+	},
+	// 確認依頼は両側の対戦結果を参照するため、どちらかが消えるなら結び付きごと消す。
+	{
+		table: "match_confirmations",
+		where: `match_id IN (` + trashPurgeMatches + `) OR mirrored_match_id IN (` + trashPurgeMatches + `)`,
+	},
+	{table: "match_tags", where: `match_id IN (` + trashPurgeMatches + `)`},
+	{table: "match_pokemon_sprites", where: `match_id IN (` + trashPurgeMatches + `)`},
+	{
+		table: "games",
+		where: `deleted_at < @before OR match_id IN (` + trashPurgeMatches + `)`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.Games },
+	},
+	{
+		table: "matches",
+		where: `id IN (` + trashPurgeMatches + `)`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.Matches },
+	},
+	{table: "record_tags", where: `record_id IN (` + trashPurgeRecords + `)`},
+	{
+		table: "records",
+		where: `deleted_at < @before`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.Records },
+	},
+	// 大会の組み合わせ・参加者は論理削除を持たないため、イベントとともに消す。
+	{table: "unofficial_event_pairings", where: `unofficial_event_id IN (` + trashPurgeUnofficialEvents + `)`},
+	{table: "unofficial_event_participants", where: `unofficial_event_id IN (` + trashPurgeUnofficialEvents + `)`},
+	{table: "unofficial_event_tournaments", where: `unofficial_event_id IN (` + trashPurgeUnofficialEvents + `)`},
+	{
+		table: "unofficial_events",
+		where: `deleted_at < @before`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.UnofficialEvents },
+	},
+	{table: "deck_code_tags", where: `deck_code_id IN (` + trashPurgeDeckCodes + `)`},
+	{table: "deck_code_cards", where: `deck_code_id IN (` + trashPurgeDeckCodes + `)`},
+	{table: "deck_asset_jobs", where: `deck_code_id IN (` + trashPurgeDeckCodes + `)`},
+	{
+		table: "deck_codes",
+		where: `id IN (` + trashPurgeDeckCodes + `)`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.DeckCodes },
+	},
+	{table: "deck_tags", where: `deck_id IN (` + trashPurgeDecks + `)`},
+	{table: "deck_pokemon_sprites", where: `deck_id IN (` + trashPurgeDecks + `)`},
+	{table: "user_favorite_decks", where: `deck_id IN (` + trashPurgeDecks + `)`},
+	{
+		table: "decks",
+		where: `deleted_at < @before`,
+		count: func(r *entity.TrashPurgeResult) *int64 { return &r.Decks },
+	},
+}
+
+type Trash struct {
+	db *gorm.DB
+}
+
+func NewTrash(
+	db *gorm.DB,
+) repository.TrashInterface {
+	return &Trash{db}
+}
+
+func newRecordTrashItem(m *model.Record) *entity.TrashItem {
+	name := ""
+	if !m.EventDate.IsZero() {
+		name = m.EventDate.Format("2006-01-02")
+	}
+
+	return &entity.TrashItem{
+		ID:        m.ID,
+		Type:      entity.TrashItemTypeRecord,
+		UserId:    m.UserId,
+		Name:      name,
+		DeletedAt: m.DeletedAt.Time,
+	}
+}
+
+func newMatchTrashItem(m *model.Match) *entity.TrashItem {
+	return &entity.TrashItem{
+		ID:        m.ID,
+		Type:      entity.TrashItemTypeMatch,
+		UserId:    m.UserId,
+		ParentId:  m.RecordId,
+		Name:      m.OpponentsDeckInfo,
+		DeletedAt: m.DeletedAt.Time,
+	}
+}
+
+func newDeckTrashItem(m *model.Deck) *entity.TrashItem {
+	return &entity.TrashItem{
+		ID:        m.ID,
+		Type:      entity.TrashItemTypeDeck,
+		UserId:    m.UserId,
+		Name:      m.Name,
+		DeletedAt: m.DeletedAt.Time,
+	}
+}
+
+func newDeckCodeTrashItem(m *model.DeckCode) *entity.TrashItem {
+	return &entity.TrashItem{
+		ID:        m.ID,
+		Type:      entity.TrashItemTypeDeckCode,
+		UserId:    m.UserId,
+		ParentId:  m.DeckId,
+		Name:      m.Code,
+		DeletedAt: m.DeletedAt.Time,
+	}
+}
+
+func (i *Trash) FindByUserId(
+	ctx context.Context,
+	userId string,
+	deletedAfter time.Time,
+) ([]*entity.TrashItem, error) {
+	db := dbFromContext(ctx, i.db)
+
+	var records []*model.Record
+	if tx := db.Unscoped().
+		Where("user_id = ? AND deleted_at >= ?", userId, deletedAfter).
+		Find(&records); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	// 削除済みの記録の対戦結果は記録を復元すれば戻るため、記録が残っているものだけを載せる。
+	var matches []*model.Match
+	if tx := db.Unscoped().
+		Where("user_id = ? AND deleted_at >= ?", userId, deletedAfter).
+		Where("record_id IN (SELECT id FROM records WHERE deleted_at IS NULL)").
+		Find(&matches); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	var decks []*model.Deck
+	if tx := db.Unscoped().
+		Where("user_id = ? AND deleted_at >= ?", userId, deletedAfter).
+		Find(&decks); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	var deckCodes []*model.DeckCode
+	if tx := db.Unscoped().
+		Where("user_id = ? AND deleted_at >= ?", userId, deletedAfter).
+		Where("deck_id IN (SELECT id FROM decks WHERE deleted_at IS NULL)").
+		Find(&deckCodes); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	items := make([]*entity.TrashItem, 0, len(records)+len(matches)+len(decks)+len(deckCodes))
+	for _, m := range records {
+		items = append(items, newRecordTrashItem(m))
+	}
+	for _, m := range matches {
+		items = append(items, newMatchTrashItem(m))
+	}
+	for _, m := range decks {
+		items = append(items, newDeckTrashItem(m))
+	}
+	for _, m := range deckCodes {
+		items = append(items, newDeckCodeTrashItem(m))
+	}
+
+	sort.SliceStable(items, func(a, b int) bool {
+		return items[a].DeletedAt.After(items[b].DeletedAt)
+	})
+
+	return items, nil
+}
+
+func (i *Trash) FindById(
+	ctx context.Context,
+	itemType entity.TrashItemType,
+	id string,
+) (*entity.TrashItem, error) {
+	db := dbFromContext(ctx, i.db).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id)
+
+	switch itemType {
+	case entity.TrashItemTypeRecord:
+		var m model.Record
+		if tx := db.First(&m); tx.Error != nil {
+			logError(ctx, tx.Error)
+			return nil, wrapError(tx.Error)
+		}
+		return newRecordTrashItem(&m), nil
+	case entity.TrashItemTypeMatch:
+		var m model.Match
+		if tx := db.First(&m); tx.Error != nil {
+			logError(ctx, tx.Error)
+			return nil, wrapError(tx.Error)
+		}
+		return newMatchTrashItem(&m), nil
+	case entity.TrashItemTypeDeck:
+		var m model.Deck
+		if tx := db.First(&m); tx.Error != nil {
+			logError(ctx, tx.Error)
+			return nil, wrapError(tx.Error)
+		}
+		return newDeckTrashItem(&m), nil
+	case entity.TrashItemTypeDeckCode:
+		var m model.DeckCode
+		if tx := db.First(&m); tx.Error != nil {
+			logError(ctx, tx.Error)
+			return nil, wrapError(tx.Error)
+		}
+		return newDeckCodeTrashItem(&m), nil
+	}
+
+	return nil, apperror.ErrRecordNotFound
+}
+
+func (i *Trash) Restore(
+	ctx context.Context,
+	item *entity.TrashItem,
+) error {
+	db := dbFromContext(ctx, i.db)
+
+	// 一緒に削除された子は、親の deleted_at の前後 TrashCascadeWindow に収まるものとみなす。
+	from := item.DeletedAt.Add(-entity.TrashCascadeWindow)
+	to := item.DeletedAt.Add(entity.TrashCascadeWindow)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var steps []*gorm.DB
+
+		switch item.Type {
+		case entity.TrashItemTypeRecord:
+			steps = []*gorm.DB{
+				tx.Unscoped().Model(&model.Game{}).Where(
+					"match_id IN (SELECT id FROM matches WHERE record_id = ?) AND deleted_at BETWEEN ? AND ?", item.ID, from, to,
+				),
+				tx.Unscoped().Model(&model.Match{}).Where("record_id = ? AND deleted_at BETWEEN ? AND ?", item.ID, from, to),
+				// 記録が参照していた自由形式イベントは記録と一緒に削除しているため、同じく戻す。
+				tx.Unscoped().Model(&model.UnofficialEvent{}).Where(
+					"id IN (SELECT unofficial_event_id FROM records WHERE id = ?) AND deleted_at BETWEEN ? AND ?", item.ID, from, to,
+				),
+				tx.Unscoped().Model(&model.Record{}).Where("id = ?", item.ID),
+			}
+		case entity.TrashItemTypeMatch:
+			if err := requireAlive(tx, &model.Record{}, item.ParentId); err != nil {
+				return err
+			}
+
+			steps = []*gorm.DB{
+				tx.Unscoped().Model(&model.Game{}).Where("match_id = ? AND deleted_at BETWEEN ? AND ?", item.ID, from, to),
+				tx.Unscoped().Model(&model.Match{}).Where("id = ?", item.ID),
+			}
+		case entity.TrashItemTypeDeck:
+			steps = []*gorm.DB{
+				tx.Unscoped().Model(&model.DeckCode{}).Where("deck_id = ? AND deleted_at BETWEEN ? AND ?", item.ID, from, to),
+				tx.Unscoped().Model(&model.Deck{}).Where("id = ?", item.ID),
+			}
+		case entity.TrashItemTypeDeckCode:
+			if err := requireAlive(tx, &model.Deck{}, item.ParentId); err != nil {
+				return err
+			}
+
+			steps = []*gorm.DB{
+				tx.Unscoped().Model(&model.DeckCode{}).Where("id = ?", item.ID),
+			}
+		default:
+			return apperror.ErrRecordNotFound
+		}
+
+		for _, step := range steps {
+			if tx := step.Update("deleted_at", nil); tx.Error != nil {
+				logError(ctx, tx.Error)
+				return tx.Error
+			}
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelDefault})
+}
+
+// requireAlive は親(記録・デッキ)が削除されていないことを確かめる。
+// 削除されたままの親の下に子だけを戻すと、一覧にも出ない宙に浮いた行になるため。
+func requireAlive(tx *gorm.DB, parent interface{}, id string) error {
+	var count int64
+	if tx := tx.Model(parent).Where("id = ?", id).Count(&count); tx.Error != nil {
+		return tx.Error
+	}
+
+	if count == 0 {
+		return apperror.ErrParentDeleted
+	}
+
+	return nil
+}
+
+func (i *Trash) CountPurgeable(
+	ctx context.Context,
+	deletedBefore time.Time,
+) (*entity.TrashPurgeResult, error) {
+	db := dbFromContext(ctx, i.db)
+
+	result := &entity.TrashPurgeResult{}
+	for _, step := range trashPurgeSteps {
+		if step.count == nil {
+			continue
+		}
+
+		if tx := db.Raw(
+			"SELECT COUNT(*) FROM "+step.table+" WHERE "+step.where,
+			sql.Named("before", deletedBefore),
+		).Scan(step.count(result)); tx.Error != nil {
+			logError(ctx, tx.Error)
+			return nil, tx.Error
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Trash) Purge(
+	ctx context.Context,
+	deletedBefore time.Time,
+) (*entity.TrashPurgeResult, error) {
+	db := dbFromContext(ctx, i.db)
+
+	result := &entity.TrashPurgeResult{}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for _, step := range trashPurgeSteps {
+			ret := tx.Exec(
+				"DELETE FROM "+step.table+" WHERE "+step.where,
+				sql.Named("before", deletedBefore),
+			)
+			if ret.Error != nil {
+				return ret.Error
+			}
+
+			if step.count != nil {
+				*step.count(result) = ret.RowsAffected
+			}
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelDefault}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return result, nil
+}
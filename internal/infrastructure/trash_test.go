@@ -0,0 +1,241 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+func TestTrashInfrastructure(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	matchId := "01HD7Y3K8D6FDHMHTZ2GT41TR2"
+	deckId := "01HD7Y3K8D6FDHMHTZ2GT41TR3"
+	deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TR4"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	cutoff := entity.TrashCutoff(now)
+
+	t.Run("FindByUserId", func(t *testing.T) {
+		t.Run("正常系_削除日時の新しい順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "records" WHERE user_id = $1 AND deleted_at >= $2`,
+			)).WithArgs(uid, cutoff).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "user_id", "event_date", "deleted_at"}).
+					AddRow(recordId, uid, time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local), now.Add(-3*time.Hour)),
+			)
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "matches" WHERE (user_id = $1 AND deleted_at >= $2) AND record_id IN (SELECT id FROM records WHERE deleted_at IS NULL)`,
+			)).WithArgs(uid, cutoff).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "user_id", "record_id", "opponents_deck_info", "deleted_at"}).
+					AddRow(matchId, uid, "01HD7Y3K8D6FDHMHTZ2GT41TR9", "ドラパルトex", now.Add(-1*time.Hour)),
+			)
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "decks" WHERE user_id = $1 AND deleted_at >= $2`,
+			)).WithArgs(uid, cutoff).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "user_id", "name", "deleted_at"}).
+					AddRow(deckId, uid, "サーナイトex", now.Add(-2*time.Hour)),
+			)
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "deck_codes" WHERE (user_id = $1 AND deleted_at >= $2) AND deck_id IN (SELECT id FROM decks WHERE deleted_at IS NULL)`,
+			)).WithArgs(uid, cutoff).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "user_id", "deck_id", "code", "deleted_at"}),
+			)
+
+			ret, err := r.FindByUserId(context.Background(), uid, cutoff)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 3)
+			require.Equal(t, entity.TrashItemTypeMatch, ret[0].Type)
+			require.Equal(t, "01HD7Y3K8D6FDHMHTZ2GT41TR9", ret[0].ParentId)
+			require.Equal(t, "ドラパルトex", ret[0].Name)
+			require.Equal(t, entity.TrashItemTypeDeck, ret[1].Type)
+			require.Equal(t, entity.TrashItemTypeRecord, ret[2].Type)
+			require.Equal(t, "2026-10-12", ret[2].Name)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("正常系_削除済みの記録を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "records" WHERE id = $1 AND deleted_at IS NOT NULL ORDER BY "records"."id" LIMIT $2`,
+			)).WithArgs(recordId, 1).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "user_id", "deleted_at"}).AddRow(recordId, uid, now),
+			)
+
+			ret, err := r.FindById(context.Background(), entity.TrashItemTypeRecord, recordId)
+
+			require.NoError(t, err)
+			require.Equal(t, uid, ret.UserId)
+			require.Equal(t, now, ret.DeletedAt)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_削除されていなければErrRecordNotFoundを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectQuery(`SELECT \* FROM "deck_codes"`).
+				WithArgs(deckCodeId, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			ret, err := r.FindById(context.Background(), entity.TrashItemTypeDeckCode, deckCodeId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		from := now.Add(-entity.TrashCascadeWindow)
+		to := now.Add(entity.TrashCascadeWindow)
+
+		t.Run("正常系_記録を一緒に削除された対戦結果・対局・自由形式イベントとともに戻す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "games" SET "deleted_at"=$1,"updated_at"=$2 WHERE match_id IN (SELECT id FROM matches WHERE record_id = $3) AND deleted_at BETWEEN $4 AND $5`,
+			)).WithArgs(nil, sqlmock.AnyArg(), recordId, from, to).WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "matches" SET "deleted_at"=$1,"updated_at"=$2 WHERE record_id = $3 AND deleted_at BETWEEN $4 AND $5`,
+			)).WithArgs(nil, sqlmock.AnyArg(), recordId, from, to).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "deleted_at"=$1,"updated_at"=$2 WHERE id IN (SELECT unofficial_event_id FROM records WHERE id = $3) AND deleted_at BETWEEN $4 AND $5`,
+			)).WithArgs(nil, sqlmock.AnyArg(), recordId, from, to).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "records" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3`,
+			)).WithArgs(nil, sqlmock.AnyArg(), recordId).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			item := &entity.TrashItem{ID: recordId, Type: entity.TrashItemTypeRecord, UserId: uid, DeletedAt: now}
+			require.NoError(t, r.Restore(context.Background(), item))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_記録が削除されたままなら対戦結果を戻さない", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT count(*) FROM "records" WHERE id = $1 AND "records"."deleted_at" IS NULL`,
+			)).WithArgs(recordId).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			mock.ExpectRollback()
+
+			item := &entity.TrashItem{ID: matchId, Type: entity.TrashItemTypeMatch, UserId: uid, ParentId: recordId, DeletedAt: now}
+			require.ErrorIs(t, r.Restore(context.Background(), item), apperror.ErrParentDeleted)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_デッキコードはデッキが残っていれば単独で戻す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT count(*) FROM "decks" WHERE id = $1 AND "decks"."deleted_at" IS NULL`,
+			)).WithArgs(deckId).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "deck_codes" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3`,
+			)).WithArgs(nil, sqlmock.AnyArg(), deckCodeId).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			item := &entity.TrashItem{ID: deckCodeId, Type: entity.TrashItemTypeDeckCode, UserId: uid, ParentId: deckId, DeletedAt: now}
+			require.NoError(t, r.Restore(context.Background(), item))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Run("正常系_参照する側から順に物理削除して件数を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			affected := map[string]int64{"attachments": 2, "games": 6, "matches": 3, "records": 1, "unofficial_events": 1, "deck_codes": 2, "decks": 1}
+
+			mock.ExpectBegin()
+			for _, step := range trashPurgeSteps {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM ` + step.table + ` WHERE`)).
+					WillReturnResult(sqlmock.NewResult(0, affected[step.table]))
+			}
+			mock.ExpectCommit()
+
+			ret, err := r.Purge(context.Background(), cutoff)
+
+			require.NoError(t, err)
+			require.Equal(t, &entity.TrashPurgeResult{
+				Records:          1,
+				Matches:          3,
+				Games:            6,
+				UnofficialEvents: 1,
+				Decks:            1,
+				DeckCodes:        2,
+				Attachments:      2,
+			}, ret)
+			require.Equal(t, int64(16), ret.Total())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_子テーブルを親より先に消す", func(t *testing.T) {
+			position := map[string]int{}
+			for i, step := range trashPurgeSteps {
+				position[step.table] = i
+			}
+
+			for child, parent := range map[string]string{
+				"attachments":                   "matches",
+				"match_confirmations":           "matches",
+				"match_tags":                    "matches",
+				"match_pokemon_sprites":         "matches",
+				"games":                         "matches",
+				"matches":                       "records",
+				"record_tags":                   "records",
+				"unofficial_event_pairings":     "unofficial_events",
+				"unofficial_event_participants": "unofficial_events",
+				"unofficial_event_tournaments":  "unofficial_events",
+				"deck_code_tags":                "deck_codes",
+				"deck_code_cards":               "deck_codes",
+				"deck_asset_jobs":               "deck_codes",
+				"deck_codes":                    "decks",
+				"deck_tags":                     "decks",
+				"deck_pokemon_sprites":          "decks",
+				"user_favorite_decks":           "decks",
+			} {
+				require.Less(t, position[child], position[parent], child)
+			}
+		})
+	})
+
+	t.Run("CountPurgeable", func(t *testing.T) {
+		t.Run("正常系_物理削除の対象件数を数える", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewTrash(db)
+
+			for _, table := range []string{"attachments", "games", "matches", "records", "unofficial_events", "deck_codes", "decks"} {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM ` + table + ` WHERE`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			}
+
+			ret, err := r.CountPurgeable(context.Background(), cutoff)
+
+			require.NoError(t, err)
+			require.Equal(t, int64(14), ret.Total())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
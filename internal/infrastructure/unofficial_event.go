@@ -2,9 +2,13 @@ package infrastructure
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
@@ -20,6 +24,20 @@ func NewUnofficialEvent(
 	return &UnofficialEvent{db}
 }
 
+func newUnofficialEventEntity(m *model.UnofficialEvent) *entity.UnofficialEvent {
+	e := entity.NewUnofficialEvent(
+		m.ID,
+		m.UserId,
+		m.Title,
+		m.Date,
+	)
+	e.CreatedAt = m.CreatedAt
+	e.UpdatedAt = m.UpdatedAt
+	e.JoinCode = m.JoinCode.String
+
+	return e
+}
+
 func (i *UnofficialEvent) FindById(
 	ctx context.Context,
 	id string,
@@ -31,15 +49,40 @@ func (i *UnofficialEvent) FindById(
 		return nil, wrapError(tx.Error)
 	}
 
-	entity := entity.NewUnofficialEvent(
-		model.ID,
-		model.UserId,
-		model.Title,
-		model.Date,
-	)
-	entity.CreatedAt = model.CreatedAt
+	return newUnofficialEventEntity(&model), nil
+}
+
+func (i *UnofficialEvent) FindByJoinCode(
+	ctx context.Context,
+	joinCode string,
+) (*entity.UnofficialEvent, error) {
+	var model model.UnofficialEvent
+
+	if tx := i.db.Where("join_code = ?", joinCode).First(&model); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUnofficialEventEntity(&model), nil
+}
+
+func (i *UnofficialEvent) FindByUserId(
+	ctx context.Context,
+	userId string,
+) ([]*entity.UnofficialEvent, error) {
+	var models []*model.UnofficialEvent
+
+	if tx := i.db.Where("user_id = ?", userId).Order("created_at ASC").Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
 
-	return entity, nil
+	ret := make([]*entity.UnofficialEvent, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newUnofficialEventEntity(m))
+	}
+
+	return ret, nil
 }
 
 func (i *UnofficialEvent) Save(
@@ -55,17 +98,31 @@ func (i *UnofficialEvent) Save(
 	// 更新時に created_at を現在時刻で潰さないよう、取得済みの値をそのまま書き戻す。
 	// 新規作成時はゼロ値のままGORMのautoCreateTimeに任せる。
 	model.CreatedAt = entity.CreatedAt
+	model.JoinCode = sql.NullString{String: entity.JoinCode, Valid: entity.JoinCode != ""}
 
-	if tx := i.db.Save(model); tx.Error != nil {
-		logError(ctx, tx.Error)
-		return tx.Error
+	if err := saveIfUnmodified(ctx, dbFromContext(ctx, i.db), entity.ID, entity.UpdatedAt, model); err != nil {
+		if isJoinCodeConflict(err) {
+			return apperror.ErrAlreadyExists
+		}
+
+		logError(ctx, err)
+		return err
 	}
+	entity.UpdatedAt = model.UpdatedAt
 
 	return nil
 }
 
+// isJoinCodeConflict は err が参加コードの一意制約(idx_unofficial_events_join_code)の違反かを返す。
+func isJoinCodeConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == "23505" &&
+		pgErr.ConstraintName == "idx_unofficial_events_join_code"
+}
+
 // 記録から参照されなくなった自由形式イベントを削除する。
-// 自由形式イベントは記録と1対1で作られるため、記録側の参照を外した後に呼ぶ想定
+// 自由形式イベントは作成者の記録と作られるため、記録側の参照を外した後に呼ぶ想定
 // (記録そのものの削除では Record.Delete が同じトランザクションの中で消している)。
 func (i *UnofficialEvent) Delete(
 	ctx context.Context,
@@ -73,7 +130,30 @@ func (i *UnofficialEvent) Delete(
 ) error {
 	db := dbFromContext(ctx, i.db)
 
-	if tx := db.Where("id = ?", id).Delete(&model.UnofficialEvent{}); tx.Error != nil {
+	if err := deleteIfUnmodified(ctx, db, id, &model.UnofficialEvent{}); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func (i *UnofficialEvent) DeleteIfUnreferenced(
+	ctx context.Context,
+	id string,
+	userId string,
+) error {
+	db := dbFromContext(ctx, i.db)
+
+	// Record.Delete と同じく、他に参照する記録が残っているイベントは消さない。
+	// 大会を開いたイベントも、組み合わせや結果が残るため消さない。
+	if tx := db.Where(
+		"id = ? AND user_id = ? AND NOT EXISTS (?) AND NOT EXISTS (?)",
+		id,
+		userId,
+		db.Model(&model.Record{}).Select("1").Where("records.unofficial_event_id = unofficial_events.id"),
+		db.Model(&model.UnofficialEventTournament{}).Select("1").Where("unofficial_event_tournaments.unofficial_event_id = unofficial_events.id"),
+	).Delete(&model.UnofficialEvent{}); tx.Error != nil {
 		logError(ctx, tx.Error)
 		return tx.Error
 	}
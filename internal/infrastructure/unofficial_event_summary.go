@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type UnofficialEventSummary struct {
+	db *gorm.DB
+}
+
+func NewUnofficialEventSummary(
+	db *gorm.DB,
+) repository.UnofficialEventSummaryInterface {
+	return &UnofficialEventSummary{db}
+}
+
+type unofficialEventSummaryResult struct {
+	RecordId     string
+	UserId       string
+	UserName     string
+	UserImageURL string
+	DeckId       string
+	Count        int
+	Wins         int
+	Draws        int
+}
+
+func (i *UnofficialEventSummary) FindByUnofficialEventId(
+	ctx context.Context,
+	unofficialEventId string,
+) (*entity.UnofficialEventSummary, error) {
+	var results []unofficialEventSummaryResult
+
+	// 共有したイベントは他のユーザも見るため、非公開の記録と退会済みユーザの記録は含めない。
+	// 対戦結果が1件もない記録も参加者として出すよう matches は LEFT JOIN にする。
+	if tx := i.db.Table("records").
+		Select("records.id AS record_id, records.user_id AS user_id, users.name AS user_name, users.image_url AS user_image_url, records.deck_id AS deck_id, COUNT(matches.id) AS count, COUNT(CASE WHEN matches.victory_flg THEN 1 END) AS wins, COUNT(CASE WHEN matches.draw_flg THEN 1 END) AS draws").
+		Joins("JOIN users ON users.id = records.user_id AND users.deleted_at IS NULL").
+		Joins("LEFT JOIN matches ON matches.record_id = records.id AND matches.deleted_at IS NULL").
+		Where("records.unofficial_event_id = ? AND records.deleted_at IS NULL AND records.private_flg = false", unofficialEventId).
+		Group("records.id, records.user_id, users.name, users.image_url, records.deck_id, records.created_at").
+		Order("records.created_at ASC").
+		Scan(&results); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	deckIds := make([]string, 0, len(results))
+	for _, r := range results {
+		if r.DeckId != "" {
+			deckIds = append(deckIds, r.DeckId)
+		}
+	}
+
+	spritesByDeckId, err := findDeckPokemonSpritesByDeckIds(ctx, i.db, deckIds)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	summary := &entity.UnofficialEventSummary{
+		UnofficialEventId: unofficialEventId,
+		Participants:      make([]*entity.UnofficialEventSummaryParticipant, 0, len(results)),
+		Archetypes:        []*entity.UnofficialEventArchetype{},
+	}
+
+	archetypes := map[string]*entity.UnofficialEventArchetype{}
+	for _, r := range results {
+		sprites := spritesByDeckId[r.DeckId]
+		losses := r.Count - r.Wins - r.Draws
+
+		summary.Participants = append(summary.Participants, &entity.UnofficialEventSummaryParticipant{
+			RecordId:       r.RecordId,
+			UserId:         r.UserId,
+			UserName:       r.UserName,
+			UserImageURL:   r.UserImageURL,
+			Wins:           r.Wins,
+			Losses:         losses,
+			Draws:          r.Draws,
+			PokemonSprites: sprites,
+		})
+		summary.TotalMatches += r.Count
+
+		spriteIds := make([]string, 0, len(sprites))
+		for _, sprite := range sprites {
+			spriteIds = append(spriteIds, sprite.ID)
+		}
+
+		key, _ := NormalizeFingerprint(spriteIds)
+		if key == "" {
+			continue
+		}
+
+		archetype, ok := archetypes[key]
+		if !ok {
+			// 表示用のスプライトは最初に見つかった参加者のデッキの並びを使う。
+			archetype = &entity.UnofficialEventArchetype{
+				Fingerprint:    key,
+				PokemonSprites: sprites,
+			}
+			archetypes[key] = archetype
+			summary.Archetypes = append(summary.Archetypes, archetype)
+		}
+
+		archetype.Count++
+		archetype.Wins += r.Wins
+		archetype.Losses += losses
+		archetype.Draws += r.Draws
+	}
+
+	sort.SliceStable(summary.Archetypes, func(a, b int) bool {
+		if summary.Archetypes[a].Count != summary.Archetypes[b].Count {
+			return summary.Archetypes[a].Count > summary.Archetypes[b].Count
+		}
+
+		return summary.Archetypes[a].Fingerprint < summary.Archetypes[b].Fingerprint
+	})
+
+	return summary, nil
+}
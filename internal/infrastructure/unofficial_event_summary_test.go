@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var unofficialEventSummaryColumns = []string{
+	"record_id", "user_id", "user_name", "user_image_url", "deck_id", "count", "wins", "draws",
+}
+
+func TestUnofficialEventSummaryInfrastructure(t *testing.T) {
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TN2"
+
+	t.Run("正常系_参加者ごとの成績とデッキの指紋ごとの成績を返す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewUnofficialEventSummary(db)
+
+		mock.ExpectQuery(`SELECT records.id AS record_id, .* FROM "records" JOIN users ON users.id = records.user_id AND users.deleted_at IS NULL LEFT JOIN matches ON matches.record_id = records.id AND matches.deleted_at IS NULL WHERE records.unofficial_event_id = \$1 AND records.deleted_at IS NULL AND records.private_flg = false GROUP BY .* ORDER BY records.created_at ASC`).
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(unofficialEventSummaryColumns).
+				AddRow("record1", "user1", "ユーザ1", "", "deck1", 3, 2, 0).
+				AddRow("record2", "user2", "ユーザ2", "", "deck2", 3, 1, 1).
+				AddRow("record3", "user3", "ユーザ3", "", "deck3", 2, 0, 0).
+				AddRow("record4", "user4", "ユーザ4", "", "", 0, 0, 0))
+
+		// 並び順が違っても同じスプライトの組み合わせなら同じ指紋にまとめる
+		mock.ExpectQuery(`SELECT \* FROM "deck_pokemon_sprites" WHERE deck_id IN`).
+			WithArgs("deck1", "deck2", "deck3").
+			WillReturnRows(sqlmock.NewRows(deckPokemonSpriteColumns).
+				AddRow("deck1", 1, "gardevoir").
+				AddRow("deck1", 2, "zacian").
+				AddRow("deck2", 1, "zacian").
+				AddRow("deck2", 2, "gardevoir").
+				AddRow("deck3", 1, "charizard"))
+
+		ret, err := r.FindByUnofficialEventId(context.Background(), id)
+
+		require.NoError(t, err)
+		require.Equal(t, id, ret.UnofficialEventId)
+		require.Equal(t, 8, ret.TotalMatches)
+
+		require.Len(t, ret.Participants, 4)
+		require.Equal(t, "user2", ret.Participants[1].UserId)
+		require.Equal(t, 1, ret.Participants[1].Wins)
+		require.Equal(t, 1, ret.Participants[1].Losses)
+		require.Equal(t, 1, ret.Participants[1].Draws)
+		require.Empty(t, ret.Participants[3].PokemonSprites)
+
+		require.Len(t, ret.Archetypes, 2)
+		require.Equal(t, "gardevoir,zacian", ret.Archetypes[0].Fingerprint)
+		require.Equal(t, 2, ret.Archetypes[0].Count)
+		require.Equal(t, 3, ret.Archetypes[0].Wins)
+		require.Equal(t, 2, ret.Archetypes[0].Losses)
+		require.Equal(t, 1, ret.Archetypes[0].Draws)
+		require.Equal(t, "gardevoir", ret.Archetypes[0].PokemonSprites[0].ID)
+		require.Equal(t, "charizard", ret.Archetypes[1].Fingerprint)
+		require.Equal(t, 2, ret.Archetypes[1].Losses)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("正常系_記録が付いていなければ空の集計を返す", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewUnofficialEventSummary(db)
+
+		mock.ExpectQuery(`SELECT records.id AS record_id`).
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(unofficialEventSummaryColumns))
+
+		ret, err := r.FindByUnofficialEventId(context.Background(), id)
+
+		require.NoError(t, err)
+		require.Empty(t, ret.Participants)
+		require.Empty(t, ret.Archetypes)
+		require.Zero(t, ret.TotalMatches)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,20 +2,23 @@ package infrastructure
 
 import (
 	"context"
+	"database/sql"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
 	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
 	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 )
 
 var unofficialEventColumns = []string{
-	"id", "created_at", "updated_at", "deleted_at", "user_id", "title", "date",
+	"id", "created_at", "updated_at", "deleted_at", "user_id", "title", "date", "join_code",
 }
 
 func TestUnofficialEventInfrastructure(t *testing.T) {
@@ -34,7 +37,7 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 				`SELECT * FROM "unofficial_events" WHERE id = $1 AND "unofficial_events"."deleted_at" IS NULL ORDER BY "unofficial_events"."id" LIMIT $2`,
 			)).WithArgs(id, 1).WillReturnRows(
 				sqlmock.NewRows(unofficialEventColumns).AddRow(
-					id, now, now, gorm.DeletedAt{}, uid, "自主大会", date,
+					id, now, now, gorm.DeletedAt{}, uid, "自主大会", date, nil,
 				),
 			)
 
@@ -45,6 +48,7 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 			require.Equal(t, uid, ret.UserId)
 			require.Equal(t, "自主大会", ret.Title)
 			require.Equal(t, date, ret.Date)
+			require.Equal(t, now, ret.UpdatedAt)
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
 
@@ -63,6 +67,70 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 		})
 	})
 
+	t.Run("FindByJoinCode", func(t *testing.T) {
+		t.Run("正常系_参加コードで共有している自由形式イベントを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "unofficial_events" WHERE join_code = $1 AND "unofficial_events"."deleted_at" IS NULL ORDER BY "unofficial_events"."id" LIMIT $2`,
+			)).WithArgs("K7QX2M9P", 1).WillReturnRows(
+				sqlmock.NewRows(unofficialEventColumns).AddRow(
+					id, now, now, gorm.DeletedAt{}, uid, "自主大会", date, "K7QX2M9P",
+				),
+			)
+
+			ret, err := r.FindByJoinCode(context.Background(), "K7QX2M9P")
+
+			require.NoError(t, err)
+			require.Equal(t, id, ret.ID)
+			require.Equal(t, "K7QX2M9P", ret.JoinCode)
+			require.True(t, ret.IsShared())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_存在しない参加コードはErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			mock.ExpectQuery(`SELECT \* FROM "unofficial_events"`).
+				WithArgs("K7QX2M9P", 1).WillReturnRows(sqlmock.NewRows(unofficialEventColumns))
+
+			ret, err := r.FindByJoinCode(context.Background(), "K7QX2M9P")
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindByUserId", func(t *testing.T) {
+		t.Run("正常系_ユーザの自由形式イベントを作成順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "unofficial_events" WHERE user_id = $1 AND "unofficial_events"."deleted_at" IS NULL ORDER BY created_at ASC`,
+			)).WithArgs(uid).WillReturnRows(
+				sqlmock.NewRows(unofficialEventColumns).AddRow(
+					id, now, now, gorm.DeletedAt{}, uid, "自主大会", date, nil,
+				),
+			)
+
+			ret, err := r.FindByUserId(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, id, ret[0].ID)
+			require.Equal(t, now, ret[0].CreatedAt)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
 	t.Run("Save", func(t *testing.T) {
 		t.Run("正常系_イベントを保存する", func(t *testing.T) {
 			db, mock := setupSqlmockDB(t)
@@ -70,9 +138,9 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 
 			mock.ExpectBegin()
 			mock.ExpectExec(regexp.QuoteMeta(
-				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $7`,
+				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6,"join_code"=$7 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $8`,
 			)).WithArgs(
-				AnyTime{}, AnyTime{}, gorm.DeletedAt{}, uid, "自主大会", date, id,
+				AnyTime{}, AnyTime{}, gorm.DeletedAt{}, uid, "自主大会", date, sql.NullString{}, id,
 			).WillReturnResult(sqlmock.NewResult(0, 1))
 			mock.ExpectCommit()
 
@@ -91,9 +159,9 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 			// GORMのSaveは全カラムを書き戻すため、created_atを渡さないと更新時刻で潰れる
 			mock.ExpectBegin()
 			mock.ExpectExec(regexp.QuoteMeta(
-				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $7`,
+				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6,"join_code"=$7 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $8`,
 			)).WithArgs(
-				createdAt, AnyTime{}, gorm.DeletedAt{}, uid, "身内対戦会", date, id,
+				createdAt, AnyTime{}, gorm.DeletedAt{}, uid, "身内対戦会", date, sql.NullString{}, id,
 			).WillReturnResult(sqlmock.NewResult(0, 1))
 			mock.ExpectCommit()
 
@@ -103,6 +171,67 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 			require.NoError(t, r.Save(context.Background(), event))
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
+
+		t.Run("正常系_参加コードを発行したイベントはjoin_codeも保存する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6,"join_code"=$7 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $8`,
+			)).WithArgs(
+				AnyTime{}, AnyTime{}, gorm.DeletedAt{}, uid, "自主大会", date, sql.NullString{String: "K7QX2M9P", Valid: true}, id,
+			).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			event := entity.NewUnofficialEvent(id, uid, "自主大会", date)
+			event.JoinCode = "K7QX2M9P"
+
+			require.NoError(t, r.Save(context.Background(), event))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_参加コードが他のイベントと重なればErrAlreadyExistsへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6,"join_code"=$7 WHERE "unofficial_events"."deleted_at" IS NULL AND "id" = $8`,
+			)).WithArgs(
+				AnyTime{}, AnyTime{}, gorm.DeletedAt{}, uid, "自主大会", date, sql.NullString{String: "K7QX2M9P", Valid: true}, id,
+			).WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_unofficial_events_join_code"})
+			mock.ExpectRollback()
+
+			event := entity.NewUnofficialEvent(id, uid, "自主大会", date)
+			event.JoinCode = "K7QX2M9P"
+
+			require.ErrorIs(t, r.Save(context.Background(), event), apperror.ErrAlreadyExists)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_読み込んだ版から更新されていれば上書きせずErrPreconditionFailed", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			createdAt := time.Date(2026, 7, 1, 12, 0, 0, 0, time.Local)
+			updatedAt := time.Date(2026, 7, 2, 12, 0, 0, 0, time.UTC)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"user_id"=$4,"title"=$5,"date"=$6,"join_code"=$7 WHERE updated_at = $8 AND "unofficial_events"."deleted_at" IS NULL AND "id" = $9`,
+			)).WithArgs(
+				createdAt, AnyTime{}, gorm.DeletedAt{}, uid, "身内対戦会", date, sql.NullString{}, updatedAt, id,
+			).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+
+			event := entity.NewUnofficialEvent(id, uid, "身内対戦会", date)
+			event.CreatedAt = createdAt
+			event.UpdatedAt = updatedAt
+
+			require.ErrorIs(t, r.Save(context.Background(), event), apperror.ErrPreconditionFailed)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
 	})
 
 	t.Run("Delete", func(t *testing.T) {
@@ -120,5 +249,50 @@ func TestUnofficialEventInfrastructure(t *testing.T) {
 			require.NoError(t, r.Delete(context.Background(), id))
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
+
+		t.Run("正常系_If-Matchの版が一致すれば削除する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			updatedAt := time.Date(2026, 7, 2, 12, 0, 0, 0, time.UTC)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE id = $2 AND updated_at = $3 AND "unofficial_events"."deleted_at" IS NULL`,
+			)).WithArgs(AnyTime{}, id, updatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			ctx := repository.WithExpectedUpdatedAt(context.Background(), id, updatedAt)
+			require.NoError(t, r.Delete(ctx, id))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+	t.Run("DeleteIfUnreferenced", func(t *testing.T) {
+		t.Run("正常系_記録にも大会にも参照されていなければ削除する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_events" SET "deleted_at"=$1 WHERE (id = $2 AND user_id = $3 AND NOT EXISTS (SELECT 1 FROM "records" WHERE records.unofficial_event_id = unofficial_events.id AND "records"."deleted_at" IS NULL) AND NOT EXISTS (SELECT 1 FROM "unofficial_event_tournaments" WHERE unofficial_event_tournaments.unofficial_event_id = unofficial_events.id)) AND "unofficial_events"."deleted_at" IS NULL`,
+			)).WithArgs(AnyTime{}, id, uid).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.DeleteIfUnreferenced(context.Background(), id, uid))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("正常系_自分の他の記録が参照していて1件も消えなくてもエラーにしない", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEvent(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "unofficial_events" SET "deleted_at"`).
+				WithArgs(AnyTime{}, id, uid).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.DeleteIfUnreferenced(context.Background(), id, uid))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
 	})
 }
@@ -0,0 +1,319 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type UnofficialEventTournament struct {
+	db *gorm.DB
+}
+
+func NewUnofficialEventTournament(
+	db *gorm.DB,
+) repository.UnofficialEventTournamentInterface {
+	return &UnofficialEventTournament{db}
+}
+
+func newTournamentNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func newTournamentNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func newUnofficialEventTournamentEntity(m *model.UnofficialEventTournament) *entity.UnofficialEventTournament {
+	return &entity.UnofficialEventTournament{
+		UnofficialEventId: m.UnofficialEventId,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		BO3Flg:            m.BO3Flg,
+		TopCutSize:        m.TopCutSize,
+	}
+}
+
+func newUnofficialEventParticipantEntity(m *model.UnofficialEventParticipant) *entity.UnofficialEventParticipant {
+	return &entity.UnofficialEventParticipant{
+		ID:                m.ID,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		UnofficialEventId: m.UnofficialEventId,
+		UserId:            m.UserId.String,
+		GuestName:         m.GuestName,
+		RecordId:          m.RecordId.String,
+		DroppedAt:         m.DroppedAt.Time,
+	}
+}
+
+func newUnofficialEventParticipantModel(e *entity.UnofficialEventParticipant) *model.UnofficialEventParticipant {
+	return &model.UnofficialEventParticipant{
+		ID:                e.ID,
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+		UnofficialEventId: e.UnofficialEventId,
+		UserId:            newTournamentNullString(e.UserId),
+		GuestName:         e.GuestName,
+		RecordId:          newTournamentNullString(e.RecordId),
+		DroppedAt:         newTournamentNullTime(e.DroppedAt),
+	}
+}
+
+func newUnofficialEventPairingEntity(m *model.UnofficialEventPairing) *entity.UnofficialEventPairing {
+	return &entity.UnofficialEventPairing{
+		ID:                m.ID,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		UnofficialEventId: m.UnofficialEventId,
+		Stage:             entity.TournamentStage(m.Stage),
+		Round:             m.Round,
+		TableNo:           m.TableNo,
+		Player1Id:         m.Player1ParticipantId,
+		Player2Id:         m.Player2ParticipantId.String,
+		Player1Wins:       m.Player1Wins,
+		Player2Wins:       m.Player2Wins,
+		ReportedAt:        m.ReportedAt.Time,
+		Player1MatchId:    m.Player1MatchId.String,
+		Player2MatchId:    m.Player2MatchId.String,
+	}
+}
+
+func newUnofficialEventPairingModel(e *entity.UnofficialEventPairing) *model.UnofficialEventPairing {
+	return &model.UnofficialEventPairing{
+		ID:                   e.ID,
+		CreatedAt:            e.CreatedAt,
+		UpdatedAt:            e.UpdatedAt,
+		UnofficialEventId:    e.UnofficialEventId,
+		Stage:                string(e.Stage),
+		Round:                e.Round,
+		TableNo:              e.TableNo,
+		Player1ParticipantId: e.Player1Id,
+		Player2ParticipantId: newTournamentNullString(e.Player2Id),
+		Player1Wins:          e.Player1Wins,
+		Player2Wins:          e.Player2Wins,
+		ReportedAt:           newTournamentNullTime(e.ReportedAt),
+		Player1MatchId:       newTournamentNullString(e.Player1MatchId),
+		Player2MatchId:       newTournamentNullString(e.Player2MatchId),
+	}
+}
+
+func (i *UnofficialEventTournament) FindByUnofficialEventId(
+	ctx context.Context,
+	unofficialEventId string,
+) (*entity.UnofficialEventTournament, error) {
+	var m model.UnofficialEventTournament
+
+	if tx := dbFromContext(ctx, i.db).Where("unofficial_event_id = ?", unofficialEventId).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUnofficialEventTournamentEntity(&m), nil
+}
+
+func (i *UnofficialEventTournament) LockByUnofficialEventId(
+	ctx context.Context,
+	unofficialEventId string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Clauses(clause.Locking{Strength: "UPDATE"}).Select("unofficial_event_id").Where("unofficial_event_id = ?", unofficialEventId).First(&model.UnofficialEventTournament{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return wrapError(tx.Error)
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) Create(
+	ctx context.Context,
+	tournament *entity.UnofficialEventTournament,
+) error {
+	m := &model.UnofficialEventTournament{
+		UnofficialEventId: tournament.UnofficialEventId,
+		CreatedAt:         tournament.CreatedAt,
+		UpdatedAt:         tournament.UpdatedAt,
+		BO3Flg:            tournament.BO3Flg,
+		TopCutSize:        tournament.TopCutSize,
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) Save(
+	ctx context.Context,
+	tournament *entity.UnofficialEventTournament,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.UnofficialEventTournament{}).Where("unofficial_event_id = ?", tournament.UnofficialEventId).Updates(map[string]interface{}{
+		"updated_at":   tournament.UpdatedAt,
+		"top_cut_size": tournament.TopCutSize,
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) FindParticipants(
+	ctx context.Context,
+	unofficialEventId string,
+) ([]*entity.UnofficialEventParticipant, error) {
+	var models []*model.UnofficialEventParticipant
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("unofficial_event_id = ?", unofficialEventId).
+		Order("created_at ASC, id ASC").
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.UnofficialEventParticipant, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newUnofficialEventParticipantEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *UnofficialEventTournament) FindParticipantById(
+	ctx context.Context,
+	id string,
+) (*entity.UnofficialEventParticipant, error) {
+	var m model.UnofficialEventParticipant
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUnofficialEventParticipantEntity(&m), nil
+}
+
+func (i *UnofficialEventTournament) CreateParticipant(
+	ctx context.Context,
+	participant *entity.UnofficialEventParticipant,
+) error {
+	if tx := dbFromContext(ctx, i.db).Create(newUnofficialEventParticipantModel(participant)); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) SaveParticipant(
+	ctx context.Context,
+	participant *entity.UnofficialEventParticipant,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.UnofficialEventParticipant{}).Where("id = ?", participant.ID).Updates(map[string]interface{}{
+		"updated_at": participant.UpdatedAt,
+		"record_id":  newTournamentNullString(participant.RecordId),
+		"dropped_at": newTournamentNullTime(participant.DroppedAt),
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) DeleteParticipant(
+	ctx context.Context,
+	id string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).Delete(&model.UnofficialEventParticipant{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) FindPairings(
+	ctx context.Context,
+	unofficialEventId string,
+) ([]*entity.UnofficialEventPairing, error) {
+	var models []*model.UnofficialEventPairing
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("unofficial_event_id = ?", unofficialEventId).
+		Order("round ASC, table_no ASC").
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.UnofficialEventPairing, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newUnofficialEventPairingEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *UnofficialEventTournament) FindPairingById(
+	ctx context.Context,
+	id string,
+) (*entity.UnofficialEventPairing, error) {
+	var m model.UnofficialEventPairing
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUnofficialEventPairingEntity(&m), nil
+}
+
+func (i *UnofficialEventTournament) CreatePairings(
+	ctx context.Context,
+	pairings []*entity.UnofficialEventPairing,
+) error {
+	if len(pairings) == 0 {
+		return nil
+	}
+
+	models := make([]*model.UnofficialEventPairing, 0, len(pairings))
+	for _, pairing := range pairings {
+		models = append(models, newUnofficialEventPairingModel(pairing))
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UnofficialEventTournament) SavePairing(
+	ctx context.Context,
+	pairing *entity.UnofficialEventPairing,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.UnofficialEventPairing{}).Where("id = ?", pairing.ID).Updates(map[string]interface{}{
+		"updated_at":       pairing.UpdatedAt,
+		"player1_wins":     pairing.Player1Wins,
+		"player2_wins":     pairing.Player2Wins,
+		"reported_at":      newTournamentNullTime(pairing.ReportedAt),
+		"player1_match_id": newTournamentNullString(pairing.Player1MatchId),
+		"player2_match_id": newTournamentNullString(pairing.Player2MatchId),
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var unofficialEventPairingColumns = []string{
+	"id", "created_at", "updated_at", "unofficial_event_id", "stage", "round", "table_no",
+	"player1_participant_id", "player2_participant_id", "player1_wins", "player2_wins",
+	"reported_at", "player1_match_id", "player2_match_id",
+}
+
+func TestUnofficialEventTournamentInfrastructure(t *testing.T) {
+	eventId := "01JTESTUNOFFICIALEVENT0000"
+	participantId := "01JTESTPARTICIPANT00000000"
+	opponentId := "01JTESTPARTICIPANT0000000B"
+	pairingId := "01JTESTPAIRING000000000000"
+
+	t.Run("FindByUnofficialEventId", func(t *testing.T) {
+		t.Run("正常系_大会の設定を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "unofficial_event_tournaments" WHERE unofficial_event_id = $1 ORDER BY "unofficial_event_tournaments"."unofficial_event_id" LIMIT $2`,
+			)).WithArgs(eventId, 1).WillReturnRows(
+				sqlmock.NewRows([]string{"unofficial_event_id", "created_at", "updated_at", "bo3_flg", "top_cut_size"}).
+					AddRow(eventId, now, now, true, 8),
+			)
+
+			ret, err := r.FindByUnofficialEventId(context.Background(), eventId)
+
+			require.NoError(t, err)
+			require.True(t, ret.BO3Flg)
+			require.Equal(t, 8, ret.TopCutSize)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_大会にしていなければErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "unofficial_event_tournaments" WHERE unofficial_event_id = $1`,
+			)).WithArgs(eventId, 1).WillReturnRows(sqlmock.NewRows([]string{"unofficial_event_id"}))
+
+			ret, err := r.FindByUnofficialEventId(context.Background(), eventId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("LockByUnofficialEventId", func(t *testing.T) {
+		t.Run("正常系_大会の行をロックする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT "unofficial_event_id" FROM "unofficial_event_tournaments" WHERE unofficial_event_id = $1 ORDER BY "unofficial_event_tournaments"."unofficial_event_id" LIMIT $2 FOR UPDATE`,
+			)).WithArgs(eventId, 1).WillReturnRows(sqlmock.NewRows([]string{"unofficial_event_id"}).AddRow(eventId))
+
+			err := r.LockByUnofficialEventId(context.Background(), eventId)
+
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_大会にしていなければErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT "unofficial_event_id" FROM "unofficial_event_tournaments" WHERE unofficial_event_id = $1`,
+			)).WithArgs(eventId, 1).WillReturnRows(sqlmock.NewRows([]string{"unofficial_event_id"}))
+
+			err := r.LockByUnofficialEventId(context.Background(), eventId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("CreateParticipant", func(t *testing.T) {
+		t.Run("正常系_ゲストは user_id を NULL にする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`INSERT INTO "unofficial_event_participants" ("id","created_at","updated_at","unofficial_event_id","user_id","guest_name","record_id","dropped_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+			)).WithArgs(
+				participantId, now, now, eventId, nil, "ゲストA", nil, nil,
+			).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			participant := entity.NewUnofficialEventParticipant(participantId, now, eventId, "", "ゲストA")
+
+			require.NoError(t, r.CreateParticipant(context.Background(), participant))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindPairings", func(t *testing.T) {
+		t.Run("正常系_回戦・卓番号の順に返し、バイは Player2Id を空にする", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "unofficial_event_pairings" WHERE unofficial_event_id = $1 ORDER BY round ASC, table_no ASC`,
+			)).WithArgs(eventId).WillReturnRows(
+				sqlmock.NewRows(unofficialEventPairingColumns).
+					AddRow(pairingId, now, now, eventId, "swiss", 1, 1, participantId, opponentId, 1, 0, now, "01JTESTMATCH00000000000000", nil).
+					AddRow(pairingId+"B", now, now, eventId, "swiss", 1, 2, "01JTESTPARTICIPANT0000000C", nil, 0, 0, now, nil, nil),
+			)
+
+			ret, err := r.FindPairings(context.Background(), eventId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, entity.TournamentStageSwiss, ret[0].Stage)
+			require.Equal(t, opponentId, ret[0].Player2Id)
+			require.Equal(t, "01JTESTMATCH00000000000000", ret[0].Player1MatchId)
+			require.Empty(t, ret[0].Player2MatchId)
+			require.True(t, ret[1].IsBye())
+			require.True(t, ret[1].IsReported())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("CreatePairings", func(t *testing.T) {
+		t.Run("正常系_組み合わせが無ければ何もしない", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			require.NoError(t, r.CreatePairings(context.Background(), nil))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("SavePairing", func(t *testing.T) {
+		t.Run("正常系_結果と作った対戦結果を更新する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUnofficialEventTournament(db)
+
+			now := time.Now().Local()
+			pairing := entity.NewUnofficialEventPairing(pairingId, now, eventId, entity.TournamentStageSwiss, 1, 1, participantId, opponentId)
+			pairing.Report(2, 1, now)
+			pairing.Player1MatchId = "01JTESTMATCH00000000000000"
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "unofficial_event_pairings" SET "player1_match_id"=$1,"player1_wins"=$2,"player2_match_id"=$3,"player2_wins"=$4,"reported_at"=$5,"updated_at"=$6 WHERE id = $7`,
+			)).WithArgs(
+				"01JTESTMATCH00000000000000", 2, nil, 1, now, now, pairingId,
+			).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.SavePairing(context.Background(), pairing))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -0,0 +1,161 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type UserExport struct {
+	db *gorm.DB
+}
+
+func NewUserExport(
+	db *gorm.DB,
+) repository.UserExportInterface {
+	return &UserExport{db}
+}
+
+func newUserExportEntity(m *model.UserExport) *entity.UserExport {
+	return &entity.UserExport{
+		ID:          m.ID,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		UserId:      m.UserId,
+		Status:      entity.UserExportStatus(m.Status),
+		Attempts:    m.Attempts,
+		NextRunAt:   m.NextRunAt,
+		LastError:   m.LastError,
+		CompletedAt: m.CompletedAt.Time,
+	}
+}
+
+func newUserExportCompletedAt(completedAt time.Time) sql.NullTime {
+	return sql.NullTime{Time: completedAt, Valid: !completedAt.IsZero()}
+}
+
+func (i *UserExport) Create(
+	ctx context.Context,
+	export *entity.UserExport,
+) error {
+	m := &model.UserExport{
+		ID:          export.ID,
+		CreatedAt:   export.CreatedAt,
+		UpdatedAt:   export.UpdatedAt,
+		UserId:      export.UserId,
+		Status:      string(export.Status),
+		Attempts:    export.Attempts,
+		NextRunAt:   export.NextRunAt,
+		LastError:   export.LastError,
+		CompletedAt: newUserExportCompletedAt(export.CompletedAt),
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UserExport) FindById(
+	ctx context.Context,
+	id string,
+) (*entity.UserExport, error) {
+	var m model.UserExport
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUserExportEntity(&m), nil
+}
+
+func (i *UserExport) FindUnfinishedByUserId(
+	ctx context.Context,
+	userId string,
+) (*entity.UserExport, error) {
+	var m model.UserExport
+
+	if tx := dbFromContext(ctx, i.db).
+		Where(
+			"user_id = ? AND status IN ?",
+			userId,
+			[]string{string(entity.UserExportStatusPending), string(entity.UserExportStatusRunning)},
+		).
+		Order("created_at DESC").
+		First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUserExportEntity(&m), nil
+}
+
+func (i *UserExport) Claim(
+	ctx context.Context,
+	now time.Time,
+	staleBefore time.Time,
+	limit int,
+) ([]*entity.UserExport, error) {
+	var models []*model.UserExport
+
+	// deck_asset_jobs と同じく、FOR UPDATE SKIP LOCKED で取り出しと実行中への更新を1文で行い、
+	// 複数のワーカーが同じエクスポートを重ねて取り出さないようにする。
+	if tx := dbFromContext(ctx, i.db).Raw(
+		`UPDATE user_exports
+		 SET status = ?, attempts = attempts + 1, updated_at = ?
+		 WHERE id IN (
+		     SELECT id FROM user_exports
+		     WHERE (status = ? AND next_run_at <= ?)
+		        OR (status = ? AND updated_at < ?)
+		     ORDER BY next_run_at ASC
+		     LIMIT ?
+		     FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING *`,
+		string(entity.UserExportStatusRunning),
+		now,
+		string(entity.UserExportStatusPending),
+		now,
+		string(entity.UserExportStatusRunning),
+		staleBefore,
+		limit,
+	).Scan(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	ret := make([]*entity.UserExport, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newUserExportEntity(m))
+	}
+
+	return ret, nil
+}
+
+func (i *UserExport) Save(
+	ctx context.Context,
+	export *entity.UserExport,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.UserExport{}).Where("id = ?", export.ID).Updates(map[string]interface{}{
+		"updated_at":   export.UpdatedAt,
+		"status":       string(export.Status),
+		"attempts":     export.Attempts,
+		"next_run_at":  export.NextRunAt,
+		"last_error":   export.LastError,
+		"completed_at": newUserExportCompletedAt(export.CompletedAt),
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,517 @@
+package infrastructure
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// エクスポートのアーカイブは本人にだけAPIを通して渡すもので、CDNやブラウザに残さない。
+const (
+	userExportArchiveContentType  = "application/zip"
+	userExportArchiveCacheControl = "private, no-store"
+)
+
+// userExportDateLayout は日付だけを持つ項目(開催日など)の書式。
+const userExportDateLayout = "2006-01-02"
+
+// UserExportArchive はユーザの全データを、エンティティごとに JSON と CSV の2形式で
+// まとめた zip にして DeckAssetStorage へ置く。ストレージは公開しないもの
+// (NewPrivateS3DeckAssetStorage 等)を渡す。
+type UserExportArchive struct {
+	storage DeckAssetStorage
+}
+
+func NewUserExportArchive(storage DeckAssetStorage) repository.UserExportArchiveInterface {
+	return &UserExportArchive{storage: storage}
+}
+
+func (i *UserExportArchive) Put(
+	ctx context.Context,
+	key string,
+	data *entity.UserExportData,
+) error {
+	body, err := buildUserExportArchive(data)
+	if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	if err := i.storage.Put(ctx, key, body, userExportArchiveContentType, userExportArchiveCacheControl); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func (i *UserExportArchive) Get(
+	ctx context.Context,
+	key string,
+) ([]byte, error) {
+	body, err := i.storage.Get(ctx, key)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return body, nil
+}
+
+type userExportManifest struct {
+	UserId     string    `json:"user_id"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+type userExportRecordRow struct {
+	ID                string    `json:"id"`
+	CreatedAt         time.Time `json:"created_at"`
+	EventDate         string    `json:"event_date"`
+	OfficialEventId   uint      `json:"official_event_id"`
+	TonamelEventId    string    `json:"tonamel_event_id"`
+	FriendId          string    `json:"friend_id"`
+	UnofficialEventId string    `json:"unofficial_event_id"`
+	DeckId            string    `json:"deck_id"`
+	DeckCodeId        string    `json:"deck_code_id"`
+	PrivateFlg        bool      `json:"private_flg"`
+	IgnoreStatsFlg    bool      `json:"ignore_stats_flg"`
+	RegulationId      uint      `json:"regulation_id"`
+	TCGMeisterURL     string    `json:"tcg_meister_url"`
+	Memo              string    `json:"memo"`
+}
+
+type userExportMatchRow struct {
+	ID                   string    `json:"id"`
+	CreatedAt            time.Time `json:"created_at"`
+	RecordId             string    `json:"record_id"`
+	Position             int       `json:"position"`
+	DeckId               string    `json:"deck_id"`
+	DeckCodeId           string    `json:"deck_code_id"`
+	OpponentsUserId      string    `json:"opponents_user_id"`
+	BO3Flg               bool      `json:"bo3_flg"`
+	GroupMatchFlg        bool      `json:"group_match_flg"`
+	GroupMatchVictoryFlg bool      `json:"group_match_victory_flg"`
+	QualifyingRoundFlg   bool      `json:"qualifying_round_flg"`
+	FinalTournamentFlg   bool      `json:"final_tournament_flg"`
+	DefaultVictoryFlg    bool      `json:"default_victory_flg"`
+	DefaultDefeatFlg     bool      `json:"default_defeat_flg"`
+	VictoryFlg           bool      `json:"victory_flg"`
+	DrawFlg              bool      `json:"draw_flg"`
+	OpponentsDeckInfo    string    `json:"opponents_deck_info"`
+	Memo                 string    `json:"memo"`
+}
+
+type userExportGameRow struct {
+	ID                  string    `json:"id"`
+	CreatedAt           time.Time `json:"created_at"`
+	MatchId             string    `json:"match_id"`
+	GoFirst             bool      `json:"go_first"`
+	WinningFlg          bool      `json:"winning_flg"`
+	YourPrizeCards      uint      `json:"your_prize_cards"`
+	OpponentsPrizeCards uint      `json:"opponents_prize_cards"`
+	Memo                string    `json:"memo"`
+}
+
+type userExportDeckRow struct {
+	ID         string     `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ArchivedAt *time.Time `json:"archived_at"`
+	Name       string     `json:"name"`
+	PrivateFlg bool       `json:"private_flg"`
+}
+
+type userExportDeckCodeRow struct {
+	ID             string    `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	DeckId         string    `json:"deck_id"`
+	Code           string    `json:"code"`
+	PrivateCodeFlg bool      `json:"private_code_flg"`
+	Memo           string    `json:"memo"`
+}
+
+type userExportTagRow struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+}
+
+type userExportUnofficialEventRow struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Title     string    `json:"title"`
+	Date      string    `json:"date"`
+}
+
+type userExportBadgeRow struct {
+	BadgeDefinitionId string    `json:"badge_definition_id"`
+	RecordId          string    `json:"record_id"`
+	AchievedAt        time.Time `json:"achieved_at"`
+}
+
+type userExportEnvironmentBadgeRow struct {
+	EnvironmentId string    `json:"environment_id"`
+	RecordId      string    `json:"record_id"`
+	AchievedAt    time.Time `json:"achieved_at"`
+}
+
+type userExportNotificationRow struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	Category  string     `json:"category"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	LinkUrl   string     `json:"link_url"`
+	IsRead    bool       `json:"is_read"`
+	ReadAt    *time.Time `json:"read_at"`
+}
+
+// userExportTable はアーカイブに含める1エンティティ分の表。name.json と name.csv の2ファイルにする。
+type userExportTable struct {
+	name   string
+	header []string
+	// rows は JSON にそのまま書き出す値(要素は上の userExport*Row)。
+	rows any
+	// records は CSV の各行。header と同じ並びの文字列にしたもの。
+	records [][]string
+}
+
+func newUserExportTable[T any](name string, header []string, rows []T, toRecord func(T) []string) *userExportTable {
+	records := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, toRecord(row))
+	}
+
+	// 0件でも JSON が null ではなく [] になるよう、nil を空スライスにしておく。
+	if rows == nil {
+		rows = []T{}
+	}
+
+	return &userExportTable{
+		name:    name,
+		header:  header,
+		rows:    rows,
+		records: records,
+	}
+}
+
+// buildUserExportArchive は data を zip にする。
+func buildUserExportArchive(data *entity.UserExportData) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	if err := writeUserExportJSON(zw, "manifest.json", &userExportManifest{
+		UserId:     data.UserId,
+		ExportedAt: data.ExportedAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, table := range newUserExportTables(data) {
+		if err := writeUserExportJSON(zw, table.name+".json", table.rows); err != nil {
+			return nil, err
+		}
+
+		if err := writeUserExportCSV(zw, table.name+".csv", table.header, table.records); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func newUserExportTables(data *entity.UserExportData) []*userExportTable {
+	var records []*userExportRecordRow
+	var matches []*userExportMatchRow
+	var games []*userExportGameRow
+	for _, cr := range data.Records {
+		r := cr.Record
+		records = append(records, &userExportRecordRow{
+			ID:                r.ID,
+			CreatedAt:         r.CreatedAt,
+			EventDate:         r.EventDate.Format(userExportDateLayout),
+			OfficialEventId:   r.OfficialEventId,
+			TonamelEventId:    r.TonamelEventId,
+			FriendId:          r.FriendId,
+			UnofficialEventId: r.UnofficialEventId,
+			DeckId:            r.DeckId,
+			DeckCodeId:        r.DeckCodeId,
+			PrivateFlg:        r.PrivateFlg,
+			IgnoreStatsFlg:    r.IgnoreStatsFlg,
+			RegulationId:      r.RegulationId,
+			TCGMeisterURL:     r.TCGMeisterURL,
+			Memo:              r.Memo,
+		})
+
+		for _, m := range cr.Matches {
+			matches = append(matches, &userExportMatchRow{
+				ID:                   m.ID,
+				CreatedAt:            m.CreatedAt,
+				RecordId:             m.RecordId,
+				Position:             m.Position,
+				DeckId:               m.DeckId,
+				DeckCodeId:           m.DeckCodeId,
+				OpponentsUserId:      m.OpponentsUserId,
+				BO3Flg:               m.BO3Flg,
+				GroupMatchFlg:        m.GroupMatchFlg,
+				GroupMatchVictoryFlg: m.GroupMatchVictoryFlg,
+				QualifyingRoundFlg:   m.QualifyingRoundFlg,
+				FinalTournamentFlg:   m.FinalTournamentFlg,
+				DefaultVictoryFlg:    m.DefaultVictoryFlg,
+				DefaultDefeatFlg:     m.DefaultDefeatFlg,
+				VictoryFlg:           m.VictoryFlg,
+				DrawFlg:              m.DrawFlg,
+				OpponentsDeckInfo:    m.OpponentsDeckInfo,
+				Memo:                 m.Memo,
+			})
+
+			for _, g := range m.Games {
+				games = append(games, &userExportGameRow{
+					ID:                  g.ID,
+					CreatedAt:           g.CreatedAt,
+					MatchId:             g.MatchId,
+					GoFirst:             g.GoFirst,
+					WinningFlg:          g.WinningFlg,
+					YourPrizeCards:      g.YourPrizeCards,
+					OpponentsPrizeCards: g.OpponentsPrizeCards,
+					Memo:                g.Memo,
+				})
+			}
+		}
+	}
+
+	var decks []*userExportDeckRow
+	var deckCodes []*userExportDeckCodeRow
+	for _, cd := range data.Decks {
+		d := cd.Deck
+		decks = append(decks, &userExportDeckRow{
+			ID:         d.ID,
+			CreatedAt:  d.CreatedAt,
+			ArchivedAt: userExportOptionalTime(d.ArchivedAt),
+			Name:       d.Name,
+			PrivateFlg: d.PrivateFlg,
+		})
+
+		for _, dc := range cd.DeckCodes {
+			deckCodes = append(deckCodes, &userExportDeckCodeRow{
+				ID:             dc.ID,
+				CreatedAt:      dc.CreatedAt,
+				DeckId:         dc.DeckId,
+				Code:           dc.Code,
+				PrivateCodeFlg: dc.PrivateCodeFlg,
+				Memo:           dc.Memo,
+			})
+		}
+	}
+
+	var tags []*userExportTagRow
+	for _, tag := range data.Tags {
+		tags = append(tags, &userExportTagRow{
+			ID:        tag.ID,
+			CreatedAt: tag.CreatedAt,
+			Name:      tag.Name,
+			Color:     tag.Color,
+		})
+	}
+
+	var unofficialEvents []*userExportUnofficialEventRow
+	for _, e := range data.UnofficialEvents {
+		unofficialEvents = append(unofficialEvents, &userExportUnofficialEventRow{
+			ID:        e.ID,
+			CreatedAt: e.CreatedAt,
+			Title:     e.Title,
+			Date:      e.Date.Format(userExportDateLayout),
+		})
+	}
+
+	var badges []*userExportBadgeRow
+	for _, b := range data.UserBadges {
+		badges = append(badges, &userExportBadgeRow{
+			BadgeDefinitionId: b.BadgeDefinitionId,
+			RecordId:          b.RecordId,
+			AchievedAt:        b.AchievedAt,
+		})
+	}
+
+	var environmentBadges []*userExportEnvironmentBadgeRow
+	for _, b := range data.UserEnvironmentBadges {
+		environmentBadges = append(environmentBadges, &userExportEnvironmentBadgeRow{
+			EnvironmentId: b.EnvironmentId,
+			RecordId:      b.RecordId,
+			AchievedAt:    b.AchievedAt,
+		})
+	}
+
+	return []*userExportTable{
+		newUserExportTable(
+			"records",
+			[]string{"id", "created_at", "event_date", "official_event_id", "tonamel_event_id", "friend_id", "unofficial_event_id", "deck_id", "deck_code_id", "private_flg", "ignore_stats_flg", "regulation_id", "tcg_meister_url", "memo"},
+			records,
+			func(r *userExportRecordRow) []string {
+				return []string{r.ID, userExportCSVTime(r.CreatedAt), r.EventDate, userExportCSVUint(r.OfficialEventId), r.TonamelEventId, r.FriendId, r.UnofficialEventId, r.DeckId, r.DeckCodeId, strconv.FormatBool(r.PrivateFlg), strconv.FormatBool(r.IgnoreStatsFlg), userExportCSVUint(r.RegulationId), r.TCGMeisterURL, r.Memo}
+			},
+		),
+		newUserExportTable(
+			"matches",
+			[]string{"id", "created_at", "record_id", "position", "deck_id", "deck_code_id", "opponents_user_id", "bo3_flg", "group_match_flg", "group_match_victory_flg", "qualifying_round_flg", "final_tournament_flg", "default_victory_flg", "default_defeat_flg", "victory_flg", "draw_flg", "opponents_deck_info", "memo"},
+			matches,
+			func(m *userExportMatchRow) []string {
+				return []string{m.ID, userExportCSVTime(m.CreatedAt), m.RecordId, strconv.Itoa(m.Position), m.DeckId, m.DeckCodeId, m.OpponentsUserId, strconv.FormatBool(m.BO3Flg), strconv.FormatBool(m.GroupMatchFlg), strconv.FormatBool(m.GroupMatchVictoryFlg), strconv.FormatBool(m.QualifyingRoundFlg), strconv.FormatBool(m.FinalTournamentFlg), strconv.FormatBool(m.DefaultVictoryFlg), strconv.FormatBool(m.DefaultDefeatFlg), strconv.FormatBool(m.VictoryFlg), strconv.FormatBool(m.DrawFlg), m.OpponentsDeckInfo, m.Memo}
+			},
+		),
+		newUserExportTable(
+			"games",
+			[]string{"id", "created_at", "match_id", "go_first", "winning_flg", "your_prize_cards", "opponents_prize_cards", "memo"},
+			games,
+			func(g *userExportGameRow) []string {
+				return []string{g.ID, userExportCSVTime(g.CreatedAt), g.MatchId, strconv.FormatBool(g.GoFirst), strconv.FormatBool(g.WinningFlg), userExportCSVUint(g.YourPrizeCards), userExportCSVUint(g.OpponentsPrizeCards), g.Memo}
+			},
+		),
+		newUserExportTable(
+			"decks",
+			[]string{"id", "created_at", "archived_at", "name", "private_flg"},
+			decks,
+			func(d *userExportDeckRow) []string {
+				return []string{d.ID, userExportCSVTime(d.CreatedAt), userExportCSVOptionalTime(d.ArchivedAt), d.Name, strconv.FormatBool(d.PrivateFlg)}
+			},
+		),
+		newUserExportTable(
+			"deck_codes",
+			[]string{"id", "created_at", "deck_id", "code", "private_code_flg", "memo"},
+			deckCodes,
+			func(dc *userExportDeckCodeRow) []string {
+				return []string{dc.ID, userExportCSVTime(dc.CreatedAt), dc.DeckId, dc.Code, strconv.FormatBool(dc.PrivateCodeFlg), dc.Memo}
+			},
+		),
+		newUserExportTable(
+			"tags",
+			[]string{"id", "created_at", "name", "color"},
+			tags,
+			func(tag *userExportTagRow) []string {
+				return []string{tag.ID, userExportCSVTime(tag.CreatedAt), tag.Name, tag.Color}
+			},
+		),
+		newUserExportTable(
+			"unofficial_events",
+			[]string{"id", "created_at", "title", "date"},
+			unofficialEvents,
+			func(e *userExportUnofficialEventRow) []string {
+				return []string{e.ID, userExportCSVTime(e.CreatedAt), e.Title, e.Date}
+			},
+		),
+		newUserExportTable(
+			"badges",
+			[]string{"badge_definition_id", "record_id", "achieved_at"},
+			badges,
+			func(b *userExportBadgeRow) []string {
+				return []string{b.BadgeDefinitionId, b.RecordId, userExportCSVTime(b.AchievedAt)}
+			},
+		),
+		newUserExportTable(
+			"environment_badges",
+			[]string{"environment_id", "record_id", "achieved_at"},
+			environmentBadges,
+			func(b *userExportEnvironmentBadgeRow) []string {
+				return []string{b.EnvironmentId, b.RecordId, userExportCSVTime(b.AchievedAt)}
+			},
+		),
+		newUserExportNotificationTable("designation_history", data.DesignationHistory),
+		newUserExportNotificationTable("notifications", data.Notifications),
+	}
+}
+
+// newUserExportNotificationTable は通知の表を作る。称号の履歴も通知から取り出したものなので同じ形にする。
+func newUserExportNotificationTable(name string, notifications []*entity.Notification) *userExportTable {
+	var rows []*userExportNotificationRow
+	for _, n := range notifications {
+		rows = append(rows, &userExportNotificationRow{
+			ID:        n.ID,
+			CreatedAt: n.CreatedAt,
+			Category:  n.Category,
+			Title:     n.Title,
+			Body:      n.Body,
+			LinkUrl:   n.LinkUrl,
+			IsRead:    n.IsRead,
+			ReadAt:    userExportOptionalTime(n.ReadAt),
+		})
+	}
+
+	return newUserExportTable(
+		name,
+		[]string{"id", "created_at", "category", "title", "body", "link_url", "is_read", "read_at"},
+		rows,
+		func(n *userExportNotificationRow) []string {
+			return []string{n.ID, userExportCSVTime(n.CreatedAt), n.Category, n.Title, n.Body, n.LinkUrl, strconv.FormatBool(n.IsRead), userExportCSVOptionalTime(n.ReadAt)}
+		},
+	)
+}
+
+func writeUserExportJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+// writeUserExportCSV は CSV を書き出す。Excel で開いても文字化けしないよう、先頭に BOM を付ける。
+func writeUserExportCSV(zw *zip.Writer, name string, header []string, records [][]string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte("\ufeff")); err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(records); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// userExportOptionalTime は未設定(ゼロ値)の日時を JSON で null にするため nil にする。
+func userExportOptionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func userExportCSVTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func userExportCSVOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return userExportCSVTime(*t)
+}
+
+func userExportCSVUint(v uint) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
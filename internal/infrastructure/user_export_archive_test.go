@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+// readUserExportArchive は zip の各ファイルの中身をファイル名ごとに返す。
+func readUserExportArchive(t *testing.T, body []byte) map[string][]byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[f.Name] = b
+	}
+
+	return files
+}
+
+func TestUserExportArchive(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	key := "user-exports/" + uid + "/01HD7Y3K8D6FDHMHTZ2GT41TR1.zip"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	eventDate := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系_エンティティごとにJSONとCSVを含むzipを非公開のメタデータで置く", func(t *testing.T) {
+		storage := NewInMemoryDeckAssetStorage()
+		r := NewUserExportArchive(storage)
+
+		game := entity.NewGame("01HD7Y3K8D6FDHMHTZ2GT41TG1", now, "01HD7Y3K8D6FDHMHTZ2GT41TM1", uid, true, true, 6, 2, "")
+		match := entity.NewMatch(
+			"01HD7Y3K8D6FDHMHTZ2GT41TM1", now, "01HD7Y3K8D6FDHMHTZ2GT41TN1", "", "", uid, "",
+			false, false, false, false, false, false, true, false, false,
+			"サーナイトex", "先攻, 取れた", []*entity.Game{game}, nil,
+		)
+		record := entity.NewRecord(
+			"01HD7Y3K8D6FDHMHTZ2GT41TN1", now, 1, "", "", "", uid, "", "", eventDate,
+			false, false, 1, "", "",
+		)
+
+		data := &entity.UserExportData{
+			UserId:     uid,
+			ExportedAt: now,
+			Records:    []*entity.CalendarRecord{entity.NewCalendarRecord(record, []*entity.Match{match})},
+			Notifications: []*entity.Notification{
+				entity.NewNotification("01HD7Y3K8D6FDHMHTZ2GT41TX1", now, uid, "rank", "ランクが上がりました", "本文", "/designations"),
+			},
+		}
+
+		require.NoError(t, r.Put(context.Background(), key, data))
+
+		object := storage.objects[key]
+		require.Equal(t, userExportArchiveContentType, object.contentType)
+		require.Equal(t, userExportArchiveCacheControl, object.cacheControl)
+
+		body, err := r.Get(context.Background(), key)
+		require.NoError(t, err)
+		files := readUserExportArchive(t, body)
+
+		for _, name := range []string{
+			"records", "matches", "games", "decks", "deck_codes", "tags", "unofficial_events",
+			"badges", "environment_badges", "designation_history", "notifications",
+		} {
+			require.Contains(t, files, name+".json")
+			require.Contains(t, files, name+".csv")
+		}
+
+		var manifest map[string]any
+		require.NoError(t, json.Unmarshal(files["manifest.json"], &manifest))
+		require.Equal(t, uid, manifest["user_id"])
+
+		var records []map[string]any
+		require.NoError(t, json.Unmarshal(files["records.json"], &records))
+		require.Len(t, records, 1)
+		require.Equal(t, "2026-10-11", records[0]["event_date"])
+
+		// 0件の表も null ではなく空配列にする
+		require.JSONEq(t, "[]", string(files["decks.json"]))
+
+		// CSV は BOM 付きで、カンマを含む値もそのまま読み戻せる
+		require.True(t, bytes.HasPrefix(files["matches.csv"], []byte("\ufeff")))
+		rows, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(files["matches.csv"], []byte("\ufeff")))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, "id", rows[0][0])
+		require.Equal(t, "先攻, 取れた", rows[1][len(rows[1])-1])
+
+		rows, err = csv.NewReader(bytes.NewReader(bytes.TrimPrefix(files["games.csv"], []byte("\ufeff")))).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, []string{"01HD7Y3K8D6FDHMHTZ2GT41TG1", "2026-10-18T12:00:00Z", "01HD7Y3K8D6FDHMHTZ2GT41TM1", "true", "true", "6", "2", ""}, rows[1])
+	})
+
+	t.Run("異常系_置いていないキーはErrRecordNotFoundを返す", func(t *testing.T) {
+		r := NewUserExportArchive(NewInMemoryDeckAssetStorage())
+
+		_, err := r.Get(context.Background(), key)
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+}
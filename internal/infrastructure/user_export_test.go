@@ -0,0 +1,149 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var userExportColumns = []string{
+	"id", "created_at", "updated_at", "user_id", "status", "attempts", "next_run_at", "last_error", "completed_at",
+}
+
+func TestUserExportInfrastructure(t *testing.T) {
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_実行待ちのエクスポートを作る", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "user_exports"`)).
+				WithArgs(id, now, now, uid, "pending", 0, now, "", nil).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Create(context.Background(), entity.NewUserExport(id, now, uid)))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("正常系_完了日時を含めて返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_exports" WHERE id = $1 ORDER BY "user_exports"."id" LIMIT $2`,
+			)).WithArgs(id, 1).WillReturnRows(
+				sqlmock.NewRows(userExportColumns).AddRow(id, now, now, uid, "succeeded", 1, now, "", now),
+			)
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.UserExportStatusSucceeded, ret.Status)
+			require.Equal(t, now, ret.CompletedAt)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_存在しないIDはErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			mock.ExpectQuery(`SELECT \* FROM "user_exports"`).
+				WithArgs(id, 1).WillReturnRows(sqlmock.NewRows(userExportColumns))
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindUnfinishedByUserId", func(t *testing.T) {
+		t.Run("正常系_実行待ち・実行中のうち最も新しいものを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_exports" WHERE user_id = $1 AND status IN ($2,$3) ORDER BY created_at DESC,"user_exports"."id" LIMIT $4`,
+			)).WithArgs(uid, "pending", "running", 1).WillReturnRows(
+				sqlmock.NewRows(userExportColumns).AddRow(id, now, now, uid, "running", 1, now, "", nil),
+			)
+
+			ret, err := r.FindUnfinishedByUserId(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Equal(t, id, ret.ID)
+			require.True(t, ret.CompletedAt.IsZero())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		t.Run("正常系_実行できるエクスポートを実行中にして返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			staleBefore := now.Add(-30 * time.Minute)
+
+			mock.ExpectQuery(`UPDATE user_exports\s+SET status = \$1, attempts = attempts \+ 1.*FOR UPDATE SKIP LOCKED.*RETURNING \*`).
+				WithArgs("running", now, "pending", now, "running", staleBefore, 1).
+				WillReturnRows(
+					sqlmock.NewRows(userExportColumns).AddRow(id, now, now, uid, "running", 1, now, "", nil),
+				)
+
+			ret, err := r.Claim(context.Background(), now, staleBefore, 1)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.UserExportStatusRunning, ret[0].Status)
+			require.Equal(t, uint(1), ret[0].Attempts)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("異常系_クエリのエラーを返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			mock.ExpectQuery(`UPDATE user_exports`).WillReturnError(errors.New("connection refused"))
+
+			_, err := r.Claim(context.Background(), now, now, 1)
+
+			require.Error(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("正常系_実行結果を反映する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserExport(db)
+
+			export := entity.NewUserExport(id, now, uid)
+			export.Attempts = 1
+			export.Succeed(now)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "user_exports" SET`)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Save(context.Background(), export))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
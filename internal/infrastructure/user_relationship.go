@@ -0,0 +1,181 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/infrastructure/model"
+)
+
+type UserRelationship struct {
+	db *gorm.DB
+}
+
+func NewUserRelationship(
+	db *gorm.DB,
+) repository.UserRelationshipInterface {
+	return &UserRelationship{db}
+}
+
+func newUserRelationshipEntity(m *model.UserRelationship) *entity.UserRelationship {
+	return &entity.UserRelationship{
+		ID:           m.ID,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+		UserId:       m.UserId,
+		TargetUserId: m.TargetUserId,
+		Status:       entity.UserRelationshipStatus(m.Status),
+	}
+}
+
+func newUserRelationshipEntities(models []*model.UserRelationship) []*entity.UserRelationship {
+	ret := make([]*entity.UserRelationship, 0, len(models))
+	for _, m := range models {
+		ret = append(ret, newUserRelationshipEntity(m))
+	}
+
+	return ret
+}
+
+func (i *UserRelationship) FindById(
+	ctx context.Context,
+	id string,
+) (*entity.UserRelationship, error) {
+	var m model.UserRelationship
+
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).First(&m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, wrapError(tx.Error)
+	}
+
+	return newUserRelationshipEntity(&m), nil
+}
+
+func (i *UserRelationship) FindBetween(
+	ctx context.Context,
+	userId string,
+	otherUserId string,
+) ([]*entity.UserRelationship, error) {
+	var models []*model.UserRelationship
+
+	if tx := dbFromContext(ctx, i.db).Where(
+		"(user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?)",
+		userId, otherUserId, otherUserId, userId,
+	).Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	return newUserRelationshipEntities(models), nil
+}
+
+func (i *UserRelationship) FindPendingByTargetUserId(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.UserRelationship, error) {
+	var models []*model.UserRelationship
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("target_user_id = ? AND status = ?", userId, string(entity.UserRelationshipStatusPending)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	return newUserRelationshipEntities(models), nil
+}
+
+func (i *UserRelationship) FindAcceptedByUserId(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.UserRelationship, error) {
+	var models []*model.UserRelationship
+
+	if tx := dbFromContext(ctx, i.db).
+		Where("(user_id = ? OR target_user_id = ?) AND status = ?", userId, userId, string(entity.UserRelationshipStatusAccepted)).
+		Order("updated_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&models); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return nil, tx.Error
+	}
+
+	return newUserRelationshipEntities(models), nil
+}
+
+func (i *UserRelationship) IsFriend(
+	ctx context.Context,
+	userId string,
+	otherUserId string,
+) (bool, error) {
+	var count int64
+
+	if tx := dbFromContext(ctx, i.db).Model(&model.UserRelationship{}).Where(
+		"((user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?)) AND status = ?",
+		userId, otherUserId, otherUserId, userId, string(entity.UserRelationshipStatusAccepted),
+	).Count(&count); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return false, tx.Error
+	}
+
+	return count > 0, nil
+}
+
+func (i *UserRelationship) Create(
+	ctx context.Context,
+	relationship *entity.UserRelationship,
+) error {
+	m := &model.UserRelationship{
+		ID:           relationship.ID,
+		CreatedAt:    relationship.CreatedAt,
+		UpdatedAt:    relationship.UpdatedAt,
+		UserId:       relationship.UserId,
+		TargetUserId: relationship.TargetUserId,
+		Status:       string(relationship.Status),
+	}
+
+	if tx := dbFromContext(ctx, i.db).Create(m); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UserRelationship) Save(
+	ctx context.Context,
+	relationship *entity.UserRelationship,
+) error {
+	if tx := dbFromContext(ctx, i.db).Model(&model.UserRelationship{}).Where("id = ?", relationship.ID).Updates(map[string]interface{}{
+		"updated_at": relationship.UpdatedAt,
+		"status":     string(relationship.Status),
+	}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (i *UserRelationship) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	if tx := dbFromContext(ctx, i.db).Where("id = ?", id).Delete(&model.UserRelationship{}); tx.Error != nil {
+		logError(ctx, tx.Error)
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package infrastructure
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+)
+
+var userRelationshipColumns = []string{
+	"id", "created_at", "updated_at", "user_id", "target_user_id", "status",
+}
+
+func TestUserRelationshipInfrastructure(t *testing.T) {
+	id := "01JTESTRELATIONSHIP0000000"
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	targetUserId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_フレンド申請を作成する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`INSERT INTO "user_relationships" ("id","created_at","updated_at","user_id","target_user_id","status") VALUES ($1,$2,$3,$4,$5,$6)`,
+			)).WithArgs(
+				id, now, now, userId, targetUserId, "pending",
+			).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			relationship := entity.NewUserRelationship(id, now, userId, targetUserId, entity.UserRelationshipStatusPending)
+
+			require.NoError(t, r.Create(context.Background(), relationship))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("異常系_無ければErrRecordNotFoundへ変換する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_relationships" WHERE id = $1 ORDER BY "user_relationships"."id" LIMIT $2`,
+			)).WithArgs(id, 1).WillReturnRows(sqlmock.NewRows(userRelationshipColumns))
+
+			ret, err := r.FindById(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindBetween", func(t *testing.T) {
+		t.Run("正常系_両方向の関係を返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_relationships" WHERE (user_id = $1 AND target_user_id = $2) OR (user_id = $3 AND target_user_id = $4)`,
+			)).WithArgs(userId, targetUserId, targetUserId, userId).WillReturnRows(
+				sqlmock.NewRows(userRelationshipColumns).
+					AddRow(id, now, now, targetUserId, userId, "blocked"),
+			)
+
+			ret, err := r.FindBetween(context.Background(), userId, targetUserId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.UserRelationshipStatusBlocked, ret[0].Status)
+			require.Equal(t, targetUserId, ret[0].UserId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindPendingByTargetUserId", func(t *testing.T) {
+		t.Run("正常系_届いた申請を新しい順に返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_relationships" WHERE target_user_id = $1 AND status = $2 ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4`,
+			)).WithArgs(targetUserId, "pending", 10, 20).WillReturnRows(
+				sqlmock.NewRows(userRelationshipColumns).
+					AddRow(id, now, now, userId, targetUserId, "pending"),
+			)
+
+			ret, err := r.FindPendingByTargetUserId(context.Background(), targetUserId, 10, 20)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, userId, ret[0].UserId)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("FindAcceptedByUserId", func(t *testing.T) {
+		t.Run("正常系_申請した側・された側のどちらのフレンドも返す", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			now := time.Now().Local()
+
+			mock.ExpectQuery(regexp.QuoteMeta(
+				`SELECT * FROM "user_relationships" WHERE (user_id = $1 OR target_user_id = $2) AND status = $3 ORDER BY updated_at DESC, id DESC LIMIT $4 OFFSET $5`,
+			)).WithArgs(userId, userId, "accepted", 10, 20).WillReturnRows(
+				sqlmock.NewRows(userRelationshipColumns).
+					AddRow(id, now, now, targetUserId, userId, "accepted"),
+			)
+
+			ret, err := r.FindAcceptedByUserId(context.Background(), userId, 10, 20)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, targetUserId, ret[0].OtherUserId(userId))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("IsFriend", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			count    int
+			expected bool
+		}{
+			"正常系_承認済みの関係があればtrueを返す":   {1, true},
+			"正常系_承認済みの関係が無ければfalseを返す": {0, false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				db, mock := setupSqlmockDB(t)
+				r := NewUserRelationship(db)
+
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT count(*) FROM "user_relationships" WHERE ((user_id = $1 AND target_user_id = $2) OR (user_id = $3 AND target_user_id = $4)) AND status = $5`,
+				)).WithArgs(userId, targetUserId, targetUserId, userId, "accepted").WillReturnRows(
+					sqlmock.NewRows([]string{"count"}).AddRow(tc.count),
+				)
+
+				ret, err := r.IsFriend(context.Background(), userId, targetUserId)
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, ret)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+		}
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("正常系_承認を保存する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			now := time.Now().Local()
+
+			relationship := entity.NewUserRelationship(id, now, userId, targetUserId, entity.UserRelationshipStatusPending)
+			relationship.Accept(now)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`UPDATE "user_relationships" SET "status"=$1,"updated_at"=$2 WHERE id = $3`,
+			)).WithArgs("accepted", now, id).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Save(context.Background(), relationship))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("正常系_行ごと削除する", func(t *testing.T) {
+			db, mock := setupSqlmockDB(t)
+			r := NewUserRelationship(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(
+				`DELETE FROM "user_relationships" WHERE id = $1`,
+			)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, r.Delete(context.Background(), id))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
@@ -41,6 +41,7 @@ func (i *UserStat) FindUserStat(
 	fromDate time.Time,
 	toDate time.Time,
 	regulationId uint,
+	recordTagId string,
 ) (*entity.UserStat, error) {
 	var matchResult matchStatsResult
 
@@ -54,6 +55,11 @@ func (i *UserStat) FindUserStat(
 		matchQuery = matchQuery.Where("records.regulation_id = ?", regulationId)
 	}
 
+	// 記録に付いたタグでの絞り込み。空は絞り込みなし。
+	if recordTagId != "" {
+		matchQuery = matchQuery.Where("records.id IN (SELECT record_id FROM record_tags WHERE tag_id = ?)", recordTagId)
+	}
+
 	if !fromDate.IsZero() {
 		matchQuery = matchQuery.Where("records.event_date >= ?", fromDate)
 	}
@@ -82,6 +88,10 @@ func (i *UserStat) FindUserStat(
 		recordQuery = recordQuery.Where("regulation_id = ?", regulationId)
 	}
 
+	if recordTagId != "" {
+		recordQuery = recordQuery.Where("id IN (SELECT record_id FROM record_tags WHERE tag_id = ?)", recordTagId)
+	}
+
 	if !fromDate.IsZero() {
 		recordQuery = recordQuery.Where("event_date >= ?", fromDate)
 	}
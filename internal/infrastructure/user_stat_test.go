@@ -35,7 +35,7 @@ func TestUserStatInfrastructure(t *testing.T) {
 
 		expectStatQueries(mock, 10, 6, 5, 2, 1, 1)
 
-		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0)
+		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0, "")
 
 		require.NoError(t, err)
 		require.Equal(t, uid, ret.UserId)
@@ -56,7 +56,7 @@ func TestUserStatInfrastructure(t *testing.T) {
 
 		expectStatQueries(mock, 0, 0, 0, 0, 0, 0)
 
-		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0)
+		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0, "")
 
 		require.NoError(t, err)
 		require.Equal(t, 0, ret.TotalMatches)
@@ -64,13 +64,35 @@ func TestUserStatInfrastructure(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("正常系_記録のタグを指定するとタグが付いた記録だけを集計する", func(t *testing.T) {
+		db, mock := setupSqlmockDB(t)
+		r := NewUserStat(db)
+
+		tagId := "01JQ7T1V3T0Y2R3X9M5F8K6W4Z"
+
+		mock.ExpectQuery(`FROM "matches" .* AND records\.id IN \(SELECT record_id FROM record_tags WHERE tag_id = \$2\)`).
+			WithArgs(uid, tagId, fromDate, toDate).
+			WillReturnRows(sqlmock.NewRows([]string{"total_matches", "wins"}).AddRow(3, 2))
+		mock.ExpectQuery(`FROM "records" .* AND id IN \(SELECT record_id FROM record_tags WHERE tag_id = \$2\)`).
+			WithArgs(uid, tagId, fromDate, toDate).
+			WillReturnRows(sqlmock.NewRows([]string{"record_count"}).AddRow(1))
+
+		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0, tagId)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, ret.TotalRecords)
+		require.Equal(t, 3, ret.TotalMatches)
+		require.Equal(t, 2, ret.Wins)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("異常系_集計クエリのエラーをそのまま返す", func(t *testing.T) {
 		db, mock := setupSqlmockDB(t)
 		r := NewUserStat(db)
 
 		mock.ExpectQuery(`SELECT COUNT\(\*\) AS total_matches`).WillReturnError(sql.ErrConnDone)
 
-		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0)
+		ret, err := r.FindUserStat(context.Background(), uid, fromDate, toDate, 0, "")
 
 		require.Error(t, err)
 		require.Nil(t, ret)
@@ -1,6 +1,12 @@
 package internal
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"runtime/debug"
@@ -9,7 +15,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/vsrecorder/core-apiserver/internal/controller/apierror"
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
 	"github.com/vsrecorder/core-apiserver/internal/logging"
 )
 
@@ -23,9 +34,18 @@ const MaxRequestBodyBytes = 1 << 20
 // 上限が無い場合、ShouldBindJSONはボディ全体をメモリへ読み込むため、
 // 巨大なボディを送りつけるだけでメモリを枯渇させられる。上限を超えたボディは
 // 読み取り時にエラーとなり、各バリデーションミドルウェアが400を返す。
-func BodySizeLimitMiddleware(maxBytes int64) gin.HandlerFunc {
+//
+// 写真のアップロードのように大きなボディを受け付けるエンドポイントは、overrides に
+// ルーティングのパス(c.FullPath()、例: /api/v1beta/records/:id/attachments)と上限を指定する。
+// MaxBytesReader は重ねると小さい方の上限が効くため、ここで1つだけ選ぶ。
+func BodySizeLimitMiddleware(maxBytes int64, overrides map[string]int64) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes)
+		limit := maxBytes
+		if v, ok := overrides[c.FullPath()]; ok {
+			limit = v
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
 
 		c.Next()
 	}
@@ -106,3 +126,186 @@ func RecoveryMiddleware(logger *slog.Logger) gin.HandlerFunc {
 		c.AbortWithStatus(http.StatusInternalServerError)
 	})
 }
+
+const (
+	// IdempotencyKeyHeader は作成系のリクエストをリトライしても二重に作成されないよう、
+	// クライアントがリクエストごとに付ける一意なキーのヘッダ。
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader は保存済みの応答を返した(今回は何も作成していない)ことを示すヘッダ。
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// MaxIdempotencyKeyLength は idempotency_keys.key VARCHAR(255) に合わせたキーの上限。
+	MaxIdempotencyKeyLength = 255
+)
+
+// IdempotencyMiddleware は paths(ルーティングのパス)への POST に Idempotency-Key が付いていれば、
+// キー・ユーザ・リクエストのハッシュと応答を保存し、24時間(entity.IdempotencyKeyTTL)以内の
+// 同じキーのリトライには保存した応答をそのまま返す。電波の悪い会場でのリトライによる
+// 記録等の二重作成(とバッジのマイルストーンの二重カウント)を防ぐ。
+//
+//   - 同じキーで内容(メソッド・パス・ボディ)が異なれば 409 を返す。
+//   - 前回のリクエストがまだ処理中なら 409 を返す(クライアントは少し待ってリトライする)。
+//   - 5xx の応答・ハンドラの panic では応答を保存せずにキーを消し、リトライでもう一度処理できるようにする。
+//   - プロセスが落ちる等で処理中のまま残ったキーは、entity.IdempotencyKeyInProgressTimeout を
+//     過ぎれば新しいリクエストとして受け付ける。
+//
+// キーはユーザごとに扱うため、グローバルなミドルウェアとして認証より前に動き、
+// トークンから uid を読む。トークンが不正なら何もせず、401 は後続の認証ミドルウェアに任せる。
+func IdempotencyMiddleware(
+	logger *slog.Logger,
+	store repository.IdempotencyKeyInterface,
+	paths ...string,
+) gin.HandlerFunc {
+	targets := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		targets[path] = true
+	}
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || c.Request.Method != http.MethodPost || !targets[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		if len(key) > MaxIdempotencyKeyLength {
+			apierror.ErrBadRequest.JSON(c)
+			return
+		}
+
+		uid, err := authentication.ParseUID(c)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		// ハッシュを取るために読んだボディは、後続のバリデーションが読めるよう戻しておく。
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			apierror.ErrBadRequest.JSON(c, err)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		now := time.Now().Local()
+		idempotencyKey := entity.NewIdempotencyKey(uid, key, idempotencyRequestHash(c.Request, body), now)
+
+		reserved, existing, err := reserveIdempotencyKey(ctx, store, idempotencyKey, now)
+		if err != nil {
+			apierror.ErrInternalServerError.JSON(c, err)
+			return
+		}
+
+		if !reserved {
+			switch {
+			case existing == nil:
+				// 予約し直しても競合が続くときは、処理中として扱いクライアントにリトライさせる
+				apierror.ErrIdempotencyKeyInProgress.JSON(c)
+			case existing.RequestHash != idempotencyKey.RequestHash:
+				apierror.ErrIdempotencyKeyMismatch.JSON(c)
+			case !existing.Completed():
+				apierror.ErrIdempotencyKeyInProgress.JSON(c)
+			default:
+				c.Header(IdempotentReplayedHeader, "true")
+				c.Data(existing.StatusCode, "application/json; charset=utf-8", existing.ResponseBody)
+				c.Abort()
+			}
+			return
+		}
+
+		release := func() {
+			if err := store.Delete(ctx, uid, key); err != nil {
+				logger.ErrorContext(ctx, "failed to release idempotency key", logging.Err(err))
+			}
+		}
+
+		// RecoveryMiddleware はこれより外側で panic を捕捉するため、ここでキーを消してから投げ直す。
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				release()
+				panic(recovered)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			release()
+			return
+		}
+
+		idempotencyKey.StatusCode = recorder.Status()
+		idempotencyKey.ResponseBody = recorder.body.Bytes()
+		if err := store.Complete(ctx, idempotencyKey); err != nil {
+			// 応答は返せているため失敗にはしない。キーは処理中のまま残り、
+			// entity.IdempotencyKeyInProgressTimeout を過ぎるまでリトライには 409 を返す。
+			logger.ErrorContext(ctx, "failed to save idempotent response", logging.Err(err))
+		}
+	}
+}
+
+// reserveIdempotencyKey は Idempotency-Key を予約する。予約できなければ保存済みのキーを返す。
+// 予約に負けてから読むまでの間に、前回のリクエストが5xxで終わってキーを消すことがある。
+// そのときは予約をもう1度だけ試し、それでも保存済みのキーが読めなければ nil を返す。
+func reserveIdempotencyKey(
+	ctx context.Context,
+	store repository.IdempotencyKeyInterface,
+	key *entity.IdempotencyKey,
+	now time.Time,
+) (bool, *entity.IdempotencyKey, error) {
+	const attempts = 2
+
+	for range attempts {
+		reserved, err := store.Reserve(ctx, key, entity.IdempotencyKeyExpiredBefore(now), entity.IdempotencyKeyStaleBefore(now))
+		if err != nil {
+			return false, nil, err
+		}
+
+		if reserved {
+			return true, nil, nil
+		}
+
+		existing, err := store.FindByKey(ctx, key.UserId, key.Key)
+		if err == nil {
+			return false, existing, nil
+		}
+
+		if !errors.Is(err, apperror.ErrRecordNotFound) {
+			return false, nil, err
+		}
+	}
+
+	return false, nil, nil
+}
+
+// idempotencyRequestHash はリクエストのメソッド・パス・ボディのSHA-256を16進で返す。
+func idempotencyRequestHash(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + "\n" + req.URL.Path + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder はクライアントへ書いた応答のボディを控えておく gin.ResponseWriter。
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.body.Write(b[:n])
+
+	return n, err
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	n, err := w.ResponseWriter.WriteString(s)
+	w.body.WriteString(s[:n])
+
+	return n, err
+}
@@ -2,18 +2,25 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vsrecorder/core-apiserver/internal/controller/auth/authentication"
 	"github.com/vsrecorder/core-apiserver/internal/controller/helper"
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	"github.com/vsrecorder/core-apiserver/internal/logging"
+	"github.com/vsrecorder/core-apiserver/internal/testutil"
 )
 
 // decodeRecords は出力された全ログレコードを map のスライスへ変換する。
@@ -150,3 +157,297 @@ func TestRecoveryMiddleware(t *testing.T) {
 		require.Contains(t, record["stack"], "TestRecoveryMiddleware")
 	})
 }
+
+// memoryIdempotencyKeyStore は IdempotencyMiddleware のテスト用に、キーをメモリに持つ
+// repository.IdempotencyKeyInterface。リトライの一連の流れを通しで確かめるため、
+// 呼び出しごとの期待値を並べる gomock ではなく状態を持つ手書きの実装にしている。
+type memoryIdempotencyKeyStore struct {
+	keys map[string]*entity.IdempotencyKey
+}
+
+func newMemoryIdempotencyKeyStore() *memoryIdempotencyKeyStore {
+	return &memoryIdempotencyKeyStore{keys: map[string]*entity.IdempotencyKey{}}
+}
+
+func (s *memoryIdempotencyKeyStore) FindByKey(ctx context.Context, userId string, key string) (*entity.IdempotencyKey, error) {
+	k, ok := s.keys[userId+"/"+key]
+	if !ok {
+		return nil, apperror.ErrRecordNotFound
+	}
+	copied := *k
+	return &copied, nil
+}
+
+func (s *memoryIdempotencyKeyStore) Reserve(ctx context.Context, key *entity.IdempotencyKey, expiredBefore time.Time, staleBefore time.Time) (bool, error) {
+	if existing, ok := s.keys[key.UserId+"/"+key.Key]; ok && !existing.CreatedAt.Before(expiredBefore) &&
+		(existing.Completed() || !existing.CreatedAt.Before(staleBefore)) {
+		return false, nil
+	}
+	copied := *key
+	s.keys[key.UserId+"/"+key.Key] = &copied
+	return true, nil
+}
+
+func (s *memoryIdempotencyKeyStore) Complete(ctx context.Context, key *entity.IdempotencyKey) error {
+	copied := *key
+	s.keys[key.UserId+"/"+key.Key] = &copied
+	return nil
+}
+
+func (s *memoryIdempotencyKeyStore) Delete(ctx context.Context, userId string, key string) error {
+	delete(s.keys, userId+"/"+key)
+	return nil
+}
+
+func (s *memoryIdempotencyKeyStore) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+// racingIdempotencyKeyStore は予約に負けた直後に前回のリクエストがキーを消した状況を作るため、
+// 最初の lost 回の Reserve を保存済みのキーが無いまま失敗させる。
+type racingIdempotencyKeyStore struct {
+	*memoryIdempotencyKeyStore
+	lost int
+}
+
+func (s *racingIdempotencyKeyStore) Reserve(ctx context.Context, key *entity.IdempotencyKey, expiredBefore time.Time, staleBefore time.Time) (bool, error) {
+	if s.lost > 0 {
+		s.lost--
+		return false, nil
+	}
+	return s.memoryIdempotencyKeyStore.Reserve(ctx, key, expiredBefore, staleBefore)
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	key := "9f1c2d4e-0b7a-4c55-8d0e-3a6f7b8c9d01"
+
+	secretKey, err := testutil.GenerateJWTSecret()
+	require.NoError(t, err)
+	t.Setenv("VSRECORDER_JWT_SECRET", secretKey)
+
+	token, err := testutil.GenerateJWT(uid, secretKey, authentication.ExpectedIssuer)
+	require.NoError(t, err)
+
+	// setup は作成のたびに連番のIDを返すハンドラを /records に持つルータを組む。
+	// status を差し替えると、そのステータスで応答する。
+	setup := func(store *memoryIdempotencyKeyStore, status *int) (*gin.Engine, *int) {
+		logger, _ := newTestLogger(t)
+
+		created := 0
+		r := gin.New()
+		r.Use(IdempotencyMiddleware(logger, store, "/records"))
+		r.POST("/records", authentication.RequiredAuthenticationMiddleware(), func(c *gin.Context) {
+			created++
+			c.JSON(*status, gin.H{"id": created})
+		})
+
+		return r, &created
+	}
+
+	post := func(r *gin.Engine, idempotencyKey string, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/records", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		if idempotencyKey != "" {
+			req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+		}
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("正常系_同じキーのリトライには保存した応答を返し作成は1度だけ行う", func(t *testing.T) {
+		status := http.StatusCreated
+		r, created := setup(newMemoryIdempotencyKeyStore(), &status)
+
+		first := post(r, key, `{"memo":"a"}`)
+		retry := post(r, key, `{"memo":"a"}`)
+
+		require.Equal(t, 1, *created)
+		require.Equal(t, http.StatusCreated, retry.Code)
+		require.JSONEq(t, first.Body.String(), retry.Body.String())
+		require.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+		require.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("正常系_キーが無ければ毎回作成する", func(t *testing.T) {
+		status := http.StatusCreated
+		r, created := setup(newMemoryIdempotencyKeyStore(), &status)
+
+		post(r, "", `{"memo":"a"}`)
+		post(r, "", `{"memo":"a"}`)
+
+		require.Equal(t, 2, *created)
+	})
+
+	t.Run("正常系_5xxの応答は保存せずリトライでもう一度処理する", func(t *testing.T) {
+		status := http.StatusInternalServerError
+		r, created := setup(newMemoryIdempotencyKeyStore(), &status)
+
+		require.Equal(t, http.StatusInternalServerError, post(r, key, `{}`).Code)
+
+		status = http.StatusCreated
+		require.Equal(t, http.StatusCreated, post(r, key, `{}`).Code)
+		require.Equal(t, 2, *created)
+	})
+
+	t.Run("正常系_期限切れのキーは新しいリクエストとして扱う", func(t *testing.T) {
+		store := newMemoryIdempotencyKeyStore()
+		expired := entity.NewIdempotencyKey(uid, key, "other", time.Now().Add(-entity.IdempotencyKeyTTL-time.Minute))
+		expired.StatusCode = http.StatusCreated
+		store.keys[uid+"/"+key] = expired
+
+		status := http.StatusCreated
+		r, created := setup(store, &status)
+
+		require.Equal(t, http.StatusCreated, post(r, key, `{}`).Code)
+		require.Equal(t, 1, *created)
+	})
+
+	t.Run("正常系_処理中のまま残ったキーは一定時間を過ぎれば新しいリクエストとして扱う", func(t *testing.T) {
+		store := newMemoryIdempotencyKeyStore()
+		req, _ := http.NewRequest("POST", "/records", nil)
+		stale := entity.NewIdempotencyKey(uid, key, idempotencyRequestHash(req, []byte(`{}`)), time.Now().Add(-entity.IdempotencyKeyInProgressTimeout-time.Second))
+		store.keys[uid+"/"+key] = stale
+
+		status := http.StatusCreated
+		r, created := setup(store, &status)
+
+		require.Equal(t, http.StatusCreated, post(r, key, `{}`).Code)
+		require.Equal(t, 1, *created)
+	})
+
+	t.Run("正常系_ハンドラがpanicしたらキーを消してリトライでもう一度処理する", func(t *testing.T) {
+		logger, _ := newTestLogger(t)
+		store := newMemoryIdempotencyKeyStore()
+
+		created := 0
+		r := gin.New()
+		r.Use(RecoveryMiddleware(logger))
+		r.Use(IdempotencyMiddleware(logger, store, "/records"))
+		r.POST("/records", authentication.RequiredAuthenticationMiddleware(), func(c *gin.Context) {
+			created++
+			if created == 1 {
+				panic("boom")
+			}
+			c.JSON(http.StatusCreated, gin.H{"id": created})
+		})
+
+		require.Equal(t, http.StatusInternalServerError, post(r, key, `{}`).Code)
+		require.Empty(t, store.keys)
+
+		require.Equal(t, http.StatusCreated, post(r, key, `{}`).Code)
+		require.Equal(t, 2, created)
+	})
+
+	t.Run("異常系_同じキーで内容が異なれば409を返す", func(t *testing.T) {
+		status := http.StatusCreated
+		r, created := setup(newMemoryIdempotencyKeyStore(), &status)
+
+		post(r, key, `{"memo":"a"}`)
+		w := post(r, key, `{"memo":"b"}`)
+
+		require.Equal(t, http.StatusConflict, w.Code)
+		require.Equal(t, 1, *created)
+	})
+
+	t.Run("異常系_前回のリクエストが処理中なら409を返す", func(t *testing.T) {
+		store := newMemoryIdempotencyKeyStore()
+		req, _ := http.NewRequest("POST", "/records", nil)
+		store.keys[uid+"/"+key] = entity.NewIdempotencyKey(uid, key, idempotencyRequestHash(req, []byte(`{}`)), time.Now())
+
+		status := http.StatusCreated
+		r, created := setup(store, &status)
+
+		require.Equal(t, http.StatusConflict, post(r, key, `{}`).Code)
+		require.Equal(t, 0, *created)
+	})
+
+	t.Run("正常系_予約に負けた後にキーが消えていればもう1度予約して処理する", func(t *testing.T) {
+		logger, _ := newTestLogger(t)
+		store := &racingIdempotencyKeyStore{memoryIdempotencyKeyStore: newMemoryIdempotencyKeyStore(), lost: 1}
+
+		created := 0
+		r := gin.New()
+		r.Use(IdempotencyMiddleware(logger, store, "/records"))
+		r.POST("/records", authentication.RequiredAuthenticationMiddleware(), func(c *gin.Context) {
+			created++
+			c.JSON(http.StatusCreated, gin.H{"id": created})
+		})
+
+		require.Equal(t, http.StatusCreated, post(r, key, `{}`).Code)
+		require.Equal(t, 1, created)
+	})
+
+	t.Run("異常系_予約し直してもキーが読めなければ500ではなく409を返す", func(t *testing.T) {
+		logger, _ := newTestLogger(t)
+		store := &racingIdempotencyKeyStore{memoryIdempotencyKeyStore: newMemoryIdempotencyKeyStore(), lost: 2}
+
+		created := 0
+		r := gin.New()
+		r.Use(IdempotencyMiddleware(logger, store, "/records"))
+		r.POST("/records", authentication.RequiredAuthenticationMiddleware(), func(c *gin.Context) {
+			created++
+			c.JSON(http.StatusCreated, gin.H{"id": created})
+		})
+
+		require.Equal(t, http.StatusConflict, post(r, key, `{}`).Code)
+		require.Equal(t, 0, created)
+	})
+
+	t.Run("異常系_未認証なら保存せず認証ミドルウェアが401を返す", func(t *testing.T) {
+		store := newMemoryIdempotencyKeyStore()
+		status := http.StatusCreated
+		r, _ := setup(store, &status)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/records", strings.NewReader(`{}`))
+		req.Header.Set(IdempotencyKeyHeader, key)
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.Empty(t, store.keys)
+	})
+}
+
+func TestBodySizeLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(BodySizeLimitMiddleware(8, map[string]int64{"/records/:id/attachments": 16}))
+
+	read := func(c *gin.Context) {
+		if _, err := io.ReadAll(c.Request.Body); err != nil {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		c.Status(http.StatusOK)
+	}
+	r.POST("/records", read)
+	r.POST("/records/:id/attachments", read)
+
+	post := func(path string, body string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("正常系_上限以内のボディは読める", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, post("/records", "12345678"))
+	})
+
+	t.Run("正常系_上限を指定したパスは大きなボディも読める", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, post("/records/01JABCDEFGHJKMNPQRSTVWXYZ0/attachments", "1234567890123456"))
+	})
+
+	t.Run("異常系_上限を超えたボディは読めない", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, post("/records", "123456789"))
+	})
+
+	t.Run("異常系_上限を指定したパスでも指定した上限を超えたボディは読めない", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, post("/records/01JABCDEFGHJKMNPQRSTVWXYZ0/attachments", "12345678901234567"))
+	})
+}
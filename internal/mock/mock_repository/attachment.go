@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/attachment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/attachment.go -destination=./internal/mock/mock_repository/attachment.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentInterface is a mock of AttachmentInterface interface.
+type MockAttachmentInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentInterfaceMockRecorder is the mock recorder for MockAttachmentInterface.
+type MockAttachmentInterfaceMockRecorder struct {
+	mock *MockAttachmentInterface
+}
+
+// NewMockAttachmentInterface creates a new mock instance.
+func NewMockAttachmentInterface(ctrl *gomock.Controller) *MockAttachmentInterface {
+	mock := &MockAttachmentInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentInterface) EXPECT() *MockAttachmentInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountByOwner mocks base method.
+func (m *MockAttachmentInterface) CountByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByOwner", ctx, ownerType, ownerId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByOwner indicates an expected call of CountByOwner.
+func (mr *MockAttachmentInterfaceMockRecorder) CountByOwner(ctx, ownerType, ownerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByOwner", reflect.TypeOf((*MockAttachmentInterface)(nil).CountByOwner), ctx, ownerType, ownerId)
+}
+
+// Create mocks base method.
+func (m *MockAttachmentInterface) Create(ctx context.Context, attachment *entity.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentInterfaceMockRecorder) Create(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentInterface)(nil).Create), ctx, attachment)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentInterface) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentInterface)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockAttachmentInterface) FindById(ctx context.Context, id string) (*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAttachmentInterfaceMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAttachmentInterface)(nil).FindById), ctx, id)
+}
+
+// FindByOwner mocks base method.
+func (m *MockAttachmentInterface) FindByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerId string) ([]*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, ownerType, ownerId)
+	ret0, _ := ret[0].([]*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockAttachmentInterfaceMockRecorder) FindByOwner(ctx, ownerType, ownerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockAttachmentInterface)(nil).FindByOwner), ctx, ownerType, ownerId)
+}
+
+// FindPurgeable mocks base method.
+func (m *MockAttachmentInterface) FindPurgeable(ctx context.Context, deletedBefore time.Time) ([]*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurgeable", ctx, deletedBefore)
+	ret0, _ := ret[0].([]*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPurgeable indicates an expected call of FindPurgeable.
+func (mr *MockAttachmentInterfaceMockRecorder) FindPurgeable(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurgeable", reflect.TypeOf((*MockAttachmentInterface)(nil).FindPurgeable), ctx, deletedBefore)
+}
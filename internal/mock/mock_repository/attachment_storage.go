@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/attachment_storage.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/attachment_storage.go -destination=./internal/mock/mock_repository/attachment_storage.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentStorageInterface is a mock of AttachmentStorageInterface interface.
+type MockAttachmentStorageInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentStorageInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentStorageInterfaceMockRecorder is the mock recorder for MockAttachmentStorageInterface.
+type MockAttachmentStorageInterfaceMockRecorder struct {
+	mock *MockAttachmentStorageInterface
+}
+
+// NewMockAttachmentStorageInterface creates a new mock instance.
+func NewMockAttachmentStorageInterface(ctrl *gomock.Controller) *MockAttachmentStorageInterface {
+	mock := &MockAttachmentStorageInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentStorageInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentStorageInterface) EXPECT() *MockAttachmentStorageInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentStorageInterface) Delete(ctx context.Context, attachment *entity.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentStorageInterfaceMockRecorder) Delete(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentStorageInterface)(nil).Delete), ctx, attachment)
+}
+
+// Get mocks base method.
+func (m *MockAttachmentStorageInterface) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAttachmentStorageInterfaceMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttachmentStorageInterface)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockAttachmentStorageInterface) Put(ctx context.Context, attachment *entity.Attachment, body []byte) (*entity.AttachmentImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, attachment, body)
+	ret0, _ := ret[0].(*entity.AttachmentImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockAttachmentStorageInterfaceMockRecorder) Put(ctx, attachment, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockAttachmentStorageInterface)(nil).Put), ctx, attachment, body)
+}
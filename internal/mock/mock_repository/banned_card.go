@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/banned_card.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/banned_card.go -destination=./internal/mock/mock_repository/banned_card.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBannedCardInterface is a mock of BannedCardInterface interface.
+type MockBannedCardInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBannedCardInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBannedCardInterfaceMockRecorder is the mock recorder for MockBannedCardInterface.
+type MockBannedCardInterfaceMockRecorder struct {
+	mock *MockBannedCardInterface
+}
+
+// NewMockBannedCardInterface creates a new mock instance.
+func NewMockBannedCardInterface(ctrl *gomock.Controller) *MockBannedCardInterface {
+	mock := &MockBannedCardInterface{ctrl: ctrl}
+	mock.recorder = &MockBannedCardInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBannedCardInterface) EXPECT() *MockBannedCardInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByRegulationIdAndDate mocks base method.
+func (m *MockBannedCardInterface) FindByRegulationIdAndDate(ctx context.Context, regulationId uint, date time.Time) ([]*entity.BannedCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRegulationIdAndDate", ctx, regulationId, date)
+	ret0, _ := ret[0].([]*entity.BannedCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRegulationIdAndDate indicates an expected call of FindByRegulationIdAndDate.
+func (mr *MockBannedCardInterfaceMockRecorder) FindByRegulationIdAndDate(ctx, regulationId, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRegulationIdAndDate", reflect.TypeOf((*MockBannedCardInterface)(nil).FindByRegulationIdAndDate), ctx, regulationId, date)
+}
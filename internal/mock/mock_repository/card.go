@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/card.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/card.go -destination=./internal/mock/mock_repository/card.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCardInterface is a mock of CardInterface interface.
+type MockCardInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCardInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCardInterfaceMockRecorder is the mock recorder for MockCardInterface.
+type MockCardInterfaceMockRecorder struct {
+	mock *MockCardInterface
+}
+
+// NewMockCardInterface creates a new mock instance.
+func NewMockCardInterface(ctrl *gomock.Controller) *MockCardInterface {
+	mock := &MockCardInterface{ctrl: ctrl}
+	mock.recorder = &MockCardInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCardInterface) EXPECT() *MockCardInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByNames mocks base method.
+func (m *MockCardInterface) FindByNames(ctx context.Context, cardNames []string) ([]*entity.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNames", ctx, cardNames)
+	ret0, _ := ret[0].([]*entity.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNames indicates an expected call of FindByNames.
+func (mr *MockCardInterfaceMockRecorder) FindByNames(ctx, cardNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNames", reflect.TypeOf((*MockCardInterface)(nil).FindByNames), ctx, cardNames)
+}
@@ -160,16 +160,31 @@ func (mr *MockDeckInterfaceMockRecorder) FindOnCursor(ctx, limit, cursor any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnCursor", reflect.TypeOf((*MockDeckInterface)(nil).FindOnCursor), ctx, limit, cursor)
 }
 
+// FindPublicByUserId mocks base method.
+func (m *MockDeckInterface) FindPublicByUserId(ctx context.Context, uid string, limit, offset int) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublicByUserId", ctx, uid, limit, offset)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPublicByUserId indicates an expected call of FindPublicByUserId.
+func (mr *MockDeckInterfaceMockRecorder) FindPublicByUserId(ctx, uid, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicByUserId", reflect.TypeOf((*MockDeckInterface)(nil).FindPublicByUserId), ctx, uid, limit, offset)
+}
+
 // Save mocks base method.
-func (m *MockDeckInterface) Save(ctx context.Context, entity *entity.Deck) error {
+func (m *MockDeckInterface) Save(ctx context.Context, arg1 *entity.Deck) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockDeckInterfaceMockRecorder) Save(ctx, entity any) *gomock.Call {
+func (mr *MockDeckInterfaceMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDeckInterface)(nil).Save), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDeckInterface)(nil).Save), ctx, arg1)
 }
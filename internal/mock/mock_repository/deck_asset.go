@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,21 @@ func (m *MockDeckAssetInterface) EXPECT() *MockDeckAssetInterfaceMockRecorder {
 	return m.recorder
 }
 
+// FindDeckCards mocks base method.
+func (m *MockDeckAssetInterface) FindDeckCards(ctx context.Context, deckCode string) ([]*entity.DeckCodeCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeckCards", ctx, deckCode)
+	ret0, _ := ret[0].([]*entity.DeckCodeCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeckCards indicates an expected call of FindDeckCards.
+func (mr *MockDeckAssetInterfaceMockRecorder) FindDeckCards(ctx, deckCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeckCards", reflect.TypeOf((*MockDeckAssetInterface)(nil).FindDeckCards), ctx, deckCode)
+}
+
 // UploadDeckImage mocks base method.
 func (m *MockDeckAssetInterface) UploadDeckImage(ctx context.Context, deckCode string) error {
 	m.ctrl.T.Helper()
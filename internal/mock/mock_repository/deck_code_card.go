@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/deck_code_card.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/deck_code_card.go -destination=./internal/mock/mock_repository/deck_code_card.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckCodeCardInterface is a mock of DeckCodeCardInterface interface.
+type MockDeckCodeCardInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckCodeCardInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckCodeCardInterfaceMockRecorder is the mock recorder for MockDeckCodeCardInterface.
+type MockDeckCodeCardInterfaceMockRecorder struct {
+	mock *MockDeckCodeCardInterface
+}
+
+// NewMockDeckCodeCardInterface creates a new mock instance.
+func NewMockDeckCodeCardInterface(ctrl *gomock.Controller) *MockDeckCodeCardInterface {
+	mock := &MockDeckCodeCardInterface{ctrl: ctrl}
+	mock.recorder = &MockDeckCodeCardInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckCodeCardInterface) EXPECT() *MockDeckCodeCardInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByDeckCodeId mocks base method.
+func (m *MockDeckCodeCardInterface) FindByDeckCodeId(ctx context.Context, deckCodeId string) ([]*entity.DeckCodeCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDeckCodeId", ctx, deckCodeId)
+	ret0, _ := ret[0].([]*entity.DeckCodeCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDeckCodeId indicates an expected call of FindByDeckCodeId.
+func (mr *MockDeckCodeCardInterfaceMockRecorder) FindByDeckCodeId(ctx, deckCodeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDeckCodeId", reflect.TypeOf((*MockDeckCodeCardInterface)(nil).FindByDeckCodeId), ctx, deckCodeId)
+}
+
+// FindByDeckCodeIds mocks base method.
+func (m *MockDeckCodeCardInterface) FindByDeckCodeIds(ctx context.Context, deckCodeIds []string) ([]*entity.DeckCodeCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDeckCodeIds", ctx, deckCodeIds)
+	ret0, _ := ret[0].([]*entity.DeckCodeCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDeckCodeIds indicates an expected call of FindByDeckCodeIds.
+func (mr *MockDeckCodeCardInterfaceMockRecorder) FindByDeckCodeIds(ctx, deckCodeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDeckCodeIds", reflect.TypeOf((*MockDeckCodeCardInterface)(nil).FindByDeckCodeIds), ctx, deckCodeIds)
+}
+
+// Replace mocks base method.
+func (m *MockDeckCodeCardInterface) Replace(ctx context.Context, deckCodeId string, cards []*entity.DeckCodeCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, deckCodeId, cards)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockDeckCodeCardInterfaceMockRecorder) Replace(ctx, deckCodeId, cards any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockDeckCodeCardInterface)(nil).Replace), ctx, deckCodeId, cards)
+}
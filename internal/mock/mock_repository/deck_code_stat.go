@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/deck_code_stat.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/deck_code_stat.go -destination=./internal/mock/mock_repository/deck_code_stat.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckCodeStatInterface is a mock of DeckCodeStatInterface interface.
+type MockDeckCodeStatInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckCodeStatInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckCodeStatInterfaceMockRecorder is the mock recorder for MockDeckCodeStatInterface.
+type MockDeckCodeStatInterfaceMockRecorder struct {
+	mock *MockDeckCodeStatInterface
+}
+
+// NewMockDeckCodeStatInterface creates a new mock instance.
+func NewMockDeckCodeStatInterface(ctrl *gomock.Controller) *MockDeckCodeStatInterface {
+	mock := &MockDeckCodeStatInterface{ctrl: ctrl}
+	mock.recorder = &MockDeckCodeStatInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckCodeStatInterface) EXPECT() *MockDeckCodeStatInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByDeckCodeIds mocks base method.
+func (m *MockDeckCodeStatInterface) FindByDeckCodeIds(ctx context.Context, deckCodeIds []string) ([]*entity.DeckCodeStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDeckCodeIds", ctx, deckCodeIds)
+	ret0, _ := ret[0].([]*entity.DeckCodeStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDeckCodeIds indicates an expected call of FindByDeckCodeIds.
+func (mr *MockDeckCodeStatInterfaceMockRecorder) FindByDeckCodeIds(ctx, deckCodeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDeckCodeIds", reflect.TypeOf((*MockDeckCodeStatInterface)(nil).FindByDeckCodeIds), ctx, deckCodeIds)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/entity_revision.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/entity_revision.go -destination=./internal/mock/mock_repository/entity_revision.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEntityRevisionInterface is a mock of EntityRevisionInterface interface.
+type MockEntityRevisionInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEntityRevisionInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEntityRevisionInterfaceMockRecorder is the mock recorder for MockEntityRevisionInterface.
+type MockEntityRevisionInterfaceMockRecorder struct {
+	mock *MockEntityRevisionInterface
+}
+
+// NewMockEntityRevisionInterface creates a new mock instance.
+func NewMockEntityRevisionInterface(ctrl *gomock.Controller) *MockEntityRevisionInterface {
+	mock := &MockEntityRevisionInterface{ctrl: ctrl}
+	mock.recorder = &MockEntityRevisionInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEntityRevisionInterface) EXPECT() *MockEntityRevisionInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockEntityRevisionInterface) Append(ctx context.Context, revision *entity.EntityRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockEntityRevisionInterfaceMockRecorder) Append(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockEntityRevisionInterface)(nil).Append), ctx, revision)
+}
+
+// FindByEntity mocks base method.
+func (m *MockEntityRevisionInterface) FindByEntity(ctx context.Context, entityType entity.EntityRevisionType, entityId string, limit, offset int) ([]*entity.EntityRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEntity", ctx, entityType, entityId, limit, offset)
+	ret0, _ := ret[0].([]*entity.EntityRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEntity indicates an expected call of FindByEntity.
+func (mr *MockEntityRevisionInterfaceMockRecorder) FindByEntity(ctx, entityType, entityId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEntity", reflect.TypeOf((*MockEntityRevisionInterface)(nil).FindByEntity), ctx, entityType, entityId, limit, offset)
+}
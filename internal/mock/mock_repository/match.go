@@ -42,17 +42,17 @@ func (m *MockMatchInterface) EXPECT() *MockMatchInterfaceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockMatchInterface) Create(ctx context.Context, entity *entity.Match) error {
+func (m *MockMatchInterface) Create(ctx context.Context, arg1 *entity.Match) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockMatchInterfaceMockRecorder) Create(ctx, entity any) *gomock.Call {
+func (mr *MockMatchInterfaceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMatchInterface)(nil).Create), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMatchInterface)(nil).Create), ctx, arg1)
 }
 
 // Delete mocks base method.
@@ -69,6 +69,21 @@ func (mr *MockMatchInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMatchInterface)(nil).Delete), ctx, id)
 }
 
+// FindByCriteria mocks base method.
+func (m *MockMatchInterface) FindByCriteria(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Match, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCriteria", ctx, criteria)
+	ret0, _ := ret[0].([]*entity.Match)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCriteria indicates an expected call of FindByCriteria.
+func (mr *MockMatchInterfaceMockRecorder) FindByCriteria(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCriteria", reflect.TypeOf((*MockMatchInterface)(nil).FindByCriteria), ctx, criteria)
+}
+
 // FindById mocks base method.
 func (m *MockMatchInterface) FindById(ctx context.Context, id string) (*entity.Match, error) {
 	m.ctrl.T.Helper()
@@ -144,15 +159,15 @@ func (mr *MockMatchInterfaceMockRecorder) Reorder(ctx, recordId, orders any) *go
 }
 
 // Update mocks base method.
-func (m *MockMatchInterface) Update(ctx context.Context, entity *entity.Match) error {
+func (m *MockMatchInterface) Update(ctx context.Context, arg1 *entity.Match) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, entity)
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockMatchInterfaceMockRecorder) Update(ctx, entity any) *gomock.Call {
+func (mr *MockMatchInterfaceMockRecorder) Update(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMatchInterface)(nil).Update), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMatchInterface)(nil).Update), ctx, arg1)
 }
@@ -57,6 +57,21 @@ func (mr *MockNotificationInterfaceMockRecorder) CountUnreadByUserId(ctx, userId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadByUserId", reflect.TypeOf((*MockNotificationInterface)(nil).CountUnreadByUserId), ctx, userId)
 }
 
+// FindAllByUserId mocks base method.
+func (m *MockNotificationInterface) FindAllByUserId(ctx context.Context, userId string) ([]*entity.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserId", ctx, userId)
+	ret0, _ := ret[0].([]*entity.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserId indicates an expected call of FindAllByUserId.
+func (mr *MockNotificationInterfaceMockRecorder) FindAllByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserId", reflect.TypeOf((*MockNotificationInterface)(nil).FindAllByUserId), ctx, userId)
+}
+
 // FindByUserId mocks base method.
 func (m *MockNotificationInterface) FindByUserId(ctx context.Context, userId string, limit int) ([]*entity.Notification, error) {
 	m.ctrl.T.Helper()
@@ -101,17 +116,17 @@ func (mr *MockNotificationInterfaceMockRecorder) MarkAsRead(ctx, id, userId any)
 }
 
 // Save mocks base method.
-func (m *MockNotificationInterface) Save(ctx context.Context, entity *entity.Notification) error {
+func (m *MockNotificationInterface) Save(ctx context.Context, arg1 *entity.Notification) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockNotificationInterfaceMockRecorder) Save(ctx, entity any) *gomock.Call {
+func (mr *MockNotificationInterfaceMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNotificationInterface)(nil).Save), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNotificationInterface)(nil).Save), ctx, arg1)
 }
 
 // UpdateContent mocks base method.
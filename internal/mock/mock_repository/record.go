@@ -85,6 +85,21 @@ func (mr *MockRecordInterfaceMockRecorder) Find(ctx, limit, offset, eventType an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRecordInterface)(nil).Find), ctx, limit, offset, eventType)
 }
 
+// FindByCriteria mocks base method.
+func (m *MockRecordInterface) FindByCriteria(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCriteria", ctx, criteria)
+	ret0, _ := ret[0].([]*entity.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCriteria indicates an expected call of FindByCriteria.
+func (mr *MockRecordInterfaceMockRecorder) FindByCriteria(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCriteria", reflect.TypeOf((*MockRecordInterface)(nil).FindByCriteria), ctx, criteria)
+}
+
 // FindByDeckCodeId mocks base method.
 func (m *MockRecordInterface) FindByDeckCodeId(ctx context.Context, deckCodeId string, limit, offset int) ([]*entity.Record, error) {
 	m.ctrl.T.Helper()
@@ -220,16 +235,30 @@ func (mr *MockRecordInterfaceMockRecorder) FindOnCursor(ctx, limit, cursorEventD
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnCursor", reflect.TypeOf((*MockRecordInterface)(nil).FindOnCursor), ctx, limit, cursorEventDate, cursorCreatedAt, eventType)
 }
 
+// LockById mocks base method.
+func (m *MockRecordInterface) LockById(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockById", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockById indicates an expected call of LockById.
+func (mr *MockRecordInterfaceMockRecorder) LockById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockById", reflect.TypeOf((*MockRecordInterface)(nil).LockById), ctx, id)
+}
+
 // Save mocks base method.
-func (m *MockRecordInterface) Save(ctx context.Context, entity *entity.Record) error {
+func (m *MockRecordInterface) Save(ctx context.Context, arg1 *entity.Record) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockRecordInterfaceMockRecorder) Save(ctx, entity any) *gomock.Call {
+func (mr *MockRecordInterfaceMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRecordInterface)(nil).Save), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRecordInterface)(nil).Save), ctx, arg1)
 }
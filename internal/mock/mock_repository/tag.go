@@ -172,16 +172,30 @@ func (mr *MockTagInterfaceMockRecorder) ReplaceMatchTags(ctx, matchId, tagIds an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceMatchTags", reflect.TypeOf((*MockTagInterface)(nil).ReplaceMatchTags), ctx, matchId, tagIds)
 }
 
+// ReplaceRecordTags mocks base method.
+func (m *MockTagInterface) ReplaceRecordTags(ctx context.Context, recordId string, tagIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecordTags", ctx, recordId, tagIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecordTags indicates an expected call of ReplaceRecordTags.
+func (mr *MockTagInterfaceMockRecorder) ReplaceRecordTags(ctx, recordId, tagIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecordTags", reflect.TypeOf((*MockTagInterface)(nil).ReplaceRecordTags), ctx, recordId, tagIds)
+}
+
 // Save mocks base method.
-func (m *MockTagInterface) Save(ctx context.Context, entity *entity.Tag) error {
+func (m *MockTagInterface) Save(ctx context.Context, arg1 *entity.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockTagInterfaceMockRecorder) Save(ctx, entity any) *gomock.Call {
+func (mr *MockTagInterfaceMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTagInterface)(nil).Save), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTagInterface)(nil).Save), ctx, arg1)
 }
@@ -55,6 +55,20 @@ func (mr *MockUnofficialEventInterfaceMockRecorder) Delete(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUnofficialEventInterface)(nil).Delete), ctx, id)
 }
 
+// DeleteIfUnreferenced mocks base method.
+func (m *MockUnofficialEventInterface) DeleteIfUnreferenced(ctx context.Context, id, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIfUnreferenced", ctx, id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIfUnreferenced indicates an expected call of DeleteIfUnreferenced.
+func (mr *MockUnofficialEventInterfaceMockRecorder) DeleteIfUnreferenced(ctx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIfUnreferenced", reflect.TypeOf((*MockUnofficialEventInterface)(nil).DeleteIfUnreferenced), ctx, id, userId)
+}
+
 // FindById mocks base method.
 func (m *MockUnofficialEventInterface) FindById(ctx context.Context, id string) (*entity.UnofficialEvent, error) {
 	m.ctrl.T.Helper()
@@ -70,16 +84,46 @@ func (mr *MockUnofficialEventInterfaceMockRecorder) FindById(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUnofficialEventInterface)(nil).FindById), ctx, id)
 }
 
+// FindByJoinCode mocks base method.
+func (m *MockUnofficialEventInterface) FindByJoinCode(ctx context.Context, joinCode string) (*entity.UnofficialEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByJoinCode", ctx, joinCode)
+	ret0, _ := ret[0].(*entity.UnofficialEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByJoinCode indicates an expected call of FindByJoinCode.
+func (mr *MockUnofficialEventInterfaceMockRecorder) FindByJoinCode(ctx, joinCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByJoinCode", reflect.TypeOf((*MockUnofficialEventInterface)(nil).FindByJoinCode), ctx, joinCode)
+}
+
+// FindByUserId mocks base method.
+func (m *MockUnofficialEventInterface) FindByUserId(ctx context.Context, userId string) ([]*entity.UnofficialEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+	ret0, _ := ret[0].([]*entity.UnofficialEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockUnofficialEventInterfaceMockRecorder) FindByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockUnofficialEventInterface)(nil).FindByUserId), ctx, userId)
+}
+
 // Save mocks base method.
-func (m *MockUnofficialEventInterface) Save(ctx context.Context, entity *entity.UnofficialEvent) error {
+func (m *MockUnofficialEventInterface) Save(ctx context.Context, arg1 *entity.UnofficialEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockUnofficialEventInterfaceMockRecorder) Save(ctx, entity any) *gomock.Call {
+func (mr *MockUnofficialEventInterfaceMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUnofficialEventInterface)(nil).Save), ctx, entity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUnofficialEventInterface)(nil).Save), ctx, arg1)
 }
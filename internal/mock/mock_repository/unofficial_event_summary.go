@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/unofficial_event_summary.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/unofficial_event_summary.go -destination=./internal/mock/mock_repository/unofficial_event_summary.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnofficialEventSummaryInterface is a mock of UnofficialEventSummaryInterface interface.
+type MockUnofficialEventSummaryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnofficialEventSummaryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUnofficialEventSummaryInterfaceMockRecorder is the mock recorder for MockUnofficialEventSummaryInterface.
+type MockUnofficialEventSummaryInterfaceMockRecorder struct {
+	mock *MockUnofficialEventSummaryInterface
+}
+
+// NewMockUnofficialEventSummaryInterface creates a new mock instance.
+func NewMockUnofficialEventSummaryInterface(ctrl *gomock.Controller) *MockUnofficialEventSummaryInterface {
+	mock := &MockUnofficialEventSummaryInterface{ctrl: ctrl}
+	mock.recorder = &MockUnofficialEventSummaryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnofficialEventSummaryInterface) EXPECT() *MockUnofficialEventSummaryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByUnofficialEventId mocks base method.
+func (m *MockUnofficialEventSummaryInterface) FindByUnofficialEventId(ctx context.Context, unofficialEventId string) (*entity.UnofficialEventSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUnofficialEventId", ctx, unofficialEventId)
+	ret0, _ := ret[0].(*entity.UnofficialEventSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUnofficialEventId indicates an expected call of FindByUnofficialEventId.
+func (mr *MockUnofficialEventSummaryInterfaceMockRecorder) FindByUnofficialEventId(ctx, unofficialEventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUnofficialEventId", reflect.TypeOf((*MockUnofficialEventSummaryInterface)(nil).FindByUnofficialEventId), ctx, unofficialEventId)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/user_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/user_export.go -destination=./internal/mock/mock_repository/user_export.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserExportInterface is a mock of UserExportInterface interface.
+type MockUserExportInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserExportInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserExportInterfaceMockRecorder is the mock recorder for MockUserExportInterface.
+type MockUserExportInterfaceMockRecorder struct {
+	mock *MockUserExportInterface
+}
+
+// NewMockUserExportInterface creates a new mock instance.
+func NewMockUserExportInterface(ctrl *gomock.Controller) *MockUserExportInterface {
+	mock := &MockUserExportInterface{ctrl: ctrl}
+	mock.recorder = &MockUserExportInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserExportInterface) EXPECT() *MockUserExportInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockUserExportInterface) Claim(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entity.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, now, staleBefore, limit)
+	ret0, _ := ret[0].([]*entity.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockUserExportInterfaceMockRecorder) Claim(ctx, now, staleBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockUserExportInterface)(nil).Claim), ctx, now, staleBefore, limit)
+}
+
+// Create mocks base method.
+func (m *MockUserExportInterface) Create(ctx context.Context, export *entity.UserExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserExportInterfaceMockRecorder) Create(ctx, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserExportInterface)(nil).Create), ctx, export)
+}
+
+// FindById mocks base method.
+func (m *MockUserExportInterface) FindById(ctx context.Context, id string) (*entity.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entity.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserExportInterfaceMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserExportInterface)(nil).FindById), ctx, id)
+}
+
+// FindUnfinishedByUserId mocks base method.
+func (m *MockUserExportInterface) FindUnfinishedByUserId(ctx context.Context, userId string) (*entity.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnfinishedByUserId", ctx, userId)
+	ret0, _ := ret[0].(*entity.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnfinishedByUserId indicates an expected call of FindUnfinishedByUserId.
+func (mr *MockUserExportInterfaceMockRecorder) FindUnfinishedByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnfinishedByUserId", reflect.TypeOf((*MockUserExportInterface)(nil).FindUnfinishedByUserId), ctx, userId)
+}
+
+// Save mocks base method.
+func (m *MockUserExportInterface) Save(ctx context.Context, export *entity.UserExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockUserExportInterfaceMockRecorder) Save(ctx, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserExportInterface)(nil).Save), ctx, export)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/user_export_archive.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/user_export_archive.go -destination=./internal/mock/mock_repository/user_export_archive.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserExportArchiveInterface is a mock of UserExportArchiveInterface interface.
+type MockUserExportArchiveInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserExportArchiveInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserExportArchiveInterfaceMockRecorder is the mock recorder for MockUserExportArchiveInterface.
+type MockUserExportArchiveInterfaceMockRecorder struct {
+	mock *MockUserExportArchiveInterface
+}
+
+// NewMockUserExportArchiveInterface creates a new mock instance.
+func NewMockUserExportArchiveInterface(ctrl *gomock.Controller) *MockUserExportArchiveInterface {
+	mock := &MockUserExportArchiveInterface{ctrl: ctrl}
+	mock.recorder = &MockUserExportArchiveInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserExportArchiveInterface) EXPECT() *MockUserExportArchiveInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUserExportArchiveInterface) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserExportArchiveInterfaceMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserExportArchiveInterface)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockUserExportArchiveInterface) Put(ctx context.Context, key string, data *entity.UserExportData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockUserExportArchiveInterfaceMockRecorder) Put(ctx, key, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockUserExportArchiveInterface)(nil).Put), ctx, key, data)
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/user_relationship.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/repository/user_relationship.go -destination=./internal/mock/mock_repository/user_relationship.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRelationshipInterface is a mock of UserRelationshipInterface interface.
+type MockUserRelationshipInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRelationshipInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRelationshipInterfaceMockRecorder is the mock recorder for MockUserRelationshipInterface.
+type MockUserRelationshipInterfaceMockRecorder struct {
+	mock *MockUserRelationshipInterface
+}
+
+// NewMockUserRelationshipInterface creates a new mock instance.
+func NewMockUserRelationshipInterface(ctrl *gomock.Controller) *MockUserRelationshipInterface {
+	mock := &MockUserRelationshipInterface{ctrl: ctrl}
+	mock.recorder = &MockUserRelationshipInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRelationshipInterface) EXPECT() *MockUserRelationshipInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserRelationshipInterface) Create(ctx context.Context, relationship *entity.UserRelationship) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, relationship)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Create(ctx, relationship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Create), ctx, relationship)
+}
+
+// Delete mocks base method.
+func (m *MockUserRelationshipInterface) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Delete), ctx, id)
+}
+
+// FindAcceptedByUserId mocks base method.
+func (m *MockUserRelationshipInterface) FindAcceptedByUserId(ctx context.Context, userId string, limit, offset int) ([]*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAcceptedByUserId", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAcceptedByUserId indicates an expected call of FindAcceptedByUserId.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindAcceptedByUserId(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAcceptedByUserId", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindAcceptedByUserId), ctx, userId, limit, offset)
+}
+
+// FindBetween mocks base method.
+func (m *MockUserRelationshipInterface) FindBetween(ctx context.Context, userId, otherUserId string) ([]*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBetween", ctx, userId, otherUserId)
+	ret0, _ := ret[0].([]*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBetween indicates an expected call of FindBetween.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindBetween(ctx, userId, otherUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBetween", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindBetween), ctx, userId, otherUserId)
+}
+
+// FindById mocks base method.
+func (m *MockUserRelationshipInterface) FindById(ctx context.Context, id string) (*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindById), ctx, id)
+}
+
+// FindPendingByTargetUserId mocks base method.
+func (m *MockUserRelationshipInterface) FindPendingByTargetUserId(ctx context.Context, userId string, limit, offset int) ([]*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByTargetUserId", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByTargetUserId indicates an expected call of FindPendingByTargetUserId.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindPendingByTargetUserId(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByTargetUserId", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindPendingByTargetUserId), ctx, userId, limit, offset)
+}
+
+// IsFriend mocks base method.
+func (m *MockUserRelationshipInterface) IsFriend(ctx context.Context, userId, otherUserId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFriend", ctx, userId, otherUserId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFriend indicates an expected call of IsFriend.
+func (mr *MockUserRelationshipInterfaceMockRecorder) IsFriend(ctx, userId, otherUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFriend", reflect.TypeOf((*MockUserRelationshipInterface)(nil).IsFriend), ctx, userId, otherUserId)
+}
+
+// Save mocks base method.
+func (m *MockUserRelationshipInterface) Save(ctx context.Context, relationship *entity.UserRelationship) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, relationship)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Save(ctx, relationship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Save), ctx, relationship)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/attachment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/attachment.go -destination=./internal/mock/mock_usecase/attachment.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentInterface is a mock of AttachmentInterface interface.
+type MockAttachmentInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentInterfaceMockRecorder is the mock recorder for MockAttachmentInterface.
+type MockAttachmentInterfaceMockRecorder struct {
+	mock *MockAttachmentInterface
+}
+
+// NewMockAttachmentInterface creates a new mock instance.
+func NewMockAttachmentInterface(ctrl *gomock.Controller) *MockAttachmentInterface {
+	mock := &MockAttachmentInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentInterface) EXPECT() *MockAttachmentInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttachmentInterface) Create(ctx context.Context, userId string, ownerType entity.AttachmentOwnerType, ownerId string, body []byte) (*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userId, ownerType, ownerId, body)
+	ret0, _ := ret[0].(*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentInterfaceMockRecorder) Create(ctx, userId, ownerType, ownerId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentInterface)(nil).Create), ctx, userId, ownerType, ownerId, body)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentInterface) Delete(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerType, ownerId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentInterfaceMockRecorder) Delete(ctx, ownerType, ownerId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentInterface)(nil).Delete), ctx, ownerType, ownerId, id)
+}
+
+// Download mocks base method.
+func (m *MockAttachmentInterface) Download(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerId, id string, thumbnail bool) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, ownerType, ownerId, id, thumbnail)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAttachmentInterfaceMockRecorder) Download(ctx, ownerType, ownerId, id, thumbnail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAttachmentInterface)(nil).Download), ctx, ownerType, ownerId, id, thumbnail)
+}
+
+// FindByOwner mocks base method.
+func (m *MockAttachmentInterface) FindByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerId string) ([]*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, ownerType, ownerId)
+	ret0, _ := ret[0].([]*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockAttachmentInterfaceMockRecorder) FindByOwner(ctx, ownerType, ownerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockAttachmentInterface)(nil).FindByOwner), ctx, ownerType, ownerId)
+}
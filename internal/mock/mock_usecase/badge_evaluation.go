@@ -84,6 +84,21 @@ func (mr *MockBadgeEvaluationInterfaceMockRecorder) EvaluateOnMatchCreated(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateOnMatchCreated", reflect.TypeOf((*MockBadgeEvaluationInterface)(nil).EvaluateOnMatchCreated), ctx, userId, match)
 }
 
+// EvaluateOnMatchesCreated mocks base method.
+func (m *MockBadgeEvaluationInterface) EvaluateOnMatchesCreated(ctx context.Context, userId string, matches []*entity.Match) ([]*entity.UserBadge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateOnMatchesCreated", ctx, userId, matches)
+	ret0, _ := ret[0].([]*entity.UserBadge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateOnMatchesCreated indicates an expected call of EvaluateOnMatchesCreated.
+func (mr *MockBadgeEvaluationInterfaceMockRecorder) EvaluateOnMatchesCreated(ctx, userId, matches any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateOnMatchesCreated", reflect.TypeOf((*MockBadgeEvaluationInterface)(nil).EvaluateOnMatchesCreated), ctx, userId, matches)
+}
+
 // EvaluateOnRecordCreated mocks base method.
 func (m *MockBadgeEvaluationInterface) EvaluateOnRecordCreated(ctx context.Context, userId string, record *entity.Record) ([]*entity.UserBadge, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +128,35 @@ func (mr *MockBadgeEvaluationInterfaceMockRecorder) EvaluateOnRecordDeleted(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateOnRecordDeleted", reflect.TypeOf((*MockBadgeEvaluationInterface)(nil).EvaluateOnRecordDeleted), ctx, userId)
 }
 
+// EvaluateOnRecordRestored mocks base method.
+func (m *MockBadgeEvaluationInterface) EvaluateOnRecordRestored(ctx context.Context, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateOnRecordRestored", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EvaluateOnRecordRestored indicates an expected call of EvaluateOnRecordRestored.
+func (mr *MockBadgeEvaluationInterfaceMockRecorder) EvaluateOnRecordRestored(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateOnRecordRestored", reflect.TypeOf((*MockBadgeEvaluationInterface)(nil).EvaluateOnRecordRestored), ctx, userId)
+}
+
+// EvaluateOnRecordsImported mocks base method.
+func (m *MockBadgeEvaluationInterface) EvaluateOnRecordsImported(ctx context.Context, userId string, records []*entity.Record, matches []*entity.Match) ([]*entity.UserBadge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateOnRecordsImported", ctx, userId, records, matches)
+	ret0, _ := ret[0].([]*entity.UserBadge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateOnRecordsImported indicates an expected call of EvaluateOnRecordsImported.
+func (mr *MockBadgeEvaluationInterfaceMockRecorder) EvaluateOnRecordsImported(ctx, userId, records, matches any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateOnRecordsImported", reflect.TypeOf((*MockBadgeEvaluationInterface)(nil).EvaluateOnRecordsImported), ctx, userId, records, matches)
+}
+
 // EvaluateOnUserCreated mocks base method.
 func (m *MockBadgeEvaluationInterface) EvaluateOnUserCreated(ctx context.Context, userId string, createdAt time.Time) ([]*entity.UserBadge, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +192,21 @@ func (mr *MockDeckInterfaceMockRecorder) FindOnCursor(ctx, limit, cursor any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnCursor", reflect.TypeOf((*MockDeckInterface)(nil).FindOnCursor), ctx, limit, cursor)
 }
 
+// FindPublicByUserId mocks base method.
+func (m *MockDeckInterface) FindPublicByUserId(ctx context.Context, uid string, limit, offset int) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublicByUserId", ctx, uid, limit, offset)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPublicByUserId indicates an expected call of FindPublicByUserId.
+func (mr *MockDeckInterfaceMockRecorder) FindPublicByUserId(ctx, uid, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicByUserId", reflect.TypeOf((*MockDeckInterface)(nil).FindPublicByUserId), ctx, uid, limit, offset)
+}
+
 // Unarchive mocks base method.
 func (m *MockDeckInterface) Unarchive(ctx context.Context, id string) (*entity.Deck, error) {
 	m.ctrl.T.Helper()
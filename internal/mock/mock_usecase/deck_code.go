@@ -71,6 +71,21 @@ func (mr *MockDeckCodeInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeckCodeInterface)(nil).Delete), ctx, id)
 }
 
+// Diff mocks base method.
+func (m *MockDeckCodeInterface) Diff(ctx context.Context, fromId, toId string) (*entity.DeckCodeDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", ctx, fromId, toId)
+	ret0, _ := ret[0].(*entity.DeckCodeDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *MockDeckCodeInterfaceMockRecorder) Diff(ctx, fromId, toId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockDeckCodeInterface)(nil).Diff), ctx, fromId, toId)
+}
+
 // FindByDeckId mocks base method.
 func (m *MockDeckCodeInterface) FindByDeckId(ctx context.Context, deckId string) ([]*entity.DeckCode, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +116,21 @@ func (mr *MockDeckCodeInterfaceMockRecorder) FindById(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDeckCodeInterface)(nil).FindById), ctx, id)
 }
 
+// FindByIdWithCards mocks base method.
+func (m *MockDeckCodeInterface) FindByIdWithCards(ctx context.Context, id string) (*entity.DeckCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdWithCards", ctx, id)
+	ret0, _ := ret[0].(*entity.DeckCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdWithCards indicates an expected call of FindByIdWithCards.
+func (mr *MockDeckCodeInterfaceMockRecorder) FindByIdWithCards(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdWithCards", reflect.TypeOf((*MockDeckCodeInterface)(nil).FindByIdWithCards), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockDeckCodeInterface) Update(ctx context.Context, id string, param *usecase.DeckCodeUpdateParam) (*entity.DeckCode, error) {
 	m.ctrl.T.Helper()
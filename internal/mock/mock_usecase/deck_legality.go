@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/deck_legality.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/deck_legality.go -destination=./internal/mock/mock_usecase/deck_legality.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckLegalityInterface is a mock of DeckLegalityInterface interface.
+type MockDeckLegalityInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckLegalityInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckLegalityInterfaceMockRecorder is the mock recorder for MockDeckLegalityInterface.
+type MockDeckLegalityInterfaceMockRecorder struct {
+	mock *MockDeckLegalityInterface
+}
+
+// NewMockDeckLegalityInterface creates a new mock instance.
+func NewMockDeckLegalityInterface(ctrl *gomock.Controller) *MockDeckLegalityInterface {
+	mock := &MockDeckLegalityInterface{ctrl: ctrl}
+	mock.recorder = &MockDeckLegalityInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckLegalityInterface) EXPECT() *MockDeckLegalityInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockDeckLegalityInterface) Check(ctx context.Context, deckCodeId string, regulationId uint, date time.Time) (*entity.DeckLegality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, deckCodeId, regulationId, date)
+	ret0, _ := ret[0].(*entity.DeckLegality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockDeckLegalityInterfaceMockRecorder) Check(ctx, deckCodeId, regulationId, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockDeckLegalityInterface)(nil).Check), ctx, deckCodeId, regulationId, date)
+}
@@ -71,6 +71,21 @@ func (mr *MockMatchInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMatchInterface)(nil).Delete), ctx, id)
 }
 
+// FindByCriteria mocks base method.
+func (m *MockMatchInterface) FindByCriteria(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Match, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCriteria", ctx, criteria)
+	ret0, _ := ret[0].([]*entity.Match)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCriteria indicates an expected call of FindByCriteria.
+func (mr *MockMatchInterfaceMockRecorder) FindByCriteria(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCriteria", reflect.TypeOf((*MockMatchInterface)(nil).FindByCriteria), ctx, criteria)
+}
+
 // FindById mocks base method.
 func (m *MockMatchInterface) FindById(ctx context.Context, id string) (*entity.Match, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +146,21 @@ func (mr *MockMatchInterfaceMockRecorder) FindLatest(ctx, limit any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatest", reflect.TypeOf((*MockMatchInterface)(nil).FindLatest), ctx, limit)
 }
 
+// History mocks base method.
+func (m *MockMatchInterface) History(ctx context.Context, id string, limit, offset int) ([]*entity.EntityRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id, limit, offset)
+	ret0, _ := ret[0].([]*entity.EntityRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockMatchInterfaceMockRecorder) History(ctx, id, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockMatchInterface)(nil).History), ctx, id, limit, offset)
+}
+
 // Reorder mocks base method.
 func (m *MockMatchInterface) Reorder(ctx context.Context, recordId string, orders []*entity.MatchOrder) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/memo_search.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/memo_search.go -destination=./internal/mock/mock_usecase/memo_search.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMemoSearchInterface is a mock of MemoSearchInterface interface.
+type MockMemoSearchInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemoSearchInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockMemoSearchInterfaceMockRecorder is the mock recorder for MockMemoSearchInterface.
+type MockMemoSearchInterfaceMockRecorder struct {
+	mock *MockMemoSearchInterface
+}
+
+// NewMockMemoSearchInterface creates a new mock instance.
+func NewMockMemoSearchInterface(ctrl *gomock.Controller) *MockMemoSearchInterface {
+	mock := &MockMemoSearchInterface{ctrl: ctrl}
+	mock.recorder = &MockMemoSearchInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMemoSearchInterface) EXPECT() *MockMemoSearchInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockMemoSearchInterface) Search(ctx context.Context, userId string, terms []string, limit, offset int) ([]*entity.MemoSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, userId, terms, limit, offset)
+	ret0, _ := ret[0].([]*entity.MemoSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockMemoSearchInterfaceMockRecorder) Search(ctx, userId, terms, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockMemoSearchInterface)(nil).Search), ctx, userId, terms, limit, offset)
+}
@@ -71,3 +71,18 @@ func (mr *MockOfficialEventInterfaceMockRecorder) FindById(ctx, id any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOfficialEventInterface)(nil).FindById), ctx, id)
 }
+
+// Search mocks base method.
+func (m *MockOfficialEventInterface) Search(ctx context.Context, criteria *entity.OfficialEventSearchCriteria) ([]*entity.OfficialEventSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, criteria)
+	ret0, _ := ret[0].([]*entity.OfficialEventSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOfficialEventInterfaceMockRecorder) Search(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOfficialEventInterface)(nil).Search), ctx, criteria)
+}
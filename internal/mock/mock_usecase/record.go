@@ -58,6 +58,22 @@ func (mr *MockRecordInterfaceMockRecorder) Create(ctx, param any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecordInterface)(nil).Create), ctx, param)
 }
 
+// CreateWithMatches mocks base method.
+func (m *MockRecordInterface) CreateWithMatches(ctx context.Context, param *usecase.RecordParam, matchParams []*usecase.MatchParam) (*entity.Record, []*entity.Match, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithMatches", ctx, param, matchParams)
+	ret0, _ := ret[0].(*entity.Record)
+	ret1, _ := ret[1].([]*entity.Match)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateWithMatches indicates an expected call of CreateWithMatches.
+func (mr *MockRecordInterfaceMockRecorder) CreateWithMatches(ctx, param, matchParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithMatches", reflect.TypeOf((*MockRecordInterface)(nil).CreateWithMatches), ctx, param, matchParams)
+}
+
 // Delete mocks base method.
 func (m *MockRecordInterface) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -87,6 +103,21 @@ func (mr *MockRecordInterfaceMockRecorder) Find(ctx, limit, offset, eventType an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRecordInterface)(nil).Find), ctx, limit, offset, eventType)
 }
 
+// FindByCriteria mocks base method.
+func (m *MockRecordInterface) FindByCriteria(ctx context.Context, criteria *entity.RecordCriteria) ([]*entity.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCriteria", ctx, criteria)
+	ret0, _ := ret[0].([]*entity.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCriteria indicates an expected call of FindByCriteria.
+func (mr *MockRecordInterfaceMockRecorder) FindByCriteria(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCriteria", reflect.TypeOf((*MockRecordInterface)(nil).FindByCriteria), ctx, criteria)
+}
+
 // FindByDeckCodeId mocks base method.
 func (m *MockRecordInterface) FindByDeckCodeId(ctx context.Context, deckCodeId string, limit, offset int) ([]*entity.Record, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +253,21 @@ func (mr *MockRecordInterfaceMockRecorder) FindOnCursor(ctx, limit, cursorEventD
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnCursor", reflect.TypeOf((*MockRecordInterface)(nil).FindOnCursor), ctx, limit, cursorEventDate, cursorCreatedAt, eventType)
 }
 
+// History mocks base method.
+func (m *MockRecordInterface) History(ctx context.Context, id string, limit, offset int) ([]*entity.EntityRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id, limit, offset)
+	ret0, _ := ret[0].([]*entity.EntityRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockRecordInterfaceMockRecorder) History(ctx, id, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockRecordInterface)(nil).History), ctx, id, limit, offset)
+}
+
 // Update mocks base method.
 func (m *MockRecordInterface) Update(ctx context.Context, id string, param *usecase.RecordParam) (*entity.Record, error) {
 	m.ctrl.T.Helper()
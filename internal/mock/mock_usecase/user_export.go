@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/user_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/user_export.go -destination=./internal/mock/mock_usecase/user_export.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserExportInterface is a mock of UserExportInterface interface.
+type MockUserExportInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserExportInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserExportInterfaceMockRecorder is the mock recorder for MockUserExportInterface.
+type MockUserExportInterfaceMockRecorder struct {
+	mock *MockUserExportInterface
+}
+
+// NewMockUserExportInterface creates a new mock instance.
+func NewMockUserExportInterface(ctrl *gomock.Controller) *MockUserExportInterface {
+	mock := &MockUserExportInterface{ctrl: ctrl}
+	mock.recorder = &MockUserExportInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserExportInterface) EXPECT() *MockUserExportInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockUserExportInterface) Download(ctx context.Context, userId, id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, userId, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockUserExportInterfaceMockRecorder) Download(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockUserExportInterface)(nil).Download), ctx, userId, id)
+}
+
+// FindById mocks base method.
+func (m *MockUserExportInterface) FindById(ctx context.Context, userId, id string) (*entity.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, userId, id)
+	ret0, _ := ret[0].(*entity.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserExportInterfaceMockRecorder) FindById(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserExportInterface)(nil).FindById), ctx, userId, id)
+}
+
+// Process mocks base method.
+func (m *MockUserExportInterface) Process(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockUserExportInterfaceMockRecorder) Process(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockUserExportInterface)(nil).Process), ctx, limit)
+}
+
+// Request mocks base method.
+func (m *MockUserExportInterface) Request(ctx context.Context, userId string) (*entity.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, userId)
+	ret0, _ := ret[0].(*entity.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockUserExportInterfaceMockRecorder) Request(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockUserExportInterface)(nil).Request), ctx, userId)
+}
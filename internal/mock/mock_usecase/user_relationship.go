@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/user_relationship.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/user_relationship.go -destination=./internal/mock/mock_usecase/user_relationship.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRelationshipInterface is a mock of UserRelationshipInterface interface.
+type MockUserRelationshipInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRelationshipInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRelationshipInterfaceMockRecorder is the mock recorder for MockUserRelationshipInterface.
+type MockUserRelationshipInterfaceMockRecorder struct {
+	mock *MockUserRelationshipInterface
+}
+
+// NewMockUserRelationshipInterface creates a new mock instance.
+func NewMockUserRelationshipInterface(ctrl *gomock.Controller) *MockUserRelationshipInterface {
+	mock := &MockUserRelationshipInterface{ctrl: ctrl}
+	mock.recorder = &MockUserRelationshipInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRelationshipInterface) EXPECT() *MockUserRelationshipInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockUserRelationshipInterface) Accept(ctx context.Context, userId, id string) (*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, userId, id)
+	ret0, _ := ret[0].(*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Accept(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Accept), ctx, userId, id)
+}
+
+// Block mocks base method.
+func (m *MockUserRelationshipInterface) Block(ctx context.Context, userId, targetUserId string) (*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, userId, targetUserId)
+	ret0, _ := ret[0].(*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Block(ctx, userId, targetUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Block), ctx, userId, targetUserId)
+}
+
+// Decline mocks base method.
+func (m *MockUserRelationshipInterface) Decline(ctx context.Context, userId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Decline(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Decline), ctx, userId, id)
+}
+
+// FindFriends mocks base method.
+func (m *MockUserRelationshipInterface) FindFriends(ctx context.Context, userId string, limit, offset int) ([]*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFriends", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFriends indicates an expected call of FindFriends.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindFriends(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFriends", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindFriends), ctx, userId, limit, offset)
+}
+
+// FindRequests mocks base method.
+func (m *MockUserRelationshipInterface) FindRequests(ctx context.Context, userId string, limit, offset int) ([]*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRequests", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRequests indicates an expected call of FindRequests.
+func (mr *MockUserRelationshipInterfaceMockRecorder) FindRequests(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRequests", reflect.TypeOf((*MockUserRelationshipInterface)(nil).FindRequests), ctx, userId, limit, offset)
+}
+
+// Request mocks base method.
+func (m *MockUserRelationshipInterface) Request(ctx context.Context, userId, targetUserId string) (*entity.UserRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, userId, targetUserId)
+	ret0, _ := ret[0].(*entity.UserRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Request(ctx, userId, targetUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Request), ctx, userId, targetUserId)
+}
+
+// Unblock mocks base method.
+func (m *MockUserRelationshipInterface) Unblock(ctx context.Context, userId, targetUserId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", ctx, userId, targetUserId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Unblock(ctx, userId, targetUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Unblock), ctx, userId, targetUserId)
+}
+
+// Unfriend mocks base method.
+func (m *MockUserRelationshipInterface) Unfriend(ctx context.Context, userId, friendUserId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfriend", ctx, userId, friendUserId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfriend indicates an expected call of Unfriend.
+func (mr *MockUserRelationshipInterfaceMockRecorder) Unfriend(ctx, userId, friendUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfriend", reflect.TypeOf((*MockUserRelationshipInterface)(nil).Unfriend), ctx, userId, friendUserId)
+}
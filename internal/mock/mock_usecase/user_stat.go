@@ -42,16 +42,16 @@ func (m *MockUserStatInterface) EXPECT() *MockUserStatInterfaceMockRecorder {
 }
 
 // GetUserStat mocks base method.
-func (m *MockUserStatInterface) GetUserStat(ctx context.Context, userId, yearMonth, environmentId, season, standardRegulationId string, regulationId uint) (*entity.UserStat, error) {
+func (m *MockUserStatInterface) GetUserStat(ctx context.Context, userId, yearMonth, environmentId, season, standardRegulationId string, regulationId uint, recordTagId string) (*entity.UserStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserStat", ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId)
+	ret := m.ctrl.Call(m, "GetUserStat", ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId, recordTagId)
 	ret0, _ := ret[0].(*entity.UserStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserStat indicates an expected call of GetUserStat.
-func (mr *MockUserStatInterfaceMockRecorder) GetUserStat(ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId any) *gomock.Call {
+func (mr *MockUserStatInterfaceMockRecorder) GetUserStat(ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId, recordTagId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockUserStatInterface)(nil).GetUserStat), ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockUserStatInterface)(nil).GetUserStat), ctx, userId, yearMonth, environmentId, season, standardRegulationId, regulationId, recordTagId)
 }
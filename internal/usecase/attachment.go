@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type AttachmentInterface interface {
+	// Create は記録・対戦結果に写真を添付する。添付先の所有者の確認は呼び出し側で済ませておく。
+	// 写真として扱えなければ apperror.ErrInvalidAttachment を、添付できる上限
+	// (entity.MaxAttachmentsPerOwner)に達していれば apperror.ErrTooManyAttachments を返す。
+	Create(
+		ctx context.Context,
+		userId string,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+		body []byte,
+	) (*entity.Attachment, error)
+
+	// FindByOwner は記録・対戦結果に添付した写真を添付した順に返す。
+	FindByOwner(
+		ctx context.Context,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+	) ([]*entity.Attachment, error)
+
+	// Download は写真の本体(thumbnail なら縮小したもの)を返す。他の記録・対戦結果に
+	// 添付した写真は存在しないものとして apperror.ErrRecordNotFound を返す。
+	Download(
+		ctx context.Context,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+		id string,
+		thumbnail bool,
+	) ([]byte, error)
+
+	// Delete は写真を行とストレージの両方から消す。
+	Delete(
+		ctx context.Context,
+		ownerType entity.AttachmentOwnerType,
+		ownerId string,
+		id string,
+	) error
+}
+
+type Attachment struct {
+	repository repository.AttachmentInterface
+	storage    repository.AttachmentStorageInterface
+}
+
+func NewAttachment(
+	repository repository.AttachmentInterface,
+	storage repository.AttachmentStorageInterface,
+) AttachmentInterface {
+	return &Attachment{
+		repository: repository,
+		storage:    storage,
+	}
+}
+
+func (u *Attachment) Create(
+	ctx context.Context,
+	userId string,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+	body []byte,
+) (*entity.Attachment, error) {
+	count, err := u.repository.CountByOwner(ctx, ownerType, ownerId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if count >= entity.MaxAttachmentsPerOwner {
+		return nil, apperror.ErrTooManyAttachments
+	}
+
+	id, err := generateId()
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	attachment := entity.NewAttachment(id, timeNow().Local(), userId, ownerType, ownerId)
+
+	// 行を先に作ると、ストレージへの配置に失敗したときに中身の無い写真が一覧に出るため、
+	// ストレージに置いてから行を作る。
+	image, err := u.storage.Put(ctx, attachment, body)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+	attachment.SetImage(image)
+
+	if err := u.repository.Create(ctx, attachment); err != nil {
+		logError(ctx, err)
+
+		// 行を作れなかった写真は誰からも参照されないため、置いたものを片付ける。
+		logWarn(ctx, u.storage.Delete(ctx, attachment))
+
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (u *Attachment) FindByOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+) ([]*entity.Attachment, error) {
+	attachments, err := u.repository.FindByOwner(ctx, ownerType, ownerId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// findByOwner は ownerType/ownerId に添付した写真を1件返す。
+func (u *Attachment) findByOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+	id string,
+) (*entity.Attachment, error) {
+	attachment, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 添付先の閲覧権限は ownerId で確認しているため、別の添付先の写真は返さない。
+	if !attachment.BelongsTo(ownerType, ownerId) {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	return attachment, nil
+}
+
+func (u *Attachment) Download(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+	id string,
+	thumbnail bool,
+) ([]byte, error) {
+	attachment, err := u.findByOwner(ctx, ownerType, ownerId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	key := attachment.ObjectKey()
+	if thumbnail {
+		key = attachment.ThumbnailKey()
+	}
+
+	body, err := u.storage.Get(ctx, key)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (u *Attachment) Delete(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerId string,
+	id string,
+) error {
+	attachment, err := u.findByOwner(ctx, ownerType, ownerId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repository.Delete(ctx, id); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	// 行は消えているため、ストレージに残っても誰からも参照されない。削除自体は成功として扱う。
+	logWarn(ctx, u.storage.Delete(ctx, attachment))
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func setup4AttachmentUsecase(t *testing.T) (
+	*mock_repository.MockAttachmentInterface,
+	*mock_repository.MockAttachmentStorageInterface,
+	AttachmentInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockAttachmentInterface(mockCtrl)
+	mockStorage := mock_repository.NewMockAttachmentStorageInterface(mockCtrl)
+
+	usecase := NewAttachment(mockRepository, mockStorage)
+
+	return mockRepository, mockStorage, usecase
+}
+
+func TestAttachmentUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TR0"
+	matchId := "01HD7Y3K8D6FDHMHTZ2GT41TR2"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	body := []byte("jpeg")
+	image := &entity.AttachmentImage{ContentType: "image/jpeg", Size: 1024, Width: 40, Height: 20}
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_ストレージに置いてから行を作る", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockStorage, usecase := setup4AttachmentUsecase(t)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().CountByOwner(context.Background(), entity.AttachmentOwnerTypeMatch, matchId).Return(int64(0), nil),
+				mockStorage.EXPECT().Put(context.Background(), gomock.Any(), body).Return(image, nil),
+				mockRepository.EXPECT().Create(context.Background(), gomock.Any()).Return(nil),
+			)
+
+			ret, err := usecase.Create(context.Background(), uid, entity.AttachmentOwnerTypeMatch, matchId, body)
+
+			require.NoError(t, err)
+			require.NotEmpty(t, ret.ID)
+			require.Equal(t, uid, ret.UserId)
+			require.Equal(t, now, ret.CreatedAt)
+			require.True(t, ret.BelongsTo(entity.AttachmentOwnerTypeMatch, matchId))
+			require.Equal(t, 1024, ret.Size)
+			require.Equal(t, 40, ret.Width)
+		})
+
+		t.Run("異常系_上限に達していればErrTooManyAttachmentsを返す", func(t *testing.T) {
+			mockRepository, _, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().CountByOwner(context.Background(), entity.AttachmentOwnerTypeRecord, recordId).Return(int64(entity.MaxAttachmentsPerOwner), nil)
+
+			ret, err := usecase.Create(context.Background(), uid, entity.AttachmentOwnerTypeRecord, recordId, body)
+
+			require.ErrorIs(t, err, apperror.ErrTooManyAttachments)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_写真として扱えなければ行を作らない", func(t *testing.T) {
+			mockRepository, mockStorage, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().CountByOwner(context.Background(), entity.AttachmentOwnerTypeRecord, recordId).Return(int64(0), nil)
+			mockStorage.EXPECT().Put(context.Background(), gomock.Any(), body).Return(nil, apperror.ErrInvalidAttachment)
+
+			ret, err := usecase.Create(context.Background(), uid, entity.AttachmentOwnerTypeRecord, recordId, body)
+
+			require.ErrorIs(t, err, apperror.ErrInvalidAttachment)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_行を作れなければ置いた写真を片付ける", func(t *testing.T) {
+			mockRepository, mockStorage, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().CountByOwner(context.Background(), entity.AttachmentOwnerTypeRecord, recordId).Return(int64(0), nil)
+			mockStorage.EXPECT().Put(context.Background(), gomock.Any(), body).Return(image, nil)
+			mockRepository.EXPECT().Create(context.Background(), gomock.Any()).Return(errors.New("db error"))
+			mockStorage.EXPECT().Delete(context.Background(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Create(context.Background(), uid, entity.AttachmentOwnerTypeRecord, recordId, body)
+
+			require.Error(t, err)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		attachment := entity.NewAttachment(id, now, uid, entity.AttachmentOwnerTypeRecord, recordId)
+
+		t.Run("正常系_サムネイルを返す", func(t *testing.T) {
+			mockRepository, mockStorage, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(attachment, nil)
+			mockStorage.EXPECT().Get(context.Background(), attachment.ThumbnailKey()).Return(body, nil)
+
+			ret, err := usecase.Download(context.Background(), entity.AttachmentOwnerTypeRecord, recordId, id, true)
+
+			require.NoError(t, err)
+			require.Equal(t, body, ret)
+		})
+
+		t.Run("異常系_別の記録に添付した写真は見つからない扱いにする", func(t *testing.T) {
+			mockRepository, _, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(attachment, nil)
+
+			ret, err := usecase.Download(context.Background(), entity.AttachmentOwnerTypeRecord, "01HD7Y3K8D6FDHMHTZ2GT41TR9", id, false)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_同じIDでも対戦結果の写真としては見つからない扱いにする", func(t *testing.T) {
+			mockRepository, _, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(attachment, nil)
+
+			ret, err := usecase.Download(context.Background(), entity.AttachmentOwnerTypeMatch, recordId, id, false)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		attachment := entity.NewAttachment(id, now, uid, entity.AttachmentOwnerTypeMatch, matchId)
+
+		t.Run("正常系_ストレージから消せなくても行を消せば成功とする", func(t *testing.T) {
+			mockRepository, mockStorage, usecase := setup4AttachmentUsecase(t)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().FindById(context.Background(), id).Return(attachment, nil),
+				mockRepository.EXPECT().Delete(context.Background(), id).Return(nil),
+				mockStorage.EXPECT().Delete(context.Background(), attachment).Return(errors.New("storage error")),
+			)
+
+			err := usecase.Delete(context.Background(), entity.AttachmentOwnerTypeMatch, matchId, id)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("異常系_行を消せなければストレージの写真も消さない", func(t *testing.T) {
+			mockRepository, _, usecase := setup4AttachmentUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(attachment, nil)
+			mockRepository.EXPECT().Delete(context.Background(), id).Return(errors.New("db error"))
+
+			err := usecase.Delete(context.Background(), entity.AttachmentOwnerTypeMatch, matchId, id)
+
+			require.Error(t, err)
+		})
+	})
+}
@@ -74,6 +74,14 @@ type BadgeEvaluationInterface interface {
 		match *entity.Match,
 	) ([]*entity.UserBadge, error)
 
+	// EvaluateOnMatchesCreated は記録と一緒に複数の対戦結果を作成した時、対戦系バッジを
+	// まとめて1度だけ判定する。
+	EvaluateOnMatchesCreated(
+		ctx context.Context,
+		userId string,
+		matches []*entity.Match,
+	) ([]*entity.UserBadge, error)
+
 	// EvaluateOnDeckCreated はデッキ登録時、デッキ系バッジを判定する。
 	EvaluateOnDeckCreated(
 		ctx context.Context,
@@ -105,6 +113,23 @@ type BadgeEvaluationInterface interface {
 		ctx context.Context,
 		userId string,
 	) error
+
+	// EvaluateOnRecordRestored はゴミ箱から記録を復元した時、ストリーク状態を作り直す。
+	// 復元した記録は作成時に判定済みのため、バッジの判定・通知はしない。
+	EvaluateOnRecordRestored(
+		ctx context.Context,
+		userId string,
+	) error
+
+	// EvaluateOnRecordsImported は記録の一括取り込み後、ストリーク状態を作り直して
+	// オンボーディング系バッジを判定し、シーズン系マイルストーンの新規達成を通知する。
+	// 取り込んだ件数によらず1度だけ呼ぶ。
+	EvaluateOnRecordsImported(
+		ctx context.Context,
+		userId string,
+		records []*entity.Record,
+		matches []*entity.Match,
+	) ([]*entity.UserBadge, error)
 }
 
 type BadgeEvaluation struct {
@@ -538,21 +563,22 @@ func (u *BadgeEvaluation) notifyBadgeAchieved(
 	return u.notificationRepo.Save(ctx, notification)
 }
 
-// notifySeasonalCountMilestones は、この1件のCreateでシーズンスコープのcriteriaType別
-// カウントがちょうど閾値をまたいだ(oldCount = newSeasonCount-1 < criteria_value <=
-// newSeasonCount)マイルストーン系定義について通知を作成する。オンボーディング系のaward()と
-// 異なりuser_badgesには永続化しない(シーズンが変わればライブに未達成へ戻る仕様のため)。
+// notifySeasonalCountMilestones は、シーズンスコープのcriteriaType別カウントが
+// oldSeasonCount から newSeasonCount に増えたことで閾値をまたいだ(oldSeasonCount <
+// criteria_value <= newSeasonCount)マイルストーン系定義について通知を作成する。1件のCreate
+// では oldSeasonCount = newSeasonCount-1、一括取り込みでは取り込んだ件数分の幅になる。
+// オンボーディング系のaward()と異なりuser_badgesには永続化しない(シーズンが変われば
+// ライブに未達成へ戻る仕様のため)。
 func (u *BadgeEvaluation) notifySeasonalCountMilestones(
 	ctx context.Context,
 	userId string,
 	definitions []*entity.BadgeDefinition,
 	criteriaType string,
+	oldSeasonCount int,
 	newSeasonCount int,
 	seasonLabel string,
 	achievedAt time.Time,
 ) error {
-	oldSeasonCount := newSeasonCount - 1
-
 	for _, def := range definitions {
 		if def.CriteriaType != criteriaType {
 			continue
@@ -648,7 +674,7 @@ func (u *BadgeEvaluation) notifySeasonalMilestonesOnRecordCreated(
 	}
 
 	if seasonRecordCount, err := u.badgeStatsRepo.CountRecordsByUserId(ctx, userId, fromDate, toDate); err == nil {
-		_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), BadgeCriteriaTypeRecordCount, seasonRecordCount, seasonLabel, createdAt)
+		_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), BadgeCriteriaTypeRecordCount, seasonRecordCount-1, seasonRecordCount, seasonLabel, createdAt)
 	}
 
 	if seasonRecordDates, err := u.badgeStatsRepo.FindRecordDatesByUserId(ctx, userId, fromDate, toDate); err == nil {
@@ -660,11 +686,13 @@ func (u *BadgeEvaluation) notifySeasonalMilestonesOnRecordCreated(
 // マイルストーン系バッジについて新規達成があれば通知する。エラー処理方針は
 // notifySeasonalMilestonesOnRecordCreatedと同様(記録作成自体は失敗させない)。
 // achievedAt は通知のcreated_atに使う実際の達成日時(match/deckの作成日時)。
+// created は今回作成した件数で、作成前の件数を求めるのに使う。
 func (u *BadgeEvaluation) notifySeasonalCountMilestonesForCriteria(
 	ctx context.Context,
 	userId string,
 	definitions []*entity.BadgeDefinition,
 	criteriaType string,
+	created int,
 	achievedAt time.Time,
 ) {
 	now := time.Now().Local()
@@ -695,7 +723,7 @@ func (u *BadgeEvaluation) notifySeasonalCountMilestonesForCriteria(
 		return
 	}
 
-	_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), criteriaType, newSeasonCount, seasonLabel, achievedAt)
+	_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), criteriaType, newSeasonCount-created, newSeasonCount, seasonLabel, achievedAt)
 }
 
 // award は criteriaType に該当する未獲得のバッジ定義のうち、
@@ -809,6 +837,23 @@ func (u *BadgeEvaluation) EvaluateOnRecordCreated(
 func (u *BadgeEvaluation) EvaluateOnRecordDeleted(
 	ctx context.Context,
 	userId string,
+) error {
+	return u.rebuildStreak(ctx, userId)
+}
+
+func (u *BadgeEvaluation) EvaluateOnRecordRestored(
+	ctx context.Context,
+	userId string,
+) error {
+	return u.rebuildStreak(ctx, userId)
+}
+
+// rebuildStreak は現存する記録の日付からストリーク状態(user_streaks)を全期間分作り直す。
+// 記録の削除や過去日付の一括取り込みのように、updateStreak(加算のみの差分更新)では
+// 正しい連続週数にならない場合に使う。
+func (u *BadgeEvaluation) rebuildStreak(
+	ctx context.Context,
+	userId string,
 ) error {
 	dates, err := u.badgeStatsRepo.FindRecordDatesByUserId(ctx, userId, time.Time{}, time.Time{})
 	if err != nil {
@@ -822,11 +867,155 @@ func (u *BadgeEvaluation) EvaluateOnRecordDeleted(
 	return u.userStreakRepo.Save(ctx, streak)
 }
 
+// EvaluateOnRecordsImported は記録の一括取り込み後に1度だけ呼ばれ、ストリーク状態を
+// 作り直したうえでオンボーディング系バッジを判定し、取り込みによってシーズンスコープの
+// 件数が閾値をまたいだマイルストーン系バッジを通知する。1件ごとに EvaluateOnRecordCreated /
+// EvaluateOnMatchCreated を呼ぶと、過去日付の記録でストリークが正しく積み上がらないうえ、
+// 閾値ごとに同じバッジの通知が何度も作られてしまうため、まとめて判定する。
+// 週次ストリーク系バッジは取り込んだ過去の記録で「今週継続した」とは言えないため通知しない
+// (一覧取得時のライブ集計には反映される)。
+func (u *BadgeEvaluation) EvaluateOnRecordsImported(
+	ctx context.Context,
+	userId string,
+	records []*entity.Record,
+	matches []*entity.Match,
+) ([]*entity.UserBadge, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	if err := u.rebuildStreak(ctx, userId); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	definitions, err := u.badgeDefinitionRepo.FindAll(ctx)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	achieved, err := u.achievedBadgeDefinitionIds(ctx, userId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	recordCount, err := u.badgeStatsRepo.CountRecordsByUserId(ctx, userId, time.Time{}, time.Time{})
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 取り込んだ記録・対戦結果は同じ処理時刻で作成されるため、先頭のものを達成日時に使う。
+	awarded, err := u.award(ctx, userId, records[0].ID, onboardingDefinitions(definitions), BadgeCriteriaTypeRecordCount, recordCount, achieved, records[0].CreatedAt)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if len(matches) != 0 {
+		matchCount, err := u.badgeStatsRepo.CountMatchesByUserId(ctx, userId, time.Time{}, time.Time{})
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		matchAwarded, err := u.award(ctx, userId, matches[0].RecordId, onboardingDefinitions(definitions), BadgeCriteriaTypeMatchCount, matchCount, achieved, matches[0].CreatedAt)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		awarded = append(awarded, matchAwarded...)
+	}
+
+	u.notifySeasonalCountMilestonesOnRecordsImported(ctx, userId, definitions, records, matches)
+
+	return awarded, nil
+}
+
+// notifySeasonalCountMilestonesOnRecordsImported は一括取り込みで増えたシーズンスコープの
+// 記録数・対戦数について、取り込み前後の件数の間にある閾値のマイルストーン系バッジを通知する。
+// 取り込み前の件数は、取り込み後の件数から今シーズンに該当する取り込み件数を引いて求める。
+// エラー処理方針は notifySeasonalMilestonesOnRecordCreated と同様(取り込み自体は失敗させない)。
+func (u *BadgeEvaluation) notifySeasonalCountMilestonesOnRecordsImported(
+	ctx context.Context,
+	userId string,
+	definitions []*entity.BadgeDefinition,
+	records []*entity.Record,
+	matches []*entity.Match,
+) {
+	now := time.Now().Local()
+
+	fromDate, toDate, err := seasonRange(ctx, u.championshipSeriesRepo, "", now)
+	if err != nil {
+		logError(ctx, err)
+		return
+	}
+
+	seasonLabel, err := CurrentSeasonLabel(ctx, u.championshipSeriesRepo, now)
+	if err != nil {
+		logWarn(ctx, err)
+		seasonLabel = ""
+	}
+
+	inSeason := func(t time.Time) bool {
+		return !t.Before(fromDate) && t.Before(toDate)
+	}
+
+	// badgeStatsRepo の件数と同じく、記録の event_date でシーズンに含まれるかを判定する。
+	seasonRecordIds := make(map[string]bool, len(records))
+	for _, record := range records {
+		if inSeason(record.EventDate) {
+			seasonRecordIds[record.ID] = true
+		}
+	}
+	if len(seasonRecordIds) == 0 {
+		return
+	}
+
+	importedMatchCount := 0
+	for _, match := range matches {
+		if seasonRecordIds[match.RecordId] {
+			importedMatchCount++
+		}
+	}
+
+	achievedAt := records[0].CreatedAt
+
+	if seasonRecordCount, err := u.badgeStatsRepo.CountRecordsByUserId(ctx, userId, fromDate, toDate); err == nil {
+		_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), BadgeCriteriaTypeRecordCount, seasonRecordCount-len(seasonRecordIds), seasonRecordCount, seasonLabel, achievedAt)
+	}
+
+	if importedMatchCount == 0 {
+		return
+	}
+
+	if seasonMatchCount, err := u.badgeStatsRepo.CountMatchesByUserId(ctx, userId, fromDate, toDate); err == nil {
+		_ = u.notifySeasonalCountMilestones(ctx, userId, milestoneDefinitions(definitions), BadgeCriteriaTypeMatchCount, seasonMatchCount-importedMatchCount, seasonMatchCount, seasonLabel, achievedAt)
+	}
+}
+
 func (u *BadgeEvaluation) EvaluateOnMatchCreated(
 	ctx context.Context,
 	userId string,
 	match *entity.Match,
 ) ([]*entity.UserBadge, error) {
+	return u.EvaluateOnMatchesCreated(ctx, userId, []*entity.Match{match})
+}
+
+// EvaluateOnMatchesCreated は1度に作成した対戦結果をまとめて判定する。1件ずつ
+// EvaluateOnMatchCreated を呼ぶと、閾値をまたいだマイルストーン系バッジの通知が
+// 作成した件数分だけ判定し直されるため、作成前後の件数の間にある閾値を1度で通知する。
+func (u *BadgeEvaluation) EvaluateOnMatchesCreated(
+	ctx context.Context,
+	userId string,
+	matches []*entity.Match,
+) ([]*entity.UserBadge, error) {
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
 	definitions, err := u.badgeDefinitionRepo.FindAll(ctx)
 	if err != nil {
 		logError(ctx, err)
@@ -847,13 +1036,14 @@ func (u *BadgeEvaluation) EvaluateOnMatchCreated(
 
 	// onboarding系(初対戦)の通知を最も古く(=通知一覧の一番下に)するため、onboarding系を
 	// 先に評価する(record作成時のEvaluateOnRecordCreatedと同じ理由)。
-	awarded, err := u.award(ctx, userId, match.RecordId, onboardingDefinitions(definitions), BadgeCriteriaTypeMatchCount, matchCount, achieved, match.CreatedAt)
+	// 一緒に作成した対戦結果は同じ処理時刻で作成されるため、先頭のものを達成日時に使う。
+	awarded, err := u.award(ctx, userId, matches[0].RecordId, onboardingDefinitions(definitions), BadgeCriteriaTypeMatchCount, matchCount, achieved, matches[0].CreatedAt)
 	if err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
 
-	u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeMatchCount, match.CreatedAt)
+	u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeMatchCount, len(matches), matches[0].CreatedAt)
 
 	return awarded, nil
 }
@@ -894,7 +1084,7 @@ func (u *BadgeEvaluation) EvaluateOnDeckCreated(
 	// 見る仕様のため、デッキコード付きで作成された場合のみ判定する。コード無しで作成した
 	// 場合は deck_codes が増えていないため判定不要(むしろ判定すると誤ってカウントされる)。
 	if deck.LatestDeckCode != nil && deck.LatestDeckCode.Code != "" {
-		u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeDeckCodeCount, deck.CreatedAt)
+		u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeDeckCodeCount, 1, deck.CreatedAt)
 	}
 
 	return awarded, nil
@@ -914,7 +1104,7 @@ func (u *BadgeEvaluation) EvaluateOnDeckCodeCreated(
 		return
 	}
 
-	u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeDeckCodeCount, deckCode.CreatedAt)
+	u.notifySeasonalCountMilestonesForCriteria(ctx, userId, definitions, BadgeCriteriaTypeDeckCodeCount, 1, deckCode.CreatedAt)
 }
 
 func (u *BadgeEvaluation) EvaluateOnUserCreated(
@@ -784,6 +784,174 @@ func TestBadgeEvaluation_EvaluateOnRecordDeleted(t *testing.T) {
 	})
 }
 
+func TestBadgeEvaluation_EvaluateOnRecordRestored(t *testing.T) {
+	t.Run("正常系_復元した記録を含めてストリークを作り直す", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		u, _, _, userStreakRepo, badgeStatsRepo, _, _ := newBadgeEvaluationTestUsecase(mockCtrl)
+
+		// 途中の週の記録を復元して、途切れていた連続が3週に戻った想定
+		dates := []time.Time{
+			time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, 6, 8, 0, 0, 0, 0, time.Local),
+			time.Date(2026, 6, 15, 0, 0, 0, 0, time.Local),
+		}
+		badgeStatsRepo.EXPECT().FindRecordDatesByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(dates, nil)
+
+		userStreakRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, streak *entity.UserStreak) error {
+				require.Equal(t, 3, streak.CurrentWeeks)
+				require.Equal(t, 3, streak.LongestWeeks)
+				require.Equal(t, mondayOf(dates[2]), streak.LastRecordedWeek)
+				return nil
+			},
+		)
+
+		err := u.EvaluateOnRecordRestored(context.Background(), "user-1")
+
+		require.NoError(t, err)
+	})
+}
+
+func TestBadgeEvaluation_EvaluateOnMatchesCreated(t *testing.T) {
+	t.Run("正常系_作成前後の件数の間にある閾値をまとめて判定する", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		u, badgeDefinitionRepo, userBadgeRepo, _, badgeStatsRepo, notificationRepo, championshipSeriesRepo := newBadgeEvaluationTestUsecase(mockCtrl)
+
+		now := time.Now()
+		definitions := []*entity.BadgeDefinition{
+			entity.NewBadgeDefinition("def-first-match", "first_match", "onboarding", "初対戦", "", "", BadgeCriteriaTypeMatchCount, 1, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-match-2", "match_count_2", "milestone", "対戦2回", "", "", BadgeCriteriaTypeMatchCount, 2, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-match-3", "match_count_3", "milestone", "対戦3回", "", "", BadgeCriteriaTypeMatchCount, 3, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-match-10", "match_count_10", "milestone", "対戦10回", "", "", BadgeCriteriaTypeMatchCount, 10, time.Time{}, time.Time{}, now, now),
+		}
+		matches := []*entity.Match{
+			entity.NewMatch("match-1", now, "record-1", "", "", "user-1", "", false, false, false, false, false, false, true, false, false, "", "", nil, nil),
+			entity.NewMatch("match-2", now, "record-1", "", "", "user-1", "", false, false, false, false, false, false, false, false, false, "", "", nil, nil),
+			entity.NewMatch("match-3", now, "record-1", "", "", "user-1", "", false, false, false, false, false, false, true, false, false, "", "", nil, nil),
+		}
+
+		badgeDefinitionRepo.EXPECT().FindAll(gomock.Any()).Return(definitions, nil)
+		userBadgeRepo.EXPECT().FindByUserId(gomock.Any(), "user-1").Return(nil, nil)
+		badgeStatsRepo.EXPECT().CountMatchesByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(4, nil)
+		userBadgeRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		championshipSeriesRepo.EXPECT().FindByDate(gomock.Any(), gomock.Any()).Return(currentChampionshipSeries(), nil).Times(2)
+		badgeStatsRepo.EXPECT().CountMatchesByUserId(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(4, nil)
+
+		// 初対戦 + 対戦2回・3回の通知(作成前は1件)。対戦10回には届かないため通知しない
+		notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+		awarded, err := u.EvaluateOnMatchesCreated(context.Background(), "user-1", matches)
+
+		require.NoError(t, err)
+		require.Len(t, awarded, 1)
+		require.Equal(t, "def-first-match", awarded[0].BadgeDefinitionId)
+	})
+
+	t.Run("正常系_対戦結果が無ければ何もしない", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		u, _, _, _, _, _, _ := newBadgeEvaluationTestUsecase(mockCtrl)
+
+		awarded, err := u.EvaluateOnMatchesCreated(context.Background(), "user-1", nil)
+
+		require.NoError(t, err)
+		require.Empty(t, awarded)
+	})
+}
+
+func TestBadgeEvaluation_EvaluateOnRecordsImported(t *testing.T) {
+	t.Run("正常系_取り込み前後の件数の間にある閾値をまとめて判定する", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		u, badgeDefinitionRepo, userBadgeRepo, userStreakRepo, badgeStatsRepo, notificationRepo, championshipSeriesRepo := newBadgeEvaluationTestUsecase(mockCtrl)
+
+		now := time.Now()
+		definitions := []*entity.BadgeDefinition{
+			entity.NewBadgeDefinition("def-first-record", "first_record", "onboarding", "はじめの記録", "", "", BadgeCriteriaTypeRecordCount, 1, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-first-match", "first_match", "onboarding", "初対戦", "", "", BadgeCriteriaTypeMatchCount, 1, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-record-3", "record_count_3", "milestone", "記録3回", "", "", BadgeCriteriaTypeRecordCount, 3, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-record-10", "record_count_10", "milestone", "記録10回", "", "", BadgeCriteriaTypeRecordCount, 10, time.Time{}, time.Time{}, now, now),
+			entity.NewBadgeDefinition("def-match-2", "match_count_2", "milestone", "対戦2回", "", "", BadgeCriteriaTypeMatchCount, 2, time.Time{}, time.Time{}, now, now),
+		}
+
+		eventDate := time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local)
+		records := []*entity.Record{
+			entity.NewRecord("record-1", now, 1, "", "", "", "user-1", "", "", eventDate, false, false, entity.RegulationIdStandard, "", ""),
+			entity.NewRecord("record-2", now, 0, "", "friend", "", "user-1", "", "", eventDate.AddDate(0, 0, 7), false, false, entity.RegulationIdStandard, "", ""),
+			entity.NewRecord("record-3", now, 0, "", "friend", "", "user-1", "", "", eventDate.AddDate(0, 0, 14), false, false, entity.RegulationIdStandard, "", ""),
+		}
+		matches := []*entity.Match{
+			entity.NewMatch("match-1", now, "record-1", "", "", "user-1", "", false, false, false, false, false, false, true, false, false, "", "", nil, nil),
+			entity.NewMatch("match-2", now, "record-2", "", "", "user-1", "", false, false, false, false, false, false, false, false, false, "", "", nil, nil),
+		}
+
+		// 過去日付の記録でも正しい連続週数になるよう、ストリークは全期間分作り直す
+		badgeStatsRepo.EXPECT().FindRecordDatesByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(
+			[]time.Time{eventDate, eventDate.AddDate(0, 0, 7), eventDate.AddDate(0, 0, 14)}, nil,
+		)
+		userStreakRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, streak *entity.UserStreak) error {
+				require.Equal(t, 3, streak.LongestWeeks)
+				return nil
+			},
+		)
+
+		badgeDefinitionRepo.EXPECT().FindAll(gomock.Any()).Return(definitions, nil)
+		userBadgeRepo.EXPECT().FindByUserId(gomock.Any(), "user-1").Return(nil, nil)
+		badgeStatsRepo.EXPECT().CountRecordsByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(3, nil)
+		badgeStatsRepo.EXPECT().CountMatchesByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(2, nil)
+		userBadgeRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		championshipSeriesRepo.EXPECT().FindByDate(gomock.Any(), gomock.Any()).Return(currentChampionshipSeries(), nil).Times(2)
+		badgeStatsRepo.EXPECT().CountRecordsByUserId(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(3, nil)
+		badgeStatsRepo.EXPECT().CountMatchesByUserId(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(2, nil)
+
+		// オンボーディング2件 + 記録3回・対戦2回の通知。記録10回には届かないため通知しない
+		var notified []string
+		notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, n *entity.Notification) error {
+				notified = append(notified, n.Body)
+				return nil
+			},
+		).Times(4)
+
+		awarded, err := u.EvaluateOnRecordsImported(context.Background(), "user-1", records, matches)
+
+		require.NoError(t, err)
+		require.Len(t, awarded, 2)
+		require.Equal(t, "def-first-record", awarded[0].BadgeDefinitionId)
+		require.Equal(t, "def-first-match", awarded[1].BadgeDefinitionId)
+		require.Len(t, notified, 4)
+	})
+
+	t.Run("正常系_今シーズン外の記録だけならシーズン系の通知はしない", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		u, badgeDefinitionRepo, userBadgeRepo, userStreakRepo, badgeStatsRepo, _, championshipSeriesRepo := newBadgeEvaluationTestUsecase(mockCtrl)
+
+		now := time.Now()
+		definitions := []*entity.BadgeDefinition{
+			entity.NewBadgeDefinition("def-record-1", "record_count_1", "milestone", "記録1回", "", "", BadgeCriteriaTypeRecordCount, 1, time.Time{}, time.Time{}, now, now),
+		}
+
+		eventDate := time.Date(2024, 6, 2, 0, 0, 0, 0, time.Local)
+		records := []*entity.Record{
+			entity.NewRecord("record-1", now, 0, "", "friend", "", "user-1", "", "", eventDate, false, false, entity.RegulationIdStandard, "", ""),
+		}
+
+		badgeStatsRepo.EXPECT().FindRecordDatesByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return([]time.Time{eventDate}, nil)
+		userStreakRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		badgeDefinitionRepo.EXPECT().FindAll(gomock.Any()).Return(definitions, nil)
+		userBadgeRepo.EXPECT().FindByUserId(gomock.Any(), "user-1").Return(nil, nil)
+		badgeStatsRepo.EXPECT().CountRecordsByUserId(gomock.Any(), "user-1", time.Time{}, time.Time{}).Return(1, nil)
+		championshipSeriesRepo.EXPECT().FindByDate(gomock.Any(), gomock.Any()).Return(currentChampionshipSeries(), nil).Times(2)
+		// シーズン内の件数は数えず、notificationRepo.Save も呼ばれない
+
+		awarded, err := u.EvaluateOnRecordsImported(context.Background(), "user-1", records, nil)
+
+		require.NoError(t, err)
+		require.Empty(t, awarded)
+	})
+}
+
 func TestComputeStreakState(t *testing.T) {
 	t.Run("正常系_記録が無ければ全てゼロ値", func(t *testing.T) {
 		currentWeeks, longestWeeks, freezeUsedCount, _, lastRecordedWeek := ComputeStreakState(nil)
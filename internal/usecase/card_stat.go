@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type CardStatInterface interface {
+	// GetUserCardStat はユーザーのデッキコードに入っていたカードごとに、採用時/不採用時の
+	// 成績を返す。期間・レギュレーションの指定は UserStat.GetUserStat と同じ。
+	GetUserCardStat(
+		ctx context.Context,
+		userId string,
+		yearMonth string,
+		environmentId string,
+		season string,
+		standardRegulationId string,
+		regulationId uint,
+	) (*entity.UserCardStat, error)
+}
+
+type CardStat struct {
+	cardStatRepo           repository.CardStatInterface
+	deckCodeRepo           repository.DeckCodeInterface
+	deckCodeCardRepo       repository.DeckCodeCardInterface
+	deckAsset              repository.DeckAssetInterface
+	environmentRepo        repository.EnvironmentInterface
+	standardRegulationRepo repository.StandardRegulationInterface
+	championshipSeriesRepo repository.ChampionshipSeriesInterface
+}
+
+func NewCardStat(
+	cardStatRepo repository.CardStatInterface,
+	deckCodeRepo repository.DeckCodeInterface,
+	deckCodeCardRepo repository.DeckCodeCardInterface,
+	deckAsset repository.DeckAssetInterface,
+	environmentRepo repository.EnvironmentInterface,
+	standardRegulationRepo repository.StandardRegulationInterface,
+	championshipSeriesRepo repository.ChampionshipSeriesInterface,
+) CardStatInterface {
+	return &CardStat{
+		cardStatRepo:           cardStatRepo,
+		deckCodeRepo:           deckCodeRepo,
+		deckCodeCardRepo:       deckCodeCardRepo,
+		deckAsset:              deckAsset,
+		environmentRepo:        environmentRepo,
+		standardRegulationRepo: standardRegulationRepo,
+		championshipSeriesRepo: championshipSeriesRepo,
+	}
+}
+
+func (u *CardStat) GetUserCardStat(
+	ctx context.Context,
+	userId string,
+	yearMonth string,
+	environmentId string,
+	season string,
+	standardRegulationId string,
+	regulationId uint,
+) (*entity.UserCardStat, error) {
+	fromDate, toDate, err := userStatDateRange(
+		ctx,
+		u.environmentRepo,
+		u.standardRegulationRepo,
+		u.championshipSeriesRepo,
+		yearMonth,
+		environmentId,
+		season,
+		standardRegulationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates, err := u.cardStatRepo.FindDeckCodeAggregates(ctx, userId, fromDate, toDate, regulationId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	deckCodeIds := make([]string, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		deckCodeIds = append(deckCodeIds, aggregate.DeckCodeId)
+	}
+
+	cards, err := u.deckCodeCardRepo.FindByDeckCodeIds(ctx, deckCodeIds)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	cards = append(cards, u.syncMissingDeckCodeCards(ctx, deckCodeIds, cards)...)
+
+	return entity.CalculateUserCardStat(userId, aggregates, cards), nil
+}
+
+// syncMissingDeckCodeCards は、カード構成がまだ保存されていないデッキコードについて
+// アップロード済みのデッキ結果HTMLを解析して保存し、そのカード構成を返す。
+//
+// 解析できなかったデッキコードは採用/不採用を判定できないだけなので、集計全体は失敗させず
+// 警告を残して母数から外す。
+func (u *CardStat) syncMissingDeckCodeCards(
+	ctx context.Context,
+	deckCodeIds []string,
+	cards []*entity.DeckCodeCard,
+) []*entity.DeckCodeCard {
+	synced := map[string]bool{}
+	for _, card := range cards {
+		synced[card.DeckCodeId] = true
+	}
+
+	var ret []*entity.DeckCodeCard
+	for _, deckCodeId := range deckCodeIds {
+		if synced[deckCodeId] {
+			continue
+		}
+
+		deckCode, err := u.deckCodeRepo.FindById(ctx, deckCodeId)
+		if err != nil {
+			logWarn(ctx, err)
+			continue
+		}
+
+		if deckCode.Code == "" {
+			continue
+		}
+
+		deckCodeCards, err := syncDeckCodeCards(ctx, u.deckAsset, u.deckCodeCardRepo, deckCode)
+		if err != nil {
+			logWarn(ctx, err)
+			continue
+		}
+
+		ret = append(ret, deckCodeCards...)
+	}
+
+	return ret
+}
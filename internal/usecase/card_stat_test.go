@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func setup4CardStatUsecase(t *testing.T) (
+	*mock_repository.MockCardStatInterface,
+	*mock_repository.MockDeckCodeInterface,
+	*mock_repository.MockDeckCodeCardInterface,
+	*mock_repository.MockDeckAssetInterface,
+	CardStatInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockCardStatRepo := mock_repository.NewMockCardStatInterface(mockCtrl)
+	mockDeckCodeRepo := mock_repository.NewMockDeckCodeInterface(mockCtrl)
+	mockDeckCodeCardRepo := mock_repository.NewMockDeckCodeCardInterface(mockCtrl)
+	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockEnvironmentRepo := mock_repository.NewMockEnvironmentInterface(mockCtrl)
+	mockRegulationRepo := mock_repository.NewMockStandardRegulationInterface(mockCtrl)
+	mockSeriesRepo := mock_repository.NewMockChampionshipSeriesInterface(mockCtrl)
+
+	usecase := NewCardStat(
+		mockCardStatRepo,
+		mockDeckCodeRepo,
+		mockDeckCodeCardRepo,
+		mockDeckAsset,
+		mockEnvironmentRepo,
+		mockRegulationRepo,
+		mockSeriesRepo,
+	)
+
+	return mockCardStatRepo, mockDeckCodeRepo, mockDeckCodeCardRepo, mockDeckAsset, usecase
+}
+
+func TestCardStatUsecase_GetUserCardStat(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	fromDate := time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local)
+	toDate := time.Date(2026, 7, 1, 0, 0, 0, 0, time.Local)
+
+	t.Run("正常系_保存済みのカード構成と突き合わせて集計する", func(t *testing.T) {
+		mockCardStatRepo, _, mockDeckCodeCardRepo, _, usecase := setup4CardStatUsecase(t)
+
+		mockCardStatRepo.EXPECT().FindDeckCodeAggregates(context.Background(), userId, fromDate, toDate, uint(1)).Return([]*entity.CardStatDeckCodeAggregate{
+			entity.NewCardStatDeckCodeAggregate("v1", 2, 1, 0, 2, 1, 1, 0),
+		}, nil)
+		mockDeckCodeCardRepo.EXPECT().FindByDeckCodeIds(context.Background(), []string{"v1"}).Return([]*entity.DeckCodeCard{
+			entity.NewDeckCodeCard("v1", 1, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+		}, nil)
+
+		ret, err := usecase.GetUserCardStat(context.Background(), userId, "2026-06", "", "", "", 1)
+
+		require.NoError(t, err)
+		require.Equal(t, uint(2), ret.MatchCount)
+		require.Len(t, ret.Cards, 1)
+		require.Equal(t, uint(2), ret.Cards[0].With.MatchCount)
+	})
+
+	t.Run("正常系_カード構成が未保存のデッキコードはHTMLを解析して補う", func(t *testing.T) {
+		mockCardStatRepo, mockDeckCodeRepo, mockDeckCodeCardRepo, mockDeckAsset, usecase := setup4CardStatUsecase(t)
+
+		code := "5dbFbk-uBwjqP-VVk5Vv"
+		parsed := []*entity.DeckCodeCard{
+			entity.NewDeckCodeCard("", 1, "", entity.DeckCardCategoryPokemon, 2),
+		}
+		stored := []*entity.DeckCodeCard{
+			entity.NewDeckCodeCard("v2", 1, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+		}
+
+		mockCardStatRepo.EXPECT().FindDeckCodeAggregates(context.Background(), userId, fromDate, toDate, uint(0)).Return([]*entity.CardStatDeckCodeAggregate{
+			entity.NewCardStatDeckCodeAggregate("v2", 3, 3, 0, 3, 2, 2, 1),
+		}, nil)
+		gomock.InOrder(
+			mockDeckCodeCardRepo.EXPECT().FindByDeckCodeIds(context.Background(), []string{"v2"}).Return([]*entity.DeckCodeCard{}, nil),
+			mockDeckCodeRepo.EXPECT().FindById(context.Background(), "v2").Return(&entity.DeckCode{ID: "v2", Code: code}, nil),
+			mockDeckAsset.EXPECT().FindDeckCards(context.Background(), code).Return(parsed, nil),
+			mockDeckCodeCardRepo.EXPECT().Replace(context.Background(), "v2", parsed).Return(nil),
+			mockDeckCodeCardRepo.EXPECT().FindByDeckCodeId(context.Background(), "v2").Return(stored, nil),
+		)
+
+		ret, err := usecase.GetUserCardStat(context.Background(), userId, "2026-06", "", "", "", 0)
+
+		require.NoError(t, err)
+		require.Equal(t, uint(3), ret.MatchCount)
+		require.Len(t, ret.Cards, 1)
+	})
+
+	t.Run("正常系_解析できなかったデッキコードは母数から外して集計を続ける", func(t *testing.T) {
+		mockCardStatRepo, mockDeckCodeRepo, mockDeckCodeCardRepo, mockDeckAsset, usecase := setup4CardStatUsecase(t)
+
+		code := "5dbFbk-uBwjqP-VVk5Vv"
+
+		mockCardStatRepo.EXPECT().FindDeckCodeAggregates(context.Background(), userId, fromDate, toDate, uint(0)).Return([]*entity.CardStatDeckCodeAggregate{
+			entity.NewCardStatDeckCodeAggregate("v3", 3, 3, 0, 3, 2, 2, 1),
+		}, nil)
+		mockDeckCodeCardRepo.EXPECT().FindByDeckCodeIds(context.Background(), []string{"v3"}).Return([]*entity.DeckCodeCard{}, nil)
+		mockDeckCodeRepo.EXPECT().FindById(context.Background(), "v3").Return(&entity.DeckCode{ID: "v3", Code: code}, nil)
+		mockDeckAsset.EXPECT().FindDeckCards(context.Background(), code).Return(nil, errors.New(""))
+
+		ret, err := usecase.GetUserCardStat(context.Background(), userId, "2026-06", "", "", "", 0)
+
+		require.NoError(t, err)
+		require.Equal(t, uint(0), ret.MatchCount)
+		require.Empty(t, ret.Cards)
+	})
+
+	t.Run("異常系_集計のエラーをそのまま返す", func(t *testing.T) {
+		mockCardStatRepo, _, _, _, usecase := setup4CardStatUsecase(t)
+
+		mockCardStatRepo.EXPECT().FindDeckCodeAggregates(context.Background(), userId, fromDate, toDate, uint(0)).Return(nil, errors.New(""))
+
+		_, err := usecase.GetUserCardStat(context.Background(), userId, "2026-06", "", "", "", 0)
+
+		require.Error(t, err)
+	})
+}
@@ -17,10 +17,13 @@ import (
 const MaxFavoriteDecksPerUser = 1
 
 type DeckCreateParam struct {
-	UserId             string
-	Name               string
-	PrivateFlg         bool
-	DeckCode           string
+	UserId     string
+	Name       string
+	PrivateFlg bool
+	DeckCode   string
+	// DeckList はデッキコードの代わりに取り込むテキスト形式のデッキリスト。
+	// DeckCode とはどちらか一方だけを指定する(どちらも空ならバージョン無しで作成する)。
+	DeckList           string
 	PrivateDeckCodeFlg bool
 	PokemonSprites     []*PokemonSpriteParam
 	TagIds             []string
@@ -31,6 +34,7 @@ func NewDeckCreateParam(
 	name string,
 	privateFlg bool,
 	deckcode string,
+	deckList string,
 	privateDeckCodeFlg bool,
 	pokemonSprites []*PokemonSpriteParam,
 	tagIds []string,
@@ -40,6 +44,7 @@ func NewDeckCreateParam(
 		Name:               name,
 		PrivateFlg:         privateFlg,
 		DeckCode:           deckcode,
+		DeckList:           deckList,
 		PrivateDeckCodeFlg: privateDeckCodeFlg,
 		PokemonSprites:     pokemonSprites,
 		TagIds:             tagIds,
@@ -106,6 +111,13 @@ type DeckInterface interface {
 		cursor time.Time,
 	) ([]*entity.Deck, error)
 
+	FindPublicByUserId(
+		ctx context.Context,
+		uid string,
+		limit int,
+		offset int,
+	) ([]*entity.Deck, error)
+
 	Create(
 		ctx context.Context,
 		param *DeckCreateParam,
@@ -145,7 +157,9 @@ type DeckInterface interface {
 
 type Deck struct {
 	repository         repository.DeckInterface
-	deckAsset          repository.DeckAssetInterface
+	deckAssetJob       repository.DeckAssetJobInterface
+	deckCodeCard       repository.DeckCodeCardInterface
+	card               repository.CardInterface
 	userFavoriteDeck   repository.UserFavoriteDeckInterface
 	tag                repository.TagInterface
 	transactionManager repository.TransactionManager
@@ -154,7 +168,9 @@ type Deck struct {
 
 func NewDeck(
 	repository repository.DeckInterface,
-	deckAsset repository.DeckAssetInterface,
+	deckAssetJob repository.DeckAssetJobInterface,
+	deckCodeCard repository.DeckCodeCardInterface,
+	card repository.CardInterface,
 	userFavoriteDeck repository.UserFavoriteDeckInterface,
 	tag repository.TagInterface,
 	transactionManager repository.TransactionManager,
@@ -162,7 +178,9 @@ func NewDeck(
 ) DeckInterface {
 	return &Deck{
 		repository,
-		deckAsset,
+		deckAssetJob,
+		deckCodeCard,
+		card,
 		userFavoriteDeck,
 		tag,
 		transactionManager,
@@ -272,6 +290,22 @@ func (u *Deck) FindByUserId(
 	return decks, nil
 }
 
+func (u *Deck) FindPublicByUserId(
+	ctx context.Context,
+	uid string,
+	limit int,
+	offset int,
+) ([]*entity.Deck, error) {
+	decks, err := u.repository.FindPublicByUserId(ctx, uid, limit, offset)
+
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return decks, nil
+}
+
 func (u *Deck) FindByUserIdOnCursor(
 	ctx context.Context,
 	uid string,
@@ -304,7 +338,7 @@ func (u *Deck) Create(
 	favoritedAt := time.Time{}
 	LatestDeckCode := entity.NewDeckCode("", time.Time{}, "", "", "", false, "")
 
-	if param.DeckCode != "" {
+	if param.DeckCode != "" || param.DeckList != "" {
 		deckCodeId, err := generateId()
 		if err != nil {
 			logError(ctx, err)
@@ -321,23 +355,6 @@ func (u *Deck) Create(
 			param.PrivateDeckCodeFlg,
 			memo,
 		)
-
-		if LatestDeckCode.Code != "" {
-			// 先にデッキコードのHTMLページをアップロードする。
-			// デッキ画像のアップロードでは、そのデッキコードが存在するか確認することができないが、
-			// デッキコードのHTMLページのアップロードでは、デッキコードが正しいかどうかを確認することができるため、
-			// 先にデッキコードのHTMLページをアップロードすることで、デッキコードが正しいかどうかを確認することができる。
-			// アップロードに失敗した場合はデッキ作成を中止する。
-			if err := u.deckAsset.UploadDeckResultHTML(ctx, LatestDeckCode.Code); err != nil {
-				logError(ctx, err)
-				return nil, err
-			}
-
-			if err := u.deckAsset.UploadDeckImage(ctx, LatestDeckCode.Code); err != nil {
-				logError(ctx, err)
-				return nil, err
-			}
-		}
 	}
 
 	var pokemonSprites []*entity.PokemonSprite
@@ -360,11 +377,50 @@ func (u *Deck) Create(
 		pokemonSprites,
 	)
 
-	if err := u.repository.Save(ctx, deck); err != nil {
+	// リソースの取得ジョブ・取り込んだデッキリストのカード構成は DeckCode.Create と同じく、
+	// デッキ本体と同じトランザクションで保存する。
+	jobs, err := newDeckAssetJobs(LatestDeckCode, createdAt)
+	if err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
 
+	var importedCards []*entity.DeckCodeCard
+	if param.DeckList != "" {
+		importedCards, err = resolveDeckList(ctx, u.card, LatestDeckCode.ID, param.DeckList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Save(ctx, deck); err != nil {
+			logError(ctx, err)
+			return err
+		}
+
+		if len(jobs) > 0 {
+			if err := u.deckAssetJob.Enqueue(ctx, jobs); err != nil {
+				logError(ctx, err)
+				return err
+			}
+		}
+
+		if importedCards == nil {
+			return nil
+		}
+
+		if err := u.deckCodeCard.Replace(ctx, LatestDeckCode.ID, importedCards); err != nil {
+			logError(ctx, err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	LatestDeckCode.Cards = importedCards
+
 	// タグの付与はデッキ本体とは別テーブルのため Save とは分けて反映する。
 	tags, err := u.syncDeckTags(ctx, deck.ID, param.UserId, param.TagIds)
 	if err != nil {
@@ -429,6 +485,8 @@ func (u *Deck) Update(
 		ret.LatestDeckCode,
 		pokemonSprites,
 	)
+	// 読み込んだ時点の版を引き継ぎ、他の端末での更新を黙って上書きしない
+	deck.UpdatedAt = ret.UpdatedAt
 
 	if err := u.repository.Save(ctx, deck); err != nil {
 		logError(ctx, err)
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// deckAssetJobStaleTimeout を過ぎても実行中のままのジョブは、処理中にプロセスが
+// 落ちたものとみなして取り出し直す。公式サイトからの取得とアップロードは
+// 通常数秒で終わるため、十分に長くとっている。
+const deckAssetJobStaleTimeout = 10 * time.Minute
+
+type DeckAssetJobInterface interface {
+	// Process は実行できるジョブを最大 limit 件取り出して処理し、取り出した件数を返す。
+	// 個々のジョブの失敗はジョブに記録して再実行を予約し、エラーとしては返さない。
+	// デッキコードが正しくないと分かったジョブは不正(invalid)として以降は実行しない。
+	Process(
+		ctx context.Context,
+		limit int,
+	) (int, error)
+
+	FindByDeckCodeId(
+		ctx context.Context,
+		deckCodeId string,
+	) ([]*entity.DeckAssetJob, error)
+}
+
+type DeckAssetJob struct {
+	repository   repository.DeckAssetJobInterface
+	deckAsset    repository.DeckAssetInterface
+	deckCodeCard repository.DeckCodeCardInterface
+}
+
+func NewDeckAssetJob(
+	repository repository.DeckAssetJobInterface,
+	deckAsset repository.DeckAssetInterface,
+	deckCodeCard repository.DeckCodeCardInterface,
+) DeckAssetJobInterface {
+	return &DeckAssetJob{repository, deckAsset, deckCodeCard}
+}
+
+// newDeckAssetJobs は deckCode のリソースを取得するジョブを種類ごとに作る。
+// デッキコードを持たない(デッキリストから取り込んだ)場合は作らない。
+func newDeckAssetJobs(
+	deckCode *entity.DeckCode,
+	createdAt time.Time,
+) ([]*entity.DeckAssetJob, error) {
+	if deckCode.Code == "" {
+		return nil, nil
+	}
+
+	jobs := make([]*entity.DeckAssetJob, 0, len(entity.DeckAssetJobKinds))
+	for _, kind := range entity.DeckAssetJobKinds {
+		id, err := generateId()
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, entity.NewDeckAssetJob(id, createdAt, deckCode.ID, deckCode.Code, kind))
+	}
+
+	return jobs, nil
+}
+
+func (u *DeckAssetJob) Process(
+	ctx context.Context,
+	limit int,
+) (int, error) {
+	now := timeNow().Local()
+
+	jobs, err := u.repository.Claim(ctx, now, now.Add(-deckAssetJobStaleTimeout), limit)
+	if err != nil {
+		logError(ctx, err)
+		return 0, err
+	}
+
+	// 同じ取り出し分で不正と分かったデッキコード。残りのジョブ(画像)は取得せずに不正にする。
+	invalidDeckCodeIds := map[string]bool{}
+
+	for _, job := range jobs {
+		if invalidDeckCodeIds[job.DeckCodeId] {
+			job.Invalidate(timeNow().Local(), apperror.ErrDeckCodeInvalid)
+		} else if err := u.run(ctx, job); err != nil {
+			if errors.Is(err, apperror.ErrDeckCodeInvalid) {
+				// 不正なデッキコードは何度取得しても結果が変わらないため、リトライせず不正として記録する。
+				// 同じデッキコードの実行待ちのジョブもあわせて不正にする。
+				job.Invalidate(timeNow().Local(), err)
+				invalidDeckCodeIds[job.DeckCodeId] = true
+
+				if err := u.repository.InvalidateByDeckCodeId(ctx, job.DeckCodeId, timeNow().Local(), err.Error()); err != nil {
+					logError(ctx, err)
+				}
+			} else {
+				job.Fail(timeNow().Local(), err)
+			}
+
+			logWarn(ctx, err)
+		} else {
+			job.Succeed(timeNow().Local())
+		}
+
+		// 保存に失敗したジョブは実行中のまま残り、deckAssetJobStaleTimeout を過ぎてから取り出し直される。
+		// 取り出し済みの他のジョブまで巻き込まないよう、記録だけして続ける。
+		if err := u.repository.Save(ctx, job); err != nil {
+			logError(ctx, err)
+		}
+	}
+
+	return len(jobs), nil
+}
+
+// run はジョブの種類に応じてリソースを取得・配置する。
+func (u *DeckAssetJob) run(
+	ctx context.Context,
+	job *entity.DeckAssetJob,
+) error {
+	switch job.Kind {
+	case entity.DeckAssetJobKindResultHTML:
+		if err := u.deckAsset.UploadDeckResultHTML(ctx, job.Code); err != nil {
+			return err
+		}
+
+		// カード構成の保存はベストエフォート(解析できなければ参照時に改めて解析する)。
+		deckCode := &entity.DeckCode{ID: job.DeckCodeId, Code: job.Code}
+		if _, err := syncDeckCodeCards(ctx, u.deckAsset, u.deckCodeCard, deckCode); err != nil {
+			logWarn(ctx, err)
+		}
+
+		return nil
+	case entity.DeckAssetJobKindImage:
+		return u.deckAsset.UploadDeckImage(ctx, job.Code)
+	default:
+		return errors.New("unknown deck asset job kind: " + string(job.Kind))
+	}
+}
+
+func (u *DeckAssetJob) FindByDeckCodeId(
+	ctx context.Context,
+	deckCodeId string,
+) ([]*entity.DeckAssetJob, error) {
+	jobs, err := u.repository.FindByDeckCodeId(ctx, deckCodeId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func setup4DeckAssetJobUsecase(t *testing.T) (
+	*mock_repository.MockDeckAssetJobInterface,
+	*mock_repository.MockDeckAssetInterface,
+	*mock_repository.MockDeckCodeCardInterface,
+	DeckAssetJobInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockDeckAssetJobInterface(mockCtrl)
+	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockDeckCodeCard := mock_repository.NewMockDeckCodeCardInterface(mockCtrl)
+
+	usecase := NewDeckAssetJob(mockRepository, mockDeckAsset, mockDeckCodeCard)
+
+	return mockRepository, mockDeckAsset, mockDeckCodeCard, usecase
+}
+
+func TestDeckAssetJobUsecase(t *testing.T) {
+	deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TN9"
+	code := "5dbFbk-uBwjqP-VVk5Vv"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	// Claim で取り出された直後の(実行中・試行回数を数えた)ジョブを作る。
+	newClaimedJob := func(kind entity.DeckAssetJobKind, attempts uint) *entity.DeckAssetJob {
+		job := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR1", now, deckCodeId, code, kind)
+		job.Status = entity.DeckAssetJobStatusRunning
+		job.Attempts = attempts
+		return job
+	}
+
+	t.Run("Process", func(t *testing.T) {
+		t.Run("正常系_結果HTMLを配置してカード構成を保存し完了にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, mockDeckCodeCard, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindResultHTML, 1)
+			cards := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard("", 47003, "", entity.DeckCardCategoryPokemon, 2),
+			}
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), now, now.Add(-deckAssetJobStaleTimeout), 10).Return([]*entity.DeckAssetJob{job}, nil),
+				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(nil),
+				mockDeckAsset.EXPECT().FindDeckCards(context.Background(), code).Return(cards, nil),
+				mockDeckCodeCard.EXPECT().Replace(context.Background(), deckCodeId, cards).Return(nil),
+				mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return(cards, nil),
+				mockRepository.EXPECT().Save(context.Background(), job).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			require.Equal(t, entity.DeckAssetJobStatusSucceeded, job.Status)
+			require.Equal(t, deckCodeId, cards[0].DeckCodeId)
+		})
+
+		t.Run("正常系_カード構成の保存に失敗しても結果HTMLのジョブは完了にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindResultHTML, 1)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{job}, nil),
+				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(nil),
+				mockDeckAsset.EXPECT().FindDeckCards(context.Background(), code).Return(nil, errors.New("")),
+				mockRepository.EXPECT().Save(context.Background(), job).Return(nil),
+			)
+
+			_, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.DeckAssetJobStatusSucceeded, job.Status)
+		})
+
+		t.Run("正常系_画像の取得に失敗したらバックオフして再実行を待つ", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindImage, 2)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{job}, nil),
+				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), code).Return(errors.New("timeout")),
+				mockRepository.EXPECT().Save(context.Background(), job).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			require.Equal(t, entity.DeckAssetJobStatusPending, job.Status)
+			require.Equal(t, now.Add(entity.DeckAssetJobBackoff(2)), job.NextRunAt)
+			require.Equal(t, "timeout", job.LastError)
+		})
+
+		t.Run("正常系_不正なデッキコードはリトライせず同じデッキコードのジョブごと不正にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindResultHTML, 1)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{job}, nil),
+				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(apperror.ErrDeckCodeInvalid),
+				mockRepository.EXPECT().InvalidateByDeckCodeId(context.Background(), deckCodeId, now, apperror.ErrDeckCodeInvalid.Error()).Return(nil),
+				mockRepository.EXPECT().Save(context.Background(), job).Return(nil),
+			)
+
+			_, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.DeckAssetJobStatusInvalid, job.Status)
+		})
+
+		t.Run("正常系_同じ取り出し分で不正と分かったデッキコードの画像は取得しない", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			htmlJob := newClaimedJob(entity.DeckAssetJobKindResultHTML, 1)
+			imageJob := newClaimedJob(entity.DeckAssetJobKindImage, 1)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{htmlJob, imageJob}, nil),
+				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(apperror.ErrDeckCodeInvalid),
+				mockRepository.EXPECT().InvalidateByDeckCodeId(context.Background(), deckCodeId, now, gomock.Any()).Return(nil),
+				mockRepository.EXPECT().Save(context.Background(), htmlJob).Return(nil),
+				mockRepository.EXPECT().Save(context.Background(), imageJob).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, 2, n)
+			require.Equal(t, entity.DeckAssetJobStatusInvalid, imageJob.Status)
+		})
+
+		t.Run("正常系_試行回数が上限に達した失敗はデッドレターにする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindImage, entity.DeckAssetJobMaxAttempts)
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{job}, nil),
+				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), code).Return(apperror.ErrUnderMaintenance),
+				mockRepository.EXPECT().Save(context.Background(), job).Return(nil),
+			)
+
+			_, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.DeckAssetJobStatusDead, job.Status)
+		})
+
+		t.Run("異常系_取り出しに失敗したらエラーを返す", func(t *testing.T) {
+			mockRepository, _, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return(nil, errors.New(""))
+
+			_, err := usecase.Process(context.Background(), 10)
+
+			require.Error(t, err)
+		})
+
+		t.Run("正常系_実行結果の保存に失敗しても残りのジョブを処理する", func(t *testing.T) {
+			mockRepository, mockDeckAsset, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job1 := newClaimedJob(entity.DeckAssetJobKindImage, 1)
+			job2 := entity.NewDeckAssetJob("01HD7Y3K8D6FDHMHTZ2GT41TR2", now, "01HD7Y3K8D6FDHMHTZ2GT41TNA", "XXXXXX-XXXXXX-XXXXXX", entity.DeckAssetJobKindImage)
+			job2.Status = entity.DeckAssetJobStatusRunning
+			job2.Attempts = 1
+
+			gomock.InOrder(
+				mockRepository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 10).Return([]*entity.DeckAssetJob{job1, job2}, nil),
+				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), code).Return(nil),
+				mockRepository.EXPECT().Save(context.Background(), job1).Return(errors.New("")),
+				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), "XXXXXX-XXXXXX-XXXXXX").Return(nil),
+				mockRepository.EXPECT().Save(context.Background(), job2).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 10)
+
+			require.NoError(t, err)
+			require.Equal(t, 2, n)
+		})
+	})
+
+	t.Run("FindByDeckCodeId", func(t *testing.T) {
+		t.Run("正常系_指定デッキコードのジョブを返す", func(t *testing.T) {
+			mockRepository, _, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			job := newClaimedJob(entity.DeckAssetJobKindImage, 1)
+			mockRepository.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return([]*entity.DeckAssetJob{job}, nil)
+
+			ret, err := usecase.FindByDeckCodeId(context.Background(), deckCodeId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+		})
+
+		t.Run("異常系_取得に失敗したらエラーを返す", func(t *testing.T) {
+			mockRepository, _, _, usecase := setup4DeckAssetJobUsecase(t)
+
+			mockRepository.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return(nil, errors.New(""))
+
+			_, err := usecase.FindByDeckCodeId(context.Background(), deckCodeId)
+
+			require.Error(t, err)
+		})
+	})
+}
@@ -10,9 +10,12 @@ import (
 )
 
 type DeckCodeCreateParam struct {
-	UserId     string
-	DeckId     string
-	Code       string
+	UserId string
+	DeckId string
+	Code   string
+	// DeckList はデッキコードの代わりに取り込むテキスト形式のデッキリスト。
+	// Code とはどちらか一方だけを指定する。
+	DeckList   string
 	PrivateFlg bool
 	Memo       string
 	TagIds     []string
@@ -28,6 +31,7 @@ func NewDeckCodeCreateParam(
 	userId string,
 	deckId string,
 	code string,
+	deckList string,
 	privateFlg bool,
 	memo string,
 	tagIds []string,
@@ -36,6 +40,7 @@ func NewDeckCodeCreateParam(
 		UserId:     userId,
 		DeckId:     deckId,
 		Code:       code,
+		DeckList:   deckList,
 		PrivateFlg: privateFlg,
 		Memo:       memo,
 		TagIds:     tagIds,
@@ -65,6 +70,20 @@ type DeckCodeInterface interface {
 		deckId string,
 	) ([]*entity.DeckCode, error)
 
+	// FindByIdWithCards はデッキコードをカード構成(Cards)付きで返す。
+	FindByIdWithCards(
+		ctx context.Context,
+		id string,
+	) (*entity.DeckCode, error)
+
+	// Diff は同じデッキの2つのデッキコード(バージョン)のカード構成の差分と、
+	// それぞれの対戦成績を返す。from / to がそのデッキのものであることは呼び出し側で確かめる。
+	Diff(
+		ctx context.Context,
+		fromId string,
+		toId string,
+	) (*entity.DeckCodeDiff, error)
+
 	Create(
 		ctx context.Context,
 		param *DeckCodeCreateParam,
@@ -83,19 +102,57 @@ type DeckCodeInterface interface {
 }
 
 type DeckCode struct {
-	repository      repository.DeckCodeInterface
-	deckAsset       repository.DeckAssetInterface
-	tag             repository.TagInterface
-	badgeEvaluation BadgeEvaluationInterface
+	repository         repository.DeckCodeInterface
+	deckAsset          repository.DeckAssetInterface
+	deckAssetJob       repository.DeckAssetJobInterface
+	deckCodeCard       repository.DeckCodeCardInterface
+	deckCodeStat       repository.DeckCodeStatInterface
+	card               repository.CardInterface
+	tag                repository.TagInterface
+	transactionManager repository.TransactionManager
+	badgeEvaluation    BadgeEvaluationInterface
 }
 
 func NewDeckCode(
 	repository repository.DeckCodeInterface,
 	deckAsset repository.DeckAssetInterface,
+	deckAssetJob repository.DeckAssetJobInterface,
+	deckCodeCard repository.DeckCodeCardInterface,
+	deckCodeStat repository.DeckCodeStatInterface,
+	card repository.CardInterface,
 	tag repository.TagInterface,
+	transactionManager repository.TransactionManager,
 	badgeEvaluation BadgeEvaluationInterface,
 ) DeckCodeInterface {
-	return &DeckCode{repository, deckAsset, tag, badgeEvaluation}
+	return &DeckCode{repository, deckAsset, deckAssetJob, deckCodeCard, deckCodeStat, card, tag, transactionManager, badgeEvaluation}
+}
+
+// syncDeckCodeCards はアップロード済みのデッキ結果HTMLを解析し、deckCode のカード構成を
+// deck_code_cards へ保存して返す。
+//
+// カード構成はデッキコードの登録とは独立した付加情報のため、呼び出し側は失敗しても
+// 登録自体は成功させる(解析できなかったデッキコードは、カード一覧の参照時に改めて解析する)。
+func syncDeckCodeCards(
+	ctx context.Context,
+	deckAsset repository.DeckAssetInterface,
+	deckCodeCard repository.DeckCodeCardInterface,
+	deckCode *entity.DeckCode,
+) ([]*entity.DeckCodeCard, error) {
+	cards, err := deckAsset.FindDeckCards(ctx, deckCode.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, card := range cards {
+		card.DeckCodeId = deckCode.ID
+	}
+
+	if err := deckCodeCard.Replace(ctx, deckCode.ID, cards); err != nil {
+		return nil, err
+	}
+
+	// カード名は cards との JOIN で得るため、保存後に読み直して返す。
+	return deckCodeCard.FindByDeckCodeId(ctx, deckCode.ID)
 }
 
 // syncDeckCodeTags は deckCodeId について、userId が付与できる有効なタグ(自分のタグ or
@@ -152,6 +209,86 @@ func (u *DeckCode) FindByDeckId(
 	return deckcodes, nil
 }
 
+func (u *DeckCode) FindByIdWithCards(
+	ctx context.Context,
+	id string,
+) (*entity.DeckCode, error) {
+	deckcode, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if err := u.fillCards(ctx, deckcode); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return deckcode, nil
+}
+
+func (u *DeckCode) Diff(
+	ctx context.Context,
+	fromId string,
+	toId string,
+) (*entity.DeckCodeDiff, error) {
+	from, err := u.FindByIdWithCards(ctx, fromId)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := u.FindByIdWithCards(ctx, toId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := u.deckCodeStat.FindByDeckCodeIds(ctx, []string{fromId, toId})
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// FindByDeckCodeIds は指定順に1件ずつ返す(from と to が同じでも2件返る)
+	return entity.NewDeckCodeDiff(from, to, stats[0], stats[1]), nil
+}
+
+// fillCards は deckcode.Cards にカード構成を詰める。
+func (u *DeckCode) fillCards(
+	ctx context.Context,
+	deckcode *entity.DeckCode,
+) error {
+	cards, err := findDeckCodeCards(ctx, u.deckAsset, u.deckCodeCard, deckcode)
+	if err != nil {
+		return err
+	}
+
+	deckcode.Cards = cards
+
+	return nil
+}
+
+// findDeckCodeCards は deckCode のカード構成を返す。
+//
+// カード構成を保存する前に登録されたデッキコードや、登録時の解析に失敗したデッキコードは
+// 行が無いため、参照されたこの時点で解析して保存する。
+func findDeckCodeCards(
+	ctx context.Context,
+	deckAsset repository.DeckAssetInterface,
+	deckCodeCard repository.DeckCodeCardInterface,
+	deckCode *entity.DeckCode,
+) ([]*entity.DeckCodeCard, error) {
+	cards, err := deckCodeCard.FindByDeckCodeId(ctx, deckCode.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cards) == 0 && deckCode.Code != "" {
+		return syncDeckCodeCards(ctx, deckAsset, deckCodeCard, deckCode)
+	}
+
+	return cards, nil
+}
+
 func (u *DeckCode) Create(
 	ctx context.Context,
 	param *DeckCodeCreateParam,
@@ -174,27 +311,51 @@ func (u *DeckCode) Create(
 		param.Memo,
 	)
 
-	if deckcode.Code != "" {
-		// 先にデッキコードのHTMLページをアップロードする。
-		// デッキ画像のアップロードでは、そのデッキコードが存在するか確認することができないが、
-		// デッキコードのHTMLページのアップロードでは、デッキコードが正しいかどうかを確認することができるため、
-		// 先にデッキコードのHTMLページをアップロードすることで、デッキコードが正しいかどうかを確認することができる。
-		// アップロードに失敗した場合はデッキ作成を中止する。
-		if err := u.deckAsset.UploadDeckResultHTML(ctx, deckcode.Code); err != nil {
-			logError(ctx, err)
+	// 公式サイトからのリソース(結果HTML・画像)の取得はリクエスト内では行わず、
+	// デッキコード本体と同じトランザクションでジョブを積んでワーカーに任せる。
+	jobs, err := newDeckAssetJobs(deckcode, createdAt)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 取り込んだデッキリストは公式サイトに結果ページが無く後から解析し直せないため、
+	// カード構成はデッキコード本体と同じトランザクションで保存する。
+	var importedCards []*entity.DeckCodeCard
+	if param.DeckList != "" {
+		importedCards, err = resolveDeckList(ctx, u.card, deckcode.ID, param.DeckList)
+		if err != nil {
 			return nil, err
 		}
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Save(ctx, deckcode); err != nil {
+			logError(ctx, err)
+			return err
+		}
 
-		if err := u.deckAsset.UploadDeckImage(ctx, deckcode.Code); err != nil {
+		if len(jobs) > 0 {
+			if err := u.deckAssetJob.Enqueue(ctx, jobs); err != nil {
+				logError(ctx, err)
+				return err
+			}
+		}
+
+		if importedCards == nil {
+			return nil
+		}
+
+		if err := u.deckCodeCard.Replace(ctx, deckcode.ID, importedCards); err != nil {
 			logError(ctx, err)
-			return nil, err
+			return err
 		}
-	}
 
-	if err := u.repository.Save(ctx, deckcode); err != nil {
-		logError(ctx, err)
+		return nil
+	}); err != nil {
 		return nil, err
 	}
+	deckcode.Cards = importedCards
 
 	// タグの付与はデッキコード本体とは別テーブルのため Save とは分けて反映する。
 	tags, err := u.syncDeckCodeTags(ctx, deckcode.ID, param.UserId, param.TagIds)
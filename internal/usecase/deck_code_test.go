@@ -32,12 +32,48 @@ func (s spyDeckCodeBadgeEvaluation) EvaluateOnDeckCodeCreated(
 func setup4DeckCodeUsecase(t *testing.T) (
 	*mock_repository.MockDeckCodeInterface,
 	*mock_repository.MockDeckAssetInterface,
+	*mock_repository.MockDeckCodeCardInterface,
+	*mock_repository.MockDeckCodeStatInterface,
+	*bool,
+	DeckCodeInterface,
+) {
+	mockRepository, mockDeckAsset, mockDeckCodeCard, mockDeckCodeStat, _, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecaseWithCard(t)
+
+	return mockRepository, mockDeckAsset, mockDeckCodeCard, mockDeckCodeStat, badgeEvaluationCalled, usecase
+}
+
+// setup4DeckCodeUsecaseWithDeckAssetJob はリソースの取得ジョブの登録を検証するテスト向けに、
+// ジョブのキューの mock も返す。
+func setup4DeckCodeUsecaseWithDeckAssetJob(t *testing.T) (
+	*mock_repository.MockDeckCodeInterface,
+	*mock_repository.MockDeckAssetJobInterface,
+	*bool,
+	DeckCodeInterface,
+) {
+	mockRepository, _, _, _, _, mockDeckAssetJob, badgeEvaluationCalled, usecase := setup4DeckCodeUsecaseWithCard(t)
+
+	return mockRepository, mockDeckAssetJob, badgeEvaluationCalled, usecase
+}
+
+// setup4DeckCodeUsecaseWithCard はデッキリストの取り込みを検証するテスト向けに、
+// カードのマスタ・ジョブのキューの mock も返す。
+func setup4DeckCodeUsecaseWithCard(t *testing.T) (
+	*mock_repository.MockDeckCodeInterface,
+	*mock_repository.MockDeckAssetInterface,
+	*mock_repository.MockDeckCodeCardInterface,
+	*mock_repository.MockDeckCodeStatInterface,
+	*mock_repository.MockCardInterface,
+	*mock_repository.MockDeckAssetJobInterface,
 	*bool,
 	DeckCodeInterface,
 ) {
 	mockCtrl := gomock.NewController(t)
 	mockRepository := mock_repository.NewMockDeckCodeInterface(mockCtrl)
 	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockDeckCodeCard := mock_repository.NewMockDeckCodeCardInterface(mockCtrl)
+	mockDeckCodeStat := mock_repository.NewMockDeckCodeStatInterface(mockCtrl)
+	mockCard := mock_repository.NewMockCardInterface(mockCtrl)
+	mockDeckAssetJob := mock_repository.NewMockDeckAssetJobInterface(mockCtrl)
 
 	// タグ同期は Create/Update のたびに呼ばれる。タグ自体の検証は別テストで行うため、
 	// ここでは呼び出しを素通り(付与なし)にする。
@@ -50,9 +86,19 @@ func setup4DeckCodeUsecase(t *testing.T) (
 		Return(nil).AnyTimes()
 
 	badgeEvaluationCalled := false
-	usecase := NewDeckCode(mockRepository, mockDeckAsset, mockTagRepository, spyDeckCodeBadgeEvaluation{called: &badgeEvaluationCalled})
-
-	return mockRepository, mockDeckAsset, &badgeEvaluationCalled, usecase
+	usecase := NewDeckCode(
+		mockRepository,
+		mockDeckAsset,
+		mockDeckAssetJob,
+		mockDeckCodeCard,
+		mockDeckCodeStat,
+		mockCard,
+		mockTagRepository,
+		stubTransactionManager{},
+		spyDeckCodeBadgeEvaluation{called: &badgeEvaluationCalled},
+	)
+
+	return mockRepository, mockDeckAsset, mockDeckCodeCard, mockDeckCodeStat, mockCard, mockDeckAssetJob, &badgeEvaluationCalled, usecase
 }
 
 func TestDeckCodeUsecase(t *testing.T) {
@@ -62,7 +108,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 
 	t.Run("FindById", func(t *testing.T) {
 		t.Run("正常系_指定IDのデッキコードを返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -78,7 +124,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_リポジトリのエラーをそのまま返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -94,7 +140,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 
 	t.Run("FindByDeckId", func(t *testing.T) {
 		t.Run("正常系_指定デッキのデッキコード一覧を返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			deckCodes := []*entity.DeckCode{{ID: "01HD7Y3K8D6FDHMHTZ2GT41TC1", DeckId: deckId}}
 
@@ -108,7 +154,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_リポジトリのエラーをそのまま返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			mockRepository.EXPECT().FindByDeckId(context.Background(), deckId).Return(nil, errors.New(""))
 
@@ -119,11 +165,124 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 	})
 
+	t.Run("FindByIdWithCards", func(t *testing.T) {
+		t.Run("正常系_保存済みのカード構成を詰めて返す", func(t *testing.T) {
+			mockRepository, _, mockDeckCodeCard, _, _, usecase := setup4DeckCodeUsecase(t)
+
+			id, err := generateId()
+			require.NoError(t, err)
+
+			cards := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(id, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+			}
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(&entity.DeckCode{ID: id, Code: code}, nil)
+			mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), id).Return(cards, nil)
+
+			ret, err := usecase.FindByIdWithCards(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, cards, ret.Cards)
+		})
+
+		t.Run("正常系_未保存ならHTMLを解析して保存してから返す", func(t *testing.T) {
+			mockRepository, mockDeckAsset, mockDeckCodeCard, _, _, usecase := setup4DeckCodeUsecase(t)
+
+			id, err := generateId()
+			require.NoError(t, err)
+
+			parsed := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard("", 47003, "", entity.DeckCardCategoryPokemon, 2),
+			}
+			stored := []*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(id, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+			}
+
+			gomock.InOrder(
+				mockRepository.EXPECT().FindById(context.Background(), id).Return(&entity.DeckCode{ID: id, Code: code}, nil),
+				mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), id).Return([]*entity.DeckCodeCard{}, nil),
+				mockDeckAsset.EXPECT().FindDeckCards(context.Background(), code).Return(parsed, nil),
+				mockDeckCodeCard.EXPECT().Replace(context.Background(), id, parsed).Return(nil),
+				mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), id).Return(stored, nil),
+			)
+
+			ret, err := usecase.FindByIdWithCards(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, stored, ret.Cards)
+		})
+
+		t.Run("正常系_コード未登録のデッキコードは解析せず空で返す", func(t *testing.T) {
+			mockRepository, _, mockDeckCodeCard, _, _, usecase := setup4DeckCodeUsecase(t)
+
+			id, err := generateId()
+			require.NoError(t, err)
+
+			mockRepository.EXPECT().FindById(context.Background(), id).Return(&entity.DeckCode{ID: id}, nil)
+			mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), id).Return([]*entity.DeckCodeCard{}, nil)
+
+			ret, err := usecase.FindByIdWithCards(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Empty(t, ret.Cards)
+		})
+
+		t.Run("異常系_存在しないIDはErrRecordNotFoundを返す", func(t *testing.T) {
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), "missing").Return(nil, apperror.ErrRecordNotFound)
+
+			_, err := usecase.FindByIdWithCards(context.Background(), "missing")
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		t.Run("正常系_2つのバージョンのカード構成の差分と成績を返す", func(t *testing.T) {
+			mockRepository, _, mockDeckCodeCard, mockDeckCodeStat, _, usecase := setup4DeckCodeUsecase(t)
+
+			fromId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+			toId := "01HD7Y3K8D6FDHMHTZ2GT41TC2"
+
+			mockRepository.EXPECT().FindById(context.Background(), fromId).Return(&entity.DeckCode{ID: fromId, DeckId: deckId, Code: code}, nil)
+			mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), fromId).Return([]*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(fromId, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 2),
+			}, nil)
+			mockRepository.EXPECT().FindById(context.Background(), toId).Return(&entity.DeckCode{ID: toId, DeckId: deckId, Code: code}, nil)
+			mockDeckCodeCard.EXPECT().FindByDeckCodeId(context.Background(), toId).Return([]*entity.DeckCodeCard{
+				entity.NewDeckCodeCard(toId, 47003, "ピカチュウex", entity.DeckCardCategoryPokemon, 3),
+			}, nil)
+			mockDeckCodeStat.EXPECT().FindByDeckCodeIds(context.Background(), []string{fromId, toId}).Return([]*entity.DeckCodeStat{
+				entity.NewDeckCodeStat(fromId, 1, 3, 1, 2, 0, 1.0/3),
+				entity.NewDeckCodeStat(toId, 1, 3, 3, 0, 0, 1),
+			}, nil)
+
+			ret, err := usecase.Diff(context.Background(), fromId, toId)
+
+			require.NoError(t, err)
+			require.Equal(t, fromId, ret.From.ID)
+			require.Equal(t, toId, ret.To.ID)
+			require.Len(t, ret.Changed, 1)
+			require.Equal(t, uint(3), ret.ToStat.WinCount)
+		})
+
+		t.Run("異常系_存在しないIDはErrRecordNotFoundを返す", func(t *testing.T) {
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
+
+			mockRepository.EXPECT().FindById(context.Background(), "missing").Return(nil, apperror.ErrRecordNotFound)
+
+			_, err := usecase.Diff(context.Background(), "missing", "01HD7Y3K8D6FDHMHTZ2GT41TC2")
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
 	t.Run("Create", func(t *testing.T) {
-		t.Run("正常系_コード未指定なら外部アップロードと称号評価なしで保存する", func(t *testing.T) {
-			mockRepository, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
+		t.Run("正常系_コード未指定なら取得ジョブと称号評価なしで保存する", func(t *testing.T) {
+			mockRepository, _, _, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
 
-			param := NewDeckCodeCreateParam(uid, deckId, "", false, "", nil)
+			param := NewDeckCodeCreateParam(uid, deckId, "", "", false, "", nil)
 
 			mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
 
@@ -138,15 +297,20 @@ func TestDeckCodeUsecase(t *testing.T) {
 			require.False(t, *badgeEvaluationCalled)
 		})
 
-		t.Run("正常系_コード指定時はHTMLと画像をアップロードして保存し称号評価する", func(t *testing.T) {
-			mockRepository, mockDeckAsset, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
+		t.Run("正常系_コード指定時は取得ジョブを積んで保存し称号評価する", func(t *testing.T) {
+			mockRepository, mockDeckAssetJob, badgeEvaluationCalled, usecase := setup4DeckCodeUsecaseWithDeckAssetJob(t)
 
-			param := NewDeckCodeCreateParam(uid, deckId, code, true, "メモ", nil)
+			param := NewDeckCodeCreateParam(uid, deckId, code, "", true, "メモ", nil)
 
+			var enqueued []*entity.DeckAssetJob
 			gomock.InOrder(
-				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(nil),
-				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), code).Return(nil),
 				mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil),
+				mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, jobs []*entity.DeckAssetJob) error {
+						enqueued = jobs
+						return nil
+					},
+				),
 			)
 
 			ret, err := usecase.Create(context.Background(), param)
@@ -156,31 +320,59 @@ func TestDeckCodeUsecase(t *testing.T) {
 			require.True(t, ret.PrivateCodeFlg)
 			require.Equal(t, "メモ", ret.Memo)
 			require.True(t, *badgeEvaluationCalled)
+			// 結果HTML→画像の順に、採番したデッキコードIDのジョブを積む
+			require.Len(t, enqueued, 2)
+			require.Equal(t, entity.DeckAssetJobKindResultHTML, enqueued[0].Kind)
+			require.Equal(t, entity.DeckAssetJobKindImage, enqueued[1].Kind)
+			require.Equal(t, ret.ID, enqueued[0].DeckCodeId)
+			require.Equal(t, code, enqueued[0].Code)
 		})
 
-		t.Run("異常系_HTMLアップロード失敗時は画像アップロードも保存も行わない", func(t *testing.T) {
-			_, mockDeckAsset, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
+		t.Run("正常系_デッキリスト指定時はカード構成を解決して一緒に保存する", func(t *testing.T) {
+			mockRepository, _, mockDeckCodeCard, _, mockCard, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecaseWithCard(t)
 
-			param := NewDeckCodeCreateParam(uid, deckId, code, false, "", nil)
+			param := NewDeckCodeCreateParam(uid, deckId, "", "2 ピカチュウex SV8 033\n8 基本雷エネルギー", false, "", nil)
 
-			mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(errors.New(""))
+			gomock.InOrder(
+				mockCard.EXPECT().FindByNames(context.Background(), []string{"ピカチュウex", "基本雷エネルギー"}).Return([]*entity.Card{
+					entity.NewCard(10, "ピカチュウex", "SV8", "H", entity.DeckCardCategoryPokemon),
+					entity.NewCard(20, "基本雷エネルギー", "", "", ""),
+				}, nil),
+				mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil),
+				mockDeckCodeCard.EXPECT().Replace(context.Background(), gomock.Any(), gomock.Len(2)).Return(nil),
+			)
 
 			ret, err := usecase.Create(context.Background(), param)
 
-			require.Error(t, err)
-			require.Nil(t, ret)
+			require.NoError(t, err)
+			require.Empty(t, ret.Code)
+			require.Len(t, ret.Cards, 2)
+			require.Equal(t, ret.ID, ret.Cards[0].DeckCodeId)
+			require.Equal(t, entity.DeckCardCategoryEnergy, ret.Cards[1].Category)
 			require.False(t, *badgeEvaluationCalled)
 		})
 
-		t.Run("異常系_画像アップロード失敗時は保存を行わない", func(t *testing.T) {
-			_, mockDeckAsset, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
+		t.Run("異常系_解決できないデッキリストはErrInvalidDeckListを返し保存しない", func(t *testing.T) {
+			_, _, _, _, mockCard, _, _, usecase := setup4DeckCodeUsecaseWithCard(t)
 
-			param := NewDeckCodeCreateParam(uid, deckId, code, false, "", nil)
+			param := NewDeckCodeCreateParam(uid, deckId, "", "2 未知のカード", false, "", nil)
 
-			gomock.InOrder(
-				mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), code).Return(nil),
-				mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), code).Return(errors.New("")),
-			)
+			mockCard.EXPECT().FindByNames(context.Background(), []string{"未知のカード"}).Return([]*entity.Card{}, nil)
+
+			ret, err := usecase.Create(context.Background(), param)
+
+			require.ErrorIs(t, err, apperror.ErrInvalidDeckList)
+			require.ErrorContains(t, err, "line 1")
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_ジョブの登録失敗時はエラーを返し称号評価しない", func(t *testing.T) {
+			mockRepository, mockDeckAssetJob, badgeEvaluationCalled, usecase := setup4DeckCodeUsecaseWithDeckAssetJob(t)
+
+			param := NewDeckCodeCreateParam(uid, deckId, code, "", false, "", nil)
+
+			mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
+			mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).Return(errors.New(""))
 
 			ret, err := usecase.Create(context.Background(), param)
 
@@ -190,9 +382,9 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_保存失敗時はエラーを返し称号評価しない", func(t *testing.T) {
-			mockRepository, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, badgeEvaluationCalled, usecase := setup4DeckCodeUsecase(t)
 
-			param := NewDeckCodeCreateParam(uid, deckId, "", false, "", nil)
+			param := NewDeckCodeCreateParam(uid, deckId, "", "", false, "", nil)
 
 			mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(errors.New(""))
 
@@ -206,7 +398,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 
 	t.Run("Update", func(t *testing.T) {
 		t.Run("正常系_公開設定とメモのみ更新され他は維持される", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -232,7 +424,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_存在しないIDはErrRecordNotFoundを返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -246,7 +438,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_保存失敗時はエラーを返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -265,7 +457,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 
 	t.Run("Delete", func(t *testing.T) {
 		t.Run("正常系_リポジトリのDeleteを呼び出す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -276,7 +468,7 @@ func TestDeckCodeUsecase(t *testing.T) {
 		})
 
 		t.Run("異常系_リポジトリのエラーをそのまま返す", func(t *testing.T) {
-			mockRepository, _, _, usecase := setup4DeckCodeUsecase(t)
+			mockRepository, _, _, _, _, usecase := setup4DeckCodeUsecase(t)
 
 			id, err := generateId()
 			require.NoError(t, err)
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type DeckLegalityInterface interface {
+	// Check はデッキコードのカードが date 時点の regulationId で使用できるかを判定する。
+	// date がゼロ値なら今日、regulationId が 0 ならスタンダードとして判定する。
+	Check(
+		ctx context.Context,
+		deckCodeId string,
+		regulationId uint,
+		date time.Time,
+	) (*entity.DeckLegality, error)
+}
+
+type DeckLegality struct {
+	deckCodeRepo           repository.DeckCodeInterface
+	deckCodeCardRepo       repository.DeckCodeCardInterface
+	deckAsset              repository.DeckAssetInterface
+	standardRegulationRepo repository.StandardRegulationInterface
+	bannedCardRepo         repository.BannedCardInterface
+}
+
+func NewDeckLegality(
+	deckCodeRepo repository.DeckCodeInterface,
+	deckCodeCardRepo repository.DeckCodeCardInterface,
+	deckAsset repository.DeckAssetInterface,
+	standardRegulationRepo repository.StandardRegulationInterface,
+	bannedCardRepo repository.BannedCardInterface,
+) DeckLegalityInterface {
+	return &DeckLegality{
+		deckCodeRepo:           deckCodeRepo,
+		deckCodeCardRepo:       deckCodeCardRepo,
+		deckAsset:              deckAsset,
+		standardRegulationRepo: standardRegulationRepo,
+		bannedCardRepo:         bannedCardRepo,
+	}
+}
+
+func (u *DeckLegality) Check(
+	ctx context.Context,
+	deckCodeId string,
+	regulationId uint,
+	date time.Time,
+) (*entity.DeckLegality, error) {
+	regulationId = entity.NormalizeRegulationId(regulationId)
+
+	if date.IsZero() {
+		date = timeNow()
+	}
+	// records.event_date 等の時刻付きの値も受けるため、日付(0時)に揃えてから期間と比べる
+	date = date.Local()
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+
+	deckCode, err := u.deckCodeRepo.FindById(ctx, deckCodeId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	cards, err := findDeckCodeCards(ctx, u.deckAsset, u.deckCodeCardRepo, deckCode)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 期間が登録されていない日付(最初のレギュレーションより前や、次の改定が未登録の先の日付)は
+	// マークの範囲が分からないため、マークの判定だけを諦めて禁止カードの判定は行う。
+	var standardRegulation *entity.StandardRegulation
+	if regulationId == entity.RegulationIdStandard {
+		standardRegulation, err = u.standardRegulationRepo.FindByDate(ctx, date)
+		if err != nil && !errors.Is(err, apperror.ErrRecordNotFound) {
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	bannedCards, err := u.bannedCardRepo.FindByRegulationIdAndDate(ctx, regulationId, date)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return entity.NewDeckLegality(deckCode.ID, regulationId, date, cards, standardRegulation, bannedCards), nil
+}
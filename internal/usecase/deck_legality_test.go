@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func setup4DeckLegalityUsecase(t *testing.T) (
+	*mock_repository.MockDeckCodeInterface,
+	*mock_repository.MockDeckCodeCardInterface,
+	*mock_repository.MockStandardRegulationInterface,
+	*mock_repository.MockBannedCardInterface,
+	DeckLegalityInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockDeckCodeRepo := mock_repository.NewMockDeckCodeInterface(mockCtrl)
+	mockDeckCodeCardRepo := mock_repository.NewMockDeckCodeCardInterface(mockCtrl)
+	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockStandardRegulationRepo := mock_repository.NewMockStandardRegulationInterface(mockCtrl)
+	mockBannedCardRepo := mock_repository.NewMockBannedCardInterface(mockCtrl)
+
+	usecase := NewDeckLegality(
+		mockDeckCodeRepo,
+		mockDeckCodeCardRepo,
+		mockDeckAsset,
+		mockStandardRegulationRepo,
+		mockBannedCardRepo,
+	)
+
+	return mockDeckCodeRepo, mockDeckCodeCardRepo, mockStandardRegulationRepo, mockBannedCardRepo, usecase
+}
+
+func TestDeckLegalityUsecase_Check(t *testing.T) {
+	deckCodeId := "01HD7Y3K8D6FDHMHTZ2GT41TC1"
+	deckCode := &entity.DeckCode{ID: deckCodeId, Code: "5dbFbk-uBwjqP-VVk5Vv"}
+	date := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+
+	rotated := entity.NewDeckCodeCard(deckCodeId, 1, "クイックボール", entity.DeckCardCategoryGoods, 4)
+	rotated.RegulationMark = "G"
+	cards := []*entity.DeckCodeCard{rotated}
+
+	t.Run("正常系_日付のスタンダードのマークで判定する", func(t *testing.T) {
+		mockDeckCodeRepo, mockDeckCodeCardRepo, mockStandardRegulationRepo, mockBannedCardRepo, usecase := setup4DeckLegalityUsecase(t)
+
+		mockDeckCodeRepo.EXPECT().FindById(context.Background(), deckCodeId).Return(deckCode, nil)
+		mockDeckCodeCardRepo.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return(cards, nil)
+		mockStandardRegulationRepo.EXPECT().FindByDate(context.Background(), date).Return(
+			entity.NewStandardRegulation("HIJ", "H・I・J", date, date.AddDate(1, 0, 0)), nil,
+		)
+		mockBannedCardRepo.EXPECT().FindByRegulationIdAndDate(context.Background(), entity.RegulationIdStandard, date).Return([]*entity.BannedCard{}, nil)
+
+		// regulationId 0 はスタンダードとして扱う
+		ret, err := usecase.Check(context.Background(), deckCodeId, 0, date)
+
+		require.NoError(t, err)
+		require.Equal(t, entity.RegulationIdStandard, ret.RegulationId)
+		require.Equal(t, "HIJ", ret.StandardRegulation.ID)
+		require.Len(t, ret.IllegalCards, 1)
+		require.Equal(t, entity.DeckIllegalReasonRotated, ret.IllegalCards[0].Reason)
+	})
+
+	t.Run("正常系_日付を省略すると今日で判定する", func(t *testing.T) {
+		mockDeckCodeRepo, mockDeckCodeCardRepo, mockStandardRegulationRepo, mockBannedCardRepo, usecase := setup4DeckLegalityUsecase(t)
+
+		overrideTimeNow(t, time.Date(2026, 2, 1, 15, 30, 0, 0, time.Local))
+
+		mockDeckCodeRepo.EXPECT().FindById(context.Background(), deckCodeId).Return(deckCode, nil)
+		mockDeckCodeCardRepo.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return(cards, nil)
+		mockStandardRegulationRepo.EXPECT().FindByDate(context.Background(), date).Return(nil, apperror.ErrRecordNotFound)
+		mockBannedCardRepo.EXPECT().FindByRegulationIdAndDate(context.Background(), entity.RegulationIdStandard, date).Return([]*entity.BannedCard{}, nil)
+
+		ret, err := usecase.Check(context.Background(), deckCodeId, entity.RegulationIdStandard, time.Time{})
+
+		require.NoError(t, err)
+		require.Equal(t, date, ret.Date)
+		// 期間が登録されていなければマークは判定しない
+		require.Nil(t, ret.StandardRegulation)
+		require.True(t, ret.IsLegal())
+	})
+
+	t.Run("正常系_スタンダード以外はスタンダードの期間を引かない", func(t *testing.T) {
+		mockDeckCodeRepo, mockDeckCodeCardRepo, _, mockBannedCardRepo, usecase := setup4DeckLegalityUsecase(t)
+
+		mockDeckCodeRepo.EXPECT().FindById(context.Background(), deckCodeId).Return(deckCode, nil)
+		mockDeckCodeCardRepo.EXPECT().FindByDeckCodeId(context.Background(), deckCodeId).Return(cards, nil)
+		mockBannedCardRepo.EXPECT().FindByRegulationIdAndDate(context.Background(), entity.RegulationIdHallOfFame, date).Return([]*entity.BannedCard{
+			entity.NewBannedCard(entity.RegulationIdHallOfFame, "クイックボール", date),
+		}, nil)
+
+		ret, err := usecase.Check(context.Background(), deckCodeId, entity.RegulationIdHallOfFame, date)
+
+		require.NoError(t, err)
+		require.Len(t, ret.IllegalCards, 1)
+		require.Equal(t, entity.DeckIllegalReasonBanned, ret.IllegalCards[0].Reason)
+	})
+
+	t.Run("異常系_デッキコードが無ければErrRecordNotFoundを返す", func(t *testing.T) {
+		mockDeckCodeRepo, _, _, _, usecase := setup4DeckLegalityUsecase(t)
+
+		mockDeckCodeRepo.EXPECT().FindById(context.Background(), deckCodeId).Return(nil, apperror.ErrRecordNotFound)
+
+		_, err := usecase.Check(context.Background(), deckCodeId, entity.RegulationIdStandard, date)
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+	})
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// resolveDeckList はテキスト形式のデッキリストを読み取り、マスタ(cards)のカードに解決して
+// deckCodeId のカード構成として返す。解釈できない行があれば、行番号を添えて
+// apperror.ErrInvalidDeckList でラップしたエラーを返す。
+func resolveDeckList(
+	ctx context.Context,
+	card repository.CardInterface,
+	deckCodeId string,
+	deckList string,
+) ([]*entity.DeckCodeCard, error) {
+	lines, err := entity.ParseDeckList(deckList)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperror.ErrInvalidDeckList, err)
+	}
+
+	cardNames := make([]string, 0, len(lines))
+	seen := make(map[string]struct{}, len(lines))
+	for _, line := range lines {
+		if _, ok := seen[line.CardName]; ok {
+			continue
+		}
+		seen[line.CardName] = struct{}{}
+		cardNames = append(cardNames, line.CardName)
+	}
+
+	cards, err := card.FindByNames(ctx, cardNames)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	ret, err := entity.NewDeckCodeCardsFromDeckList(deckCodeId, lines, cards)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperror.ErrInvalidDeckList, err)
+	}
+
+	return ret, nil
+}
@@ -82,11 +82,43 @@ func (stubTagRepository) ReplaceMatchTags(ctx context.Context, matchId string, t
 	return nil
 }
 
+func (stubTagRepository) ReplaceRecordTags(ctx context.Context, recordId string, tagIds []string) error {
+	return nil
+}
+
+// stubDeckCodeCardRepository はカード構成の保存を素通りさせるスタブ。カード構成の
+// 保存・読み直しの詳細は deck_code のテストが受け持つ。
+type stubDeckCodeCardRepository struct{}
+
+func (stubDeckCodeCardRepository) FindByDeckCodeId(ctx context.Context, deckCodeId string) ([]*entity.DeckCodeCard, error) {
+	return nil, nil
+}
+
+func (stubDeckCodeCardRepository) FindByDeckCodeIds(ctx context.Context, deckCodeIds []string) ([]*entity.DeckCodeCard, error) {
+	return nil, nil
+}
+
+func (stubDeckCodeCardRepository) Replace(ctx context.Context, deckCodeId string, cards []*entity.DeckCodeCard) error {
+	return nil
+}
+
+// stubCardRepository は指定されたカード名がすべてマスタにある(区分は未知)ものとして返すスタブ。
+// 名前の解決そのものは entity / deck_code_test.go で検証する。
+type stubCardRepository struct{}
+
+func (stubCardRepository) FindByNames(ctx context.Context, cardNames []string) ([]*entity.Card, error) {
+	cards := []*entity.Card{}
+	for i, cardName := range cardNames {
+		cards = append(cards, entity.NewCard(uint(i+1), cardName, "", "", ""))
+	}
+	return cards, nil
+}
+
 func TestDeckUsecase(t *testing.T) {
 	for scenario, fn := range map[string]func(
 		t *testing.T,
 		mockRepository *mock_repository.MockDeckInterface,
-		mockDeckAsset *mock_repository.MockDeckAssetInterface,
+		mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 		mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 		usecase DeckInterface,
 	){
@@ -107,18 +139,20 @@ func TestDeckUsecase(t *testing.T) {
 		t.Run(scenario, func(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			mockRepository := mock_repository.NewMockDeckInterface(mockCtrl)
-			mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+			mockDeckAssetJob := mock_repository.NewMockDeckAssetJobInterface(mockCtrl)
 			mockUserFavoriteDeck := mock_repository.NewMockUserFavoriteDeckInterface(mockCtrl)
 			usecase := NewDeck(
 				mockRepository,
-				mockDeckAsset,
+				mockDeckAssetJob,
+				stubDeckCodeCardRepository{},
+				stubCardRepository{},
 				mockUserFavoriteDeck,
 				stubTagRepository{},
 				stubTransactionManager{},
 				stubBadgeEvaluation{},
 			)
 
-			fn(t, mockRepository, mockDeckAsset, mockUserFavoriteDeck, usecase)
+			fn(t, mockRepository, mockDeckAssetJob, mockUserFavoriteDeck, usecase)
 		})
 	}
 }
@@ -126,7 +160,7 @@ func TestDeckUsecase(t *testing.T) {
 func test_DeckUsecase_Find(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -179,7 +213,7 @@ func test_DeckUsecase_Find(
 func test_DeckUsecase_FindAll(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -230,7 +264,7 @@ func test_DeckUsecase_FindAll(
 func test_DeckUsecase_FindOnCursor(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -283,7 +317,7 @@ func test_DeckUsecase_FindOnCursor(
 func test_DeckUsecase_FindById(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -329,7 +363,7 @@ func test_DeckUsecase_FindById(
 func test_DeckUsecase_FindByUserId(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -414,7 +448,7 @@ func test_DeckUsecase_FindByUserId(
 func test_DeckUsecase_FindByUserIdOnCursor(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -499,16 +533,16 @@ func test_DeckUsecase_FindByUserIdOnCursor(
 func test_DeckUsecase_Create(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
 	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
 	deckCode := "5dbFbk-uBwjqP-VVk5Vv"
 
-	// デッキコードを指定しない場合、外部リソース(HTML・画像)のアップロードは行われない
-	t.Run("正常系_デッキコード未指定なら外部アップロードなしで保存される", func(t *testing.T) {
-		param := NewDeckCreateParam(uid, "テストデッキ", false, "", false, nil, nil)
+	// デッキコードを指定しない場合、外部リソース(HTML・画像)の取得ジョブは積まれない
+	t.Run("正常系_デッキコード未指定なら取得ジョブを積まずに保存される", func(t *testing.T) {
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "", false, nil, nil)
 
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
 
@@ -525,14 +559,20 @@ func test_DeckUsecase_Create(
 		require.Empty(t, ret.PokemonSprites)
 	})
 
-	// デッキコードを指定した場合、HTML→画像の順にアップロードした上でデッキが保存される
-	t.Run("正常系_デッキコード指定時はHTMLと画像をアップロードして保存される", func(t *testing.T) {
-		param := NewDeckCreateParam(uid, "テストデッキ", true, deckCode, true, nil, nil)
+	// デッキコードを指定した場合、HTML・画像は取得せず、デッキ本体と同じトランザクションで
+	// 結果HTML→画像の順に取得ジョブを積む
+	t.Run("正常系_デッキコード指定時はリソースの取得ジョブを積んで保存される", func(t *testing.T) {
+		param := NewDeckCreateParam(uid, "テストデッキ", true, deckCode, "", true, nil, nil)
 
+		var enqueued []*entity.DeckAssetJob
 		gomock.InOrder(
-			mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), deckCode).Return(nil),
-			mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), deckCode).Return(nil),
 			mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil),
+			mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, jobs []*entity.DeckAssetJob) error {
+					enqueued = jobs
+					return nil
+				},
+			),
 		)
 
 		ret, err := usecase.Create(context.Background(), param)
@@ -544,6 +584,15 @@ func test_DeckUsecase_Create(
 		require.Equal(t, ret.ID, ret.LatestDeckCode.DeckId)
 		require.Equal(t, uid, ret.LatestDeckCode.UserId)
 		require.True(t, ret.LatestDeckCode.PrivateCodeFlg)
+
+		require.Len(t, enqueued, 2)
+		require.Equal(t, entity.DeckAssetJobKindResultHTML, enqueued[0].Kind)
+		require.Equal(t, entity.DeckAssetJobKindImage, enqueued[1].Kind)
+		for _, job := range enqueued {
+			require.Equal(t, ret.LatestDeckCode.ID, job.DeckCodeId)
+			require.Equal(t, deckCode, job.Code)
+			require.Equal(t, entity.DeckAssetJobStatusPending, job.Status)
+		}
 	})
 
 	// 指定されたポケモンスプライトがエンティティへ引き継がれる
@@ -552,7 +601,7 @@ func test_DeckUsecase_Create(
 			NewPokemonSpriteParam("pikachu"),
 			NewPokemonSpriteParam("raichu"),
 		}
-		param := NewDeckCreateParam(uid, "テストデッキ", false, "", false, pokemonSprites, nil)
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "", false, pokemonSprites, nil)
 
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
 
@@ -564,27 +613,37 @@ func test_DeckUsecase_Create(
 		require.Equal(t, "raichu", ret.PokemonSprites[1].ID)
 	})
 
-	// デッキコードのHTMLアップロードに失敗した場合(=不正なデッキコード)、
-	// 画像アップロードもデッキ保存も行わずに中止する
-	t.Run("異常系_HTMLアップロード失敗時は画像アップロードも保存も行わない", func(t *testing.T) {
-		param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, false, nil, nil)
+	// デッキリストを指定した場合、取得ジョブは積まずにカード構成付きの最初のバージョンを保存する
+	t.Run("正常系_デッキリスト指定時はカード構成付きのバージョンを保存する", func(t *testing.T) {
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "サポート\n4 ナンジャモ", false, nil, nil)
 
-		mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), deckCode).Return(errors.New(""))
+		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
 
 		ret, err := usecase.Create(context.Background(), param)
 
-		require.Error(t, err)
+		require.NoError(t, err)
+		require.NotEmpty(t, ret.LatestDeckCode.ID)
+		require.Empty(t, ret.LatestDeckCode.Code)
+		require.Len(t, ret.LatestDeckCode.Cards, 1)
+		require.Equal(t, ret.LatestDeckCode.ID, ret.LatestDeckCode.Cards[0].DeckCodeId)
+		require.Equal(t, entity.DeckCardCategorySupporter, ret.LatestDeckCode.Cards[0].Category)
+	})
+
+	t.Run("異常系_解釈できないデッキリストはErrInvalidDeckListを返し保存しない", func(t *testing.T) {
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "ナンジャモ", false, nil, nil)
+
+		ret, err := usecase.Create(context.Background(), param)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidDeckList)
 		require.Empty(t, ret)
 	})
 
-	// デッキ画像のアップロードに失敗した場合もデッキ保存は行わずに中止する
-	t.Run("異常系_画像アップロード失敗時は保存を行わない", func(t *testing.T) {
-		param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, false, nil, nil)
+	// ジョブを積めなかった場合は、デッキ本体もロールバックされるようエラーを返す
+	t.Run("異常系_ジョブの登録失敗時はエラーを返す", func(t *testing.T) {
+		param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, "", false, nil, nil)
 
-		gomock.InOrder(
-			mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), deckCode).Return(nil),
-			mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), deckCode).Return(errors.New("")),
-		)
+		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
+		mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).Return(errors.New(""))
 
 		ret, err := usecase.Create(context.Background(), param)
 
@@ -593,7 +652,7 @@ func test_DeckUsecase_Create(
 	})
 
 	t.Run("異常系_保存失敗時はエラーを返す", func(t *testing.T) {
-		param := NewDeckCreateParam(uid, "テストデッキ", false, "", false, nil, nil)
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "", false, nil, nil)
 
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(errors.New(""))
 
@@ -607,13 +666,15 @@ func test_DeckUsecase_Create(
 	t.Run("異常系_称号評価失敗時はエラーを返す", func(t *testing.T) {
 		usecase := NewDeck(
 			mockRepository,
-			mockDeckAsset,
+			mockDeckAssetJob,
+			stubDeckCodeCardRepository{},
+			stubCardRepository{},
 			mockUserFavoriteDeck,
 			stubTagRepository{},
 			stubTransactionManager{},
 			errBadgeEvaluation{},
 		)
-		param := NewDeckCreateParam(uid, "テストデッキ", false, "", false, nil, nil)
+		param := NewDeckCreateParam(uid, "テストデッキ", false, "", "", false, nil, nil)
 
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
 
@@ -627,7 +688,7 @@ func test_DeckUsecase_Create(
 func test_DeckUsecase_Update(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -727,7 +788,7 @@ func test_DeckUsecase_Update(
 func test_DeckUsecase_Archive(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -801,7 +862,7 @@ func test_DeckUsecase_Archive(
 func test_DeckUsecase_Unarchive(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -872,7 +933,7 @@ func test_DeckUsecase_Unarchive(
 func test_DeckUsecase_Favorite(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -970,7 +1031,7 @@ func test_DeckUsecase_Favorite(
 func test_DeckUsecase_Unfavorite(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -1007,7 +1068,7 @@ func test_DeckUsecase_Unfavorite(
 func test_DeckUsecase_Delete(
 	t *testing.T,
 	mockRepository *mock_repository.MockDeckInterface,
-	mockDeckAsset *mock_repository.MockDeckAssetInterface,
+	mockDeckAssetJob *mock_repository.MockDeckAssetJobInterface,
 	mockUserFavoriteDeck *mock_repository.MockUserFavoriteDeckInterface,
 	usecase DeckInterface,
 ) {
@@ -1035,13 +1096,15 @@ func test_DeckUsecase_Delete(
 func TestDeckUsecaseCreateAppliesTagsToDeckCode(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockRepository := mock_repository.NewMockDeckInterface(mockCtrl)
-	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockDeckAssetJob := mock_repository.NewMockDeckAssetJobInterface(mockCtrl)
 	mockUserFavoriteDeck := mock_repository.NewMockUserFavoriteDeckInterface(mockCtrl)
 	mockTag := mock_repository.NewMockTagInterface(mockCtrl)
 
 	usecase := NewDeck(
 		mockRepository,
-		mockDeckAsset,
+		mockDeckAssetJob,
+		stubDeckCodeCardRepository{},
+		stubCardRepository{},
 		mockUserFavoriteDeck,
 		mockTag,
 		stubTransactionManager{},
@@ -1053,17 +1116,16 @@ func TestDeckUsecaseCreateAppliesTagsToDeckCode(t *testing.T) {
 	now := time.Now().Local()
 	tag := entity.NewTag("tag-1", now, now, uid, "アグロ", "", false)
 
-	// デッキコードのアップロードとデッキ本体の保存
-	mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), deckCode).Return(nil)
-	mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), deckCode).Return(nil)
+	// デッキ本体の保存とリソースの取得ジョブの登録
 	mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
+	mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).Return(nil)
 
 	// 所有権チェックで tag-1 が返り、デッキ本体・デッキコードの双方に同じタグIDが付与される
 	mockTag.EXPECT().FindAttachableByIds(context.Background(), []string{"tag-1"}, uid).Return([]*entity.Tag{tag}, nil)
 	mockTag.EXPECT().ReplaceDeckTags(context.Background(), gomock.Any(), []string{"tag-1"}).Return(nil)
 	mockTag.EXPECT().ReplaceDeckCodeTags(context.Background(), gomock.Any(), []string{"tag-1"}).Return(nil)
 
-	param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, false, nil, []string{"tag-1"})
+	param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, "", false, nil, []string{"tag-1"})
 
 	deck, err := usecase.Create(context.Background(), param)
 
@@ -1080,13 +1142,15 @@ func TestDeckUsecaseCreateAppliesTagsToDeckCode(t *testing.T) {
 func TestDeckUsecaseCreateDeckCodeWithoutTags(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockRepository := mock_repository.NewMockDeckInterface(mockCtrl)
-	mockDeckAsset := mock_repository.NewMockDeckAssetInterface(mockCtrl)
+	mockDeckAssetJob := mock_repository.NewMockDeckAssetJobInterface(mockCtrl)
 	mockUserFavoriteDeck := mock_repository.NewMockUserFavoriteDeckInterface(mockCtrl)
 	mockTag := mock_repository.NewMockTagInterface(mockCtrl)
 
 	usecase := NewDeck(
 		mockRepository,
-		mockDeckAsset,
+		mockDeckAssetJob,
+		stubDeckCodeCardRepository{},
+		stubCardRepository{},
 		mockUserFavoriteDeck,
 		mockTag,
 		stubTransactionManager{},
@@ -1096,15 +1160,14 @@ func TestDeckUsecaseCreateDeckCodeWithoutTags(t *testing.T) {
 	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
 	deckCode := "5dbFbk-uBwjqP-VVk5Vv"
 
-	mockDeckAsset.EXPECT().UploadDeckResultHTML(context.Background(), deckCode).Return(nil)
-	mockDeckAsset.EXPECT().UploadDeckImage(context.Background(), deckCode).Return(nil)
 	mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
+	mockDeckAssetJob.EXPECT().Enqueue(context.Background(), gomock.Any()).Return(nil)
 
 	// タグ無し: 付与可能タグは空。ReplaceDeckTags は空で呼ばれるが ReplaceDeckCodeTags は呼ばれない。
 	mockTag.EXPECT().FindAttachableByIds(context.Background(), gomock.Nil(), uid).Return([]*entity.Tag{}, nil)
 	mockTag.EXPECT().ReplaceDeckTags(context.Background(), gomock.Any(), []string{}).Return(nil)
 
-	param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, false, nil, nil)
+	param := NewDeckCreateParam(uid, "テストデッキ", false, deckCode, "", false, nil, nil)
 
 	deck, err := usecase.Create(context.Background(), param)
 
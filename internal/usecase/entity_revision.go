@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+	"github.com/vsrecorder/core-apiserver/internal/logging"
+)
+
+// appendRevision は記録・対戦結果の変更履歴を1件追記する。変更した人とリクエストは
+// ctx の uid / request_id から取る(認証・RequestIDMiddleware が載せている)。
+// 変更本体と同じ TransactionManager.Do の中で呼び、履歴だけが欠けないようにする。
+func appendRevision(
+	ctx context.Context,
+	revisionRepository repository.EntityRevisionInterface,
+	entityType entity.EntityRevisionType,
+	entityId string,
+	action entity.EntityRevisionAction,
+	userId string,
+	before any,
+	after any,
+) error {
+	id, err := generateId()
+	if err != nil {
+		return err
+	}
+
+	revision, err := entity.NewEntityRevision(
+		id,
+		timeNow().Local(),
+		entityType,
+		entityId,
+		action,
+		userId,
+		logging.UIDFromContext(ctx),
+		logging.RequestIDFromContext(ctx),
+		before,
+		after,
+	)
+	if err != nil {
+		return err
+	}
+
+	return revisionRepository.Append(ctx, revision)
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/logging"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+// stubEntityRevisionRepository は追記された変更履歴を溜めておくだけのスタブ。
+// 履歴の中身を確かめないテストでは、単に素通りさせるために使う。
+type stubEntityRevisionRepository struct {
+	revisions []*entity.EntityRevision
+}
+
+func (s *stubEntityRevisionRepository) Append(ctx context.Context, revision *entity.EntityRevision) error {
+	s.revisions = append(s.revisions, revision)
+	return nil
+}
+
+func (s *stubEntityRevisionRepository) FindByEntity(
+	ctx context.Context,
+	entityType entity.EntityRevisionType,
+	entityId string,
+	limit int,
+	offset int,
+) ([]*entity.EntityRevision, error) {
+	return s.revisions, nil
+}
+
+func TestAppendRevision(t *testing.T) {
+	t.Run("正常系_変更した人とリクエストをctxから記録する", func(t *testing.T) {
+		revisionRepository := &stubEntityRevisionRepository{}
+
+		ctx := logging.ContextWithUID(context.Background(), "actor-1")
+		ctx = logging.ContextWithRequestID(ctx, "request-1")
+
+		before := &entity.Record{ID: "record-1", UserId: "user-1", PrivateFlg: false}
+		after := &entity.Record{ID: "record-1", UserId: "user-1", PrivateFlg: true}
+
+		err := appendRevision(ctx, revisionRepository, entity.EntityRevisionTypeRecord, "record-1", entity.EntityRevisionActionUpdate, "user-1", before, after)
+		require.NoError(t, err)
+
+		require.Len(t, revisionRepository.revisions, 1)
+		revision := revisionRepository.revisions[0]
+		require.NotEmpty(t, revision.ID)
+		require.Equal(t, entity.EntityRevisionTypeRecord, revision.EntityType)
+		require.Equal(t, "record-1", revision.EntityId)
+		require.Equal(t, entity.EntityRevisionActionUpdate, revision.Action)
+		require.Equal(t, "user-1", revision.UserId)
+		require.Equal(t, "actor-1", revision.ActorUid)
+		require.Equal(t, "request-1", revision.RequestId)
+
+		var gotBefore, gotAfter entity.Record
+		hasBefore, hasAfter, err := revision.DecodeSnapshots(&gotBefore, &gotAfter)
+		require.NoError(t, err)
+		require.True(t, hasBefore)
+		require.True(t, hasAfter)
+		require.False(t, gotBefore.PrivateFlg)
+		require.True(t, gotAfter.PrivateFlg)
+	})
+
+	t.Run("正常系_削除では変更後を持たない", func(t *testing.T) {
+		revisionRepository := &stubEntityRevisionRepository{}
+
+		before := &entity.Match{ID: "match-1", UserId: "user-1"}
+
+		err := appendRevision(context.Background(), revisionRepository, entity.EntityRevisionTypeMatch, "match-1", entity.EntityRevisionActionDelete, "user-1", before, nil)
+		require.NoError(t, err)
+
+		require.Len(t, revisionRepository.revisions, 1)
+		require.Nil(t, revisionRepository.revisions[0].After)
+
+		var gotBefore, gotAfter entity.Match
+		hasBefore, hasAfter, err := revisionRepository.revisions[0].DecodeSnapshots(&gotBefore, &gotAfter)
+		require.NoError(t, err)
+		require.True(t, hasBefore)
+		require.False(t, hasAfter)
+		require.Equal(t, "match-1", gotBefore.ID)
+	})
+}
+
+func TestMatchUsecase_Reorder_AppendsRevision(t *testing.T) {
+	t.Run("正常系_位置か区分が変わった対戦結果だけ履歴に残す", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+		mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+		revisionRepository := &stubEntityRevisionRepository{}
+
+		usecase := NewMatch(mockRepository, mockRecordRepository, stubTagRepository{}, stubBadgeEvaluation{}, stubDesignationEvaluation{}, stubEnvironmentBadgeEvaluation{}, stubTransactionManager{}, revisionRepository, stubMatchConfirmationSync{})
+
+		recordId := "01JMPK4VF04QX714CG4PHYJ88K"
+		matches := []*entity.Match{
+			{ID: "match-1", UserId: "user-1", Position: 0, QualifyingRoundFlg: true},
+			{ID: "match-2", UserId: "user-1", Position: 1, QualifyingRoundFlg: true},
+			{ID: "match-3", UserId: "user-1", Position: 2, QualifyingRoundFlg: true},
+		}
+		orders := []*entity.MatchOrder{
+			// 位置は変わらない
+			{ID: "match-1", QualifyingRoundFlg: true},
+			// 位置が入れ替わる
+			{ID: "match-3", QualifyingRoundFlg: true},
+			{ID: "match-2", QualifyingRoundFlg: true},
+		}
+
+		mockRepository.EXPECT().FindByRecordId(context.Background(), recordId).Return(matches, nil)
+		mockRepository.EXPECT().Reorder(context.Background(), recordId, orders).Return(nil)
+
+		err := usecase.Reorder(context.Background(), recordId, orders)
+		require.NoError(t, err)
+
+		require.Len(t, revisionRepository.revisions, 2)
+		for _, revision := range revisionRepository.revisions {
+			require.Equal(t, entity.EntityRevisionActionReorder, revision.Action)
+			require.NotEqual(t, "match-1", revision.EntityId)
+		}
+
+		var before, after entity.Match
+		_, _, err = revisionRepository.revisions[0].DecodeSnapshots(&before, &after)
+		require.NoError(t, err)
+		require.Equal(t, "match-3", revisionRepository.revisions[0].EntityId)
+		require.Equal(t, 2, before.Position)
+		require.Equal(t, 1, after.Position)
+	})
+}
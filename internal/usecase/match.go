@@ -116,6 +116,11 @@ type MatchInterface interface {
 		limit int,
 	) ([]*entity.Match, error)
 
+	FindByCriteria(
+		ctx context.Context,
+		criteria *entity.RecordCriteria,
+	) ([]*entity.Match, error)
+
 	FindLatest(
 		ctx context.Context,
 		limit int,
@@ -142,6 +147,14 @@ type MatchInterface interface {
 		recordId string,
 		orders []*entity.MatchOrder,
 	) error
+
+	// History は対戦結果の変更履歴(更新・削除・並び替え)を新しい順に返す。
+	History(
+		ctx context.Context,
+		id string,
+		limit int,
+		offset int,
+	) ([]*entity.EntityRevision, error)
 }
 
 type Match struct {
@@ -151,6 +164,11 @@ type Match struct {
 	badgeEvaluation       BadgeEvaluationInterface
 	designationEvaluation DesignationEvaluationInterface
 	environmentBadgeEval  EnvironmentBadgeEvaluationInterface
+	// Update / Delete / Reorder の変更前後を、変更と同じトランザクションで履歴へ残す。
+	transactionManager repository.TransactionManager
+	revision           repository.EntityRevisionInterface
+	// 確認依頼で結び付いた対戦結果を直す・消すときは、同じトランザクションで依頼の状態を改める。
+	confirmationSync MatchConfirmationSyncInterface
 }
 
 func NewMatch(
@@ -160,20 +178,24 @@ func NewMatch(
 	badgeEvaluation BadgeEvaluationInterface,
 	designationEvaluation DesignationEvaluationInterface,
 	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	transactionManager repository.TransactionManager,
+	revision repository.EntityRevisionInterface,
+	confirmationSync MatchConfirmationSyncInterface,
 ) MatchInterface {
-	return &Match{repository, recordRepository, tag, badgeEvaluation, designationEvaluation, environmentBadgeEval}
+	return &Match{repository, recordRepository, tag, badgeEvaluation, designationEvaluation, environmentBadgeEval, transactionManager, revision, confirmationSync}
 }
 
 // syncMatchTags は対戦結果について、userId が付与できる有効なタグ(自分のタグ or
 // プリセット)だけを残して match_tags を更新し、付与後のタグを返す。
-// 挙動は Deck.syncDeckTags と同じ。
-func (u *Match) syncMatchTags(
+// 挙動は Deck.syncDeckTags と同じ。対戦結果を作成・更新する Match・Record で共有する。
+func syncMatchTags(
 	ctx context.Context,
+	tagRepository repository.TagInterface,
 	matchId string,
 	userId string,
 	tagIds []string,
 ) ([]*entity.Tag, error) {
-	tags, err := u.tag.FindAttachableByIds(ctx, tagIds, userId)
+	tags, err := tagRepository.FindAttachableByIds(ctx, tagIds, userId)
 	if err != nil {
 		logError(ctx, err)
 		return nil, err
@@ -182,7 +204,7 @@ func (u *Match) syncMatchTags(
 	// FindAttachableByIds の戻り順は不定なので、付与順(tagIds)に整列してから採番する。
 	orderedTags, attachableTagIds := orderAttachableTagsByIds(tags, tagIds)
 
-	if err := u.tag.ReplaceMatchTags(ctx, matchId, attachableTagIds); err != nil {
+	if err := tagRepository.ReplaceMatchTags(ctx, matchId, attachableTagIds); err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
@@ -233,6 +255,20 @@ func (u *Match) FindByUserId(
 	return matches, nil
 }
 
+func (u *Match) FindByCriteria(
+	ctx context.Context,
+	criteria *entity.RecordCriteria,
+) ([]*entity.Match, error) {
+	matches, err := u.repository.FindByCriteria(ctx, criteria)
+
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return matches, nil
+}
+
 func (u *Match) FindLatest(
 	ctx context.Context,
 	limit int,
@@ -272,38 +308,19 @@ func validateMatchParam(param *MatchParam) error {
 	return nil
 }
 
-func (u *Match) Create(
-	ctx context.Context,
+// newMatchFromParam は検証済みの param から、作成日時 createdAt の対戦結果 matchId を組み立てる。
+func newMatchFromParam(
+	matchId string,
+	createdAt time.Time,
 	param *MatchParam,
 ) (*entity.Match, error) {
-	if err := validateMatchParam(param); err != nil {
-		logError(ctx, err)
-		return nil, err
-	}
-
-	matchId, err := generateId()
-	if err != nil {
-		logError(ctx, err)
-		return nil, err
-	}
-
-	// 称号のtier変化を対戦結果作成の前後で比較するため、保存前の時点で取得しておく。
-	// 称号の「記録数」条件は対戦結果が1件以上紐づく記録のみをカウントするため、
-	// 記録作成時点ではなく対戦結果作成時点でtierが上がることが多い。
-	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, param.UserId)
-
-	createdAt := time.Now().Local()
-
 	var games []*entity.Game
 	for _, game := range param.Games {
 		gameId, err := generateId()
 		if err != nil {
-			logError(ctx, err)
 			return nil, err
 		}
 
-		createdAt := time.Now().Local()
-
 		games = append(
 			games,
 			entity.NewGame(
@@ -328,7 +345,7 @@ func (u *Match) Create(
 		)
 	}
 
-	match := entity.NewMatch(
+	return entity.NewMatch(
 		matchId,
 		createdAt,
 		param.RecordId,
@@ -349,7 +366,72 @@ func (u *Match) Create(
 		param.Memo,
 		games,
 		pokemonSprites,
-	)
+	), nil
+}
+
+// evaluateMatchesCreated は、他のユースケースがトランザクションの中で対戦結果のリポジトリに
+// 直接作った userId の対戦結果 matches(いずれも記録 record に紐づく)を、コミットした後に
+// Match.Create と同じ「ユーザバッジ→環境バッジ→称号/ランクアップ」の順で1度だけ判定する。
+// beforeTier / tierErr はトランザクションの前に取得した称号のtier。判定をトランザクションの
+// 中で行うと、ロールバックした対戦結果のバッジ・通知が残るため分けている。保存済みの
+// 対戦結果を失敗にしないよう、エラーはログに残すだけにする。
+func evaluateMatchesCreated(
+	ctx context.Context,
+	badgeEvaluation BadgeEvaluationInterface,
+	designationEvaluation DesignationEvaluationInterface,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	userId string,
+	record *entity.Record,
+	matches []*entity.Match,
+	beforeTier int,
+	tierErr error,
+) {
+	if len(matches) == 0 {
+		return
+	}
+
+	if _, err := badgeEvaluation.EvaluateOnMatchesCreated(ctx, userId, matches); err != nil {
+		logError(ctx, err)
+	}
+
+	// 環境バッジは公式イベントの記録のみを対象とし、環境ごとの初回対戦の判定のため先頭の対戦結果で判定する。
+	if record.OfficialEventId != 0 {
+		basisTime := RecordBasisTime(record.EventDate, record.CreatedAt)
+		if _, err := environmentBadgeEval.EvaluateOnMatchCreated(ctx, userId, matches[0], basisTime); err != nil {
+			logError(ctx, err)
+		}
+	}
+
+	if tierErr == nil {
+		designationEvaluation.NotifyIfTierChanged(ctx, userId, beforeTier, matches[0].CreatedAt)
+	}
+}
+
+func (u *Match) Create(
+	ctx context.Context,
+	param *MatchParam,
+) (*entity.Match, error) {
+	if err := validateMatchParam(param); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	matchId, err := generateId()
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 称号のtier変化を対戦結果作成の前後で比較するため、保存前の時点で取得しておく。
+	// 称号の「記録数」条件は対戦結果が1件以上紐づく記録のみをカウントするため、
+	// 記録作成時点ではなく対戦結果作成時点でtierが上がることが多い。
+	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, param.UserId)
+
+	match, err := newMatchFromParam(matchId, time.Now().Local(), param)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
 
 	if err := u.repository.Create(ctx, match); err != nil {
 		logError(ctx, err)
@@ -357,7 +439,7 @@ func (u *Match) Create(
 	}
 
 	// タグの付与は対戦結果本体とは別テーブルのため Create とは分けて反映する。
-	tags, err := u.syncMatchTags(ctx, match.ID, param.UserId, param.TagIds)
+	tags, err := syncMatchTags(ctx, u.tag, match.ID, param.UserId, param.TagIds)
 	if err != nil {
 		logError(ctx, err)
 		return nil, err
@@ -512,19 +594,31 @@ func (u *Match) Update(
 	)
 	// position は通常の更新では変更しないため、既存値を引き継ぐ
 	match.Position = ret.Position
+	// 版も引き継ぎ、読み込み後に他の端末で更新されていれば上書きしない
+	match.UpdatedAt = ret.UpdatedAt
 
-	if err := u.repository.Update(ctx, match); err != nil {
-		logError(ctx, err)
-		return nil, err
-	}
+	// 変更履歴の変更後にタグも含めるため、タグの付与まで同じトランザクションで行う。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Update(ctx, match); err != nil {
+			return err
+		}
 
-	// タグの付与を param.TagIds の集合に合わせて更新する。
-	tags, err := u.syncMatchTags(ctx, match.ID, param.UserId, param.TagIds)
-	if err != nil {
+		// タグの付与を param.TagIds の集合に合わせて更新する。
+		tags, err := syncMatchTags(ctx, u.tag, match.ID, param.UserId, param.TagIds)
+		if err != nil {
+			return err
+		}
+		match.Tags = tags
+
+		if err := appendRevision(ctx, u.revision, entity.EntityRevisionTypeMatch, id, entity.EntityRevisionActionUpdate, ret.UserId, ret, match); err != nil {
+			return err
+		}
+
+		return u.confirmationSync.OnMatchChanged(ctx, ret, match)
+	}); err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
-	match.Tags = tags
 
 	return match, nil
 }
@@ -542,7 +636,17 @@ func (u *Match) Delete(
 	// 称号のtier変化を削除の前後で比較するため、削除前の時点で取得しておく。
 	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, match.UserId)
 
-	if err := u.repository.Delete(ctx, id); err != nil {
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		if err := appendRevision(ctx, u.revision, entity.EntityRevisionTypeMatch, id, entity.EntityRevisionActionDelete, match.UserId, match, nil); err != nil {
+			return err
+		}
+
+		return u.confirmationSync.OnMatchChanged(ctx, match, nil)
+	}); err != nil {
 		logError(ctx, err)
 		return err
 	}
@@ -559,5 +663,65 @@ func (u *Match) Reorder(
 	recordId string,
 	orders []*entity.MatchOrder,
 ) error {
-	return u.repository.Reorder(ctx, recordId, orders)
+	// 並び替えで位置・予選/決勝の区分が変わった対戦結果だけ履歴に残すため、変更前を取得しておく。
+	matches, err := u.repository.FindByRecordId(ctx, recordId)
+	if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	before := make(map[string]*entity.Match, len(matches))
+	for _, match := range matches {
+		before[match.ID] = match
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Reorder(ctx, recordId, orders); err != nil {
+			return err
+		}
+
+		for position, order := range orders {
+			prev, ok := before[order.ID]
+			if !ok {
+				continue
+			}
+
+			if prev.Position == position &&
+				prev.QualifyingRoundFlg == order.QualifyingRoundFlg &&
+				prev.FinalTournamentFlg == order.FinalTournamentFlg {
+				continue
+			}
+
+			after := *prev
+			after.Position = position
+			after.QualifyingRoundFlg = order.QualifyingRoundFlg
+			after.FinalTournamentFlg = order.FinalTournamentFlg
+
+			if err := appendRevision(ctx, u.revision, entity.EntityRevisionTypeMatch, prev.ID, entity.EntityRevisionActionReorder, prev.UserId, prev, &after); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *Match) History(
+	ctx context.Context,
+	id string,
+	limit int,
+	offset int,
+) ([]*entity.EntityRevision, error) {
+	revisions, err := u.revision.FindByEntity(ctx, entity.EntityRevisionTypeMatch, id, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return revisions, nil
 }
@@ -0,0 +1,780 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+const (
+	NotificationCategoryMatchConfirmation = "match_confirmation"
+
+	// matchConfirmationLinkUrl は確認依頼の通知のリンク先(確認依頼の画面)の接頭辞。
+	matchConfirmationLinkUrl = "/match_confirmations/"
+
+	// 承認後に対戦相手が自分の側の対戦結果を直した・消したとき、依頼した側に見せる異議の理由。
+	matchConfirmationReasonMirroredMatchChanged = "承認後に対戦相手が対戦結果を変更しました"
+	matchConfirmationReasonMirroredMatchDeleted = "承認後に対戦相手が対戦結果を削除しました"
+	// 承認後に依頼した側が対戦結果を消したとき、対戦相手に見せる理由。
+	matchConfirmationReasonMatchDeleted = "承認後に依頼した側が対戦結果を削除しました"
+)
+
+// MatchConfirmationSyncInterface は承認後の対戦結果の変更を確認依頼に反映する。
+// Match が対戦結果を更新・削除するトランザクションの中から呼ぶ。
+type MatchConfirmationSyncInterface interface {
+	// OnMatchChanged は対戦結果 before が after に変わった(削除なら after は nil)ことを、
+	// before を結び付けている承認済みの確認依頼に反映し、もう片方のユーザに通知する。
+	// 依頼した側が直せば回答待ちに戻して確認し直してもらい、依頼した側が消すか、
+	// 対戦相手が自分の側を直す・消せば異議ありにする。デッキ・メモだけの変更など、
+	// 両側が裏返しのままの変更では何もしない。
+	OnMatchChanged(
+		ctx context.Context,
+		before *entity.Match,
+		after *entity.Match,
+	) error
+}
+
+type MatchConfirmationInterface interface {
+	// Request は userId の対戦結果 matchId について、対戦相手(登録ユーザ)に確認を依頼して通知する。
+	// 対戦相手は対戦結果の opponents_user_id、無ければ記録の friend_id。対戦相手がいなければ
+	// apperror.ErrNoMatchOpponent を、回答待ち・承認済みの依頼があれば
+	// apperror.ErrMatchConfirmationClosed を、どちらかがブロックしていれば apperror.ErrUserBlocked を
+	// 返す。異議を申し立てられた依頼は回答待ちに戻す。
+	Request(
+		ctx context.Context,
+		userId string,
+		matchId string,
+	) (*entity.MatchConfirmation, error)
+
+	// FindById は確認依頼を両側の対戦結果とともに返す。依頼した側・対戦相手以外には
+	// 存在しないものとして apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		userId string,
+		id string,
+	) (*entity.MatchConfirmation, error)
+
+	// FindByOpponentUserId は userId に届いた確認依頼を、依頼した側の対戦結果とともに新しい順に返す。
+	FindByOpponentUserId(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.MatchConfirmation, error)
+
+	// Confirm は対戦相手 userId が確認依頼を承認し、自分の記録 recordId に勝敗・先攻後攻・
+	// サイドの枚数を入れ替えた対戦結果を作る。承認後に依頼した側が直して回答待ちに
+	// 戻った依頼では、前に作った対戦結果を直す(デッキ・メモ等はそのまま残す)。
+	// recordId が userId の記録でなければ apperror.ErrInvalidRecord を、依頼した側との
+	// 間にどちらかからのブロックがあれば apperror.ErrUserBlocked を返す。
+	Confirm(
+		ctx context.Context,
+		userId string,
+		id string,
+		recordId string,
+	) (*entity.MatchConfirmation, error)
+
+	// Dispute は対戦相手 userId が確認依頼の内容に異議を申し立てる。依頼した側との間に
+	// どちらかからのブロックがあれば apperror.ErrUserBlocked を返す。
+	Dispute(
+		ctx context.Context,
+		userId string,
+		id string,
+		reason string,
+	) (*entity.MatchConfirmation, error)
+
+	MatchConfirmationSyncInterface
+}
+
+type MatchConfirmation struct {
+	repository       repository.MatchConfirmationInterface
+	matchRepository  repository.MatchInterface
+	recordRepository repository.RecordInterface
+	userRepository   repository.UserInterface
+	// ブロックし合っているユーザの間では、依頼・回答の通知を送らない。
+	relationshipRepository repository.UserRelationshipInterface
+	notificationRepo       repository.NotificationInterface
+	// 相手側の対戦結果は承認と同じトランザクションで作り、手で登録したときと同じ
+	// バッジ・称号の判定はコミットした後に行う。
+	badgeEvaluation       BadgeEvaluationInterface
+	designationEvaluation DesignationEvaluationInterface
+	environmentBadgeEval  EnvironmentBadgeEvaluationInterface
+	transactionManager    repository.TransactionManager
+	// 承認し直して相手側の対戦結果を直したときは、Match.Update と同じく履歴に残す。
+	revision repository.EntityRevisionInterface
+}
+
+func NewMatchConfirmation(
+	repository repository.MatchConfirmationInterface,
+	matchRepository repository.MatchInterface,
+	recordRepository repository.RecordInterface,
+	userRepository repository.UserInterface,
+	relationshipRepository repository.UserRelationshipInterface,
+	notificationRepo repository.NotificationInterface,
+	badgeEvaluation BadgeEvaluationInterface,
+	designationEvaluation DesignationEvaluationInterface,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	transactionManager repository.TransactionManager,
+	revision repository.EntityRevisionInterface,
+) MatchConfirmationInterface {
+	return &MatchConfirmation{
+		repository:             repository,
+		matchRepository:        matchRepository,
+		recordRepository:       recordRepository,
+		userRepository:         userRepository,
+		relationshipRepository: relationshipRepository,
+		notificationRepo:       notificationRepo,
+		badgeEvaluation:        badgeEvaluation,
+		designationEvaluation:  designationEvaluation,
+		environmentBadgeEval:   environmentBadgeEval,
+		transactionManager:     transactionManager,
+		revision:               revision,
+	}
+}
+
+// newMirroredMatchParam は match を対戦相手 userId の側から見た対戦結果として、
+// 相手の記録 record に作るためのパラメータを返す。デッキは相手の記録のものを使い、
+// 相手のデッキ情報・メモ・ポケモンのスプライトは相手自身が記録するため空にする。
+func newMirroredMatchParam(
+	match *entity.Match,
+	record *entity.Record,
+	userId string,
+) *MatchParam {
+	games := make([]*GameParam, 0, len(match.Games))
+	for _, game := range match.Games {
+		games = append(games, NewGameParam(
+			!game.GoFirst,
+			!game.WinningFlg,
+			game.OpponentsPrizeCards,
+			game.YourPrizeCards,
+			"",
+		))
+	}
+
+	result := match.Result().Opposite()
+
+	// チームの勝敗はチーム戦のBO1にだけあり、相手のチームの勝敗は自分のチームの逆になる。
+	groupMatchVictoryFlg := match.GroupMatchFlg && !match.BO3Flg && !match.GroupMatchVictoryFlg
+
+	return NewMatchParam(
+		record.ID,
+		record.DeckId,
+		record.DeckCodeId,
+		userId,
+		match.UserId,
+		match.BO3Flg,
+		match.GroupMatchFlg,
+		match.QualifyingRoundFlg,
+		match.FinalTournamentFlg,
+		match.DefaultDefeatFlg,
+		match.DefaultVictoryFlg,
+		result == entity.MatchResultWin,
+		result == entity.MatchResultDraw,
+		groupMatchVictoryFlg,
+		"",
+		"",
+		games,
+		nil,
+	)
+}
+
+// remirrorMatch は前に作った相手側の対戦結果 existing の勝敗・先攻後攻・サイドの枚数を、
+// newMirroredMatchParam で作った param に合わせたものを返す。記録・デッキ・メモ・
+// ポケモンのスプライトは相手自身が記録したものなので existing のまま残す。
+func remirrorMatch(
+	existing *entity.Match,
+	param *MatchParam,
+) (*entity.Match, error) {
+	mirrored := *existing
+	mirrored.OpponentsUserId = param.OpponentsUserId
+	mirrored.BO3Flg = param.BO3Flg
+	mirrored.GroupMatchFlg = param.GroupMatchFlg
+	mirrored.QualifyingRoundFlg = param.QualifyingRoundFlg
+	mirrored.FinalTournamentFlg = param.FinalTournamentFlg
+	mirrored.DefaultVictoryFlg = param.DefaultVictoryFlg
+	mirrored.DefaultDefeatFlg = param.DefaultDefeatFlg
+	mirrored.VictoryFlg = param.VictoryFlg
+	mirrored.DrawFlg = param.DrawFlg
+	mirrored.GroupMatchVictoryFlg = param.GroupMatchVictoryFlg
+
+	// ゲームは先頭から上書きし、増えた分は追加する(Match.Update と同じ)。
+	mirrored.Games = make([]*entity.Game, 0, len(param.Games))
+	for i, game := range param.Games {
+		var (
+			gameId    string
+			createdAt time.Time
+			memo      string
+		)
+
+		if i < len(existing.Games) {
+			gameId = existing.Games[i].ID
+			createdAt = existing.Games[i].CreatedAt
+			memo = existing.Games[i].Memo
+		} else {
+			id, err := generateId()
+			if err != nil {
+				return nil, err
+			}
+			gameId = id
+			createdAt = timeNow().Local()
+		}
+
+		mirrored.Games = append(mirrored.Games, entity.NewGame(
+			gameId,
+			createdAt,
+			existing.ID,
+			existing.UserId,
+			game.GoFirst,
+			game.WinningFlg,
+			game.YourPrizeCards,
+			game.OpponentsPrizeCards,
+			memo,
+		))
+	}
+
+	return &mirrored, nil
+}
+
+// opponentUserId は対戦結果の対戦相手の登録ユーザを返す。対戦結果に無ければ、
+// フレンド対戦の記録の friend_id を使う。
+func (u *MatchConfirmation) opponentUserId(
+	ctx context.Context,
+	match *entity.Match,
+) (string, error) {
+	if match.OpponentsUserId != "" {
+		return match.OpponentsUserId, nil
+	}
+
+	record, err := u.recordRepository.FindById(ctx, match.RecordId)
+	if err != nil {
+		logError(ctx, err)
+		return "", err
+	}
+
+	return record.FriendId, nil
+}
+
+// userName は通知の本文に使うユーザ名を返す。取得できなければ空文字を返す。
+func (u *MatchConfirmation) userName(
+	ctx context.Context,
+	userId string,
+) string {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		logWarn(ctx, err)
+		return ""
+	}
+
+	return user.Name
+}
+
+// blocked は2人の間に、どちらかからのブロックがあるかを返す。
+func (u *MatchConfirmation) blocked(
+	ctx context.Context,
+	userId string,
+	otherUserId string,
+) (bool, error) {
+	relationships, err := u.relationshipRepository.FindBetween(ctx, userId, otherUserId)
+	if err != nil {
+		logError(ctx, err)
+		return false, err
+	}
+
+	for _, relationship := range relationships {
+		if relationship.Status == entity.UserRelationshipStatusBlocked {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (u *MatchConfirmation) notify(
+	ctx context.Context,
+	userId string,
+	confirmation *entity.MatchConfirmation,
+	title string,
+	body string,
+) error {
+	id, err := generateId()
+	if err != nil {
+		return err
+	}
+
+	notification := entity.NewNotification(
+		id,
+		timeNow(),
+		userId,
+		NotificationCategoryMatchConfirmation,
+		title,
+		body,
+		matchConfirmationLinkUrl+confirmation.ID,
+	)
+
+	return u.notificationRepo.Save(ctx, notification)
+}
+
+// findMatch は確認依頼が参照する対戦結果を返す。削除されていれば nil を返す。
+func (u *MatchConfirmation) findMatch(
+	ctx context.Context,
+	id string,
+) (*entity.Match, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	match, err := u.matchRepository.FindById(ctx, id)
+	if errors.Is(err, apperror.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return match, nil
+}
+
+// findOpen は対戦相手 userId が回答できる(回答待ちの)確認依頼を返す。同時に回答された
+// 場合に備え、回答待ちであることは保存するとき(Save)にも確かめ直す。
+func (u *MatchConfirmation) findOpen(
+	ctx context.Context,
+	userId string,
+	id string,
+) (*entity.MatchConfirmation, error) {
+	confirmation, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 回答できるのは依頼先の対戦相手だけ。依頼した側にも存在は明かさない。
+	if confirmation.OpponentUserId != userId {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	if !confirmation.IsPending() {
+		return nil, apperror.ErrMatchConfirmationClosed
+	}
+
+	// 依頼の後にブロックした・された相手には、回答の通知も送らない。
+	if blocked, err := u.blocked(ctx, userId, confirmation.RequesterUserId); err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, apperror.ErrUserBlocked
+	}
+
+	return confirmation, nil
+}
+
+func (u *MatchConfirmation) Request(
+	ctx context.Context,
+	userId string,
+	matchId string,
+) (*entity.MatchConfirmation, error) {
+	match, err := u.matchRepository.FindById(ctx, matchId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	opponentUserId, err := u.opponentUserId(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+
+	if opponentUserId == "" || opponentUserId == userId {
+		return nil, apperror.ErrNoMatchOpponent
+	}
+
+	if _, err := u.userRepository.FindById(ctx, opponentUserId); errors.Is(err, apperror.ErrRecordNotFound) {
+		return nil, apperror.ErrNoMatchOpponent
+	} else if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if blocked, err := u.blocked(ctx, userId, opponentUserId); err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, apperror.ErrUserBlocked
+	}
+
+	now := timeNow()
+
+	confirmation, err := u.repository.FindByMatchId(ctx, matchId)
+	if err != nil && !errors.Is(err, apperror.ErrRecordNotFound) {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		switch {
+		case confirmation == nil:
+			id, err := generateId()
+			if err != nil {
+				return err
+			}
+
+			confirmation = entity.NewMatchConfirmation(id, now, matchId, userId, opponentUserId)
+			if err := u.repository.Create(ctx, confirmation); err != nil {
+				return err
+			}
+		case confirmation.Status == entity.MatchConfirmationStatusDisputed:
+			confirmation.Reopen(opponentUserId, now)
+			if err := u.repository.Save(ctx, confirmation, entity.MatchConfirmationStatusDisputed); err != nil {
+				return err
+			}
+		default:
+			return apperror.ErrMatchConfirmationClosed
+		}
+
+		return u.notify(
+			ctx,
+			opponentUserId,
+			confirmation,
+			"対戦結果の確認依頼が届きました",
+			u.userName(ctx, userId)+"さんがあなたとの対戦結果を登録しました。内容を確認してください",
+		)
+	}); err != nil {
+		if !errors.Is(err, apperror.ErrMatchConfirmationClosed) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	confirmation.Match = match
+
+	return confirmation, nil
+}
+
+func (u *MatchConfirmation) FindById(
+	ctx context.Context,
+	userId string,
+	id string,
+) (*entity.MatchConfirmation, error) {
+	confirmation, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if !confirmation.IsParticipant(userId) {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	if confirmation.Match, err = u.findMatch(ctx, confirmation.MatchId); err != nil {
+		return nil, err
+	}
+
+	if confirmation.MirroredMatch, err = u.findMatch(ctx, confirmation.MirroredMatchId); err != nil {
+		return nil, err
+	}
+
+	return confirmation, nil
+}
+
+func (u *MatchConfirmation) FindByOpponentUserId(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.MatchConfirmation, error) {
+	confirmations, err := u.repository.FindByOpponentUserId(ctx, userId, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	for _, confirmation := range confirmations {
+		if confirmation.Match, err = u.findMatch(ctx, confirmation.MatchId); err != nil {
+			return nil, err
+		}
+	}
+
+	return confirmations, nil
+}
+
+func (u *MatchConfirmation) Confirm(
+	ctx context.Context,
+	userId string,
+	id string,
+	recordId string,
+) (*entity.MatchConfirmation, error) {
+	confirmation, err := u.findOpen(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := u.matchRepository.FindById(ctx, confirmation.MatchId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	record, err := u.recordRepository.FindById(ctx, recordId)
+	if errors.Is(err, apperror.ErrRecordNotFound) {
+		return nil, apperror.ErrInvalidRecord
+	} else if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if record.UserId != userId {
+		return nil, apperror.ErrInvalidRecord
+	}
+
+	param := newMirroredMatchParam(match, record, userId)
+	if err := validateMatchParam(param); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 承認後に依頼した側が直して回答待ちに戻った依頼なら、前に作った対戦結果が残っている。
+	existing, err := u.findMatch(ctx, confirmation.MirroredMatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return u.reconfirm(ctx, userId, confirmation, match, existing, param)
+	}
+
+	matchId, err := generateId()
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	mirrored, err := newMatchFromParam(matchId, timeNow().Local(), param)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 称号のtier変化を対戦結果の作成前後で比較するため、保存前の時点で取得しておく(Match.Create と同じ)。
+	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, userId)
+
+	// 相手側の対戦結果の作成と承認を1つにまとめ、承認を保存できなかったとき(同時に
+	// 回答された場合を含む)に結び付かない対戦結果が残らないようにする。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.matchRepository.Create(ctx, mirrored); err != nil {
+			return err
+		}
+
+		confirmation.Confirm(mirrored.ID, timeNow())
+		if err := u.repository.Save(ctx, confirmation, entity.MatchConfirmationStatusPending); err != nil {
+			return err
+		}
+		confirmation.MirroredMatch = mirrored
+
+		return u.notify(
+			ctx,
+			confirmation.RequesterUserId,
+			confirmation,
+			"対戦結果が承認されました",
+			u.userName(ctx, userId)+"さんが対戦結果を承認しました",
+		)
+	}); err != nil {
+		if !errors.Is(err, apperror.ErrMatchConfirmationClosed) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	evaluateMatchesCreated(ctx, u.badgeEvaluation, u.designationEvaluation, u.environmentBadgeEval, userId, record, []*entity.Match{mirrored}, beforeTier, tierErr)
+
+	confirmation.Match = match
+
+	return confirmation, nil
+}
+
+// reconfirm は承認し直した依頼について、前に作った相手側の対戦結果 existing を直す。
+// 対戦結果は増えないため、バッジ・称号の判定は Match.Update と同じく行わない。
+func (u *MatchConfirmation) reconfirm(
+	ctx context.Context,
+	userId string,
+	confirmation *entity.MatchConfirmation,
+	match *entity.Match,
+	existing *entity.Match,
+	param *MatchParam,
+) (*entity.MatchConfirmation, error) {
+	mirrored, err := remirrorMatch(existing, param)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.matchRepository.Update(ctx, mirrored); err != nil {
+			return err
+		}
+
+		if err := appendRevision(ctx, u.revision, entity.EntityRevisionTypeMatch, mirrored.ID, entity.EntityRevisionActionUpdate, mirrored.UserId, existing, mirrored); err != nil {
+			return err
+		}
+
+		confirmation.Confirm(mirrored.ID, timeNow())
+		if err := u.repository.Save(ctx, confirmation, entity.MatchConfirmationStatusPending); err != nil {
+			return err
+		}
+		confirmation.MirroredMatch = mirrored
+
+		return u.notify(
+			ctx,
+			confirmation.RequesterUserId,
+			confirmation,
+			"対戦結果が承認されました",
+			u.userName(ctx, userId)+"さんが対戦結果を承認しました",
+		)
+	}); err != nil {
+		if !errors.Is(err, apperror.ErrMatchConfirmationClosed) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	confirmation.Match = match
+
+	return confirmation, nil
+}
+
+func (u *MatchConfirmation) Dispute(
+	ctx context.Context,
+	userId string,
+	id string,
+	reason string,
+) (*entity.MatchConfirmation, error) {
+	confirmation, err := u.findOpen(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body := u.userName(ctx, userId) + "さんが対戦結果に異議を申し立てました"
+	if reason != "" {
+		body += "：" + reason
+	}
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		confirmation.Dispute(reason, timeNow())
+		if err := u.repository.Save(ctx, confirmation, entity.MatchConfirmationStatusPending); err != nil {
+			return err
+		}
+
+		return u.notify(ctx, confirmation.RequesterUserId, confirmation, "対戦結果に異議が申し立てられました", body)
+	}); err != nil {
+		if !errors.Is(err, apperror.ErrMatchConfirmationClosed) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	if confirmation.Match, err = u.findMatch(ctx, confirmation.MatchId); err != nil {
+		return nil, err
+	}
+
+	return confirmation, nil
+}
+
+func (u *MatchConfirmation) OnMatchChanged(
+	ctx context.Context,
+	before *entity.Match,
+	after *entity.Match,
+) error {
+	confirmations, err := u.repository.FindByLinkedMatchId(ctx, before.ID)
+	if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	for _, confirmation := range confirmations {
+		if err := u.reconcile(ctx, confirmation, before, after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcile は承認済みの confirmation について、結び付いた対戦結果 before が after に
+// 変わったことを反映する。
+func (u *MatchConfirmation) reconcile(
+	ctx context.Context,
+	confirmation *entity.MatchConfirmation,
+	before *entity.Match,
+	after *entity.Match,
+) error {
+	if confirmation.Status != entity.MatchConfirmationStatusConfirmed {
+		return nil
+	}
+
+	byRequester := confirmation.MatchId == before.ID
+
+	if after != nil {
+		otherId := confirmation.MirroredMatchId
+		if !byRequester {
+			otherId = confirmation.MatchId
+		}
+
+		other, err := u.findMatch(ctx, otherId)
+		if err != nil {
+			return err
+		}
+
+		if other != nil && after.IsMirrorOf(other) {
+			return nil
+		}
+	}
+
+	now := timeNow()
+	name := u.userName(ctx, before.UserId)
+
+	var notifyUserId, title, body string
+	switch {
+	case byRequester && after != nil:
+		confirmation.Reopen(confirmation.OpponentUserId, now)
+		notifyUserId = confirmation.OpponentUserId
+		title = "承認した対戦結果が変更されました"
+		body = name + "さんが承認済みの対戦結果を変更しました。内容を確認してください"
+	case byRequester:
+		confirmation.Dispute(matchConfirmationReasonMatchDeleted, now)
+		notifyUserId = confirmation.OpponentUserId
+		title = "承認した対戦結果が削除されました"
+		body = name + "さんが承認済みの対戦結果を削除しました"
+	case after != nil:
+		confirmation.Dispute(matchConfirmationReasonMirroredMatchChanged, now)
+		notifyUserId = confirmation.RequesterUserId
+		title = "承認された対戦結果が変更されました"
+		body = name + "さんが承認した対戦結果を変更しました。内容を確認してください"
+	default:
+		confirmation.Dispute(matchConfirmationReasonMirroredMatchDeleted, now)
+		notifyUserId = confirmation.RequesterUserId
+		title = "承認された対戦結果が削除されました"
+		body = name + "さんが承認した対戦結果を削除しました"
+	}
+
+	// 同時に別のリクエストが状態を変えていれば、そちらを優先して何もしない。
+	if err := u.repository.Save(ctx, confirmation, entity.MatchConfirmationStatusConfirmed); errors.Is(err, apperror.ErrMatchConfirmationClosed) {
+		return nil
+	} else if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	// 状態は改めるが、ブロックし合っている相手には通知しない。
+	if blocked, err := u.blocked(ctx, before.UserId, notifyUserId); err != nil {
+		return err
+	} else if blocked {
+		return nil
+	}
+
+	if err := u.notify(ctx, notifyUserId, confirmation, title, body); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
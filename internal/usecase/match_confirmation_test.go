@@ -0,0 +1,677 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+type matchConfirmationUsecaseMocks struct {
+	confirmation *mock_repository.MockMatchConfirmationInterface
+	match        *mock_repository.MockMatchInterface
+	record       *mock_repository.MockRecordInterface
+	user         *mock_repository.MockUserInterface
+	relationship *mock_repository.MockUserRelationshipInterface
+	notification *mock_repository.MockNotificationInterface
+	revision     *stubEntityRevisionRepository
+	// calls はトランザクションのコミット("commit")とバッジ・称号の判定の順序。
+	calls *[]string
+}
+
+func setup4MatchConfirmationUsecase(t *testing.T) (
+	matchConfirmationUsecaseMocks,
+	MatchConfirmationInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mocks := matchConfirmationUsecaseMocks{
+		confirmation: mock_repository.NewMockMatchConfirmationInterface(mockCtrl),
+		match:        mock_repository.NewMockMatchInterface(mockCtrl),
+		record:       mock_repository.NewMockRecordInterface(mockCtrl),
+		user:         mock_repository.NewMockUserInterface(mockCtrl),
+		relationship: mock_repository.NewMockUserRelationshipInterface(mockCtrl),
+		notification: mock_repository.NewMockNotificationInterface(mockCtrl),
+		revision:     &stubEntityRevisionRepository{},
+		calls:        &[]string{},
+	}
+	mockTransactionManager := mock_repository.NewMockTransactionManager(mockCtrl)
+	mockTransactionManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+
+			*mocks.calls = append(*mocks.calls, "commit")
+			return nil
+		},
+	).AnyTimes()
+
+	usecase := NewMatchConfirmation(
+		mocks.confirmation,
+		mocks.match,
+		mocks.record,
+		mocks.user,
+		mocks.relationship,
+		mocks.notification,
+		orderTrackingBadgeEvaluation{calls: mocks.calls},
+		orderTrackingDesignationEvaluation{calls: mocks.calls},
+		orderTrackingEnvironmentBadgeEvaluation{calls: mocks.calls},
+		mockTransactionManager,
+		mocks.revision,
+	)
+
+	return mocks, usecase
+}
+
+func TestMatchConfirmationUsecase(t *testing.T) {
+	requesterId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	opponentId := "Q8qU2m0aBcXyZ1234567890abcd"
+	matchId := "01JTESTMATCH0000000000000A"
+	recordId := "01JTESTRECORD000000000000A"
+	opponentRecordId := "01JTESTRECORD000000000000B"
+	confirmationId := "01JTESTCONFIRM000000000000"
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+
+	newMatch := func() *entity.Match {
+		return &entity.Match{
+			ID:              matchId,
+			RecordId:        recordId,
+			UserId:          requesterId,
+			OpponentsUserId: opponentId,
+			BO3Flg:          true,
+			VictoryFlg:      true,
+			Games: []*entity.Game{
+				{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 2},
+				{GoFirst: false, WinningFlg: false, YourPrizeCards: 3, OpponentsPrizeCards: 6},
+				{GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 5},
+			},
+		}
+	}
+
+	mirroredMatchId := "01JTESTMIRROREDMATCH000000"
+
+	// newMirroredMatch は newMatch を対戦相手の側から記録した対戦結果を返す。
+	newMirroredMatch := func() *entity.Match {
+		return &entity.Match{
+			ID:              mirroredMatchId,
+			RecordId:        opponentRecordId,
+			UserId:          opponentId,
+			OpponentsUserId: requesterId,
+			BO3Flg:          true,
+			Memo:            "相手のメモ",
+			Games: []*entity.Game{
+				{ID: "01JTESTGAME000000000000001", GoFirst: false, WinningFlg: false, YourPrizeCards: 2, OpponentsPrizeCards: 6, Memo: "1戦目"},
+				{ID: "01JTESTGAME000000000000002", GoFirst: true, WinningFlg: true, YourPrizeCards: 6, OpponentsPrizeCards: 3},
+				{ID: "01JTESTGAME000000000000003", GoFirst: false, WinningFlg: false, YourPrizeCards: 5, OpponentsPrizeCards: 6},
+			},
+		}
+	}
+
+	// newConfirmed は承認済みの依頼を返す。
+	newConfirmed := func() *entity.MatchConfirmation {
+		confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+		confirmation.Confirm(mirroredMatchId, now)
+		return confirmation
+	}
+
+	t.Run("Request", func(t *testing.T) {
+		t.Run("正常系_対戦相手に確認を依頼して通知する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.confirmation.EXPECT().FindByMatchId(gomock.Any(), matchId).Return(nil, apperror.ErrRecordNotFound)
+			mocks.confirmation.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId, Name: "テスト"}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, opponentId, notification.UserId)
+					require.Equal(t, NotificationCategoryMatchConfirmation, notification.Category)
+					return nil
+				},
+			)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusPending, ret.Status)
+			require.Equal(t, requesterId, ret.RequesterUserId)
+			require.Equal(t, opponentId, ret.OpponentUserId)
+			require.Equal(t, now, ret.CreatedAt)
+		})
+
+		t.Run("正常系_対戦結果に相手がいなければフレンド対戦の相手に依頼する", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			match := newMatch()
+			match.OpponentsUserId = ""
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(&entity.Record{ID: recordId, UserId: requesterId, FriendId: opponentId}, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.confirmation.EXPECT().FindByMatchId(gomock.Any(), matchId).Return(nil, apperror.ErrRecordNotFound)
+			mocks.confirmation.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.NoError(t, err)
+			require.Equal(t, opponentId, ret.OpponentUserId)
+		})
+
+		t.Run("正常系_異議を申し立てられた依頼は回答待ちに戻す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			disputed := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+			disputed.Dispute("2戦目は私の勝ちです", now)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.confirmation.EXPECT().FindByMatchId(gomock.Any(), matchId).Return(disputed, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), disputed, entity.MatchConfirmationStatusDisputed).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.NoError(t, err)
+			require.Equal(t, confirmationId, ret.ID)
+			require.True(t, ret.IsPending())
+		})
+
+		t.Run("異常系_対戦相手がいなければErrNoMatchOpponentを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			match := newMatch()
+			match.OpponentsUserId = ""
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(&entity.Record{ID: recordId, UserId: requesterId}, nil)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.ErrorIs(t, err, apperror.ErrNoMatchOpponent)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_対戦相手が退会していればErrNoMatchOpponentを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.ErrorIs(t, err, apperror.ErrNoMatchOpponent)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_どちらかがブロックしていればErrUserBlockedを返し通知しない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return([]*entity.UserRelationship{
+				entity.NewUserRelationship("01JTESTRELATIONSHIP0000000", now, opponentId, requesterId, entity.UserRelationshipStatusBlocked),
+			}, nil)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.ErrorIs(t, err, apperror.ErrUserBlocked)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_回答待ちの依頼があればErrMatchConfirmationClosedを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			pending := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.confirmation.EXPECT().FindByMatchId(gomock.Any(), matchId).Return(pending, nil)
+
+			ret, err := usecase.Request(context.Background(), requesterId, matchId)
+
+			require.ErrorIs(t, err, apperror.ErrMatchConfirmationClosed)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("正常系_当事者には両側の対戦結果とともに返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+			confirmation.Confirm("01JTESTMIRROREDMATCH000000", now)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), "01JTESTMIRROREDMATCH000000").Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.FindById(context.Background(), opponentId, confirmationId)
+
+			require.NoError(t, err)
+			require.NotNil(t, ret.Match)
+			require.Nil(t, ret.MirroredMatch)
+			require.False(t, ret.InSync())
+		})
+
+		t.Run("異常系_当事者以外にはErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+
+			ret, err := usecase.FindById(context.Background(), "other", confirmationId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		t.Run("正常系_相手の記録に裏返しの対戦結果を作って承認し、コミット後に判定する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			var mirrored *entity.Match
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), opponentRecordId).Return(&entity.Record{ID: opponentRecordId, UserId: opponentId, DeckId: "01JTESTDECK00000000000000A"}, nil)
+			mocks.match.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, match *entity.Match) error {
+					mirrored = match
+					return nil
+				},
+			)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusPending).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, requesterId, notification.UserId)
+					return nil
+				},
+			)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, opponentRecordId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusConfirmed, ret.Status)
+			require.Equal(t, mirrored.ID, ret.MirroredMatchId)
+			require.Equal(t, now, ret.RespondedAt)
+
+			require.Equal(t, opponentRecordId, mirrored.RecordId)
+			require.Equal(t, opponentId, mirrored.UserId)
+			require.Equal(t, requesterId, mirrored.OpponentsUserId)
+			require.Equal(t, "01JTESTDECK00000000000000A", mirrored.DeckId)
+			require.False(t, mirrored.VictoryFlg)
+			require.Len(t, mirrored.Games, 3)
+			require.False(t, mirrored.Games[0].GoFirst)
+			require.False(t, mirrored.Games[0].WinningFlg)
+			require.Equal(t, uint(2), mirrored.Games[0].YourPrizeCards)
+			require.Equal(t, uint(6), mirrored.Games[0].OpponentsPrizeCards)
+
+			// 判定はロールバックされ得るトランザクションの中ではなく、コミットした後に1度だけ行う。
+			require.Equal(t, []string{"commit", "badge", "designation"}, *mocks.calls)
+		})
+
+		t.Run("正常系_承認後に直されて回答待ちに戻った依頼は前に作った対戦結果を直す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			// 依頼した側が3戦目を負けに直し、1勝2敗の負けにした。
+			match := newMatch()
+			match.VictoryFlg = false
+			match.Games[2] = &entity.Game{GoFirst: true, WinningFlg: false, YourPrizeCards: 5, OpponentsPrizeCards: 6}
+
+			confirmation := newConfirmed()
+			confirmation.Reopen(opponentId, now)
+
+			var mirrored *entity.Match
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(match, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), opponentRecordId).Return(&entity.Record{ID: opponentRecordId, UserId: opponentId}, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), mirroredMatchId).Return(newMirroredMatch(), nil)
+			mocks.match.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, match *entity.Match) error {
+					mirrored = match
+					return nil
+				},
+			)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusPending).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, opponentRecordId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusConfirmed, ret.Status)
+			require.Equal(t, mirroredMatchId, ret.MirroredMatchId)
+
+			// 勝敗は裏返しに直し、相手が自分で記録したメモとゲームのIDは残す。
+			require.Equal(t, mirroredMatchId, mirrored.ID)
+			require.True(t, mirrored.VictoryFlg)
+			require.True(t, mirrored.Games[2].WinningFlg)
+			require.Equal(t, "01JTESTGAME000000000000003", mirrored.Games[2].ID)
+			require.Equal(t, "1戦目", mirrored.Games[0].Memo)
+			require.Equal(t, "相手のメモ", mirrored.Memo)
+			require.True(t, match.IsMirrorOf(mirrored))
+
+			// 直した相手側の対戦結果は履歴に残し、対戦結果は増えないので判定しない。
+			require.Len(t, mocks.revision.revisions, 1)
+			require.Equal(t, mirroredMatchId, mocks.revision.revisions[0].EntityId)
+			require.Equal(t, []string{"commit"}, *mocks.calls)
+		})
+
+		t.Run("異常系_依頼した側とブロックし合っていればErrUserBlockedを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return([]*entity.UserRelationship{
+				entity.NewUserRelationship("01JTESTRELATIONSHIP0000000", now, opponentId, requesterId, entity.UserRelationshipStatusBlocked),
+			}, nil)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, opponentRecordId)
+
+			require.ErrorIs(t, err, apperror.ErrUserBlocked)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_同時に回答されていればErrMatchConfirmationClosedを返し判定しない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), opponentRecordId).Return(&entity.Record{ID: opponentRecordId, UserId: opponentId}, nil)
+			mocks.match.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusPending).Return(apperror.ErrMatchConfirmationClosed)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, opponentRecordId)
+
+			require.ErrorIs(t, err, apperror.ErrMatchConfirmationClosed)
+			require.Nil(t, ret)
+			require.Empty(t, *mocks.calls)
+		})
+
+		t.Run("異常系_依頼先でなければErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+
+			ret, err := usecase.Confirm(context.Background(), requesterId, confirmationId, recordId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_回答済みならErrMatchConfirmationClosedを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+			confirmation.Dispute("", now)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, opponentRecordId)
+
+			require.ErrorIs(t, err, apperror.ErrMatchConfirmationClosed)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_他人の記録を指定するとErrInvalidRecordを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(&entity.Record{ID: recordId, UserId: requesterId}, nil)
+
+			ret, err := usecase.Confirm(context.Background(), opponentId, confirmationId, recordId)
+
+			require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Dispute", func(t *testing.T) {
+		t.Run("正常系_異議を申し立てて依頼した側に通知する", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusPending).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, requesterId, notification.UserId)
+					require.Contains(t, notification.Body, "2戦目は私の勝ちです")
+					return nil
+				},
+			)
+
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+
+			ret, err := usecase.Dispute(context.Background(), opponentId, confirmationId, "2戦目は私の勝ちです")
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusDisputed, ret.Status)
+			require.Equal(t, "2戦目は私の勝ちです", ret.DisputeReason)
+		})
+
+		t.Run("異常系_同時に承認されていればErrMatchConfirmationClosedを返す", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindById(gomock.Any(), confirmationId).Return(confirmation, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusPending).Return(apperror.ErrMatchConfirmationClosed)
+
+			ret, err := usecase.Dispute(context.Background(), opponentId, confirmationId, "")
+
+			require.ErrorIs(t, err, apperror.ErrMatchConfirmationClosed)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("OnMatchChanged", func(t *testing.T) {
+		t.Run("正常系_依頼した側が直して食い違えば回答待ちに戻し対戦相手に通知する", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+			before := newMatch()
+			after := newMatch()
+			after.VictoryFlg = false
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), matchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), mirroredMatchId).Return(newMirroredMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusConfirmed).Return(nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, opponentId, notification.UserId)
+					return nil
+				},
+			)
+
+			err := usecase.OnMatchChanged(context.Background(), before, after)
+
+			require.NoError(t, err)
+			require.True(t, confirmation.IsPending())
+			require.Equal(t, mirroredMatchId, confirmation.MirroredMatchId)
+		})
+
+		t.Run("正常系_両側が裏返しのままの変更では何もしない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+			after := newMatch()
+			after.Memo = "メモだけ直した"
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), matchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), mirroredMatchId).Return(newMirroredMatch(), nil)
+
+			err := usecase.OnMatchChanged(context.Background(), newMatch(), after)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusConfirmed, confirmation.Status)
+		})
+
+		t.Run("正常系_依頼した側が消せば異議ありにして対戦相手に通知する", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), matchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusConfirmed).Return(nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), requesterId, opponentId).Return(nil, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, opponentId, notification.UserId)
+					return nil
+				},
+			)
+
+			err := usecase.OnMatchChanged(context.Background(), newMatch(), nil)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusDisputed, confirmation.Status)
+			require.Equal(t, matchConfirmationReasonMatchDeleted, confirmation.DisputeReason)
+		})
+
+		t.Run("正常系_対戦相手が自分の側を直して食い違えば異議ありにして依頼した側に通知する", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+			after := newMirroredMatch()
+			after.Games[0].YourPrizeCards = 4
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), mirroredMatchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.match.EXPECT().FindById(gomock.Any(), matchId).Return(newMatch(), nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusConfirmed).Return(nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return(nil, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, requesterId, notification.UserId)
+					return nil
+				},
+			)
+
+			err := usecase.OnMatchChanged(context.Background(), newMirroredMatch(), after)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusDisputed, confirmation.Status)
+			require.Equal(t, matchConfirmationReasonMirroredMatchChanged, confirmation.DisputeReason)
+		})
+
+		t.Run("正常系_ブロックし合っていれば状態だけ改めて通知しない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), mirroredMatchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), opponentId).Return(&entity.User{ID: opponentId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusConfirmed).Return(nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), opponentId, requesterId).Return([]*entity.UserRelationship{
+				entity.NewUserRelationship("01JTESTRELATIONSHIP0000000", now, requesterId, opponentId, entity.UserRelationshipStatusBlocked),
+			}, nil)
+
+			err := usecase.OnMatchChanged(context.Background(), newMirroredMatch(), nil)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.MatchConfirmationStatusDisputed, confirmation.Status)
+			require.Equal(t, matchConfirmationReasonMirroredMatchDeleted, confirmation.DisputeReason)
+		})
+
+		t.Run("正常系_承認前の依頼には何もしない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			pending := entity.NewMatchConfirmation(confirmationId, now, matchId, requesterId, opponentId)
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), matchId).Return([]*entity.MatchConfirmation{pending}, nil)
+
+			err := usecase.OnMatchChanged(context.Background(), newMatch(), nil)
+
+			require.NoError(t, err)
+			require.True(t, pending.IsPending())
+		})
+
+		t.Run("正常系_同時に状態が変わっていれば通知しない", func(t *testing.T) {
+			mocks, usecase := setup4MatchConfirmationUsecase(t)
+
+			confirmation := newConfirmed()
+
+			mocks.confirmation.EXPECT().FindByLinkedMatchId(gomock.Any(), matchId).Return([]*entity.MatchConfirmation{confirmation}, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), requesterId).Return(&entity.User{ID: requesterId}, nil)
+			mocks.confirmation.EXPECT().Save(gomock.Any(), confirmation, entity.MatchConfirmationStatusConfirmed).Return(apperror.ErrMatchConfirmationClosed)
+
+			err := usecase.OnMatchChanged(context.Background(), newMatch(), nil)
+
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestNewMirroredMatchParam(t *testing.T) {
+	record := &entity.Record{ID: "01JTESTRECORD000000000000B"}
+
+	for name, tc := range map[string]struct {
+		match    *entity.Match
+		expected bool
+	}{
+		"正常系_チーム戦のBO1でチームが勝っていれば相手のチームは負け": {
+			match:    &entity.Match{GroupMatchFlg: true, VictoryFlg: true, GroupMatchVictoryFlg: true, Games: []*entity.Game{{WinningFlg: true}}},
+			expected: false,
+		},
+		"正常系_チーム戦のBO1でチームが負けていれば相手のチームは勝ち": {
+			match:    &entity.Match{GroupMatchFlg: true, VictoryFlg: true, Games: []*entity.Game{{WinningFlg: true}}},
+			expected: true,
+		},
+		"正常系_チーム戦のBO3はチームの勝敗を持たない": {
+			match:    &entity.Match{GroupMatchFlg: true, BO3Flg: true, VictoryFlg: true, Games: []*entity.Game{{WinningFlg: true}, {WinningFlg: true}}},
+			expected: false,
+		},
+		"正常系_チーム戦でなければチームの勝敗を持たない": {
+			match:    &entity.Match{VictoryFlg: true, Games: []*entity.Game{{WinningFlg: true}}},
+			expected: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			param := newMirroredMatchParam(tc.match, record, "Q8qU2m0aBcXyZ1234567890abcd")
+
+			require.Equal(t, tc.expected, param.GroupMatchVictoryFlg)
+			require.NoError(t, validateMatchParam(param))
+		})
+	}
+}
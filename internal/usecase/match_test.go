@@ -49,6 +49,11 @@ func (s orderTrackingBadgeEvaluation) EvaluateOnMatchCreated(ctx context.Context
 	return nil, nil
 }
 
+func (s orderTrackingBadgeEvaluation) EvaluateOnMatchesCreated(ctx context.Context, userId string, matches []*entity.Match) ([]*entity.UserBadge, error) {
+	*s.calls = append(*s.calls, "badge")
+	return nil, nil
+}
+
 func (s orderTrackingBadgeEvaluation) EvaluateOnDeckCreated(ctx context.Context, userId string, deck *entity.Deck) ([]*entity.UserBadge, error) {
 	return nil, nil
 }
@@ -64,6 +69,15 @@ func (s orderTrackingBadgeEvaluation) EvaluateOnRecordDeleted(ctx context.Contex
 	return nil
 }
 
+func (s orderTrackingBadgeEvaluation) EvaluateOnRecordRestored(ctx context.Context, userId string) error {
+	return nil
+}
+
+func (s orderTrackingBadgeEvaluation) EvaluateOnRecordsImported(ctx context.Context, userId string, records []*entity.Record, matches []*entity.Match) ([]*entity.UserBadge, error) {
+	*s.calls = append(*s.calls, "badge")
+	return nil, nil
+}
+
 type orderTrackingEnvironmentBadgeEvaluation struct {
 	calls *[]string
 }
@@ -81,6 +95,25 @@ func (s orderTrackingEnvironmentBadgeEvaluation) UpdateAchievedNotification(ctx
 	return nil
 }
 
+// stubMatchConfirmationSync は確認依頼への反映を何もしない手書きスタブ。
+type stubMatchConfirmationSync struct{}
+
+func (stubMatchConfirmationSync) OnMatchChanged(ctx context.Context, before *entity.Match, after *entity.Match) error {
+	return nil
+}
+
+// spyMatchConfirmationSync は確認依頼へ反映しようとした変更前後の対戦結果を記録する。
+type spyMatchConfirmationSync struct {
+	before []*entity.Match
+	after  []*entity.Match
+}
+
+func (s *spyMatchConfirmationSync) OnMatchChanged(ctx context.Context, before *entity.Match, after *entity.Match) error {
+	s.before = append(s.before, before)
+	s.after = append(s.after, after)
+	return nil
+}
+
 // 通知の作成順は「ユーザバッジ→環境バッジ→称号/ランクアップ」である必要がある。
 // created_at DESC(同値時はid DESC)で表示されるため、この作成順により表示順は下から
 // 「ユーザバッジ→環境バッジ→称号/ランクアップ」(=上から称号/ランクアップ→環境バッジ→
@@ -99,6 +132,9 @@ func TestMatchUsecase_Create_NotificationCreationOrder(t *testing.T) {
 		orderTrackingBadgeEvaluation{calls: &calls},
 		orderTrackingDesignationEvaluation{calls: &calls},
 		orderTrackingEnvironmentBadgeEvaluation{calls: &calls},
+		stubTransactionManager{},
+		&stubEntityRevisionRepository{},
+		stubMatchConfirmationSync{},
 	)
 
 	recordId := "01JMPK4VF04QX714CG4PHYJ88K"
@@ -128,7 +164,7 @@ func TestMatchUsecase(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockRepository := mock_repository.NewMockMatchInterface(mockCtrl)
 	mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
-	usecase := NewMatch(mockRepository, mockRecordRepository, stubTagRepository{}, stubBadgeEvaluation{}, stubDesignationEvaluation{}, stubEnvironmentBadgeEvaluation{})
+	usecase := NewMatch(mockRepository, mockRecordRepository, stubTagRepository{}, stubBadgeEvaluation{}, stubDesignationEvaluation{}, stubEnvironmentBadgeEvaluation{}, stubTransactionManager{}, &stubEntityRevisionRepository{}, stubMatchConfirmationSync{})
 
 	for scenario, fn := range map[string]func(
 		t *testing.T,
@@ -974,6 +1010,56 @@ func test_MatchUsecase_Delete(t *testing.T, mockRepository *mock_repository.Mock
 	})
 }
 
+// 確認依頼で結び付いた対戦結果を直す・消すときは、変更前後を確認依頼にも反映する。
+func TestMatchUsecase_ConfirmationSync(t *testing.T) {
+	id := "01JMPK4VF04QX714CG4PHYJ88M"
+	recordId := "01JMPK4VF04QX714CG4PHYJ88K"
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+
+	setup := func(t *testing.T) (*mock_repository.MockMatchInterface, *spyMatchConfirmationSync, MatchInterface) {
+		mockCtrl := gomock.NewController(t)
+		mockRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+		sync := &spyMatchConfirmationSync{}
+		usecase := NewMatch(mockRepository, mock_repository.NewMockRecordInterface(mockCtrl), stubTagRepository{}, stubBadgeEvaluation{}, stubDesignationEvaluation{}, stubEnvironmentBadgeEvaluation{}, stubTransactionManager{}, &stubEntityRevisionRepository{}, sync)
+
+		return mockRepository, sync, usecase
+	}
+
+	t.Run("正常系_更新すると変更前後を反映する", func(t *testing.T) {
+		mockRepository, sync, usecase := setup(t)
+
+		before := &entity.Match{ID: id, RecordId: recordId, UserId: userId, VictoryFlg: true}
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(before, nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		param := NewMatchParam(
+			recordId, "", "", userId, "",
+			false, false, false, false, false, false, false, false, false,
+			"", "", []*GameParam{NewGameParam(true, false, 0, 0, "")}, nil,
+		)
+
+		ret, err := usecase.Update(context.Background(), id, param)
+
+		require.NoError(t, err)
+		require.Equal(t, []*entity.Match{before}, sync.before)
+		require.Equal(t, []*entity.Match{ret}, sync.after)
+	})
+
+	t.Run("正常系_削除すると変更後をnilとして反映する", func(t *testing.T) {
+		mockRepository, sync, usecase := setup(t)
+
+		match := &entity.Match{ID: id, UserId: userId}
+		mockRepository.EXPECT().FindById(gomock.Any(), id).Return(match, nil)
+		mockRepository.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+		err := usecase.Delete(context.Background(), id)
+
+		require.NoError(t, err)
+		require.Equal(t, []*entity.Match{match}, sync.before)
+		require.Equal(t, []*entity.Match{nil}, sync.after)
+	})
+}
+
 func test_MatchUsecase_Reorder(t *testing.T, mockRepository *mock_repository.MockMatchInterface, mockRecordRepository *mock_repository.MockRecordInterface, usecase MatchInterface) {
 	t.Run("正常系_指定順序でリポジトリのReorderを呼び出す", func(t *testing.T) {
 		recordId, _ := generateId()
@@ -985,6 +1071,7 @@ func test_MatchUsecase_Reorder(t *testing.T, mockRepository *mock_repository.Moc
 			{ID: id2, QualifyingRoundFlg: true, FinalTournamentFlg: false},
 		}
 
+		mockRepository.EXPECT().FindByRecordId(context.Background(), recordId).Return([]*entity.Match{}, nil)
 		mockRepository.EXPECT().Reorder(context.Background(), recordId, orders).Return(nil)
 
 		err := usecase.Reorder(context.Background(), recordId, orders)
@@ -996,6 +1083,7 @@ func test_MatchUsecase_Reorder(t *testing.T, mockRepository *mock_repository.Moc
 		recordId, _ := generateId()
 		orders := []*entity.MatchOrder{}
 
+		mockRepository.EXPECT().FindByRecordId(context.Background(), recordId).Return([]*entity.Match{}, nil)
 		mockRepository.EXPECT().Reorder(context.Background(), recordId, orders).Return(errors.New(""))
 
 		err := usecase.Reorder(context.Background(), recordId, orders)
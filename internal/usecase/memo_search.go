@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type MemoSearchInterface interface {
+	// Search はユーザの記録・対戦結果・対局のメモのうち terms の全てを含むものを、
+	// 一致した箇所の周辺を切り出したスニペット付きで新しい順に返す。
+	Search(
+		ctx context.Context,
+		userId string,
+		terms []string,
+		limit int,
+		offset int,
+	) ([]*entity.MemoSearchHit, error)
+}
+
+type MemoSearch struct {
+	repository repository.MemoSearchInterface
+}
+
+func NewMemoSearch(
+	repository repository.MemoSearchInterface,
+) MemoSearchInterface {
+	return &MemoSearch{repository}
+}
+
+func (u *MemoSearch) Search(
+	ctx context.Context,
+	userId string,
+	terms []string,
+	limit int,
+	offset int,
+) ([]*entity.MemoSearchHit, error) {
+	hits, err := u.repository.Search(ctx, userId, terms, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	for _, hit := range hits {
+		hit.Snippet = entity.NewMemoSnippet(hit.Memo, terms)
+	}
+
+	return hits, nil
+}
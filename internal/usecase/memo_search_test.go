@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func TestMemoSearchUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	terms := []string{"ドラパルト"}
+
+	t.Run("正常系_一致したメモにスニペットを付けて返す", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockRepository := mock_repository.NewMockMemoSearchInterface(mockCtrl)
+		usecase := NewMemoSearch(mockRepository)
+
+		mockRepository.EXPECT().Search(context.Background(), uid, terms, 10, 0).Return([]*entity.MemoSearchHit{
+			{Type: entity.MemoSearchHitTypeMatch, ID: "match-1", RecordId: "record-1", MatchId: "match-1", Memo: "後攻でドラパルトに勝ち"},
+		}, nil)
+
+		hits, err := usecase.Search(context.Background(), uid, terms, 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		require.Equal(t, "後攻でドラパルトに勝ち", hits[0].Snippet.Text)
+		require.Equal(t, []*entity.MemoHighlight{{Offset: 3, Length: 5}}, hits[0].Snippet.Highlights)
+	})
+
+	t.Run("異常系_リポジトリのエラーを返す", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockRepository := mock_repository.NewMockMemoSearchInterface(mockCtrl)
+		usecase := NewMemoSearch(mockRepository)
+
+		mockRepository.EXPECT().Search(context.Background(), uid, terms, 10, 0).Return(nil, errors.New(""))
+
+		_, err := usecase.Search(context.Background(), uid, terms, 10, 0)
+
+		require.Error(t, err)
+	})
+}
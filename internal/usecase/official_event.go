@@ -21,6 +21,12 @@ type OfficialEventInterface interface {
 		ctx context.Context,
 		id uint,
 	) (*entity.OfficialEvent, error)
+
+	// Search は条件に合う公式イベントを開催日の新しい順に返す。
+	Search(
+		ctx context.Context,
+		criteria *entity.OfficialEventSearchCriteria,
+	) ([]*entity.OfficialEventSearchHit, error)
 }
 
 type OfficialEvent struct {
@@ -63,3 +69,17 @@ func (u *OfficialEvent) FindById(
 
 	return officialEvent, nil
 }
+
+func (u *OfficialEvent) Search(
+	ctx context.Context,
+	criteria *entity.OfficialEventSearchCriteria,
+) ([]*entity.OfficialEventSearchHit, error) {
+	hits, err := u.repository.Search(ctx, criteria)
+
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return hits, nil
+}
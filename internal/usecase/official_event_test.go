@@ -29,6 +29,7 @@ func TestOfficialEventUsecase(t *testing.T) {
 	){
 		"Find":     test_OfficialEventUsecase_Find,
 		"FindById": test_OfficialEventUsecase_FindById,
+		"Search":   test_OfficialEventUsecase_Search,
 	} {
 		t.Run(scenario, func(t *testing.T) {
 			fn(t, mockRepository, usecase)
@@ -112,3 +113,39 @@ func test_OfficialEventUsecase_FindById(
 		require.Empty(t, ret)
 	})
 }
+
+func test_OfficialEventUsecase_Search(
+	t *testing.T,
+	mockRepository *mock_repository.MockOfficialEventInterface,
+	usecase OfficialEventInterface,
+) {
+	t.Run("正常系_条件に合致する公式イベントを返す", func(t *testing.T) {
+		criteria := &entity.OfficialEventSearchCriteria{
+			Terms:        []string{"ジムバトル"},
+			PrefectureId: 13,
+			Limit:        10,
+		}
+
+		hits := []*entity.OfficialEventSearchHit{
+			{OfficialEvent: &entity.OfficialEvent{ID: uint(606466)}},
+		}
+
+		mockRepository.EXPECT().Search(context.Background(), criteria).Return(hits, nil)
+
+		ret, err := usecase.Search(context.Background(), criteria)
+
+		require.NoError(t, err)
+		require.Equal(t, hits, ret)
+	})
+
+	t.Run("異常系_リポジトリのエラーをそのまま返す", func(t *testing.T) {
+		criteria := &entity.OfficialEventSearchCriteria{Limit: 10}
+
+		mockRepository.EXPECT().Search(context.Background(), criteria).Return(nil, errors.New(""))
+
+		ret, err := usecase.Search(context.Background(), criteria)
+
+		require.Error(t, err)
+		require.Empty(t, ret)
+	})
+}
@@ -18,6 +18,7 @@ type OpponentDeckUsageStatInterface interface {
 		standardRegulationId string,
 		regulationId uint,
 		deckId string,
+		excludePrivate bool,
 	) (*entity.OpponentDeckUsageStat, error)
 }
 
@@ -51,6 +52,7 @@ func (u *OpponentDeckUsageStat) GetOpponentDeckUsageStat(
 	standardRegulationId string,
 	regulationId uint,
 	deckId string,
+	excludePrivate bool,
 ) (*entity.OpponentDeckUsageStat, error) {
 	var fromDate, toDate time.Time
 
@@ -110,5 +112,5 @@ func (u *OpponentDeckUsageStat) GetOpponentDeckUsageStat(
 	// yearMonth/season/environmentId/standard_regulation_idのいずれも未指定の場合は、
 	// fromDate/toDateをゼロ値のまま渡し「全期間」として扱う
 	// （repository側はゼロ値の場合event_dateによる絞り込みを行わない）
-	return u.opponentDeckUsageStatRepo.FindOpponentDeckUsageStat(ctx, userId, fromDate, toDate, deckId, regulationId)
+	return u.opponentDeckUsageStatRepo.FindOpponentDeckUsageStat(ctx, userId, fromDate, toDate, deckId, regulationId, excludePrivate)
 }
@@ -45,10 +45,10 @@ func test_OpponentDeckUsageStatUsecase_GetOpponentDeckUsageStat(t *testing.T, mo
 		stat := entity.NewOpponentDeckUsageStat(userId, 5, []*entity.OpponentDeckUsage{})
 
 		mockRepository.EXPECT().
-			FindOpponentDeckUsageStat(context.Background(), userId, gomock.Any(), gomock.Any(), deckId, uint(0)).
+			FindOpponentDeckUsageStat(context.Background(), userId, gomock.Any(), gomock.Any(), deckId, uint(0), false).
 			Return(stat, nil)
 
-		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId)
+		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId, false)
 
 		require.NoError(t, err)
 		require.Equal(t, stat, ret)
@@ -65,10 +65,10 @@ func test_OpponentDeckUsageStatUsecase_GetOpponentDeckUsageStat(t *testing.T, mo
 		stat := entity.NewOpponentDeckUsageStat(userId, 0, []*entity.OpponentDeckUsage{})
 
 		mockRepository.EXPECT().
-			FindOpponentDeckUsageStat(context.Background(), userId, gomock.Any(), gomock.Any(), deckId, uint(0)).
+			FindOpponentDeckUsageStat(context.Background(), userId, gomock.Any(), gomock.Any(), deckId, uint(0), false).
 			Return(stat, nil)
 
-		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId)
+		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId, false)
 
 		require.NoError(t, err)
 		require.Equal(t, stat, ret)
@@ -88,10 +88,10 @@ func test_OpponentDeckUsageStatUsecase_GetOpponentDeckUsageStat(t *testing.T, mo
 		stat := entity.NewOpponentDeckUsageStat(userId, 3, []*entity.OpponentDeckUsage{})
 
 		mockRepository.EXPECT().
-			FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, deckId, uint(0)).
+			FindOpponentDeckUsageStat(context.Background(), userId, time.Time{}, time.Time{}, deckId, uint(0), false).
 			Return(stat, nil)
 
-		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId)
+		ret, err := usecase.GetOpponentDeckUsageStat(context.Background(), userId, yearMonth, environmentId, season, standardRegulationId, 0, deckId, false)
 
 		require.NoError(t, err)
 		require.Equal(t, stat, ret)
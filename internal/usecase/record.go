@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -24,6 +26,9 @@ type RecordParam struct {
 	regulationId      uint
 	tcgMeisterURL     string
 	memo              string
+	// TagIds は付与するタグID。MatchParam.TagIds と同じく NewRecordParam の引数には
+	// 含めず、controller が直接設定する。
+	TagIds []string
 }
 
 func NewRecordParam(
@@ -96,6 +101,11 @@ type RecordInterface interface {
 		eventType string,
 	) ([]*entity.Record, error)
 
+	FindByCriteria(
+		ctx context.Context,
+		criteria *entity.RecordCriteria,
+	) ([]*entity.Record, error)
+
 	FindByOfficialEventId(
 		ctx context.Context,
 		officialEventId uint,
@@ -139,6 +149,15 @@ type RecordInterface interface {
 		param *RecordParam,
 	) (*entity.Record, error)
 
+	// CreateWithMatches は記録と、それに紐づく対戦結果(ゲーム・ポケモン・タグ込み)を
+	// 1つのトランザクションでまとめて作成する。対戦結果の RecordId・UserId は作成時に
+	// 設定するため、呼び出し側では指定しない。DeckId・DeckCodeId が空なら記録のデッキを引き継ぐ。
+	CreateWithMatches(
+		ctx context.Context,
+		param *RecordParam,
+		matchParams []*MatchParam,
+	) (*entity.Record, []*entity.Match, error)
+
 	Update(
 		ctx context.Context,
 		id string,
@@ -149,6 +168,14 @@ type RecordInterface interface {
 		ctx context.Context,
 		id string,
 	) error
+
+	// History は記録の変更履歴(更新・削除)を新しい順に返す。
+	History(
+		ctx context.Context,
+		id string,
+		limit int,
+		offset int,
+	) ([]*entity.EntityRevision, error)
 }
 
 type Record struct {
@@ -161,6 +188,15 @@ type Record struct {
 	// 記録作成時に一度だけ取得して保存し、カレンダー等の参照を外部通信なしにする。
 	tonamelEventRepo  repository.TonamelEventInterface
 	tonamelEventStore repository.TonamelEventStoreInterface
+	// 以下は CreateWithMatches で対戦結果を記録と同じトランザクションで作成するために使う。
+	matchRepository      repository.MatchInterface
+	tag                  repository.TagInterface
+	transactionManager   repository.TransactionManager
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface
+	// revision は Update / Delete の変更前後を履歴(entity_revisions)へ残す。
+	revision repository.EntityRevisionInterface
+	// unofficialEventRepository は記録に付ける自由形式イベントの作成者を確かめる。
+	unofficialEventRepository repository.UnofficialEventInterface
 }
 
 func NewRecord(
@@ -170,14 +206,26 @@ func NewRecord(
 	designationEvaluation DesignationEvaluationInterface,
 	tonamelEventRepo repository.TonamelEventInterface,
 	tonamelEventStore repository.TonamelEventStoreInterface,
+	matchRepository repository.MatchInterface,
+	tag repository.TagInterface,
+	transactionManager repository.TransactionManager,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	revision repository.EntityRevisionInterface,
+	unofficialEventRepository repository.UnofficialEventInterface,
 ) RecordInterface {
 	return &Record{
-		logger:                logger,
-		repository:            repository,
-		badgeEvaluation:       badgeEvaluation,
-		designationEvaluation: designationEvaluation,
-		tonamelEventRepo:      tonamelEventRepo,
-		tonamelEventStore:     tonamelEventStore,
+		logger:                    logger,
+		repository:                repository,
+		badgeEvaluation:           badgeEvaluation,
+		designationEvaluation:     designationEvaluation,
+		tonamelEventRepo:          tonamelEventRepo,
+		tonamelEventStore:         tonamelEventStore,
+		matchRepository:           matchRepository,
+		tag:                       tag,
+		transactionManager:        transactionManager,
+		environmentBadgeEval:      environmentBadgeEval,
+		revision:                  revision,
+		unofficialEventRepository: unofficialEventRepository,
 	}
 }
 
@@ -263,6 +311,20 @@ func (u *Record) FindByUserIdOnCursor(
 	return records, nil
 }
 
+func (u *Record) FindByCriteria(
+	ctx context.Context,
+	criteria *entity.RecordCriteria,
+) ([]*entity.Record, error) {
+	records, err := u.repository.FindByCriteria(ctx, criteria)
+
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return records, nil
+}
+
 func (u *Record) FindByOfficialEventId(
 	ctx context.Context,
 	officialEventId uint,
@@ -368,6 +430,35 @@ func normalizeAndValidateRecordParam(param *RecordParam) error {
 	return nil
 }
 
+// validateRecordUnofficialEvent は記録に付ける自由形式イベント unofficialEventId が userId の
+// 作ったものかを確かめる。他のユーザが共有したイベントへは参加コードで付け替える
+// (UnofficialEventShare.Join)ため、記録に既に付いている current のまま直す場合を除き、
+// 他のユーザのイベントや存在しないイベントは apperror.ErrInvalidRecord にする。
+func validateRecordUnofficialEvent(
+	ctx context.Context,
+	unofficialEventRepository repository.UnofficialEventInterface,
+	userId string,
+	unofficialEventId string,
+	current string,
+) error {
+	if unofficialEventId == "" || unofficialEventId == current {
+		return nil
+	}
+
+	unofficialEvent, err := unofficialEventRepository.FindById(ctx, unofficialEventId)
+	if errors.Is(err, apperror.ErrRecordNotFound) {
+		return apperror.ErrInvalidRecord
+	} else if err != nil {
+		return err
+	}
+
+	if unofficialEvent.UserId != userId {
+		return apperror.ErrInvalidRecord
+	}
+
+	return nil
+}
+
 func (u *Record) Create(
 	ctx context.Context,
 	param *RecordParam,
@@ -377,6 +468,11 @@ func (u *Record) Create(
 		return nil, err
 	}
 
+	if err := validateRecordUnofficialEvent(ctx, u.unofficialEventRepository, param.userId, param.unofficialEventId, ""); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	id, err := generateId()
 	if err != nil {
 		logError(ctx, err)
@@ -411,14 +507,27 @@ func (u *Record) Create(
 		record.DeckRegisteredAt = &createdAt
 	}
 
-	if err := u.repository.Save(ctx, record); err != nil {
+	// タグの付与は別テーブルのため、記録と同じトランザクションでまとめて反映する。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Save(ctx, record); err != nil {
+			return err
+		}
+
+		tags, err := u.syncRecordTags(ctx, record.ID, param.userId, param.TagIds)
+		if err != nil {
+			return err
+		}
+		record.Tags = tags
+
+		return nil
+	}); err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
 
 	// Tonamel記録なら、大会情報をこの時点で一度だけ取得してDBへ保存しておく。
 	// カレンダー等はこれを参照するだけで済み、表示のたびに外部サイトを引かずに済む。
-	u.persistTonamelEvent(ctx, param.tonamelEventId)
+	persistTonamelEvent(ctx, u.logger, u.tonamelEventRepo, u.tonamelEventStore, param.tonamelEventId)
 
 	// 通知一覧はcreated_at DESC(新しい順、同値時はid DESC)で表示されるため、後から
 	// 生成した通知ほど上に表示される。作成順序を「ユーザバッジ→称号/ランクアップ」に
@@ -439,7 +548,124 @@ func (u *Record) Create(
 	return record, nil
 }
 
+func (u *Record) CreateWithMatches(
+	ctx context.Context,
+	param *RecordParam,
+	matchParams []*MatchParam,
+) (*entity.Record, []*entity.Match, error) {
+	if err := normalizeAndValidateRecordParam(param); err != nil {
+		logError(ctx, err)
+		return nil, nil, err
+	}
+
+	if err := validateRecordUnofficialEvent(ctx, u.unofficialEventRepository, param.userId, param.unofficialEventId, ""); err != nil {
+		logError(ctx, err)
+		return nil, nil, err
+	}
+
+	for i, matchParam := range matchParams {
+		if err := validateMatchParam(matchParam); err != nil {
+			err = fmt.Errorf("%w: matches[%d]", err, i)
+			logError(ctx, err)
+			return nil, nil, err
+		}
+	}
+
+	// 記録・対戦結果の組み立ては一括取り込みと同じ(同じ処理時刻で作成し、デッキを引き継ぐ)。
+	built, err := buildImportedRecord(NewRecordImportParam(param, matchParams))
+	if err != nil {
+		logError(ctx, err)
+		return nil, nil, err
+	}
+	record, matches := built.Record, built.Matches
+
+	// 称号のtier変化を記録の前後で比較するため、保存前の時点で取得しておく(Create と同じ)。
+	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, param.userId)
+
+	// 途中の対戦結果で失敗したときに対戦結果の欠けた記録が残らないよう、1つのトランザクションで保存する。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Save(ctx, record); err != nil {
+			return err
+		}
+
+		tags, err := u.syncRecordTags(ctx, record.ID, param.userId, param.TagIds)
+		if err != nil {
+			return err
+		}
+		record.Tags = tags
+
+		for i, match := range matches {
+			if err := u.matchRepository.Create(ctx, match); err != nil {
+				return err
+			}
+
+			tags, err := syncMatchTags(ctx, u.tag, match.ID, param.userId, matchParams[i].TagIds)
+			if err != nil {
+				return err
+			}
+			match.Tags = tags
+		}
+
+		return nil
+	}); err != nil {
+		logError(ctx, err)
+		return nil, nil, err
+	}
+
+	persistTonamelEvent(ctx, u.logger, u.tonamelEventRepo, u.tonamelEventStore, param.tonamelEventId)
+
+	// 以降の判定は保存済みの記録を失敗にしないよう、エラーはログに残すだけにする。
+	// 記録と対戦結果を別々に作成した場合と同じく「ユーザバッジ→環境バッジ→称号/ランクアップ」の
+	// 順で、対戦結果の件数によらずそれぞれ1度だけ判定する。
+	if _, err := u.badgeEvaluation.EvaluateOnRecordCreated(ctx, param.userId, record); err != nil {
+		logError(ctx, err)
+	}
+
+	if len(matches) != 0 {
+		if _, err := u.badgeEvaluation.EvaluateOnMatchesCreated(ctx, param.userId, matches); err != nil {
+			logError(ctx, err)
+		}
+
+		// 環境バッジは公式イベントの記録のみを対象とし、環境ごとの初回対戦の判定のため先頭の対戦結果で判定する。
+		if record.OfficialEventId != 0 {
+			basisTime := RecordBasisTime(record.EventDate, record.CreatedAt)
+			if _, err := u.environmentBadgeEval.EvaluateOnMatchCreated(ctx, param.userId, matches[0], basisTime); err != nil {
+				logError(ctx, err)
+			}
+		}
+	}
+
+	if tierErr == nil {
+		u.designationEvaluation.NotifyIfTierChanged(ctx, param.userId, beforeTier, record.CreatedAt)
+	}
+
+	return record, matches, nil
+}
+
+// syncRecordTags は記録について、userId が付与できる有効なタグ(自分のタグ or
+// プリセット)だけを残して record_tags を更新し、付与後のタグを返す。
+func (u *Record) syncRecordTags(
+	ctx context.Context,
+	recordId string,
+	userId string,
+	tagIds []string,
+) ([]*entity.Tag, error) {
+	tags, err := u.tag.FindAttachableByIds(ctx, tagIds, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderedTags, attachableTagIds := orderAttachableTagsByIds(tags, tagIds)
+
+	if err := u.tag.ReplaceRecordTags(ctx, recordId, attachableTagIds); err != nil {
+		return nil, err
+	}
+
+	return orderedTags, nil
+}
+
 // persistTonamelEvent は Tonamel の大会情報を tonamel_events へ保存する。
+// 記録の作成・更新(Record)と一括取り込み(RecordImport)で共有する。
 //
 // すべてベストエフォートで、失敗しても記録作成自体は成功させる(大会情報が
 // 無くてもタイトル不明として扱えるため。カレンダー側と同じ寛容な方針)。
@@ -447,17 +673,20 @@ func (u *Record) Create(
 //   - 既に保存済みなら再取得しない(大会情報は不変で全ユーザー共通のため、
 //     別のユーザーや過去の記録作成で保存済みなら外部通信を省ける)。
 //   - 未保存なら tonamel.com から取得して保存する。
-func (u *Record) persistTonamelEvent(
+func persistTonamelEvent(
 	ctx context.Context,
+	logger *slog.Logger,
+	tonamelEventRepo repository.TonamelEventInterface,
+	tonamelEventStore repository.TonamelEventStoreInterface,
 	tonamelEventId string,
 ) {
 	if tonamelEventId == "" {
 		return
 	}
 
-	existing, err := u.tonamelEventStore.FindByIds(ctx, []string{tonamelEventId})
+	existing, err := tonamelEventStore.FindByIds(ctx, []string{tonamelEventId})
 	if err != nil {
-		u.logger.WarnContext(
+		logger.WarnContext(
 			ctx,
 			"failed to look up tonamel event before persisting",
 			slog.String("tonamel_event_id", tonamelEventId),
@@ -469,9 +698,9 @@ func (u *Record) persistTonamelEvent(
 		return
 	}
 
-	tonamelEvent, err := u.tonamelEventRepo.FindById(ctx, tonamelEventId)
+	tonamelEvent, err := tonamelEventRepo.FindById(ctx, tonamelEventId)
 	if err != nil {
-		u.logger.WarnContext(
+		logger.WarnContext(
 			ctx,
 			"failed to fetch tonamel event for persisting",
 			slog.String("tonamel_event_id", tonamelEventId),
@@ -480,8 +709,8 @@ func (u *Record) persistTonamelEvent(
 		return
 	}
 
-	if err := u.tonamelEventStore.Save(ctx, tonamelEvent); err != nil {
-		u.logger.WarnContext(
+	if err := tonamelEventStore.Save(ctx, tonamelEvent); err != nil {
+		logger.WarnContext(
 			ctx,
 			"failed to save tonamel event",
 			slog.String("tonamel_event_id", tonamelEventId),
@@ -509,6 +738,11 @@ func (u *Record) Update(
 		return nil, err
 	}
 
+	if err := validateRecordUnofficialEvent(ctx, u.unofficialEventRepository, param.userId, param.unofficialEventId, ret.UnofficialEventId); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
 	// 称号のtier変化を更新の前後で比較するため、保存前の時点で取得しておく。
 	// デッキ未登録のまま作成した記録に、後からデッキを登録するケースでは、この
 	// Updateで初めて称号のrecordカウント対象になりtierが変化しうる(Createと同様)。
@@ -539,15 +773,32 @@ func (u *Record) Update(
 		now := time.Now().Local()
 		record.DeckRegisteredAt = &now
 	}
-
-	if err := u.repository.Save(ctx, record); err != nil {
+	// 読み込んだ時点の版を引き継ぎ、保存までの間に他の端末で更新されていれば
+	// 上書きせず apperror.ErrPreconditionFailed で止める。
+	record.UpdatedAt = ret.UpdatedAt
+
+	// 変更履歴は更新と同じトランザクションで書き、履歴だけが欠けないようにする。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Save(ctx, record); err != nil {
+			return err
+		}
+
+		// タグの付与を param.TagIds の集合に合わせて更新する。
+		tags, err := u.syncRecordTags(ctx, record.ID, param.userId, param.TagIds)
+		if err != nil {
+			return err
+		}
+		record.Tags = tags
+
+		return appendRevision(ctx, u.revision, entity.EntityRevisionTypeRecord, id, entity.EntityRevisionActionUpdate, ret.UserId, ret, record)
+	}); err != nil {
 		logError(ctx, err)
 		return nil, err
 	}
 
 	// 編集で Tonamel記録に変わった/別の大会に付け替えられたケースに追随する。
 	// 既に保存済みの大会なら再取得しない(persistTonamelEvent 内で判定)。
-	u.persistTonamelEvent(ctx, param.tonamelEventId)
+	persistTonamelEvent(ctx, u.logger, u.tonamelEventRepo, u.tonamelEventStore, param.tonamelEventId)
 
 	if tierErr == nil {
 		u.designationEvaluation.NotifyIfTierChanged(ctx, param.userId, beforeTier, time.Now().Local())
@@ -570,7 +821,13 @@ func (u *Record) Delete(
 	// 称号のtier変化を削除の前後で比較するため、削除前の時点で取得しておく。
 	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, record.UserId)
 
-	if err := u.repository.Delete(ctx, id); err != nil {
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return appendRevision(ctx, u.revision, entity.EntityRevisionTypeRecord, id, entity.EntityRevisionActionDelete, record.UserId, record, nil)
+	}); err != nil {
 		logError(ctx, err)
 		return err
 	}
@@ -586,3 +843,18 @@ func (u *Record) Delete(
 
 	return nil
 }
+
+func (u *Record) History(
+	ctx context.Context,
+	id string,
+	limit int,
+	offset int,
+) ([]*entity.EntityRevision, error) {
+	revisions, err := u.revision.FindByEntity(ctx, entity.EntityRevisionTypeRecord, id, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// RecordImportParam は一括取り込みする記録1件と、それに紐づく対戦結果。
+// 対戦結果の RecordId・UserId は取り込み時に採番・設定するため、呼び出し側では指定しない。
+// DeckId・DeckCodeId が空なら記録のデッキを引き継ぐ。
+type RecordImportParam struct {
+	Record  *RecordParam
+	Matches []*MatchParam
+}
+
+func NewRecordImportParam(
+	record *RecordParam,
+	matches []*MatchParam,
+) *RecordImportParam {
+	return &RecordImportParam{
+		Record:  record,
+		Matches: matches,
+	}
+}
+
+type RecordImportInterface interface {
+	// Import は記録と対戦結果をまとめて取り込む。1件でも整合性の取れない記録・対戦結果が
+	// あれば何も保存せず、何件目か分かるメッセージ付きの apperror.ErrInvalidRecord /
+	// apperror.ErrInvalidMatch を返す。dryRun なら検証と組み立てだけを行い、保存しない。
+	Import(
+		ctx context.Context,
+		userId string,
+		params []*RecordImportParam,
+		dryRun bool,
+	) ([]*entity.ImportedRecord, error)
+}
+
+type RecordImport struct {
+	logger                *slog.Logger
+	recordRepository      repository.RecordInterface
+	matchRepository       repository.MatchInterface
+	transactionManager    repository.TransactionManager
+	badgeEvaluation       BadgeEvaluationInterface
+	designationEvaluation DesignationEvaluationInterface
+	environmentBadgeEval  EnvironmentBadgeEvaluationInterface
+	tonamelEventRepo      repository.TonamelEventInterface
+	tonamelEventStore     repository.TonamelEventStoreInterface
+	// unofficialEventRepository は取り込む記録に付ける自由形式イベントの作成者を確かめる。
+	unofficialEventRepository repository.UnofficialEventInterface
+}
+
+func NewRecordImport(
+	logger *slog.Logger,
+	recordRepository repository.RecordInterface,
+	matchRepository repository.MatchInterface,
+	transactionManager repository.TransactionManager,
+	badgeEvaluation BadgeEvaluationInterface,
+	designationEvaluation DesignationEvaluationInterface,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	tonamelEventRepo repository.TonamelEventInterface,
+	tonamelEventStore repository.TonamelEventStoreInterface,
+	unofficialEventRepository repository.UnofficialEventInterface,
+) RecordImportInterface {
+	return &RecordImport{
+		logger:                    logger,
+		recordRepository:          recordRepository,
+		matchRepository:           matchRepository,
+		transactionManager:        transactionManager,
+		badgeEvaluation:           badgeEvaluation,
+		designationEvaluation:     designationEvaluation,
+		environmentBadgeEval:      environmentBadgeEval,
+		tonamelEventRepo:          tonamelEventRepo,
+		tonamelEventStore:         tonamelEventStore,
+		unofficialEventRepository: unofficialEventRepository,
+	}
+}
+
+// validateRecordImportParams は取り込む全件を保存前に検証する。エラーには
+// 何件目(0始まり、リクエストの配列の添字)の記録・対戦結果かを含める。
+func validateRecordImportParams(userId string, params []*RecordImportParam) error {
+	if len(params) == 0 {
+		return fmt.Errorf("%w: no records", apperror.ErrInvalidRecord)
+	}
+
+	for i, param := range params {
+		param.Record.userId = userId
+
+		if err := normalizeAndValidateRecordParam(param.Record); err != nil {
+			return fmt.Errorf("%w: records[%d]", err, i)
+		}
+
+		for j, matchParam := range param.Matches {
+			if err := validateMatchParam(matchParam); err != nil {
+				return fmt.Errorf("%w: records[%d].matches[%d]", err, i, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildImportedRecord は検証済みの param から保存する記録・対戦結果を組み立てる。
+// 取り込み分はすべて同じ処理時刻(createdAt)で作成する。
+func buildImportedRecord(param *RecordImportParam) (*entity.ImportedRecord, error) {
+	recordId, err := generateId()
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := timeNow().Local()
+
+	r := param.Record
+	record := entity.NewRecord(
+		recordId,
+		createdAt,
+		r.officialEventId,
+		r.tonamelEventId,
+		r.friendId,
+		r.unofficialEventId,
+		r.userId,
+		r.deckId,
+		r.deckCodeId,
+		r.eventDate,
+		r.privateFlg,
+		r.ignoreStatsFlg,
+		r.regulationId,
+		r.tcgMeisterURL,
+		r.memo,
+	)
+	if r.deckId != "" || r.deckCodeId != "" {
+		record.DeckRegisteredAt = &createdAt
+	}
+
+	matches := make([]*entity.Match, 0, len(param.Matches))
+	for _, m := range param.Matches {
+		matchId, err := generateId()
+		if err != nil {
+			return nil, err
+		}
+
+		var games []*entity.Game
+		for _, g := range m.Games {
+			gameId, err := generateId()
+			if err != nil {
+				return nil, err
+			}
+
+			games = append(
+				games,
+				entity.NewGame(
+					gameId,
+					createdAt,
+					matchId,
+					r.userId,
+					g.GoFirst,
+					g.WinningFlg,
+					g.YourPrizeCards,
+					g.OpponentsPrizeCards,
+					g.Memo,
+				),
+			)
+		}
+
+		var pokemonSprites []*entity.PokemonSprite
+		for _, pokemonSprite := range m.PokemonSprites {
+			pokemonSprites = append(
+				pokemonSprites,
+				entity.NewPokemonSpriteWithPosition(pokemonSprite.ID, pokemonSprite.Position),
+			)
+		}
+
+		deckId, deckCodeId := m.DeckId, m.DeckCodeId
+		if deckId == "" && deckCodeId == "" {
+			deckId, deckCodeId = r.deckId, r.deckCodeId
+		}
+
+		matches = append(
+			matches,
+			entity.NewMatch(
+				matchId,
+				createdAt,
+				recordId,
+				deckId,
+				deckCodeId,
+				r.userId,
+				m.OpponentsUserId,
+				m.BO3Flg,
+				m.GroupMatchFlg,
+				m.QualifyingRoundFlg,
+				m.FinalTournamentFlg,
+				m.DefaultVictoryFlg,
+				m.DefaultDefeatFlg,
+				m.VictoryFlg,
+				m.DrawFlg,
+				m.GroupMatchVictoryFlg,
+				m.OpponentsDeckInfo,
+				m.Memo,
+				games,
+				pokemonSprites,
+			),
+		)
+	}
+
+	return entity.NewImportedRecord(record, matches), nil
+}
+
+func (u *RecordImport) Import(
+	ctx context.Context,
+	userId string,
+	params []*RecordImportParam,
+	dryRun bool,
+) ([]*entity.ImportedRecord, error) {
+	if err := validateRecordImportParams(userId, params); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	for i, param := range params {
+		if err := validateRecordUnofficialEvent(ctx, u.unofficialEventRepository, userId, param.Record.unofficialEventId, ""); err != nil {
+			err = fmt.Errorf("%w: records[%d]", err, i)
+			logError(ctx, err)
+			return nil, err
+		}
+	}
+
+	imported := make([]*entity.ImportedRecord, 0, len(params))
+	for _, param := range params {
+		ir, err := buildImportedRecord(param)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		imported = append(imported, ir)
+	}
+
+	if dryRun {
+		return imported, nil
+	}
+
+	// 称号のtier変化を取り込みの前後で比較するため、保存前の時点で取得しておく。
+	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, userId)
+
+	// 途中の1件で失敗したときに半端な取り込み結果が残らないよう、全件を1つのトランザクションで保存する。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		for _, ir := range imported {
+			if err := u.recordRepository.Save(ctx, ir.Record); err != nil {
+				return err
+			}
+
+			for _, match := range ir.Matches {
+				if err := u.matchRepository.Create(ctx, match); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 以降のバッジ・称号の判定は保存済みの取り込み結果を失敗にしないよう、エラーはログに残すだけにする。
+	// 1件ずつ作成した場合と同じく「ユーザバッジ→環境バッジ→称号/ランクアップ」の順で通知を作る。
+	u.evaluateOnImported(ctx, userId, imported)
+
+	if tierErr == nil {
+		u.designationEvaluation.NotifyIfTierChanged(ctx, userId, beforeTier, imported[0].Record.CreatedAt)
+	}
+
+	// Record.Create と同じく Tonamel の大会情報を保存する。同じ大会の記録が複数あっても取得は1度にする。
+	persisted := map[string]bool{}
+	for _, ir := range imported {
+		tonamelEventId := ir.Record.TonamelEventId
+		if tonamelEventId == "" || persisted[tonamelEventId] {
+			continue
+		}
+		persisted[tonamelEventId] = true
+
+		persistTonamelEvent(ctx, u.logger, u.tonamelEventRepo, u.tonamelEventStore, tonamelEventId)
+	}
+
+	return imported, nil
+}
+
+// evaluateOnImported は取り込み後のバッジを判定する。ユーザバッジ(ストリーク含む)は
+// 件数によらず1度だけ判定する。環境バッジは環境ごとに初回対戦を判定する必要があるため、
+// 対戦結果のある公式イベントの記録ごとに、対戦日の古い順に判定する(同じ環境の2件目以降は
+// 獲得済みとして素通りする)。
+func (u *RecordImport) evaluateOnImported(
+	ctx context.Context,
+	userId string,
+	imported []*entity.ImportedRecord,
+) {
+	records := make([]*entity.Record, 0, len(imported))
+	var matches []*entity.Match
+	for _, ir := range imported {
+		records = append(records, ir.Record)
+		matches = append(matches, ir.Matches...)
+	}
+
+	if _, err := u.badgeEvaluation.EvaluateOnRecordsImported(ctx, userId, records, matches); err != nil {
+		logError(ctx, err)
+	}
+
+	official := make([]*entity.ImportedRecord, 0, len(imported))
+	for _, ir := range imported {
+		if ir.Record.OfficialEventId != 0 && len(ir.Matches) != 0 {
+			official = append(official, ir)
+		}
+	}
+	sort.SliceStable(official, func(i, j int) bool {
+		return RecordBasisTime(official[i].Record.EventDate, official[i].Record.CreatedAt).
+			Before(RecordBasisTime(official[j].Record.EventDate, official[j].Record.CreatedAt))
+	})
+
+	for _, ir := range official {
+		basisTime := RecordBasisTime(ir.Record.EventDate, ir.Record.CreatedAt)
+		if _, err := u.environmentBadgeEval.EvaluateOnMatchCreated(ctx, userId, ir.Matches[0], basisTime); err != nil {
+			logError(ctx, err)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+func setup4RecordImportUsecase(t *testing.T) (
+	*mock_repository.MockRecordInterface,
+	*mock_repository.MockMatchInterface,
+	RecordImportInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+	mockMatchRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+
+	usecase := NewRecordImport(
+		testLogger(),
+		mockRecordRepository,
+		mockMatchRepository,
+		stubTransactionManager{},
+		stubBadgeEvaluation{},
+		stubDesignationEvaluation{},
+		stubEnvironmentBadgeEvaluation{},
+		&stubTonamelEventFetcher{},
+		&stubTonamelEventStore{},
+		&stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound},
+	)
+
+	return mockRecordRepository, mockMatchRepository, usecase
+}
+
+func newRecordImportParam4Test(officialEventId uint, friendId string, matches ...*MatchParam) *RecordImportParam {
+	return NewRecordImportParam(
+		NewRecordParam(
+			officialEventId, "", friendId, "", "", "01JMKRNBW5TVN902YAE8GYZ367", "",
+			time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local),
+			false, false, 0, "", "",
+		),
+		matches,
+	)
+}
+
+func newImportMatchParam4Test(victoryFlg bool, gameWinningFlg bool) *MatchParam {
+	return NewMatchParam(
+		"", "", "", "", "",
+		false, false, false, false, false, false, victoryFlg, false, false,
+		"サーナイトex", "",
+		[]*GameParam{NewGameParam(true, gameWinningFlg, 0, 0, "")},
+		nil,
+	)
+}
+
+func TestRecordImportUsecase_Import(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+
+	t.Run("正常系_dry-runなら組み立てた内容を返すだけで保存しない", func(t *testing.T) {
+		_, _, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(1, "", newImportMatchParam4Test(true, true)),
+		}
+
+		// リポジトリの呼び出しを期待しない(呼ばれたら gomock が失敗させる)
+		imported, err := usecase.Import(context.Background(), userId, params, true)
+
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		require.Equal(t, userId, imported[0].Record.UserId)
+		require.Equal(t, entity.RegulationIdStandard, imported[0].Record.RegulationId)
+		require.Len(t, imported[0].Matches, 1)
+		require.Equal(t, imported[0].Record.ID, imported[0].Matches[0].RecordId)
+		require.Len(t, imported[0].Matches[0].Games, 1)
+	})
+
+	t.Run("正常系_全件を保存し対戦結果は記録のデッキを引き継ぐ", func(t *testing.T) {
+		mockRecordRepository, mockMatchRepository, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(1, "", newImportMatchParam4Test(true, true), newImportMatchParam4Test(false, false)),
+			newRecordImportParam4Test(0, "friend", newImportMatchParam4Test(true, true)),
+		}
+
+		mockRecordRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockMatchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, match *entity.Match) error {
+				require.Equal(t, "01JMKRNBW5TVN902YAE8GYZ367", match.DeckId)
+				require.Equal(t, userId, match.UserId)
+				return nil
+			},
+		).Times(3)
+
+		imported, err := usecase.Import(context.Background(), userId, params, false)
+
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+		require.NotNil(t, imported[0].Record.DeckRegisteredAt)
+	})
+
+	t.Run("正常系_バッジの判定は取り込み件数によらず1度だけ行う", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+		mockMatchRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+
+		var calls []string
+		usecase := NewRecordImport(
+			testLogger(),
+			mockRecordRepository,
+			mockMatchRepository,
+			stubTransactionManager{},
+			orderTrackingBadgeEvaluation{calls: &calls},
+			orderTrackingDesignationEvaluation{calls: &calls},
+			orderTrackingEnvironmentBadgeEvaluation{calls: &calls},
+			&stubTonamelEventFetcher{},
+			&stubTonamelEventStore{},
+			&stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound},
+		)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(1, "", newImportMatchParam4Test(true, true)),
+			newRecordImportParam4Test(0, "friend", newImportMatchParam4Test(true, true)),
+			newRecordImportParam4Test(0, "friend"),
+		}
+
+		mockRecordRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		mockMatchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		_, err := usecase.Import(context.Background(), userId, params, false)
+
+		require.NoError(t, err)
+		// 環境バッジは対戦結果のある公式イベントの記録(1件目)についてのみ判定する
+		require.Equal(t, []string{"badge", "environment_badge", "designation"}, calls)
+	})
+
+	t.Run("正常系_Tonamelの記録は取り込み後に大会情報を1度だけ保存する", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockRecordRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+		mockMatchRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+
+		fetcher := &stubTonamelEventFetcher{events: map[string]*entity.TonamelEvent{
+			"61ozP": entity.NewTonamelEvent("61ozP", "テスト大会", "説明", "https://example.com/i.png"),
+		}}
+		store := &stubTonamelEventStore{}
+
+		usecase := NewRecordImport(
+			testLogger(),
+			mockRecordRepository,
+			mockMatchRepository,
+			stubTransactionManager{},
+			stubBadgeEvaluation{},
+			stubDesignationEvaluation{},
+			stubEnvironmentBadgeEvaluation{},
+			fetcher,
+			store,
+			&stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound},
+		)
+
+		newTonamelParam := func() *RecordImportParam {
+			return NewRecordImportParam(
+				NewRecordParam(
+					0, "61ozP", "", "", "", "01JMKRNBW5TVN902YAE8GYZ367", "",
+					time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local),
+					false, false, 0, "", "",
+				),
+				nil,
+			)
+		}
+		params := []*RecordImportParam{newTonamelParam(), newTonamelParam()}
+
+		mockRecordRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		_, err := usecase.Import(context.Background(), userId, params, false)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"61ozP"}, fetcher.calledIds)
+		require.Len(t, store.savedByCalls, 1)
+		require.Equal(t, "61ozP", store.savedByCalls[0].ID)
+	})
+
+	t.Run("異常系_記録が1件も無ければ不正な記録として扱う", func(t *testing.T) {
+		_, _, usecase := setup4RecordImportUsecase(t)
+
+		imported, err := usecase.Import(context.Background(), userId, nil, false)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+		require.Empty(t, imported)
+	})
+
+	t.Run("異常系_不正な記録があれば何件目かを返して何も保存しない", func(t *testing.T) {
+		_, _, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(1, ""),
+			// イベントの指定が無い記録は不正
+			newRecordImportParam4Test(0, ""),
+		}
+
+		imported, err := usecase.Import(context.Background(), userId, params, false)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+		require.Contains(t, err.Error(), "records[1]")
+		require.Empty(t, imported)
+	})
+
+	t.Run("異常系_自分で作っていない自由形式イベントの記録は何件目かを返して何も保存しない", func(t *testing.T) {
+		_, _, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(1, ""),
+			NewRecordImportParam(
+				NewRecordParam(
+					0, "", "", "01JTESTUNOFFICIALEVENT0000", "", "", "",
+					time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local),
+					false, false, 0, "", "",
+				),
+				nil,
+			),
+		}
+
+		imported, err := usecase.Import(context.Background(), userId, params, true)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+		require.Contains(t, err.Error(), "records[1]")
+		require.Empty(t, imported)
+	})
+
+	t.Run("異常系_不正な対戦結果があれば何件目かを返して何も保存しない", func(t *testing.T) {
+		_, _, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			// 勝利なのにゲームは負けている対戦結果は不正
+			newRecordImportParam4Test(1, "", newImportMatchParam4Test(true, true), newImportMatchParam4Test(true, false)),
+		}
+
+		imported, err := usecase.Import(context.Background(), userId, params, true)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidMatch)
+		require.Contains(t, err.Error(), "records[0].matches[1]")
+		require.Empty(t, imported)
+	})
+
+	t.Run("異常系_保存に失敗したらエラーを返す", func(t *testing.T) {
+		mockRecordRepository, _, usecase := setup4RecordImportUsecase(t)
+
+		params := []*RecordImportParam{
+			newRecordImportParam4Test(0, "friend"),
+		}
+
+		mockRecordRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New(""))
+
+		imported, err := usecase.Import(context.Background(), userId, params, false)
+
+		require.Error(t, err)
+		require.Empty(t, imported)
+	})
+}
@@ -37,6 +37,14 @@ func (stubBadgeEvaluation) EvaluateOnMatchCreated(
 	return nil, nil
 }
 
+func (stubBadgeEvaluation) EvaluateOnMatchesCreated(
+	ctx context.Context,
+	userId string,
+	matches []*entity.Match,
+) ([]*entity.UserBadge, error) {
+	return nil, nil
+}
+
 func (stubBadgeEvaluation) EvaluateOnDeckCreated(
 	ctx context.Context,
 	userId string,
@@ -67,6 +75,22 @@ func (stubBadgeEvaluation) EvaluateOnRecordDeleted(
 	return nil
 }
 
+func (stubBadgeEvaluation) EvaluateOnRecordRestored(
+	ctx context.Context,
+	userId string,
+) error {
+	return nil
+}
+
+func (stubBadgeEvaluation) EvaluateOnRecordsImported(
+	ctx context.Context,
+	userId string,
+	records []*entity.Record,
+	matches []*entity.Match,
+) ([]*entity.UserBadge, error) {
+	return nil, nil
+}
+
 // stubDesignationEvaluation は usecase パッケージ自身のテストで使う
 // DesignationEvaluationInterface のスタブ(stubBadgeEvaluationと同じ理由でgomockを使わない)。
 type stubDesignationEvaluation struct{}
@@ -196,6 +220,12 @@ func newRecordUsecaseForTest(
 		designationEval,
 		&stubTonamelEventFetcher{},
 		&stubTonamelEventStore{},
+		nil,
+		stubTagRepository{},
+		stubTransactionManager{},
+		stubEnvironmentBadgeEvaluation{},
+		&stubEntityRevisionRepository{},
+		&stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound},
 	)
 }
 
@@ -239,6 +269,78 @@ func TestRecordUsecase_Update_NotifiesDesignationChange(t *testing.T) {
 	require.True(t, notifyIfTierLostCalled)
 }
 
+// 記録に付けられる自由形式イベントは自分で作ったものだけ。他のユーザが共有したイベントへは
+// 参加コードで付け替えるため、作成・編集で直接付けることはできない。
+func TestRecordUsecase_UnofficialEventOwnership(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	otherId := "CeQ0Oa9g9uRThL11lj4l45VAg8p1"
+	unofficialEventId := "01JTESTUNOFFICIALEVENT0000"
+	date := time.Date(2026, 7, 18, 0, 0, 0, 0, time.Local)
+
+	setup := func(t *testing.T, ownerId string) (*mock_repository.MockRecordInterface, RecordInterface) {
+		mockRepository := mock_repository.NewMockRecordInterface(gomock.NewController(t))
+		unofficialEventRepository := &stubUnofficialEventRepository{
+			findResult: entity.NewUnofficialEvent(unofficialEventId, ownerId, "自主大会", date),
+		}
+
+		return mockRepository, NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, &stubTonamelEventFetcher{}, &stubTonamelEventStore{}, nil, stubTagRepository{}, stubTransactionManager{}, stubEnvironmentBadgeEvaluation{}, &stubEntityRevisionRepository{}, unofficialEventRepository)
+	}
+
+	newParam := func() *RecordParam {
+		return NewRecordParam(0, "", "", unofficialEventId, userId, "", "", date, false, false, entity.RegulationIdStandard, "", "")
+	}
+
+	t.Run("正常系_自分の自由形式イベントの記録を作成する", func(t *testing.T) {
+		mockRepository, usecase := setup(t, userId)
+
+		mockRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+		ret, err := usecase.Create(context.Background(), newParam())
+
+		require.NoError(t, err)
+		require.Equal(t, unofficialEventId, ret.UnofficialEventId)
+	})
+
+	t.Run("異常系_他のユーザの自由形式イベントの記録は作成できない", func(t *testing.T) {
+		_, usecase := setup(t, otherId)
+
+		_, err := usecase.Create(context.Background(), newParam())
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+	})
+
+	t.Run("異常系_他のユーザの自由形式イベントの記録はまとめて作成できない", func(t *testing.T) {
+		_, usecase := setup(t, otherId)
+
+		_, _, err := usecase.CreateWithMatches(context.Background(), newParam(), nil)
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+	})
+
+	t.Run("正常系_参加コードで付け替えた記録はイベントを付けたまま編集できる", func(t *testing.T) {
+		mockRepository, usecase := setup(t, otherId)
+
+		record := entity.NewRecord("01JTESTRECORD000000000000A", date, 0, "", "", unofficialEventId, userId, "", "", date, false, false, entity.RegulationIdStandard, "", "")
+		mockRepository.EXPECT().FindById(gomock.Any(), record.ID).Return(record, nil)
+		mockRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Update(context.Background(), record.ID, newParam())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系_他のユーザの自由形式イベントへは編集で付け替えられない", func(t *testing.T) {
+		mockRepository, usecase := setup(t, otherId)
+
+		record := entity.NewRecord("01JTESTRECORD000000000000A", date, 0, "", "friend", "", userId, "", "", date, false, false, entity.RegulationIdStandard, "", "")
+		mockRepository.EXPECT().FindById(gomock.Any(), record.ID).Return(record, nil)
+
+		_, err := usecase.Update(context.Background(), record.ID, newParam())
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+	})
+}
+
 // Tonamel記録を作成すると、大会情報を1度だけ取得して tonamel_events へ保存する。
 func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
@@ -250,7 +352,7 @@ func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 		}}
 		store := &stubTonamelEventStore{} // 事前に保存済みのものは無い
 
-		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store)
+		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store, nil, stubTagRepository{}, stubTransactionManager{}, stubEnvironmentBadgeEvaluation{}, &stubEntityRevisionRepository{}, &stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound})
 
 		param := NewRecordParam(0, "61ozP", "", "", "user-1", "", "", time.Time{}, false, false, entity.RegulationIdStandard, "", "")
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
@@ -270,7 +372,7 @@ func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 			"61ozP": {ID: "61ozP"}, // 既に保存済み
 		}}
 
-		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store)
+		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store, nil, stubTagRepository{}, stubTransactionManager{}, stubEnvironmentBadgeEvaluation{}, &stubEntityRevisionRepository{}, &stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound})
 
 		param := NewRecordParam(0, "61ozP", "", "", "user-1", "", "", time.Time{}, false, false, entity.RegulationIdStandard, "", "")
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
@@ -286,7 +388,7 @@ func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 		fetcher := &stubTonamelEventFetcher{}
 		store := &stubTonamelEventStore{}
 
-		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store)
+		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store, nil, stubTagRepository{}, stubTransactionManager{}, stubEnvironmentBadgeEvaluation{}, &stubEntityRevisionRepository{}, &stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound})
 
 		param := NewRecordParam(1, "", "", "", "user-1", "", "", time.Time{}, false, false, entity.RegulationIdStandard, "", "")
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
@@ -303,7 +405,7 @@ func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 		fetcher := &stubTonamelEventFetcher{err: errors.New("")} // tonamel.com取得に失敗
 		store := &stubTonamelEventStore{}
 
-		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store)
+		usecase := NewRecord(testLogger(), mockRepository, stubBadgeEvaluation{}, stubDesignationEvaluation{}, fetcher, store, nil, stubTagRepository{}, stubTransactionManager{}, stubEnvironmentBadgeEvaluation{}, &stubEntityRevisionRepository{}, &stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound})
 
 		param := NewRecordParam(0, "61ozP", "", "", "user-1", "", "", time.Time{}, false, false, entity.RegulationIdStandard, "", "")
 		mockRepository.EXPECT().Save(context.Background(), gomock.Any()).Return(nil)
@@ -316,6 +418,139 @@ func TestRecordUsecase_Create_PersistsTonamelEvent(t *testing.T) {
 	})
 }
 
+func setup4RecordCreateWithMatches(t *testing.T, calls *[]string) (
+	*mock_repository.MockRecordInterface,
+	*mock_repository.MockMatchInterface,
+	*mock_repository.MockTagInterface,
+	RecordInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockRecordInterface(mockCtrl)
+	mockMatchRepository := mock_repository.NewMockMatchInterface(mockCtrl)
+	mockTagRepository := mock_repository.NewMockTagInterface(mockCtrl)
+
+	usecase := NewRecord(
+		testLogger(),
+		mockRepository,
+		orderTrackingBadgeEvaluation{calls: calls},
+		orderTrackingDesignationEvaluation{calls: calls},
+		&stubTonamelEventFetcher{},
+		&stubTonamelEventStore{},
+		mockMatchRepository,
+		mockTagRepository,
+		stubTransactionManager{},
+		orderTrackingEnvironmentBadgeEvaluation{calls: calls},
+		&stubEntityRevisionRepository{},
+		&stubUnofficialEventRepository{findErr: apperror.ErrRecordNotFound},
+	)
+
+	return mockRepository, mockMatchRepository, mockTagRepository, usecase
+}
+
+func TestRecordUsecase_CreateWithMatches(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	deckId := "01JMKRNBW5TVN902YAE8GYZ367"
+	tagId := "01JQ7T1V3T0Y2R3X9M5F8K6W4Z"
+
+	newParam := func(officialEventId uint, friendId string) *RecordParam {
+		return NewRecordParam(
+			officialEventId, "", friendId, "", userId, deckId, "",
+			time.Date(2026, 6, 7, 0, 0, 0, 0, time.Local),
+			false, false, entity.RegulationIdStandard, "", "",
+		)
+	}
+	newMatchParam := func(victoryFlg bool, tagIds []string) *MatchParam {
+		param := newImportMatchParam4Test(victoryFlg, victoryFlg)
+		param.TagIds = tagIds
+		return param
+	}
+
+	t.Run("正常系_記録と対戦結果をまとめて作成し判定は1度だけ行う", func(t *testing.T) {
+		var calls []string
+		mockRepository, mockMatchRepository, mockTagRepository, usecase := setup4RecordCreateWithMatches(t, &calls)
+
+		tag := &entity.Tag{ID: tagId}
+		mockRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		mockMatchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		// 記録と1件目の対戦結果に同じタグを付与する
+		mockTagRepository.EXPECT().FindAttachableByIds(gomock.Any(), []string{tagId}, userId).Return([]*entity.Tag{tag}, nil).Times(2)
+		mockTagRepository.EXPECT().FindAttachableByIds(gomock.Any(), nil, userId).Return(nil, nil)
+		mockTagRepository.EXPECT().ReplaceRecordTags(gomock.Any(), gomock.Any(), []string{tagId}).Return(nil)
+		mockTagRepository.EXPECT().ReplaceMatchTags(gomock.Any(), gomock.Any(), []string{tagId}).Return(nil)
+		mockTagRepository.EXPECT().ReplaceMatchTags(gomock.Any(), gomock.Any(), []string{}).Return(nil)
+
+		param := newParam(1, "")
+		param.TagIds = []string{tagId}
+
+		record, matches, err := usecase.CreateWithMatches(
+			context.Background(),
+			param,
+			[]*MatchParam{newMatchParam(true, []string{tagId}), newMatchParam(false, nil)},
+		)
+
+		require.NoError(t, err)
+		require.NotNil(t, record.DeckRegisteredAt)
+		require.Len(t, matches, 2)
+		for _, match := range matches {
+			require.Equal(t, record.ID, match.RecordId)
+			require.Equal(t, userId, match.UserId)
+			// デッキ未指定の対戦結果は記録のデッキを引き継ぐ
+			require.Equal(t, deckId, match.DeckId)
+		}
+		require.Equal(t, []*entity.Tag{tag}, record.Tags)
+		require.Equal(t, []*entity.Tag{tag}, matches[0].Tags)
+		require.Equal(t, []string{"badge", "environment_badge", "designation"}, calls)
+	})
+
+	t.Run("正常系_公式イベントでなければ環境バッジを判定しない", func(t *testing.T) {
+		var calls []string
+		mockRepository, mockMatchRepository, mockTagRepository, usecase := setup4RecordCreateWithMatches(t, &calls)
+
+		mockRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		mockMatchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		mockTagRepository.EXPECT().FindAttachableByIds(gomock.Any(), gomock.Any(), userId).Return(nil, nil).Times(2)
+		mockTagRepository.EXPECT().ReplaceRecordTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockTagRepository.EXPECT().ReplaceMatchTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		_, _, err := usecase.CreateWithMatches(context.Background(), newParam(0, "friend"), []*MatchParam{newMatchParam(true, nil)})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"badge", "designation"}, calls)
+	})
+
+	t.Run("異常系_不正な対戦結果があれば何も保存しない", func(t *testing.T) {
+		var calls []string
+		_, _, _, usecase := setup4RecordCreateWithMatches(t, &calls)
+
+		// 勝利なのに唯一のゲームで負けている
+		invalid := newImportMatchParam4Test(true, false)
+
+		record, matches, err := usecase.CreateWithMatches(context.Background(), newParam(1, ""), []*MatchParam{newMatchParam(true, nil), invalid})
+
+		require.ErrorIs(t, err, apperror.ErrInvalidMatch)
+		require.ErrorContains(t, err, "matches[1]")
+		require.Nil(t, record)
+		require.Nil(t, matches)
+		require.Empty(t, calls)
+	})
+
+	t.Run("異常系_対戦結果の保存に失敗したら判定せずにエラーを返す", func(t *testing.T) {
+		var calls []string
+		mockRepository, mockMatchRepository, mockTagRepository, usecase := setup4RecordCreateWithMatches(t, &calls)
+
+		mockRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+		mockTagRepository.EXPECT().FindAttachableByIds(gomock.Any(), gomock.Any(), userId).Return(nil, nil)
+		mockTagRepository.EXPECT().ReplaceRecordTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockMatchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		record, _, err := usecase.CreateWithMatches(context.Background(), newParam(1, ""), []*MatchParam{newMatchParam(true, nil)})
+
+		require.Error(t, err)
+		require.Nil(t, record)
+		require.Empty(t, calls)
+	})
+}
+
 func TestRecordUsecase(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockRepository := mock_repository.NewMockRecordInterface(mockCtrl)
@@ -945,6 +1180,8 @@ func test_RecordUsecase_Update(t *testing.T, mockRepository *mock_repository.Moc
 			"",
 			"",
 		)
+		// 読み込んだ時点の版(updated_at)を引き継いで保存すること
+		record.UpdatedAt = createdAt
 
 		mockRepository.EXPECT().FindById(context.Background(), id).Return(record, nil)
 		mockRepository.EXPECT().Save(context.Background(), record).Return(nil)
@@ -952,6 +1189,8 @@ func test_RecordUsecase_Update(t *testing.T, mockRepository *mock_repository.Moc
 		ret, err := usecase.Update(context.Background(), id, param)
 
 		require.NoError(t, err)
+		// タグを指定していなければ付与されたタグは空になる
+		record.Tags = []*entity.Tag{}
 		require.Equal(t, ret, record)
 	})
 
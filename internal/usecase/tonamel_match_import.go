@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type TonamelMatchImportInterface interface {
+	// Import は記録 recordId に紐づく Tonamel の大会から、playerName の終了済みの試合を取得して
+	// 対戦結果として登録する。デッキ・メモはプレイヤーが後から入力する。
+	// 記録が Tonamel の大会に紐づいていなければ apperror.ErrInvalidRecord を、既に対戦結果が
+	// あれば apperror.ErrAlreadyExists を、組み合わせに playerName の試合が無ければ
+	// apperror.ErrTonamelPlayerNotFound を返す。
+	Import(
+		ctx context.Context,
+		recordId string,
+		playerName string,
+	) ([]*entity.Match, error)
+}
+
+type TonamelMatchImport struct {
+	recordRepository  repository.RecordInterface
+	matchRepository   repository.MatchInterface
+	bracketRepository repository.TonamelBracketInterface
+	// 対戦結果は1つのトランザクションでまとめて作り、手で登録したときと同じバッジ・称号の
+	// 判定はコミットした後に1度だけ行う。
+	badgeEvaluation       BadgeEvaluationInterface
+	designationEvaluation DesignationEvaluationInterface
+	environmentBadgeEval  EnvironmentBadgeEvaluationInterface
+	transactionManager    repository.TransactionManager
+}
+
+func NewTonamelMatchImport(
+	recordRepository repository.RecordInterface,
+	matchRepository repository.MatchInterface,
+	bracketRepository repository.TonamelBracketInterface,
+	badgeEvaluation BadgeEvaluationInterface,
+	designationEvaluation DesignationEvaluationInterface,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	transactionManager repository.TransactionManager,
+) TonamelMatchImportInterface {
+	return &TonamelMatchImport{
+		recordRepository:      recordRepository,
+		matchRepository:       matchRepository,
+		bracketRepository:     bracketRepository,
+		badgeEvaluation:       badgeEvaluation,
+		designationEvaluation: designationEvaluation,
+		environmentBadgeEval:  environmentBadgeEval,
+		transactionManager:    transactionManager,
+	}
+}
+
+func (u *TonamelMatchImport) Import(
+	ctx context.Context,
+	recordId string,
+	playerName string,
+) ([]*entity.Match, error) {
+	record, err := u.recordRepository.FindById(ctx, recordId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if record.TonamelEventId == "" {
+		return nil, apperror.ErrInvalidRecord
+	}
+
+	bracket, err := u.bracketRepository.FindById(ctx, record.TonamelEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	bracketMatches := bracket.MatchesOf(playerName)
+	if len(bracketMatches) == 0 {
+		return nil, apperror.ErrTonamelPlayerNotFound
+	}
+
+	// 取り込み分はすべて同じ処理時刻で作成する(記録と対戦結果をまとめて作るときと同じ)。
+	createdAt := timeNow().Local()
+	matches := make([]*entity.Match, 0, len(bracketMatches))
+	for _, bracketMatch := range bracketMatches {
+		param := newTonamelMatchParam(record, bracketMatch, playerName)
+		if err := validateMatchParam(param); err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		matchId, err := generateId()
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		match, err := newMatchFromParam(matchId, createdAt, param)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	// 称号のtier変化を取り込みの前後で比較するため、保存前の時点で取得しておく。
+	beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, record.UserId)
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		// 同じ記録への取り込みが同時に来ても二重にならないよう、記録をロックしてから
+		// 対戦結果の無い記録にだけ取り込む(手で入力した対戦結果とも二重にしない)。
+		if err := u.recordRepository.LockById(ctx, recordId); err != nil {
+			return err
+		}
+
+		existing, err := u.matchRepository.FindByRecordId(ctx, recordId)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return apperror.ErrAlreadyExists
+		}
+
+		for _, match := range matches {
+			if err := u.matchRepository.Create(ctx, match); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); errors.Is(err, apperror.ErrAlreadyExists) {
+		return nil, err
+	} else if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	evaluateMatchesCreated(ctx, u.badgeEvaluation, u.designationEvaluation, u.environmentBadgeEval, record.UserId, record, matches, beforeTier, tierErr)
+
+	return matches, nil
+}
+
+// newTonamelMatchParam は playerName から見た Tonamel の試合を対戦結果にする。
+// 各ゲームの順番までは Tonamel に無いため、勝ち負けを交互に並べてから残りを足す。
+// ゲームを1本も取っていない決着(相手の棄権等)は不戦勝/不戦敗として扱う。
+func newTonamelMatchParam(
+	record *entity.Record,
+	bracketMatch *entity.TonamelBracketMatch,
+	playerName string,
+) *MatchParam {
+	result := bracketMatch.ResultOf(playerName)
+	wins, losses := bracketMatch.ScoresOf(playerName)
+
+	memo := bracketMatch.RoundName + " バイ"
+	if !bracketMatch.IsBye() {
+		memo = bracketMatch.RoundName + " vs " + bracketMatch.OpponentOf(playerName)
+	}
+
+	isDefault := bracketMatch.IsBye() || (wins+losses == 0 && result != entity.MatchResultDraw)
+
+	var games []*GameParam
+	if !isDefault {
+		common := min(wins, losses)
+		for i := 0; i < common; i++ {
+			games = append(games, NewGameParam(false, true, 0, 0, ""), NewGameParam(false, false, 0, 0, ""))
+		}
+		for i := common; i < max(wins, losses); i++ {
+			games = append(games, NewGameParam(false, wins > losses, 0, 0, ""))
+		}
+	}
+
+	return NewMatchParam(
+		record.ID,
+		record.DeckId,
+		record.DeckCodeId,
+		record.UserId,
+		"",
+		!isDefault && (max(wins, losses) >= 2 || result == entity.MatchResultDraw),
+		false,
+		bracketMatch.Stage == entity.TonamelBracketStageQualifying,
+		bracketMatch.Stage == entity.TonamelBracketStageFinal,
+		isDefault && result == entity.MatchResultWin,
+		isDefault && result == entity.MatchResultLose,
+		result == entity.MatchResultWin,
+		result == entity.MatchResultDraw,
+		false,
+		"",
+		memo,
+		games,
+		nil,
+	)
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+type tonamelMatchImportUsecaseMocks struct {
+	record  *mock_repository.MockRecordInterface
+	match   *mock_repository.MockMatchInterface
+	bracket *mock_repository.MockTonamelBracketInterface
+	calls   *[]string
+}
+
+func setup4TonamelMatchImportUsecase(t *testing.T) (
+	tonamelMatchImportUsecaseMocks,
+	TonamelMatchImportInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mocks := tonamelMatchImportUsecaseMocks{
+		record:  mock_repository.NewMockRecordInterface(mockCtrl),
+		match:   mock_repository.NewMockMatchInterface(mockCtrl),
+		bracket: mock_repository.NewMockTonamelBracketInterface(mockCtrl),
+		calls:   &[]string{},
+	}
+	mockTransactionManager := mock_repository.NewMockTransactionManager(mockCtrl)
+	mockTransactionManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+
+			*mocks.calls = append(*mocks.calls, "commit")
+			return nil
+		},
+	).AnyTimes()
+
+	usecase := NewTonamelMatchImport(
+		mocks.record,
+		mocks.match,
+		mocks.bracket,
+		orderTrackingBadgeEvaluation{calls: mocks.calls},
+		orderTrackingDesignationEvaluation{calls: mocks.calls},
+		orderTrackingEnvironmentBadgeEvaluation{calls: mocks.calls},
+		mockTransactionManager,
+	)
+
+	return mocks, usecase
+}
+
+func TestTonamelMatchImportUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	recordId := "01JTESTRECORD000000000000A"
+	tonamelEventId := "OakZc"
+
+	newRecord := func() *entity.Record {
+		return &entity.Record{
+			ID:             recordId,
+			TonamelEventId: tonamelEventId,
+			UserId:         uid,
+			DeckId:         "01JTESTDECK00000000000000A",
+		}
+	}
+
+	bracket := &entity.TonamelBracket{
+		TonamelEventId: tonamelEventId,
+		Matches: []*entity.TonamelBracketMatch{
+			// 決勝は予選より後に並ぶ
+			{Stage: entity.TonamelBracketStageFinal, Round: 1, RoundName: "決勝1回戦", Player1Name: "たけし", Player2Name: "さとし", Player1Score: 2, Player2Score: 1, WinnerName: "たけし", Finished: true},
+			{Stage: entity.TonamelBracketStageQualifying, Round: 2, RoundName: "予選2回戦", Player1Name: "さとし", WinnerName: "さとし", Finished: true},
+			{Stage: entity.TonamelBracketStageQualifying, Round: 1, RoundName: "予選1回戦", Player1Name: "かすみ", Player2Name: "さとし", Player1Score: 0, Player2Score: 1, WinnerName: "さとし", Finished: true},
+			{Stage: entity.TonamelBracketStageQualifying, Round: 3, RoundName: "予選3回戦", Player1Name: "さとし", Player2Name: "カツラ", Player1Score: 1, Player2Score: 1, Finished: true},
+			// 終了していない試合・他のプレイヤーの試合は取り込まない
+			{Stage: entity.TonamelBracketStageFinal, Round: 2, RoundName: "決勝2回戦", Player1Name: "たけし", Player2Name: "さとし"},
+			{Stage: entity.TonamelBracketStageQualifying, Round: 1, RoundName: "予選1回戦", Player1Name: "たけし", Player2Name: "カツラ", Player1Score: 1, WinnerName: "たけし", Finished: true},
+		},
+	}
+
+	t.Run("正常系_終了済みの試合を予選から順に対戦結果として登録する", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(newRecord(), nil)
+		mocks.bracket.EXPECT().FindById(gomock.Any(), tonamelEventId).Return(bracket, nil)
+		mocks.record.EXPECT().LockById(gomock.Any(), recordId).Return(nil)
+		mocks.match.EXPECT().FindByRecordId(gomock.Any(), recordId).Return([]*entity.Match{}, nil)
+		var matches []*entity.Match
+		mocks.match.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, match *entity.Match) error {
+				matches = append(matches, match)
+				return nil
+			},
+		).Times(4)
+
+		ret, err := usecase.Import(context.Background(), recordId, "さとし")
+
+		require.NoError(t, err)
+		require.Equal(t, matches, ret)
+
+		for _, match := range matches {
+			require.Equal(t, recordId, match.RecordId)
+			require.Equal(t, uid, match.UserId)
+			require.Equal(t, "01JTESTDECK00000000000000A", match.DeckId)
+		}
+
+		// バッジ・称号の判定は全ての対戦結果をコミットした後に1度だけ行う。
+		require.Equal(t, []string{"commit", "badge", "designation"}, *mocks.calls)
+
+		// 予選1回戦: BO1の勝ち
+		require.Equal(t, "予選1回戦 vs かすみ", matches[0].Memo)
+		require.True(t, matches[0].QualifyingRoundFlg)
+		require.False(t, matches[0].BO3Flg)
+		require.True(t, matches[0].VictoryFlg)
+		require.Len(t, matches[0].Games, 1)
+
+		// 予選2回戦: バイは不戦勝
+		require.Equal(t, "予選2回戦 バイ", matches[1].Memo)
+		require.True(t, matches[1].DefaultVictoryFlg)
+		require.True(t, matches[1].VictoryFlg)
+		require.Empty(t, matches[1].Games)
+
+		// 予選3回戦: 1勝1敗の両者引き分け
+		require.Equal(t, "予選3回戦 vs カツラ", matches[2].Memo)
+		require.True(t, matches[2].BO3Flg)
+		require.True(t, matches[2].DrawFlg)
+		require.Len(t, matches[2].Games, 2)
+
+		// 決勝1回戦: BO3の1-2の負け
+		require.Equal(t, "決勝1回戦 vs たけし", matches[3].Memo)
+		require.True(t, matches[3].FinalTournamentFlg)
+		require.True(t, matches[3].BO3Flg)
+		require.False(t, matches[3].VictoryFlg)
+		require.Len(t, matches[3].Games, 3)
+	})
+
+	t.Run("異常系_Tonamelの大会に紐づかない記録はErrInvalidRecordを返す", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		record := newRecord()
+		record.TonamelEventId = ""
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+
+		ret, err := usecase.Import(context.Background(), recordId, "さとし")
+
+		require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+		require.Nil(t, ret)
+	})
+
+	t.Run("異常系_記録をロックした時点で対戦結果があればErrAlreadyExistsを返す", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(newRecord(), nil)
+		mocks.bracket.EXPECT().FindById(gomock.Any(), tonamelEventId).Return(bracket, nil)
+		gomock.InOrder(
+			mocks.record.EXPECT().LockById(gomock.Any(), recordId).Return(nil),
+			mocks.match.EXPECT().FindByRecordId(gomock.Any(), recordId).Return([]*entity.Match{{ID: "01JTESTMATCH0000000000000A"}}, nil),
+		)
+
+		ret, err := usecase.Import(context.Background(), recordId, "さとし")
+
+		require.ErrorIs(t, err, apperror.ErrAlreadyExists)
+		require.Nil(t, ret)
+		require.Empty(t, *mocks.calls)
+	})
+
+	t.Run("異常系_対戦結果の保存に失敗したら判定せずにエラーを返す", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(newRecord(), nil)
+		mocks.bracket.EXPECT().FindById(gomock.Any(), tonamelEventId).Return(bracket, nil)
+		mocks.record.EXPECT().LockById(gomock.Any(), recordId).Return(nil)
+		mocks.match.EXPECT().FindByRecordId(gomock.Any(), recordId).Return([]*entity.Match{}, nil)
+		mocks.match.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		mocks.match.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		ret, err := usecase.Import(context.Background(), recordId, "さとし")
+
+		require.Error(t, err)
+		require.Nil(t, ret)
+		require.Empty(t, *mocks.calls)
+	})
+
+	t.Run("異常系_組み合わせにプレイヤーがいなければErrTonamelPlayerNotFoundを返す", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(newRecord(), nil)
+		mocks.bracket.EXPECT().FindById(gomock.Any(), tonamelEventId).Return(bracket, nil)
+
+		ret, err := usecase.Import(context.Background(), recordId, "タケシ")
+
+		require.ErrorIs(t, err, apperror.ErrTonamelPlayerNotFound)
+		require.Nil(t, ret)
+	})
+
+	t.Run("異常系_組み合わせを取得できなければエラーを返す", func(t *testing.T) {
+		mocks, usecase := setup4TonamelMatchImportUsecase(t)
+
+		mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(newRecord(), nil)
+		mocks.bracket.EXPECT().FindById(gomock.Any(), tonamelEventId).Return(nil, apperror.ErrRecordNotFound)
+
+		ret, err := usecase.Import(context.Background(), recordId, "さとし")
+
+		require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		require.Nil(t, ret)
+	})
+}
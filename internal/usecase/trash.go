@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+type TrashInterface interface {
+	// FindByUserId は保持期間内(entity.TrashRetention)に削除したユーザの記録・対戦結果・
+	// デッキ・デッキコードを、削除日時の新しい順に返す。
+	FindByUserId(
+		ctx context.Context,
+		userId string,
+	) ([]*entity.TrashItem, error)
+
+	// Restore は uid が削除した itemType/id を、一緒に削除された子とともに復元する。
+	// 他人のもの・保持期間を過ぎたものは存在しないものとして apperror.ErrRecordNotFound を、
+	// 親が削除されたままなら apperror.ErrParentDeleted を返す。
+	Restore(
+		ctx context.Context,
+		uid string,
+		itemType entity.TrashItemType,
+		id string,
+	) (*entity.TrashItem, error)
+
+	// Purge は保持期間を過ぎたものを物理削除し、消した件数を返す。
+	// 一緒に消える写真は、ストレージに置いた本体とサムネイルも消す。
+	// dryRun なら削除せず、削除対象の件数だけを返す。
+	Purge(
+		ctx context.Context,
+		dryRun bool,
+	) (*entity.TrashPurgeResult, error)
+}
+
+type Trash struct {
+	repository        repository.TrashInterface
+	badgeEvaluation   BadgeEvaluationInterface
+	attachmentRepo    repository.AttachmentInterface
+	attachmentStorage repository.AttachmentStorageInterface
+}
+
+func NewTrash(
+	repository repository.TrashInterface,
+	badgeEvaluation BadgeEvaluationInterface,
+	attachmentRepo repository.AttachmentInterface,
+	attachmentStorage repository.AttachmentStorageInterface,
+) TrashInterface {
+	return &Trash{
+		repository:        repository,
+		badgeEvaluation:   badgeEvaluation,
+		attachmentRepo:    attachmentRepo,
+		attachmentStorage: attachmentStorage,
+	}
+}
+
+func (u *Trash) FindByUserId(
+	ctx context.Context,
+	userId string,
+) ([]*entity.TrashItem, error) {
+	items, err := u.repository.FindByUserId(ctx, userId, entity.TrashCutoff(timeNow().Local()))
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (u *Trash) Restore(
+	ctx context.Context,
+	uid string,
+	itemType entity.TrashItemType,
+	id string,
+) (*entity.TrashItem, error) {
+	item, err := u.repository.FindById(ctx, itemType, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if item.UserId != uid || !item.Restorable(timeNow().Local()) {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	if err := u.repository.Restore(ctx, item); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 記録の増減はストリーク(週ごとの記録の有無)に効くため、削除時と同じく作り直す。
+	// 対戦結果・デッキ・デッキコードの件数を使うバッジは一覧取得時のライブ集計のため、
+	// 復元後に何もしなくても反映される。
+	// 復元は確定済みなので、作り直しに失敗してもログに残すだけにする
+	// (エラーを返すとクライアントは復元できていないものとして再試行してしまう)。
+	if item.Type == entity.TrashItemTypeRecord {
+		if err := u.badgeEvaluation.EvaluateOnRecordRestored(ctx, uid); err != nil {
+			logError(ctx, err)
+		}
+	}
+
+	return item, nil
+}
+
+func (u *Trash) Purge(
+	ctx context.Context,
+	dryRun bool,
+) (*entity.TrashPurgeResult, error) {
+	cutoff := entity.TrashCutoff(timeNow().Local())
+
+	if dryRun {
+		result, err := u.repository.CountPurgeable(ctx, cutoff)
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		return result, nil
+	}
+
+	// 行を消すと写真のキーが分からなくなるため、消す前に控えておく。
+	attachments, err := u.attachmentRepo.FindPurgeable(ctx, cutoff)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	result, err := u.repository.Purge(ctx, cutoff)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 行は消えているため、ストレージに残っても誰からも参照されない。1枚の失敗で残りを止めない。
+	for _, attachment := range attachments {
+		logWarn(ctx, u.attachmentStorage.Delete(ctx, attachment))
+	}
+
+	return result, nil
+}
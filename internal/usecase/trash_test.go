@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+// spyRestoreBadgeEvaluation はEvaluateOnRecordRestoredの呼び出し先ユーザだけを
+// 記録する手書きスタブ(stubBadgeEvaluationと同じ理由でgomockを使わない)。
+type spyRestoreBadgeEvaluation struct {
+	stubBadgeEvaluation
+	restored *[]string
+	err      error
+}
+
+func (s spyRestoreBadgeEvaluation) EvaluateOnRecordRestored(
+	ctx context.Context,
+	userId string,
+) error {
+	*s.restored = append(*s.restored, userId)
+	return s.err
+}
+
+func setup4TrashUsecase(t *testing.T) (*mock_repository.MockTrashInterface, *[]string, TrashInterface) {
+	mockRepository, _, _, restored, usecase := setup4TrashUsecaseWithAttachments(t)
+
+	return mockRepository, restored, usecase
+}
+
+// setup4TrashUsecaseWithAttachments は物理削除で一緒に消す写真のモックも返す。
+func setup4TrashUsecaseWithAttachments(t *testing.T) (
+	*mock_repository.MockTrashInterface,
+	*mock_repository.MockAttachmentInterface,
+	*mock_repository.MockAttachmentStorageInterface,
+	*[]string,
+	TrashInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockTrashInterface(mockCtrl)
+	mockAttachmentRepository := mock_repository.NewMockAttachmentInterface(mockCtrl)
+	mockAttachmentStorage := mock_repository.NewMockAttachmentStorageInterface(mockCtrl)
+
+	restored := &[]string{}
+	usecase := NewTrash(mockRepository, spyRestoreBadgeEvaluation{restored: restored}, mockAttachmentRepository, mockAttachmentStorage)
+
+	return mockRepository, mockAttachmentRepository, mockAttachmentStorage, restored, usecase
+}
+
+func TestTrashUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("FindByUserId", func(t *testing.T) {
+		t.Run("正常系_保持期間内に削除したものを返す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, _, usecase := setup4TrashUsecase(t)
+
+			items := []*entity.TrashItem{{ID: id, Type: entity.TrashItemTypeRecord, UserId: uid, DeletedAt: now}}
+			mockRepository.EXPECT().FindByUserId(gomock.Any(), uid, now.Add(-entity.TrashRetention)).Return(items, nil)
+
+			ret, err := usecase.FindByUserId(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Equal(t, items, ret)
+		})
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		t.Run("正常系_記録を復元してストリークを作り直す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, restored, usecase := setup4TrashUsecase(t)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeRecord, UserId: uid, DeletedAt: now.Add(-24 * time.Hour)}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeRecord, id).Return(item, nil)
+			mockRepository.EXPECT().Restore(gomock.Any(), item).Return(nil)
+
+			ret, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeRecord, id)
+
+			require.NoError(t, err)
+			require.Equal(t, item, ret)
+			require.Equal(t, []string{uid}, *restored)
+		})
+
+		t.Run("正常系_ストリークの作り直しに失敗しても復元できたものを返す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockCtrl := gomock.NewController(t)
+			mockRepository := mock_repository.NewMockTrashInterface(mockCtrl)
+			restored := &[]string{}
+			usecase := NewTrash(
+				mockRepository,
+				spyRestoreBadgeEvaluation{restored: restored, err: errors.New("")},
+				mock_repository.NewMockAttachmentInterface(mockCtrl),
+				mock_repository.NewMockAttachmentStorageInterface(mockCtrl),
+			)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeRecord, UserId: uid, DeletedAt: now}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeRecord, id).Return(item, nil)
+			mockRepository.EXPECT().Restore(gomock.Any(), item).Return(nil)
+
+			ret, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeRecord, id)
+
+			require.NoError(t, err)
+			require.Equal(t, item, ret)
+			require.Equal(t, []string{uid}, *restored)
+		})
+
+		t.Run("正常系_デッキの復元ではストリークを作り直さない", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, restored, usecase := setup4TrashUsecase(t)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeDeck, UserId: uid, DeletedAt: now}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeDeck, id).Return(item, nil)
+			mockRepository.EXPECT().Restore(gomock.Any(), item).Return(nil)
+
+			_, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeDeck, id)
+
+			require.NoError(t, err)
+			require.Empty(t, *restored)
+		})
+
+		t.Run("異常系_他人のものは存在しないものとして扱う", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, _, usecase := setup4TrashUsecase(t)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeRecord, UserId: "other", DeletedAt: now}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeRecord, id).Return(item, nil)
+
+			ret, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeRecord, id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_保持期間を過ぎたものは復元できない", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, _, usecase := setup4TrashUsecase(t)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeRecord, UserId: uid, DeletedAt: now.Add(-entity.TrashRetention - time.Second)}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeRecord, id).Return(item, nil)
+
+			_, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeRecord, id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+
+		t.Run("異常系_親が削除されたままならエラーを返す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, restored, usecase := setup4TrashUsecase(t)
+
+			item := &entity.TrashItem{ID: id, Type: entity.TrashItemTypeMatch, UserId: uid, ParentId: "record", DeletedAt: now}
+			mockRepository.EXPECT().FindById(gomock.Any(), entity.TrashItemTypeMatch, id).Return(item, nil)
+			mockRepository.EXPECT().Restore(gomock.Any(), item).Return(apperror.ErrParentDeleted)
+
+			_, err := usecase.Restore(context.Background(), uid, entity.TrashItemTypeMatch, id)
+
+			require.ErrorIs(t, err, apperror.ErrParentDeleted)
+			require.Empty(t, *restored)
+		})
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Run("正常系_保持期間を過ぎたものを物理削除する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockAttachmentRepository, _, _, usecase := setup4TrashUsecaseWithAttachments(t)
+
+			result := &entity.TrashPurgeResult{Records: 1}
+			mockAttachmentRepository.EXPECT().FindPurgeable(gomock.Any(), now.Add(-entity.TrashRetention)).Return([]*entity.Attachment{}, nil)
+			mockRepository.EXPECT().Purge(gomock.Any(), now.Add(-entity.TrashRetention)).Return(result, nil)
+
+			ret, err := usecase.Purge(context.Background(), false)
+
+			require.NoError(t, err)
+			require.Equal(t, result, ret)
+		})
+
+		t.Run("正常系_一緒に消える写真はストレージからも消す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockAttachmentRepository, mockAttachmentStorage, _, usecase := setup4TrashUsecaseWithAttachments(t)
+
+			attachments := []*entity.Attachment{{ID: "a1", UserId: uid}, {ID: "a2", UserId: uid}}
+			result := &entity.TrashPurgeResult{Records: 1, Attachments: 2}
+
+			gomock.InOrder(
+				mockAttachmentRepository.EXPECT().FindPurgeable(gomock.Any(), gomock.Any()).Return(attachments, nil),
+				mockRepository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(result, nil),
+				// 1枚目の失敗で残りを止めない
+				mockAttachmentStorage.EXPECT().Delete(gomock.Any(), attachments[0]).Return(errors.New("storage error")),
+				mockAttachmentStorage.EXPECT().Delete(gomock.Any(), attachments[1]).Return(nil),
+			)
+
+			ret, err := usecase.Purge(context.Background(), false)
+
+			require.NoError(t, err)
+			require.Equal(t, result, ret)
+		})
+
+		t.Run("正常系_dryRunなら件数を数えるだけで削除しない", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, _, usecase := setup4TrashUsecase(t)
+
+			result := &entity.TrashPurgeResult{Decks: 2}
+			mockRepository.EXPECT().CountPurgeable(gomock.Any(), now.Add(-entity.TrashRetention)).Return(result, nil)
+
+			ret, err := usecase.Purge(context.Background(), true)
+
+			require.NoError(t, err)
+			require.Equal(t, result, ret)
+		})
+
+		t.Run("異常系_削除に失敗したらエラーを返す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mockRepository, mockAttachmentRepository, _, _, usecase := setup4TrashUsecaseWithAttachments(t)
+
+			// 行を消せなかったときはストレージの写真も消さない
+			mockAttachmentRepository.EXPECT().FindPurgeable(gomock.Any(), gomock.Any()).Return([]*entity.Attachment{{ID: "a1", UserId: uid}}, nil)
+			mockRepository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+			ret, err := usecase.Purge(context.Background(), false)
+
+			require.Error(t, err)
+			require.Nil(t, ret)
+		})
+	})
+}
@@ -118,6 +118,10 @@ func (u *UnofficialEvent) Update(
 	)
 	// 作成日時は更新対象ではないため、保存済みの値を引き継ぐ
 	unofficialEvent.CreatedAt = ret.CreatedAt
+	// 版も引き継ぎ、読み込み後に他の端末で更新されていれば上書きしない
+	unofficialEvent.UpdatedAt = ret.UpdatedAt
+	// 参加コードは IssueJoinCode・RevokeJoinCode だけが変えるため、そのまま引き継ぐ
+	unofficialEvent.JoinCode = ret.JoinCode
 
 	if err := u.repository.Save(ctx, unofficialEvent); err != nil {
 		logError(ctx, err)
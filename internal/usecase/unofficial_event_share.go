@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// joinCodeAlphabet は参加コードに使う文字。口頭や手書きで伝えても読み違えないよう、
+// 0/O・1/I/L のような紛らわしい文字を除いている。
+const joinCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// generateJoinCode は参加コードを作る。テストから決まった値に差し替えられるように変数にしている。
+var generateJoinCode = func() (string, error) {
+	max := big.NewInt(int64(len(joinCodeAlphabet)))
+
+	code := make([]byte, entity.JoinCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = joinCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// joinCodeIssueAttempts は作った参加コードが他のイベントと重なったときに、作り直して
+// 発行を試す回数。重なることは滅多に無いため、続けて重なれば apperror.ErrAlreadyExists を返す。
+const joinCodeIssueAttempts = 5
+
+type UnofficialEventShareInterface interface {
+	// IssueJoinCode は自由形式イベントの参加コードを発行する。発行済みなら新しいコードに
+	// 差し替え、以前のコードでは参加できなくする。作ったコードが他のイベントと重なれば作り直す。
+	IssueJoinCode(
+		ctx context.Context,
+		id string,
+	) (*entity.UnofficialEvent, error)
+
+	// RevokeJoinCode は参加コードを取り消す。既に付けられた記録はイベントに残る。
+	RevokeJoinCode(
+		ctx context.Context,
+		id string,
+	) (*entity.UnofficialEvent, error)
+
+	// Join は userId の記録 recordId を、参加コード joinCode で共有されたイベントに付け替える。
+	// コードに当たるイベントが無ければ apperror.ErrRecordNotFound を、recordId が userId の
+	// 記録でなければ apperror.ErrInvalidRecord を返す。
+	Join(
+		ctx context.Context,
+		userId string,
+		joinCode string,
+		recordId string,
+	) (*entity.Record, error)
+
+	// FindSummary はイベントに付いた記録の参加者・デッキごとの集計を返す。
+	FindSummary(
+		ctx context.Context,
+		id string,
+	) (*entity.UnofficialEventSummary, error)
+}
+
+type UnofficialEventShare struct {
+	repository         repository.UnofficialEventInterface
+	summaryRepository  repository.UnofficialEventSummaryInterface
+	recordRepository   repository.RecordInterface
+	transactionManager repository.TransactionManager
+	revision           repository.EntityRevisionInterface
+}
+
+func NewUnofficialEventShare(
+	repository repository.UnofficialEventInterface,
+	summaryRepository repository.UnofficialEventSummaryInterface,
+	recordRepository repository.RecordInterface,
+	transactionManager repository.TransactionManager,
+	revision repository.EntityRevisionInterface,
+) UnofficialEventShareInterface {
+	return &UnofficialEventShare{
+		repository:         repository,
+		summaryRepository:  summaryRepository,
+		recordRepository:   recordRepository,
+		transactionManager: transactionManager,
+		revision:           revision,
+	}
+}
+
+func (u *UnofficialEventShare) IssueJoinCode(
+	ctx context.Context,
+	id string,
+) (*entity.UnofficialEvent, error) {
+	unofficialEvent, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		joinCode, err := generateJoinCode()
+		if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		unofficialEvent.JoinCode = joinCode
+
+		err = u.repository.Save(ctx, unofficialEvent)
+		if err == nil {
+			return unofficialEvent, nil
+		} else if errors.Is(err, apperror.ErrAlreadyExists) && attempt < joinCodeIssueAttempts {
+			continue
+		}
+
+		logError(ctx, err)
+		return nil, err
+	}
+}
+
+func (u *UnofficialEventShare) RevokeJoinCode(
+	ctx context.Context,
+	id string,
+) (*entity.UnofficialEvent, error) {
+	unofficialEvent, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if !unofficialEvent.IsShared() {
+		return unofficialEvent, nil
+	}
+
+	unofficialEvent.JoinCode = ""
+
+	if err := u.repository.Save(ctx, unofficialEvent); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return unofficialEvent, nil
+}
+
+func (u *UnofficialEventShare) Join(
+	ctx context.Context,
+	userId string,
+	joinCode string,
+	recordId string,
+) (*entity.Record, error) {
+	unofficialEvent, err := u.repository.FindByJoinCode(ctx, joinCode)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	record, err := u.recordRepository.FindById(ctx, recordId)
+	if errors.Is(err, apperror.ErrRecordNotFound) {
+		return nil, apperror.ErrInvalidRecord
+	} else if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if record.UserId != userId {
+		return nil, apperror.ErrInvalidRecord
+	}
+
+	if record.UnofficialEventId == unofficialEvent.ID {
+		return record, nil
+	}
+
+	previousId := record.UnofficialEventId
+	before := *record
+
+	// 記録が紐づくイベントはちょうど1つのため、他の種別の参照は外してから付け替える。
+	// 開催日も共有されたイベントに揃え、参加者ごとに日付がずれないようにする。
+	record.OfficialEventId = 0
+	record.TonamelEventId = ""
+	record.FriendId = ""
+	record.UnofficialEventId = unofficialEvent.ID
+	record.EventDate = unofficialEvent.Date
+
+	// 記録の編集(Record.Update)と同じく、変更履歴は付け替えと同じトランザクションで書く。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.recordRepository.Save(ctx, record); err != nil {
+			return err
+		}
+
+		if err := appendRevision(ctx, u.revision, entity.EntityRevisionTypeRecord, record.ID, entity.EntityRevisionActionUpdate, userId, &before, record); err != nil {
+			return err
+		}
+
+		if previousId == "" {
+			return nil
+		}
+
+		// 付け替える前のイベントは、自分で作ったもので他の記録や大会から参照されていなければ
+		// 孤立するため消す。共有しているイベントは他の記録が付いている可能性があるため残す。
+		previous, err := u.repository.FindById(ctx, previousId)
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if previous.UserId != userId || previous.IsShared() {
+			return nil
+		}
+
+		return u.repository.DeleteIfUnreferenced(ctx, previous.ID, userId)
+	}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (u *UnofficialEventShare) FindSummary(
+	ctx context.Context,
+	id string,
+) (*entity.UnofficialEventSummary, error) {
+	if _, err := u.repository.FindById(ctx, id); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	summary, err := u.summaryRepository.FindByUnofficialEventId(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return summary, nil
+}
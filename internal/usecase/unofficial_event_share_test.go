@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+type unofficialEventShareUsecaseMocks struct {
+	unofficialEvent *mock_repository.MockUnofficialEventInterface
+	summary         *mock_repository.MockUnofficialEventSummaryInterface
+	record          *mock_repository.MockRecordInterface
+	revision        *stubEntityRevisionRepository
+}
+
+func setup4UnofficialEventShareUsecase(t *testing.T) (
+	unofficialEventShareUsecaseMocks,
+	UnofficialEventShareInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mocks := unofficialEventShareUsecaseMocks{
+		unofficialEvent: mock_repository.NewMockUnofficialEventInterface(mockCtrl),
+		summary:         mock_repository.NewMockUnofficialEventSummaryInterface(mockCtrl),
+		record:          mock_repository.NewMockRecordInterface(mockCtrl),
+		revision:        &stubEntityRevisionRepository{},
+	}
+	mockTransactionManager := mock_repository.NewMockTransactionManager(mockCtrl)
+	mockTransactionManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+
+	return mocks, NewUnofficialEventShare(
+		mocks.unofficialEvent,
+		mocks.summary,
+		mocks.record,
+		mockTransactionManager,
+		mocks.revision,
+	)
+}
+
+func TestUnofficialEventShareUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	ownerId := "CeQ0Oa9g9uRThL11lj4l45VAg8p1"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TN2"
+	recordId := "01HD7Y3K8D6FDHMHTZ2GT41TN3"
+	date := time.Date(2026, 7, 18, 0, 0, 0, 0, time.Local)
+
+	t.Run("IssueJoinCode", func(t *testing.T) {
+		t.Run("正常系_参加コードを発行して保存する", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).
+				Return(entity.NewUnofficialEvent(id, ownerId, "自主大会", date), nil)
+			mocks.unofficialEvent.EXPECT().Save(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, e *entity.UnofficialEvent) error {
+					require.Len(t, e.JoinCode, entity.JoinCodeLength)
+					return nil
+				})
+
+			ret, err := usecase.IssueJoinCode(context.Background(), id)
+
+			require.NoError(t, err)
+			require.True(t, ret.IsShared())
+			require.Regexp(t, "^["+joinCodeAlphabet+"]+$", ret.JoinCode)
+		})
+
+		t.Run("正常系_参加コードが他のイベントと重なれば作り直して発行する", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			var saved []string
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).
+				Return(entity.NewUnofficialEvent(id, ownerId, "自主大会", date), nil)
+			mocks.unofficialEvent.EXPECT().Save(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, e *entity.UnofficialEvent) error {
+					saved = append(saved, e.JoinCode)
+					if len(saved) < joinCodeIssueAttempts {
+						return apperror.ErrAlreadyExists
+					}
+					return nil
+				}).Times(joinCodeIssueAttempts)
+
+			ret, err := usecase.IssueJoinCode(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, saved[len(saved)-1], ret.JoinCode)
+		})
+
+		t.Run("異常系_参加コードが重なり続ければErrAlreadyExistsを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).
+				Return(entity.NewUnofficialEvent(id, ownerId, "自主大会", date), nil)
+			mocks.unofficialEvent.EXPECT().Save(gomock.Any(), gomock.Any()).
+				Return(apperror.ErrAlreadyExists).Times(joinCodeIssueAttempts)
+
+			ret, err := usecase.IssueJoinCode(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrAlreadyExists)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_存在しないイベントはErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.IssueJoinCode(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("RevokeJoinCode", func(t *testing.T) {
+		t.Run("正常系_参加コードを取り消して保存する", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			event := entity.NewUnofficialEvent(id, ownerId, "自主大会", date)
+			event.JoinCode = "K7QX2M9P"
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).Return(event, nil)
+			mocks.unofficialEvent.EXPECT().Save(gomock.Any(), event).Return(nil)
+
+			ret, err := usecase.RevokeJoinCode(context.Background(), id)
+
+			require.NoError(t, err)
+			require.False(t, ret.IsShared())
+		})
+
+		t.Run("正常系_共有していなければ保存しない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).
+				Return(entity.NewUnofficialEvent(id, ownerId, "自主大会", date), nil)
+
+			ret, err := usecase.RevokeJoinCode(context.Background(), id)
+
+			require.NoError(t, err)
+			require.False(t, ret.IsShared())
+		})
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		shared := entity.NewUnofficialEvent(id, ownerId, "自主大会", date)
+		shared.JoinCode = "K7QX2M9P"
+
+		t.Run("正常系_記録を共有されたイベントに付け替え元のイベントを削除する", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			previousId := "01HD7Y3K8D6FDHMHTZ2GT41TN4"
+			record := &entity.Record{
+				ID:                recordId,
+				UserId:            uid,
+				UnofficialEventId: previousId,
+				EventDate:         date.AddDate(0, 0, -1),
+			}
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), record).Return(nil)
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), previousId).
+				Return(entity.NewUnofficialEvent(previousId, uid, "自主大会", date), nil)
+			mocks.unofficialEvent.EXPECT().DeleteIfUnreferenced(gomock.Any(), previousId, uid).Return(nil)
+
+			ret, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.NoError(t, err)
+			require.Equal(t, id, ret.UnofficialEventId)
+			require.Equal(t, date, ret.EventDate)
+		})
+
+		t.Run("正常系_付け替えを記録の変更履歴に残す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			record := &entity.Record{ID: recordId, UserId: uid, OfficialEventId: 12345}
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), record).Return(nil)
+
+			_, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.NoError(t, err)
+			require.Len(t, mocks.revision.revisions, 1)
+
+			revision := mocks.revision.revisions[0]
+			require.Equal(t, entity.EntityRevisionTypeRecord, revision.EntityType)
+			require.Equal(t, recordId, revision.EntityId)
+			require.Equal(t, entity.EntityRevisionActionUpdate, revision.Action)
+
+			var before, after entity.Record
+			_, _, err = revision.DecodeSnapshots(&before, &after)
+			require.NoError(t, err)
+			require.Equal(t, uint(12345), before.OfficialEventId)
+			require.Empty(t, before.UnofficialEventId)
+			require.Zero(t, after.OfficialEventId)
+			require.Equal(t, id, after.UnofficialEventId)
+		})
+
+		t.Run("正常系_元のイベントを自分の他の記録も参照していれば参照の残らないときだけ消すよう頼む", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			// 同じ自分のイベントに付いた2つの記録のうち、1つだけを共有されたイベントへ付け替える。
+			// 元のイベントはもう1つの記録が参照し続けるため、参照を確かめずに Delete してはいけない
+			// (Delete を呼べば gomock が想定外の呼び出しとして落とす)。
+			previousId := "01HD7Y3K8D6FDHMHTZ2GT41TN4"
+			record := &entity.Record{ID: recordId, UserId: uid, UnofficialEventId: previousId}
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), record).Return(nil)
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), previousId).
+				Return(entity.NewUnofficialEvent(previousId, uid, "自主大会", date), nil)
+			mocks.unofficialEvent.EXPECT().DeleteIfUnreferenced(gomock.Any(), previousId, uid).Return(nil)
+
+			_, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("正常系_公式イベントの記録は参照を外して付け替える", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			record := &entity.Record{ID: recordId, UserId: uid, OfficialEventId: 12345}
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), record).Return(nil)
+
+			ret, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.NoError(t, err)
+			require.Zero(t, ret.OfficialEventId)
+			require.Equal(t, id, ret.UnofficialEventId)
+		})
+
+		t.Run("正常系_元のイベントが共有されていれば削除しない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			previousId := "01HD7Y3K8D6FDHMHTZ2GT41TN4"
+			previous := entity.NewUnofficialEvent(previousId, uid, "自主大会", date)
+			previous.JoinCode = "AB23CD45"
+			record := &entity.Record{ID: recordId, UserId: uid, UnofficialEventId: previousId}
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).Return(record, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), record).Return(nil)
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), previousId).Return(previous, nil)
+
+			_, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("異常系_存在しない参加コードはErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_他のユーザの記録はErrInvalidRecordを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).
+				Return(&entity.Record{ID: recordId, UserId: ownerId}, nil)
+
+			ret, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.ErrorIs(t, err, apperror.ErrInvalidRecord)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_保存に失敗したらエラーを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindByJoinCode(gomock.Any(), "K7QX2M9P").Return(shared, nil)
+			mocks.record.EXPECT().FindById(gomock.Any(), recordId).
+				Return(&entity.Record{ID: recordId, UserId: uid}, nil)
+			mocks.record.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New(""))
+
+			ret, err := usecase.Join(context.Background(), uid, "K7QX2M9P", recordId)
+
+			require.Error(t, err)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("FindSummary", func(t *testing.T) {
+		t.Run("正常系_イベントに付いた記録の集計を返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			summary := &entity.UnofficialEventSummary{UnofficialEventId: id, TotalMatches: 3}
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).Return(entity.NewUnofficialEvent(id, ownerId, "自主大会", date), nil)
+			mocks.summary.EXPECT().FindByUnofficialEventId(gomock.Any(), id).Return(summary, nil)
+
+			ret, err := usecase.FindSummary(context.Background(), id)
+
+			require.NoError(t, err)
+			require.Equal(t, summary, ret)
+		})
+
+		t.Run("異常系_存在しないイベントはErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventShareUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), id).Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.FindSummary(context.Background(), id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+}
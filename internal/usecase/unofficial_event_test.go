@@ -27,6 +27,14 @@ func (s *stubUnofficialEventRepository) FindById(ctx context.Context, id string)
 	return s.findResult, s.findErr
 }
 
+func (s *stubUnofficialEventRepository) FindByJoinCode(ctx context.Context, joinCode string) (*entity.UnofficialEvent, error) {
+	return s.findResult, s.findErr
+}
+
+func (s *stubUnofficialEventRepository) FindByUserId(ctx context.Context, userId string) ([]*entity.UnofficialEvent, error) {
+	return nil, nil
+}
+
 func (s *stubUnofficialEventRepository) Save(ctx context.Context, e *entity.UnofficialEvent) error {
 	s.saved = e
 	return s.saveErr
@@ -37,6 +45,11 @@ func (s *stubUnofficialEventRepository) Delete(ctx context.Context, id string) e
 	return s.deleteErr
 }
 
+func (s *stubUnofficialEventRepository) DeleteIfUnreferenced(ctx context.Context, id string, userId string) error {
+	s.deletedId = id
+	return s.deleteErr
+}
+
 func TestUnofficialEventUsecase(t *testing.T) {
 	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
 
@@ -0,0 +1,1019 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// shuffleParticipantIds はスイスドローの1回戦の組み合わせを無作為にするための並べ替え。
+// テストから決定的な並びに差し替えられるように変数にしている。
+var shuffleParticipantIds = func(ids []string) {
+	rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+}
+
+type UnofficialEventTournamentInterface interface {
+	// FindByUnofficialEventId は自由形式イベントの大会の設定を返す。大会にしていなければ
+	// apperror.ErrRecordNotFound を返す。
+	FindByUnofficialEventId(
+		ctx context.Context,
+		unofficialEventId string,
+	) (*entity.UnofficialEventTournament, error)
+
+	// Create は自由形式イベントを大会にする。既に大会なら apperror.ErrAlreadyExists を返す。
+	Create(
+		ctx context.Context,
+		unofficialEventId string,
+		bo3Flg bool,
+	) (*entity.UnofficialEventTournament, error)
+
+	// FindParticipants は参加者を登録順に、登録ユーザとともに返す。
+	FindParticipants(
+		ctx context.Context,
+		unofficialEventId string,
+	) ([]*entity.UnofficialEventParticipant, error)
+
+	// AddParticipant は登録ユーザ userId かゲスト guestName のどちらかを参加者にする。
+	// ユーザが存在しなければ apperror.ErrRecordNotFound を、既に参加していれば
+	// apperror.ErrAlreadyExists を、トップカットを始めていれば apperror.ErrTournamentClosed を、
+	// 参加者が entity.MaxUnofficialEventParticipants 人に達していれば apperror.ErrTooManyParticipants を返す。
+	AddParticipant(
+		ctx context.Context,
+		unofficialEventId string,
+		userId string,
+		guestName string,
+	) (*entity.UnofficialEventParticipant, error)
+
+	// RemoveParticipant は参加者を外す。まだ組み合わせていなければ登録ごと消し、
+	// 組み合わせた後なら棄権にして次の回戦から組み合わせない。
+	RemoveParticipant(
+		ctx context.Context,
+		unofficialEventId string,
+		participantId string,
+	) error
+
+	// FindPairings は組み合わせを回戦・卓番号の順に、参加者とともに返す。round が 0 なら全ての回戦。
+	FindPairings(
+		ctx context.Context,
+		unofficialEventId string,
+		round int,
+	) ([]*entity.UnofficialEventPairing, error)
+
+	// CreateNextRound は次の回戦を組む。トップカットを始めていればトップカットの次の回戦を、
+	// そうでなければスイスドローの次の回戦を組む。今の回戦に未入力の結果があれば
+	// apperror.ErrTournamentRoundInProgress を、決勝が終わっていれば apperror.ErrTournamentClosed を返す。
+	// バイになった登録ユーザの対戦結果はこの時点で作る。
+	CreateNextRound(
+		ctx context.Context,
+		unofficialEventId string,
+	) ([]*entity.UnofficialEventPairing, error)
+
+	// StartTopCut はスイスドローの上位 size 人でトップカットの1回戦を組む。既に始めていれば
+	// apperror.ErrTournamentClosed を、棄権していない参加者が size 人に満たなければ
+	// apperror.ErrNotEnoughParticipants を返す。
+	StartTopCut(
+		ctx context.Context,
+		unofficialEventId string,
+		size int,
+	) ([]*entity.UnofficialEventPairing, error)
+
+	// ReportResult は組み合わせ pairingId に取ったゲーム数を入力し、登録ユーザの参加者の記録に
+	// 対戦結果を作る(入力し直したときは作った対戦結果を直す)。前の回戦の結果は次の回戦を
+	// 組むときに全て入力済みで、組み合わせに使ったため入力し直せない。入力し直せるのは
+	// 最新の回戦だけで、それより前なら apperror.ErrTournamentClosed を、形式に合わない
+	// ゲーム数なら apperror.ErrInvalidMatch を返す。
+	ReportResult(
+		ctx context.Context,
+		unofficialEventId string,
+		pairingId string,
+		player1Wins int,
+		player2Wins int,
+	) (*entity.UnofficialEventPairing, error)
+
+	// FindStandings はスイスドローの順位表を返す。
+	FindStandings(
+		ctx context.Context,
+		unofficialEventId string,
+	) ([]*entity.UnofficialEventStanding, error)
+}
+
+type UnofficialEventTournament struct {
+	repository                repository.UnofficialEventTournamentInterface
+	unofficialEventRepository repository.UnofficialEventInterface
+	userRepository            repository.UserInterface
+	recordRepository          repository.RecordInterface
+	matchRepository           repository.MatchInterface
+	// match は入力し直した結果で、作った対戦結果を直す(変更履歴も残す)。
+	match MatchInterface
+	// 参加者の記録・対戦結果は組み合わせ・結果と同じトランザクションで作り、手で記録したときと
+	// 同じバッジ・称号の判定はコミットした後に行う(evaluate)。
+	badgeEvaluation       BadgeEvaluationInterface
+	designationEvaluation DesignationEvaluationInterface
+	environmentBadgeEval  EnvironmentBadgeEvaluationInterface
+	transactionManager    repository.TransactionManager
+}
+
+func NewUnofficialEventTournament(
+	repository repository.UnofficialEventTournamentInterface,
+	unofficialEventRepository repository.UnofficialEventInterface,
+	userRepository repository.UserInterface,
+	recordRepository repository.RecordInterface,
+	matchRepository repository.MatchInterface,
+	match MatchInterface,
+	badgeEvaluation BadgeEvaluationInterface,
+	designationEvaluation DesignationEvaluationInterface,
+	environmentBadgeEval EnvironmentBadgeEvaluationInterface,
+	transactionManager repository.TransactionManager,
+) UnofficialEventTournamentInterface {
+	return &UnofficialEventTournament{
+		repository:                repository,
+		unofficialEventRepository: unofficialEventRepository,
+		userRepository:            userRepository,
+		recordRepository:          recordRepository,
+		matchRepository:           matchRepository,
+		match:                     match,
+		badgeEvaluation:           badgeEvaluation,
+		designationEvaluation:     designationEvaluation,
+		environmentBadgeEval:      environmentBadgeEval,
+		transactionManager:        transactionManager,
+	}
+}
+
+// tournamentEvaluation は大会の操作で登録ユーザ userId に作った記録・対戦結果。
+type tournamentEvaluation struct {
+	userId string
+	// beforeTier / tierErr は記録・対戦結果を書き込む前に取得した称号のtier。
+	beforeTier int
+	tierErr    error
+	// createdRecord は新しく作った記録。既にあった記録に書き込んだときは nil。
+	createdRecord *entity.Record
+	record        *entity.Record
+	matches       []*entity.Match
+}
+
+type tournamentEvaluations []*tournamentEvaluation
+
+func (e tournamentEvaluations) of(userId string) *tournamentEvaluation {
+	for _, evaluation := range e {
+		if evaluation.userId == userId {
+			return evaluation
+		}
+	}
+
+	return nil
+}
+
+// newEvaluations は pairings の登録ユーザの参加者について、称号のtier変化を比べるため
+// 保存前の時点のtierを取得しておく。
+func (u *UnofficialEventTournament) newEvaluations(
+	ctx context.Context,
+	state *tournamentState,
+	pairings []*entity.UnofficialEventPairing,
+) tournamentEvaluations {
+	ret := tournamentEvaluations{}
+	for _, pairing := range pairings {
+		for _, id := range []string{pairing.Player1Id, pairing.Player2Id} {
+			participant := state.participant(id)
+			if participant == nil || participant.UserId == "" || ret.of(participant.UserId) != nil {
+				continue
+			}
+
+			beforeTier, tierErr := u.designationEvaluation.CurrentTier(ctx, participant.UserId)
+			ret = append(ret, &tournamentEvaluation{
+				userId:     participant.UserId,
+				beforeTier: beforeTier,
+				tierErr:    tierErr,
+			})
+		}
+	}
+
+	return ret
+}
+
+// evaluate はコミットした後に、作った記録・対戦結果を手で記録したときと同じ
+// 「ユーザバッジ→環境バッジ→称号/ランクアップ」の順で判定する。保存済みの結果を
+// 失敗にしないよう、エラーはログに残すだけにする。
+func (u *UnofficialEventTournament) evaluate(
+	ctx context.Context,
+	evaluations tournamentEvaluations,
+) {
+	for _, e := range evaluations {
+		if e.createdRecord != nil {
+			if _, err := u.badgeEvaluation.EvaluateOnRecordCreated(ctx, e.userId, e.createdRecord); err != nil {
+				logError(ctx, err)
+			}
+		}
+
+		if len(e.matches) != 0 {
+			evaluateMatchesCreated(ctx, u.badgeEvaluation, u.designationEvaluation, u.environmentBadgeEval, e.userId, e.record, e.matches, e.beforeTier, e.tierErr)
+		} else if e.createdRecord != nil && e.tierErr == nil {
+			u.designationEvaluation.NotifyIfTierChanged(ctx, e.userId, e.beforeTier, e.createdRecord.CreatedAt)
+		}
+	}
+}
+
+// tournamentState は大会の今の状態。
+type tournamentState struct {
+	event        *entity.UnofficialEvent
+	tournament   *entity.UnofficialEventTournament
+	participants []*entity.UnofficialEventParticipant
+	pairings     []*entity.UnofficialEventPairing
+}
+
+func (s *tournamentState) latestRound() int {
+	if len(s.pairings) == 0 {
+		return 0
+	}
+
+	return s.pairings[len(s.pairings)-1].Round
+}
+
+func (s *tournamentState) roundPairings(round int) []*entity.UnofficialEventPairing {
+	ret := []*entity.UnofficialEventPairing{}
+	for _, pairing := range s.pairings {
+		if pairing.Round == round {
+			ret = append(ret, pairing)
+		}
+	}
+
+	return ret
+}
+
+func (s *tournamentState) isRoundInProgress() bool {
+	for _, pairing := range s.roundPairings(s.latestRound()) {
+		if !pairing.IsReported() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *tournamentState) participant(id string) *entity.UnofficialEventParticipant {
+	for _, participant := range s.participants {
+		if participant.ID == id {
+			return participant
+		}
+	}
+
+	return nil
+}
+
+// activeStandings は棄権していない参加者の順位表を上位から返す。
+func (s *tournamentState) activeStandings() []*entity.UnofficialEventStanding {
+	ret := []*entity.UnofficialEventStanding{}
+	for _, standing := range entity.NewUnofficialEventStandings(s.participants, s.pairings) {
+		if !standing.Participant.IsDropped() {
+			ret = append(ret, standing)
+		}
+	}
+
+	return ret
+}
+
+// roundLabel は対戦結果のメモに書く回戦の名前を返す。
+func (s *tournamentState) roundLabel(pairing *entity.UnofficialEventPairing) string {
+	if pairing.Stage == entity.TournamentStageSwiss {
+		return fmt.Sprintf("スイスドロー %d回戦", pairing.Round)
+	}
+
+	n := len(s.roundPairings(pairing.Round))
+	if n <= 1 {
+		return "決勝"
+	}
+
+	return fmt.Sprintf("トップ%d", n*2)
+}
+
+// attach は pairings に参加者を詰める。
+func (s *tournamentState) attach(pairings []*entity.UnofficialEventPairing) []*entity.UnofficialEventPairing {
+	for _, pairing := range pairings {
+		pairing.Player1 = s.participant(pairing.Player1Id)
+		pairing.Player2 = s.participant(pairing.Player2Id)
+	}
+
+	return pairings
+}
+
+// load は自由形式イベントの大会の状態を読み込む。
+func (u *UnofficialEventTournament) load(
+	ctx context.Context,
+	unofficialEventId string,
+) (*tournamentState, error) {
+	event, err := u.unofficialEventRepository.FindById(ctx, unofficialEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	tournament, err := u.repository.FindByUnofficialEventId(ctx, unofficialEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	participants, err := u.repository.FindParticipants(ctx, unofficialEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	for _, participant := range participants {
+		if participant.UserId == "" {
+			continue
+		}
+
+		// 退会したユーザは名前を出さずに参加者として残す。
+		user, err := u.userRepository.FindById(ctx, participant.UserId)
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			continue
+		} else if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+		participant.User = user
+	}
+
+	pairings, err := u.repository.FindPairings(ctx, unofficialEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return &tournamentState{
+		event:        event,
+		tournament:   tournament,
+		participants: participants,
+		pairings:     pairings,
+	}, nil
+}
+
+// loadForUpdate は大会の行をロックしてから状態を読み込む。読み込んだ状態をもとに
+// 書き込む操作(参加者の登録・回戦を組む・結果の入力)はトランザクションの中でこれを使い、
+// 同時に届いたリクエスト(二重クリックや別のタブ)が同じ状態を読んで二重に書き込まないようにする。
+func (u *UnofficialEventTournament) loadForUpdate(
+	ctx context.Context,
+	unofficialEventId string,
+) (*tournamentState, error) {
+	if err := u.repository.LockByUnofficialEventId(ctx, unofficialEventId); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return u.load(ctx, unofficialEventId)
+}
+
+// isTournamentRuleError は大会の進行上受け付けられないことを表すエラーかを返す。
+// 利用者の操作によるものなのでログには残さない。
+func isTournamentRuleError(err error) bool {
+	return errors.Is(err, apperror.ErrTournamentRoundInProgress) ||
+		errors.Is(err, apperror.ErrTournamentClosed) ||
+		errors.Is(err, apperror.ErrNotEnoughParticipants) ||
+		errors.Is(err, apperror.ErrTooManyParticipants) ||
+		errors.Is(err, apperror.ErrAlreadyExists) ||
+		errors.Is(err, apperror.ErrInvalidMatch) ||
+		errors.Is(err, apperror.ErrRecordNotFound)
+}
+
+func (u *UnofficialEventTournament) FindByUnofficialEventId(
+	ctx context.Context,
+	unofficialEventId string,
+) (*entity.UnofficialEventTournament, error) {
+	tournament, err := u.repository.FindByUnofficialEventId(ctx, unofficialEventId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return tournament, nil
+}
+
+func (u *UnofficialEventTournament) Create(
+	ctx context.Context,
+	unofficialEventId string,
+	bo3Flg bool,
+) (*entity.UnofficialEventTournament, error) {
+	if _, err := u.unofficialEventRepository.FindById(ctx, unofficialEventId); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if _, err := u.repository.FindByUnofficialEventId(ctx, unofficialEventId); err == nil {
+		return nil, apperror.ErrAlreadyExists
+	} else if !errors.Is(err, apperror.ErrRecordNotFound) {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	tournament := entity.NewUnofficialEventTournament(unofficialEventId, timeNow(), bo3Flg)
+
+	if err := u.repository.Create(ctx, tournament); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return tournament, nil
+}
+
+func (u *UnofficialEventTournament) FindParticipants(
+	ctx context.Context,
+	unofficialEventId string,
+) ([]*entity.UnofficialEventParticipant, error) {
+	state, err := u.load(ctx, unofficialEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.participants, nil
+}
+
+func (u *UnofficialEventTournament) AddParticipant(
+	ctx context.Context,
+	unofficialEventId string,
+	userId string,
+	guestName string,
+) (*entity.UnofficialEventParticipant, error) {
+	var participant *entity.UnofficialEventParticipant
+
+	// 同時に登録されても上限を超えないよう、大会の行をロックしてから数える。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		state, err := u.loadForUpdate(ctx, unofficialEventId)
+		if err != nil {
+			return err
+		}
+
+		if state.tournament.TopCutSize > 0 {
+			return apperror.ErrTournamentClosed
+		}
+
+		if len(state.participants) >= entity.MaxUnofficialEventParticipants {
+			return apperror.ErrTooManyParticipants
+		}
+
+		var user *entity.User
+		if userId != "" {
+			for _, participant := range state.participants {
+				if participant.UserId == userId {
+					return apperror.ErrAlreadyExists
+				}
+			}
+
+			if user, err = u.userRepository.FindById(ctx, userId); err != nil {
+				return err
+			}
+		}
+
+		id, err := generateId()
+		if err != nil {
+			return err
+		}
+
+		participant = entity.NewUnofficialEventParticipant(id, timeNow(), unofficialEventId, userId, guestName)
+		if err := u.repository.CreateParticipant(ctx, participant); err != nil {
+			return err
+		}
+
+		participant.User = user
+
+		return nil
+	}); err != nil {
+		if !isTournamentRuleError(err) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	return participant, nil
+}
+
+func (u *UnofficialEventTournament) RemoveParticipant(
+	ctx context.Context,
+	unofficialEventId string,
+	participantId string,
+) error {
+	state, err := u.load(ctx, unofficialEventId)
+	if err != nil {
+		return err
+	}
+
+	participant := state.participant(participantId)
+	if participant == nil {
+		return apperror.ErrRecordNotFound
+	}
+
+	for _, pairing := range state.pairings {
+		if pairing.Player1Id != participantId && pairing.Player2Id != participantId {
+			continue
+		}
+
+		// これまでの組み合わせ・順位表に残すため、登録は消さずに棄権にする。
+		if participant.IsDropped() {
+			return nil
+		}
+
+		participant.Drop(timeNow())
+		if err := u.repository.SaveParticipant(ctx, participant); err != nil {
+			logError(ctx, err)
+			return err
+		}
+
+		return nil
+	}
+
+	if err := u.repository.DeleteParticipant(ctx, participantId); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *UnofficialEventTournament) FindPairings(
+	ctx context.Context,
+	unofficialEventId string,
+	round int,
+) ([]*entity.UnofficialEventPairing, error) {
+	state, err := u.load(ctx, unofficialEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if round == 0 {
+		return state.attach(state.pairings), nil
+	}
+
+	return state.attach(state.roundPairings(round)), nil
+}
+
+func (u *UnofficialEventTournament) CreateNextRound(
+	ctx context.Context,
+	unofficialEventId string,
+) ([]*entity.UnofficialEventPairing, error) {
+	var (
+		state       *tournamentState
+		pairings    []*entity.UnofficialEventPairing
+		evaluations tournamentEvaluations
+	)
+
+	// 同時に組もうとしたリクエストが同じ回戦を二重に組まないよう、大会の行をロックして
+	// 読み込んでから、組み合わせとバイの対戦結果の作成までを1つのトランザクションで行う。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if state, err = u.loadForUpdate(ctx, unofficialEventId); err != nil {
+			return err
+		}
+
+		if pairings, err = u.nextRoundPairings(state); err != nil {
+			return err
+		}
+
+		// バイはこの時点で結果が決まっているため、対戦結果も作る。
+		byes := []*entity.UnofficialEventPairing{}
+		for _, pairing := range pairings {
+			if pairing.IsBye() {
+				byes = append(byes, pairing)
+			}
+		}
+		evaluations = u.newEvaluations(ctx, state, byes)
+
+		if err := u.repository.CreatePairings(ctx, pairings); err != nil {
+			return err
+		}
+
+		state.pairings = append(state.pairings, pairings...)
+
+		for _, pairing := range byes {
+			if err := u.materialize(ctx, state, pairing, evaluations); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		if !isTournamentRuleError(err) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	u.evaluate(ctx, evaluations)
+
+	return state.attach(pairings), nil
+}
+
+// nextRoundPairings は state の次の回戦の組み合わせを返す。トップカットを始めていれば
+// 前の回戦の勝者同士を、それまでは順位の近い相手同士をスイスドローで組む。
+func (u *UnofficialEventTournament) nextRoundPairings(
+	state *tournamentState,
+) ([]*entity.UnofficialEventPairing, error) {
+	if state.isRoundInProgress() {
+		return nil, apperror.ErrTournamentRoundInProgress
+	}
+
+	var pairs [][2]string
+	var byeId string
+	stage := entity.TournamentStageSwiss
+
+	if state.tournament.TopCutSize > 0 {
+		stage = entity.TournamentStageTopCut
+
+		// 前の回戦の隣り合う卓の勝者同士を組む。
+		previous := state.roundPairings(state.latestRound())
+		if len(previous) <= 1 {
+			return nil, apperror.ErrTournamentClosed
+		}
+
+		for i := 0; i+1 < len(previous); i += 2 {
+			pairs = append(pairs, [2]string{previous[i].WinnerId(), previous[i+1].WinnerId()})
+		}
+	} else {
+		standings := state.activeStandings()
+		if len(standings) < 2 {
+			return nil, apperror.ErrNotEnoughParticipants
+		}
+
+		ids := make([]string, 0, len(standings))
+		for _, standing := range standings {
+			ids = append(ids, standing.Participant.ID)
+		}
+
+		if state.latestRound() == 0 {
+			shuffleParticipantIds(ids)
+		}
+
+		pairs, byeId = entity.PairSwissRound(ids, state.pairings)
+	}
+
+	if byeId != "" {
+		pairs = append(pairs, [2]string{byeId, ""})
+	}
+
+	return u.newPairings(state, stage, pairs)
+}
+
+// newPairings は pairs を次の回戦の卓番号の順に組み合わせにする。
+func (u *UnofficialEventTournament) newPairings(
+	state *tournamentState,
+	stage entity.TournamentStage,
+	pairs [][2]string,
+) ([]*entity.UnofficialEventPairing, error) {
+	now := timeNow()
+	round := state.latestRound() + 1
+
+	pairings := make([]*entity.UnofficialEventPairing, 0, len(pairs))
+	for i, pair := range pairs {
+		id, err := generateId()
+		if err != nil {
+			return nil, err
+		}
+
+		pairings = append(pairings, entity.NewUnofficialEventPairing(
+			id,
+			now,
+			state.event.ID,
+			stage,
+			round,
+			i+1,
+			pair[0],
+			pair[1],
+		))
+	}
+
+	return pairings, nil
+}
+
+func (u *UnofficialEventTournament) StartTopCut(
+	ctx context.Context,
+	unofficialEventId string,
+	size int,
+) ([]*entity.UnofficialEventPairing, error) {
+	var (
+		state    *tournamentState
+		pairings []*entity.UnofficialEventPairing
+	)
+
+	// CreateNextRound と同じく、大会の行をロックして読み込み、トップカットを二重に組まない。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if state, err = u.loadForUpdate(ctx, unofficialEventId); err != nil {
+			return err
+		}
+
+		if state.tournament.TopCutSize > 0 {
+			return apperror.ErrTournamentClosed
+		}
+
+		if state.isRoundInProgress() {
+			return apperror.ErrTournamentRoundInProgress
+		}
+
+		standings := state.activeStandings()
+		if !entity.IsValidTopCutSize(size) || len(standings) < size {
+			return apperror.ErrNotEnoughParticipants
+		}
+
+		seeds := entity.TopCutSeedOrder(size)
+		pairs := make([][2]string, 0, size/2)
+		for i := 0; i+1 < len(seeds); i += 2 {
+			pairs = append(pairs, [2]string{
+				standings[seeds[i]-1].Participant.ID,
+				standings[seeds[i+1]-1].Participant.ID,
+			})
+		}
+
+		if pairings, err = u.newPairings(state, entity.TournamentStageTopCut, pairs); err != nil {
+			return err
+		}
+
+		state.tournament.TopCutSize = size
+		state.tournament.UpdatedAt = timeNow()
+		if err := u.repository.Save(ctx, state.tournament); err != nil {
+			return err
+		}
+
+		return u.repository.CreatePairings(ctx, pairings)
+	}); err != nil {
+		if !isTournamentRuleError(err) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	return state.attach(pairings), nil
+}
+
+func (u *UnofficialEventTournament) ReportResult(
+	ctx context.Context,
+	unofficialEventId string,
+	pairingId string,
+	player1Wins int,
+	player2Wins int,
+) (*entity.UnofficialEventPairing, error) {
+	var (
+		state       *tournamentState
+		pairing     *entity.UnofficialEventPairing
+		evaluations tournamentEvaluations
+	)
+
+	// 同時に入力された結果が、同じ組み合わせの記録・対戦結果を二重に作らないよう、
+	// 大会の行をロックして読み込んでから書き込む。
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if state, err = u.loadForUpdate(ctx, unofficialEventId); err != nil {
+			return err
+		}
+
+		for _, p := range state.pairings {
+			if p.ID == pairingId {
+				pairing = p
+			}
+		}
+		if pairing == nil {
+			return apperror.ErrRecordNotFound
+		}
+
+		// バイの結果は決まっていて、前の回戦の結果は次の回戦の組み合わせに使ったため直せない。
+		if pairing.IsBye() || pairing.Round != state.latestRound() {
+			return apperror.ErrTournamentClosed
+		}
+
+		if !state.tournament.IsValidScore(pairing.Stage, player1Wins, player2Wins) {
+			return apperror.ErrInvalidMatch
+		}
+
+		evaluations = u.newEvaluations(ctx, state, []*entity.UnofficialEventPairing{pairing})
+
+		pairing.Report(player1Wins, player2Wins, timeNow())
+
+		if err := u.materialize(ctx, state, pairing, evaluations); err != nil {
+			return err
+		}
+
+		return u.repository.SavePairing(ctx, pairing)
+	}); err != nil {
+		if !isTournamentRuleError(err) {
+			logError(ctx, err)
+		}
+		return nil, err
+	}
+
+	u.evaluate(ctx, evaluations)
+
+	state.attach([]*entity.UnofficialEventPairing{pairing})
+
+	return pairing, nil
+}
+
+func (u *UnofficialEventTournament) FindStandings(
+	ctx context.Context,
+	unofficialEventId string,
+) ([]*entity.UnofficialEventStanding, error) {
+	state, err := u.load(ctx, unofficialEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewUnofficialEventStandings(state.participants, state.pairings), nil
+}
+
+// materialize は pairing の結果を、登録ユーザの参加者それぞれの記録に対戦結果として書き込む。
+// 既に書き込んでいれば直す(参加者が消していたら作り直す)。バイの組み合わせはここで保存する。
+// 新しく作った記録・対戦結果は evaluations に入れ、コミットした後に判定する。
+func (u *UnofficialEventTournament) materialize(
+	ctx context.Context,
+	state *tournamentState,
+	pairing *entity.UnofficialEventPairing,
+	evaluations tournamentEvaluations,
+) error {
+	sides := []struct {
+		participantId string
+		matchId       *string
+	}{
+		{pairing.Player1Id, &pairing.Player1MatchId},
+		{pairing.Player2Id, &pairing.Player2MatchId},
+	}
+
+	for _, side := range sides {
+		participant := state.participant(side.participantId)
+		if participant == nil || participant.UserId == "" {
+			continue
+		}
+
+		evaluation := evaluations.of(participant.UserId)
+
+		record, err := u.participantRecord(ctx, state.event, participant, evaluation)
+		if err != nil {
+			return err
+		}
+
+		param := newTournamentMatchParam(
+			state.tournament,
+			pairing,
+			participant,
+			state.participant(pairing.OpponentIdOf(participant.ID)),
+			record,
+			state.roundLabel(pairing),
+		)
+
+		if *side.matchId != "" {
+			_, err := u.match.Update(ctx, *side.matchId, param)
+			if err == nil {
+				continue
+			} else if !errors.Is(err, apperror.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		if err := validateMatchParam(param); err != nil {
+			return err
+		}
+
+		matchId, err := generateId()
+		if err != nil {
+			return err
+		}
+
+		match, err := newMatchFromParam(matchId, timeNow().Local(), param)
+		if err != nil {
+			return err
+		}
+
+		if err := u.matchRepository.Create(ctx, match); err != nil {
+			return err
+		}
+		*side.matchId = match.ID
+
+		evaluation.record = record
+		evaluation.matches = append(evaluation.matches, match)
+	}
+
+	if pairing.IsBye() {
+		return u.repository.SavePairing(ctx, pairing)
+	}
+
+	return nil
+}
+
+// participantRecord は登録ユーザの参加者の、この大会の記録を返す。まだ無ければ
+// (参加者が消していた場合も)作り、evaluation に入れる。
+func (u *UnofficialEventTournament) participantRecord(
+	ctx context.Context,
+	event *entity.UnofficialEvent,
+	participant *entity.UnofficialEventParticipant,
+	evaluation *tournamentEvaluation,
+) (*entity.Record, error) {
+	if participant.RecordId != "" {
+		record, err := u.recordRepository.FindById(ctx, participant.RecordId)
+		if err == nil {
+			return record, nil
+		} else if !errors.Is(err, apperror.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	param := NewRecordParam(
+		0,
+		"",
+		"",
+		event.ID,
+		participant.UserId,
+		"",
+		"",
+		event.Date,
+		false,
+		false,
+		0,
+		"",
+		"",
+	)
+	if err := normalizeAndValidateRecordParam(param); err != nil {
+		return nil, err
+	}
+
+	// 記録の組み立ては記録と対戦結果をまとめて作るとき(CreateWithMatches)と同じ。
+	built, err := buildImportedRecord(NewRecordImportParam(param, nil))
+	if err != nil {
+		return nil, err
+	}
+	record := built.Record
+
+	if err := u.recordRepository.Save(ctx, record); err != nil {
+		return nil, err
+	}
+	evaluation.createdRecord = record
+
+	participant.RecordId = record.ID
+	participant.UpdatedAt = timeNow()
+	if err := u.repository.SaveParticipant(ctx, participant); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// newTournamentMatchParam は participant から見た pairing の結果を対戦結果にする。
+// 大会では取ったゲーム数しか入力しないため、各ゲームは participant の勝ち負けを
+// 交互に並べたあと残りを続けた順にし(2-1 なら勝・負・勝)、先攻後攻・サイドの枚数は既定値のままにする。
+// 対戦相手側は勝ち負けを裏返した同じ順になる。
+func newTournamentMatchParam(
+	tournament *entity.UnofficialEventTournament,
+	pairing *entity.UnofficialEventPairing,
+	participant *entity.UnofficialEventParticipant,
+	opponent *entity.UnofficialEventParticipant,
+	record *entity.Record,
+	roundLabel string,
+) *MatchParam {
+	player1Wins, player2Wins := pairing.Player1Wins, pairing.Player2Wins
+	memo := roundLabel + " バイ"
+	opponentUserId := ""
+	if opponent != nil {
+		memo = roundLabel + " vs " + opponent.Name()
+		opponentUserId = opponent.UserId
+	}
+
+	var games []*GameParam
+	if !pairing.IsBye() {
+		// player1 から見た並びを作り、player2 なら裏返す。
+		common := min(player1Wins, player2Wins)
+		results := make([]bool, 0, player1Wins+player2Wins)
+		for i := 0; i < common; i++ {
+			results = append(results, true, false)
+		}
+		for i := common; i < max(player1Wins, player2Wins); i++ {
+			results = append(results, player1Wins > player2Wins)
+		}
+
+		isPlayer1 := participant.ID == pairing.Player1Id
+		for _, won := range results {
+			games = append(games, NewGameParam(false, won == isPlayer1, 0, 0, ""))
+		}
+	}
+
+	result := pairing.ResultOf(participant.ID)
+
+	return NewMatchParam(
+		record.ID,
+		record.DeckId,
+		record.DeckCodeId,
+		participant.UserId,
+		opponentUserId,
+		tournament.BO3Flg,
+		false,
+		pairing.Stage == entity.TournamentStageSwiss,
+		pairing.Stage == entity.TournamentStageTopCut,
+		pairing.IsBye(),
+		false,
+		result == entity.MatchResultWin,
+		result == entity.MatchResultDraw,
+		false,
+		"",
+		memo,
+		games,
+		nil,
+	)
+}
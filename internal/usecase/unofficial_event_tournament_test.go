@@ -0,0 +1,657 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+// stubTournamentMatch は参加者の対戦結果を直す MatchInterface のスタブ。Update 以外は呼ばれない想定。
+// updateErr を設定すると Update はそのエラーを返す。
+type stubTournamentMatch struct {
+	MatchInterface
+	updated   []*MatchParam
+	updateErr error
+}
+
+func (s *stubTournamentMatch) Update(
+	ctx context.Context,
+	id string,
+	param *MatchParam,
+) (*entity.Match, error) {
+	if s.updateErr != nil {
+		return nil, s.updateErr
+	}
+	s.updated = append(s.updated, param)
+
+	return &entity.Match{ID: id, RecordId: param.RecordId, UserId: param.UserId}, nil
+}
+
+// inTransactionKey はテストの TransactionManager が、トランザクションの中で呼んだことを
+// 示すために ctx へ入れるキー。
+type inTransactionKey struct{}
+
+type unofficialEventTournamentUsecaseMocks struct {
+	tournament      *mock_repository.MockUnofficialEventTournamentInterface
+	unofficialEvent *mock_repository.MockUnofficialEventInterface
+	user            *mock_repository.MockUserInterface
+	record          *mock_repository.MockRecordInterface
+	matchRepository *mock_repository.MockMatchInterface
+	match           *stubTournamentMatch
+	calls           *[]string
+}
+
+func setup4UnofficialEventTournamentUsecase(t *testing.T) (
+	unofficialEventTournamentUsecaseMocks,
+	UnofficialEventTournamentInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mocks := unofficialEventTournamentUsecaseMocks{
+		tournament:      mock_repository.NewMockUnofficialEventTournamentInterface(mockCtrl),
+		unofficialEvent: mock_repository.NewMockUnofficialEventInterface(mockCtrl),
+		user:            mock_repository.NewMockUserInterface(mockCtrl),
+		record:          mock_repository.NewMockRecordInterface(mockCtrl),
+		matchRepository: mock_repository.NewMockMatchInterface(mockCtrl),
+		match:           &stubTournamentMatch{},
+		calls:           &[]string{},
+	}
+	mockTransactionManager := mock_repository.NewMockTransactionManager(mockCtrl)
+	mockTransactionManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			if err := fn(context.WithValue(ctx, inTransactionKey{}, true)); err != nil {
+				return err
+			}
+
+			*mocks.calls = append(*mocks.calls, "commit")
+			return nil
+		},
+	).AnyTimes()
+
+	usecase := NewUnofficialEventTournament(
+		mocks.tournament,
+		mocks.unofficialEvent,
+		mocks.user,
+		mocks.record,
+		mocks.matchRepository,
+		mocks.match,
+		orderTrackingBadgeEvaluation{calls: mocks.calls},
+		orderTrackingDesignationEvaluation{calls: mocks.calls},
+		orderTrackingEnvironmentBadgeEvaluation{calls: mocks.calls},
+		mockTransactionManager,
+	)
+
+	return mocks, usecase
+}
+
+// overrideShuffleParticipantIds は1回戦の並べ替えを止め、登録順のまま組ませる。
+func overrideShuffleParticipantIds(t *testing.T) {
+	t.Helper()
+
+	original := shuffleParticipantIds
+	shuffleParticipantIds = func([]string) {}
+	t.Cleanup(func() { shuffleParticipantIds = original })
+}
+
+func TestUnofficialEventTournamentUsecase(t *testing.T) {
+	eventId := "01JTESTUNOFFICIALEVENT0000"
+	ownerId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	userId := "Q8qU2m0aBcXyZ1234567890abcd"
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+	event := &entity.UnofficialEvent{ID: eventId, UserId: ownerId, Title: "身内大会", Date: now}
+
+	newParticipant := func(id string, userId string, guestName string) *entity.UnofficialEventParticipant {
+		return entity.NewUnofficialEventParticipant(id, now, eventId, userId, guestName)
+	}
+
+	newPairing := func(round int, tableNo int, player1Id string, player2Id string) *entity.UnofficialEventPairing {
+		return entity.NewUnofficialEventPairing("pairing-"+player1Id, now, eventId, entity.TournamentStageSwiss, round, tableNo, player1Id, player2Id)
+	}
+
+	expectLoad := func(
+		mocks unofficialEventTournamentUsecaseMocks,
+		tournament *entity.UnofficialEventTournament,
+		participants []*entity.UnofficialEventParticipant,
+		pairings []*entity.UnofficialEventPairing,
+	) {
+		mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), eventId).Return(event, nil)
+		mocks.tournament.EXPECT().FindByUnofficialEventId(gomock.Any(), eventId).Return(tournament, nil)
+		mocks.tournament.EXPECT().FindParticipants(gomock.Any(), eventId).Return(participants, nil)
+		for _, participant := range participants {
+			if participant.UserId != "" {
+				mocks.user.EXPECT().FindById(gomock.Any(), participant.UserId).Return(&entity.User{ID: participant.UserId, Name: "登録ユーザ"}, nil)
+			}
+		}
+		mocks.tournament.EXPECT().FindPairings(gomock.Any(), eventId).Return(pairings, nil)
+	}
+
+	// expectLockedLoad は書き込む操作が、トランザクションの中で大会の行をロックしてから
+	// 状態を読み込むことを期待する。
+	expectLockedLoad := func(
+		mocks unofficialEventTournamentUsecaseMocks,
+		tournament *entity.UnofficialEventTournament,
+		participants []*entity.UnofficialEventParticipant,
+		pairings []*entity.UnofficialEventPairing,
+	) {
+		mocks.tournament.EXPECT().LockByUnofficialEventId(gomock.Any(), eventId).DoAndReturn(
+			func(ctx context.Context, unofficialEventId string) error {
+				require.Equal(t, true, ctx.Value(inTransactionKey{}), "トランザクションの外でロックしている")
+				return nil
+			},
+		)
+		expectLoad(mocks, tournament, participants, pairings)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("正常系_自由形式イベントを大会にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), eventId).Return(event, nil)
+			mocks.tournament.EXPECT().FindByUnofficialEventId(gomock.Any(), eventId).Return(nil, apperror.ErrRecordNotFound)
+			mocks.tournament.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Create(context.Background(), eventId, true)
+
+			require.NoError(t, err)
+			require.True(t, ret.BO3Flg)
+			require.Equal(t, now, ret.CreatedAt)
+		})
+
+		t.Run("異常系_既に大会ならErrAlreadyExists", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			mocks.unofficialEvent.EXPECT().FindById(gomock.Any(), eventId).Return(event, nil)
+			mocks.tournament.EXPECT().FindByUnofficialEventId(gomock.Any(), eventId).Return(entity.NewUnofficialEventTournament(eventId, now, false), nil)
+
+			_, err := usecase.Create(context.Background(), eventId, true)
+
+			require.ErrorIs(t, err, apperror.ErrAlreadyExists)
+		})
+	})
+
+	t.Run("AddParticipant", func(t *testing.T) {
+		t.Run("異常系_同じユーザは2度登録できない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", userId, ""),
+			}, nil)
+
+			_, err := usecase.AddParticipant(context.Background(), eventId, userId, "")
+
+			require.ErrorIs(t, err, apperror.ErrAlreadyExists)
+		})
+
+		t.Run("異常系_参加者が上限に達していれば登録できない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			participants := make([]*entity.UnofficialEventParticipant, 0, entity.MaxUnofficialEventParticipants)
+			for i := 0; i < entity.MaxUnofficialEventParticipants; i++ {
+				participants = append(participants, newParticipant(fmt.Sprintf("p%03d", i), "", fmt.Sprintf("ゲスト%d", i)))
+			}
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), participants, nil)
+
+			_, err := usecase.AddParticipant(context.Background(), eventId, "", "ゲスト")
+
+			require.ErrorIs(t, err, apperror.ErrTooManyParticipants)
+		})
+
+		t.Run("異常系_トップカットを始めたら登録できない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			tournament := entity.NewUnofficialEventTournament(eventId, now, false)
+			tournament.TopCutSize = 4
+			expectLockedLoad(mocks, tournament, nil, nil)
+
+			_, err := usecase.AddParticipant(context.Background(), eventId, "", "ゲスト")
+
+			require.ErrorIs(t, err, apperror.ErrTournamentClosed)
+		})
+	})
+
+	t.Run("RemoveParticipant", func(t *testing.T) {
+		t.Run("正常系_組み合わせる前なら登録ごと消す", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+			}, nil)
+			mocks.tournament.EXPECT().DeleteParticipant(gomock.Any(), "a").Return(nil)
+
+			require.NoError(t, usecase.RemoveParticipant(context.Background(), eventId, "a"))
+		})
+
+		t.Run("正常系_組み合わせた後なら棄権にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{
+				newPairing(1, 1, "a", "b"),
+			})
+			mocks.tournament.EXPECT().SaveParticipant(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, participant *entity.UnofficialEventParticipant) error {
+					require.Equal(t, "a", participant.ID)
+					require.True(t, participant.IsDropped())
+					return nil
+				},
+			)
+
+			require.NoError(t, usecase.RemoveParticipant(context.Background(), eventId, "a"))
+		})
+	})
+
+	t.Run("CreateNextRound", func(t *testing.T) {
+		t.Run("正常系_1回戦を組み、バイの登録ユーザには記録と不戦勝の対戦結果を作る", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			overrideShuffleParticipantIds(t)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+				newParticipant("c", userId, ""),
+			}, nil)
+			mocks.tournament.EXPECT().CreatePairings(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, pairings []*entity.UnofficialEventPairing) error {
+					require.Len(t, pairings, 2)
+					return nil
+				},
+			)
+			var record *entity.Record
+			mocks.record.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *entity.Record) error {
+					record = r
+					return nil
+				},
+			)
+			mocks.tournament.EXPECT().SaveParticipant(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, participant *entity.UnofficialEventParticipant) error {
+					require.Equal(t, record.ID, participant.RecordId)
+					return nil
+				},
+			)
+			var match *entity.Match
+			mocks.matchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, m *entity.Match) error {
+					match = m
+					return nil
+				},
+			)
+			mocks.tournament.EXPECT().SavePairing(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, pairing *entity.UnofficialEventPairing) error {
+					require.True(t, pairing.IsBye())
+					require.Equal(t, match.ID, pairing.Player1MatchId)
+					return nil
+				},
+			)
+
+			ret, err := usecase.CreateNextRound(context.Background(), eventId)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 2)
+			require.Equal(t, "a", ret[0].Player1Id)
+			require.Equal(t, "b", ret[0].Player2Id)
+			require.Equal(t, "ゲストB", ret[0].Player2.Name())
+			require.True(t, ret[1].IsBye())
+			require.Equal(t, "c", ret[1].Player1Id)
+
+			require.Equal(t, eventId, record.UnofficialEventId)
+			require.Equal(t, userId, record.UserId)
+
+			require.Equal(t, record.ID, match.RecordId)
+			require.True(t, match.DefaultVictoryFlg)
+			require.True(t, match.VictoryFlg)
+			require.True(t, match.QualifyingRoundFlg)
+			require.Empty(t, match.Games)
+			require.Equal(t, "スイスドロー 1回戦 バイ", match.Memo)
+
+			// バッジ・称号の判定は組み合わせ・記録・対戦結果をコミットした後に行う。
+			require.Equal(t, []string{"commit", "badge", "designation"}, *mocks.calls)
+		})
+
+		t.Run("異常系_今の回戦に未入力の結果があれば組めない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{
+				newPairing(1, 1, "a", "b"),
+			})
+
+			_, err := usecase.CreateNextRound(context.Background(), eventId)
+
+			require.ErrorIs(t, err, apperror.ErrTournamentRoundInProgress)
+		})
+
+		t.Run("異常系_参加者が1人なら組めない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+			}, nil)
+
+			_, err := usecase.CreateNextRound(context.Background(), eventId)
+
+			require.ErrorIs(t, err, apperror.ErrNotEnoughParticipants)
+		})
+
+		t.Run("異常系_決勝が終わっていれば組めない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			tournament := entity.NewUnofficialEventTournament(eventId, now, false)
+			tournament.TopCutSize = 2
+			final := entity.NewUnofficialEventPairing("final", now, eventId, entity.TournamentStageTopCut, 2, 1, "a", "b")
+			final.Report(1, 0, now)
+			expectLockedLoad(mocks, tournament, []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{final})
+
+			_, err := usecase.CreateNextRound(context.Background(), eventId)
+
+			require.ErrorIs(t, err, apperror.ErrTournamentClosed)
+		})
+	})
+
+	t.Run("ReportResult", func(t *testing.T) {
+		t.Run("正常系_結果を入力し、登録ユーザの記録に対戦結果を作る", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			player1 := newParticipant("a", userId, "")
+			player1.RecordId = "01JTESTRECORD000000000000A"
+			pairing := newPairing(1, 1, "a", "b")
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, true), []*entity.UnofficialEventParticipant{
+				player1,
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{pairing})
+			mocks.record.EXPECT().FindById(gomock.Any(), player1.RecordId).Return(&entity.Record{ID: player1.RecordId, DeckId: "01JTESTDECK00000000000000A"}, nil)
+			var match *entity.Match
+			mocks.matchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, m *entity.Match) error {
+					match = m
+					return nil
+				},
+			)
+			mocks.tournament.EXPECT().SavePairing(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.ReportResult(context.Background(), eventId, pairing.ID, 2, 1)
+
+			require.NoError(t, err)
+			require.True(t, ret.IsReported())
+			require.Equal(t, match.ID, ret.Player1MatchId)
+			require.Empty(t, ret.Player2MatchId)
+
+			require.Equal(t, player1.RecordId, match.RecordId)
+			require.Equal(t, userId, match.UserId)
+			require.Equal(t, "01JTESTDECK00000000000000A", match.DeckId)
+			require.True(t, match.BO3Flg)
+			require.True(t, match.VictoryFlg)
+			require.Empty(t, match.OpponentsUserId)
+			require.Equal(t, "スイスドロー 1回戦 vs ゲストB", match.Memo)
+			require.Len(t, match.Games, 3)
+			require.Equal(t, []bool{true, false, true}, []bool{match.Games[0].WinningFlg, match.Games[1].WinningFlg, match.Games[2].WinningFlg})
+
+			require.Equal(t, []string{"commit", "badge", "designation"}, *mocks.calls)
+		})
+
+		t.Run("正常系_入力し直すと作った対戦結果を直し、消されていれば作り直す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+			mocks.match.updateErr = apperror.ErrRecordNotFound
+
+			player2 := newParticipant("b", userId, "")
+			player2.RecordId = "01JTESTRECORD000000000000B"
+			pairing := newPairing(1, 1, "a", "b")
+			pairing.Report(2, 0, now)
+			pairing.Player2MatchId = "01JTESTDELETEDMATCH0000000"
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, true), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				player2,
+			}, []*entity.UnofficialEventPairing{pairing})
+			mocks.record.EXPECT().FindById(gomock.Any(), player2.RecordId).Return(&entity.Record{ID: player2.RecordId}, nil)
+			var match *entity.Match
+			mocks.matchRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, m *entity.Match) error {
+					match = m
+					return nil
+				},
+			)
+			mocks.tournament.EXPECT().SavePairing(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.ReportResult(context.Background(), eventId, pairing.ID, 1, 2)
+
+			require.NoError(t, err)
+			require.Equal(t, match.ID, ret.Player2MatchId)
+
+			require.True(t, match.VictoryFlg)
+			// player1 から見た 勝・負・負 を裏返す
+			require.Equal(t, []bool{false, true, true}, []bool{match.Games[0].WinningFlg, match.Games[1].WinningFlg, match.Games[2].WinningFlg})
+		})
+
+		t.Run("正常系_入力し直して作った対戦結果を直したときは判定しない", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			player1 := newParticipant("a", userId, "")
+			player1.RecordId = "01JTESTRECORD000000000000A"
+			pairing := newPairing(1, 1, "a", "b")
+			pairing.Report(2, 0, now)
+			pairing.Player1MatchId = "01JTESTMATCH0000000000000A"
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, true), []*entity.UnofficialEventParticipant{
+				player1,
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{pairing})
+			mocks.record.EXPECT().FindById(gomock.Any(), player1.RecordId).Return(&entity.Record{ID: player1.RecordId}, nil)
+			mocks.tournament.EXPECT().SavePairing(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.ReportResult(context.Background(), eventId, pairing.ID, 1, 2)
+
+			require.NoError(t, err)
+			require.Equal(t, "01JTESTMATCH0000000000000A", ret.Player1MatchId)
+			require.Len(t, mocks.match.updated, 1)
+			require.False(t, mocks.match.updated[0].VictoryFlg)
+			require.Equal(t, []string{"commit"}, *mocks.calls)
+		})
+
+		// 前の回戦の結果は次の回戦を組むのに使ったため、直せるのは最新の回戦だけ。
+		t.Run("異常系_前の回戦の結果は直せない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			old := newPairing(1, 1, "a", "b")
+			old.Report(1, 0, now)
+			latest := newPairing(2, 1, "b", "a")
+			latest.ID = "latest"
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{old, latest})
+
+			_, err := usecase.ReportResult(context.Background(), eventId, old.ID, 0, 1)
+
+			require.ErrorIs(t, err, apperror.ErrTournamentClosed)
+		})
+
+		t.Run("異常系_形式に合わないゲーム数はErrInvalidMatch", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			pairing := newPairing(1, 1, "a", "b")
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, []*entity.UnofficialEventPairing{pairing})
+
+			_, err := usecase.ReportResult(context.Background(), eventId, pairing.ID, 2, 1)
+
+			require.ErrorIs(t, err, apperror.ErrInvalidMatch)
+		})
+	})
+
+	t.Run("StartTopCut", func(t *testing.T) {
+		t.Run("正常系_順位表の上位をシード順に組む", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			r1 := newPairing(1, 1, "a", "b")
+			r1.Report(0, 1, now)
+			r2 := newPairing(1, 2, "c", "d")
+			r2.Report(1, 0, now)
+			r2.ID = "r2"
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+				newParticipant("c", "", "ゲストC"),
+				newParticipant("d", "", "ゲストD"),
+			}, []*entity.UnofficialEventPairing{r1, r2})
+			mocks.tournament.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, tournament *entity.UnofficialEventTournament) error {
+					require.Equal(t, 2, tournament.TopCutSize)
+					return nil
+				},
+			)
+			mocks.tournament.EXPECT().CreatePairings(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.StartTopCut(context.Background(), eventId, 2)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, entity.TournamentStageTopCut, ret[0].Stage)
+			require.Equal(t, 2, ret[0].Round)
+			// b と c が1勝で並び、登録の早い b が1位
+			require.Equal(t, "b", ret[0].Player1Id)
+			require.Equal(t, "c", ret[0].Player2Id)
+		})
+
+		t.Run("異常系_参加者が足りなければ始められない", func(t *testing.T) {
+			mocks, usecase := setup4UnofficialEventTournamentUsecase(t)
+
+			expectLockedLoad(mocks, entity.NewUnofficialEventTournament(eventId, now, false), []*entity.UnofficialEventParticipant{
+				newParticipant("a", "", "ゲストA"),
+				newParticipant("b", "", "ゲストB"),
+			}, nil)
+
+			_, err := usecase.StartTopCut(context.Background(), eventId, 4)
+
+			require.ErrorIs(t, err, apperror.ErrNotEnoughParticipants)
+		})
+	})
+}
+
+// heldRowLocksKey は rowLockTransactionManager が、トランザクションの中で取った行ロックの
+// 解放を ctx に溜めておくためのキー。
+type heldRowLocksKey struct{}
+
+// rowLockTransactionManager は SELECT ... FOR UPDATE のロックをトランザクションが終わるまで
+// 持ち続けるふりをする TransactionManager。ロックは heldRowLocksKey で溜めた解放を、
+// fn を抜けたときに呼んで手放す。
+type rowLockTransactionManager struct{}
+
+func (rowLockTransactionManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	held := &[]func(){}
+	defer func() {
+		for _, release := range *held {
+			release()
+		}
+	}()
+
+	return fn(context.WithValue(ctx, heldRowLocksKey{}, held))
+}
+
+// 二重クリックや別のタブから同時に「次の回戦を組む」が届いても、大会の行のロックで
+// 1つずつ処理され、後の方は先に組まれた回戦を読み直して組まない。
+func TestUnofficialEventTournamentUsecase_CreateNextRoundConcurrently(t *testing.T) {
+	overrideShuffleParticipantIds(t)
+
+	eventId := "01JTESTUNOFFICIALEVENT0000"
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+
+	mockCtrl := gomock.NewController(t)
+	mockRepository := mock_repository.NewMockUnofficialEventTournamentInterface(mockCtrl)
+	mockUnofficialEventRepository := mock_repository.NewMockUnofficialEventInterface(mockCtrl)
+
+	var (
+		rowLock  sync.Mutex
+		mu       sync.Mutex
+		pairings []*entity.UnofficialEventPairing
+	)
+
+	participants := []*entity.UnofficialEventParticipant{}
+	for _, id := range []string{"p1", "p2", "p3", "p4"} {
+		participants = append(participants, entity.NewUnofficialEventParticipant(id, now, eventId, "", "ゲスト"+id))
+	}
+
+	mockRepository.EXPECT().LockByUnofficialEventId(gomock.Any(), eventId).DoAndReturn(
+		func(ctx context.Context, unofficialEventId string) error {
+			rowLock.Lock()
+			held := ctx.Value(heldRowLocksKey{}).(*[]func())
+			*held = append(*held, rowLock.Unlock)
+			return nil
+		},
+	).Times(2)
+	mockUnofficialEventRepository.EXPECT().FindById(gomock.Any(), eventId).Return(&entity.UnofficialEvent{ID: eventId}, nil).Times(2)
+	mockRepository.EXPECT().FindByUnofficialEventId(gomock.Any(), eventId).Return(entity.NewUnofficialEventTournament(eventId, now, false), nil).Times(2)
+	mockRepository.EXPECT().FindParticipants(gomock.Any(), eventId).Return(participants, nil).Times(2)
+	mockRepository.EXPECT().FindPairings(gomock.Any(), eventId).DoAndReturn(
+		func(ctx context.Context, unofficialEventId string) ([]*entity.UnofficialEventPairing, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]*entity.UnofficialEventPairing{}, pairings...), nil
+		},
+	).Times(2)
+	mockRepository.EXPECT().CreatePairings(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, created []*entity.UnofficialEventPairing) error {
+			mu.Lock()
+			defer mu.Unlock()
+			pairings = append(pairings, created...)
+			return nil
+		},
+	).Times(1)
+
+	usecase := NewUnofficialEventTournament(
+		mockRepository,
+		mockUnofficialEventRepository,
+		mock_repository.NewMockUserInterface(mockCtrl),
+		mock_repository.NewMockRecordInterface(mockCtrl),
+		mock_repository.NewMockMatchInterface(mockCtrl),
+		&stubTournamentMatch{},
+		stubBadgeEvaluation{},
+		stubDesignationEvaluation{},
+		stubEnvironmentBadgeEvaluation{},
+		rowLockTransactionManager{},
+	)
+
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = usecase.CreateNextRound(context.Background(), eventId)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, apperror.ErrTournamentRoundInProgress)
+	}
+	require.Equal(t, 1, succeeded)
+	require.Len(t, pairings, 2)
+}
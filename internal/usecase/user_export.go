@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+// userExportStaleTimeout を過ぎても実行中のままのエクスポートは、処理中にプロセスが
+// 落ちたものとみなして取り出し直す。記録の多いユーザでもアーカイブの作成は1分程度で
+// 終わるため、十分に長くとっている。
+const userExportStaleTimeout = 30 * time.Minute
+
+type UserExportInterface interface {
+	// Request はユーザの全データのエクスポートを受け付ける。未完了のエクスポートがあれば
+	// 新しく作らずにそれを返す(連打で同じアーカイブを何度も作らないため)。
+	Request(
+		ctx context.Context,
+		userId string,
+	) (*entity.UserExport, error)
+
+	// FindById は userId のエクスポートを1件返す。他人のエクスポートは存在しないものとして
+	// apperror.ErrRecordNotFound を返す。
+	FindById(
+		ctx context.Context,
+		userId string,
+		id string,
+	) (*entity.UserExport, error)
+
+	// Download は完了したエクスポートのアーカイブ(zip)を返す。
+	// 完了していなければ apperror.ErrNotReady を返す。
+	Download(
+		ctx context.Context,
+		userId string,
+		id string,
+	) ([]byte, error)
+
+	// Process は実行できるエクスポートを最大 limit 件取り出して処理し、取り出した件数を返す。
+	// 個々のエクスポートの失敗はエクスポートに記録して再実行を予約し、エラーとしては返さない。
+	Process(
+		ctx context.Context,
+		limit int,
+	) (int, error)
+}
+
+type UserExport struct {
+	repository               repository.UserExportInterface
+	archive                  repository.UserExportArchiveInterface
+	calendarRepo             repository.CalendarInterface
+	tagRepo                  repository.TagInterface
+	unofficialEventRepo      repository.UnofficialEventInterface
+	userBadgeRepo            repository.UserBadgeInterface
+	userEnvironmentBadgeRepo repository.UserEnvironmentBadgeInterface
+	notificationRepo         repository.NotificationInterface
+}
+
+func NewUserExport(
+	repository repository.UserExportInterface,
+	archive repository.UserExportArchiveInterface,
+	calendarRepo repository.CalendarInterface,
+	tagRepo repository.TagInterface,
+	unofficialEventRepo repository.UnofficialEventInterface,
+	userBadgeRepo repository.UserBadgeInterface,
+	userEnvironmentBadgeRepo repository.UserEnvironmentBadgeInterface,
+	notificationRepo repository.NotificationInterface,
+) UserExportInterface {
+	return &UserExport{
+		repository:               repository,
+		archive:                  archive,
+		calendarRepo:             calendarRepo,
+		tagRepo:                  tagRepo,
+		unofficialEventRepo:      unofficialEventRepo,
+		userBadgeRepo:            userBadgeRepo,
+		userEnvironmentBadgeRepo: userEnvironmentBadgeRepo,
+		notificationRepo:         notificationRepo,
+	}
+}
+
+func (u *UserExport) Request(
+	ctx context.Context,
+	userId string,
+) (*entity.UserExport, error) {
+	unfinished, err := u.repository.FindUnfinishedByUserId(ctx, userId)
+	if err == nil {
+		return unfinished, nil
+	}
+	if !errors.Is(err, apperror.ErrRecordNotFound) {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	id, err := generateId()
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	export := entity.NewUserExport(id, timeNow().Local(), userId)
+
+	if err := u.repository.Create(ctx, export); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (u *UserExport) FindById(
+	ctx context.Context,
+	userId string,
+	id string,
+) (*entity.UserExport, error) {
+	export, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	if export.UserId != userId {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	return export, nil
+}
+
+func (u *UserExport) Download(
+	ctx context.Context,
+	userId string,
+	id string,
+) ([]byte, error) {
+	export, err := u.FindById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Status != entity.UserExportStatusSucceeded {
+		return nil, apperror.ErrNotReady
+	}
+
+	body, err := u.archive.Get(ctx, export.ObjectKey())
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (u *UserExport) Process(
+	ctx context.Context,
+	limit int,
+) (int, error) {
+	now := timeNow().Local()
+
+	exports, err := u.repository.Claim(ctx, now, now.Add(-userExportStaleTimeout), limit)
+	if err != nil {
+		logError(ctx, err)
+		return 0, err
+	}
+
+	for _, export := range exports {
+		if err := u.run(ctx, export); err != nil {
+			export.Fail(timeNow().Local(), err)
+			logWarn(ctx, err)
+		} else {
+			export.Succeed(timeNow().Local())
+		}
+
+		if err := u.repository.Save(ctx, export); err != nil {
+			logError(ctx, err)
+			return 0, err
+		}
+	}
+
+	return len(exports), nil
+}
+
+// run はユーザの全データを集めてアーカイブを置く。
+func (u *UserExport) run(
+	ctx context.Context,
+	export *entity.UserExport,
+) error {
+	data, err := u.collect(ctx, export.UserId)
+	if err != nil {
+		return err
+	}
+
+	return u.archive.Put(ctx, export.ObjectKey(), data)
+}
+
+// collect はエクスポートに含めるユーザの全データを集める。記録(対戦結果・対局を含む)と
+// デッキ(デッキコードを含む)はカレンダーと同じくクエリ本数が件数によらない形でまとめて引く。
+func (u *UserExport) collect(
+	ctx context.Context,
+	userId string,
+) (*entity.UserExportData, error) {
+	calendar, err := u.calendarRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := u.tagRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	unofficialEvents, err := u.unofficialEventRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	userBadges, err := u.userBadgeRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	userEnvironmentBadges, err := u.userEnvironmentBadgeRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := u.notificationRepo.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 称号・ランクの変化は通知としてしか残っていないため、その通知を履歴として取り出す。
+	var designationHistory []*entity.Notification
+	for _, n := range notifications {
+		if n.Category == NotificationCategoryDesignation || n.Category == NotificationCategoryRank {
+			designationHistory = append(designationHistory, n)
+		}
+	}
+
+	return &entity.UserExportData{
+		UserId:                userId,
+		ExportedAt:            timeNow().Local(),
+		Records:               calendar.Records,
+		Decks:                 calendar.Decks,
+		Tags:                  tags,
+		UnofficialEvents:      unofficialEvents,
+		UserBadges:            userBadges,
+		UserEnvironmentBadges: userEnvironmentBadges,
+		DesignationHistory:    designationHistory,
+		Notifications:         notifications,
+	}, nil
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+type userExportMocks struct {
+	repository               *mock_repository.MockUserExportInterface
+	archive                  *mock_repository.MockUserExportArchiveInterface
+	calendarRepo             *mock_repository.MockCalendarInterface
+	tagRepo                  *mock_repository.MockTagInterface
+	unofficialEventRepo      *mock_repository.MockUnofficialEventInterface
+	userBadgeRepo            *mock_repository.MockUserBadgeInterface
+	userEnvironmentBadgeRepo *mock_repository.MockUserEnvironmentBadgeInterface
+	notificationRepo         *mock_repository.MockNotificationInterface
+}
+
+func setup4UserExportUsecase(t *testing.T) (*userExportMocks, UserExportInterface) {
+	mockCtrl := gomock.NewController(t)
+	m := &userExportMocks{
+		repository:               mock_repository.NewMockUserExportInterface(mockCtrl),
+		archive:                  mock_repository.NewMockUserExportArchiveInterface(mockCtrl),
+		calendarRepo:             mock_repository.NewMockCalendarInterface(mockCtrl),
+		tagRepo:                  mock_repository.NewMockTagInterface(mockCtrl),
+		unofficialEventRepo:      mock_repository.NewMockUnofficialEventInterface(mockCtrl),
+		userBadgeRepo:            mock_repository.NewMockUserBadgeInterface(mockCtrl),
+		userEnvironmentBadgeRepo: mock_repository.NewMockUserEnvironmentBadgeInterface(mockCtrl),
+		notificationRepo:         mock_repository.NewMockNotificationInterface(mockCtrl),
+	}
+
+	usecase := NewUserExport(
+		m.repository,
+		m.archive,
+		m.calendarRepo,
+		m.tagRepo,
+		m.unofficialEventRepo,
+		m.userBadgeRepo,
+		m.userEnvironmentBadgeRepo,
+		m.notificationRepo,
+	)
+
+	return m, usecase
+}
+
+func TestUserExportUsecase(t *testing.T) {
+	uid := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	id := "01HD7Y3K8D6FDHMHTZ2GT41TR1"
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	t.Run("Request", func(t *testing.T) {
+		t.Run("正常系_未完了のエクスポートが無ければ実行待ちで作る", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			m, usecase := setup4UserExportUsecase(t)
+
+			m.repository.EXPECT().FindUnfinishedByUserId(context.Background(), uid).Return(nil, apperror.ErrRecordNotFound)
+			m.repository.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
+			export, err := usecase.Request(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Equal(t, uid, export.UserId)
+			require.Equal(t, entity.UserExportStatusPending, export.Status)
+			require.Equal(t, now, export.NextRunAt)
+		})
+
+		t.Run("正常系_未完了のエクスポートがあれば新しく作らずに返す", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			unfinished := entity.NewUserExport(id, now, uid)
+			m.repository.EXPECT().FindUnfinishedByUserId(context.Background(), uid).Return(unfinished, nil)
+
+			export, err := usecase.Request(context.Background(), uid)
+
+			require.NoError(t, err)
+			require.Equal(t, unfinished, export)
+		})
+
+		t.Run("異常系_未完了のエクスポートの確認に失敗したらエラーを返す", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			m.repository.EXPECT().FindUnfinishedByUserId(context.Background(), uid).Return(nil, errors.New(""))
+
+			_, err := usecase.Request(context.Background(), uid)
+
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		t.Run("異常系_他人のエクスポートは存在しないものとして扱う", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			m.repository.EXPECT().FindById(context.Background(), id).Return(entity.NewUserExport(id, now, "KBp7roRDZobZg1t0OPzFR1kvLeO2"), nil)
+
+			_, err := usecase.FindById(context.Background(), uid, id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		t.Run("正常系_完了したエクスポートのアーカイブを返す", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			export := entity.NewUserExport(id, now, uid)
+			export.Succeed(now)
+
+			m.repository.EXPECT().FindById(context.Background(), id).Return(export, nil)
+			m.archive.EXPECT().Get(context.Background(), export.ObjectKey()).Return([]byte("zip"), nil)
+
+			body, err := usecase.Download(context.Background(), uid, id)
+
+			require.NoError(t, err)
+			require.Equal(t, []byte("zip"), body)
+		})
+
+		t.Run("異常系_完了していなければErrNotReadyを返す", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			m.repository.EXPECT().FindById(context.Background(), id).Return(entity.NewUserExport(id, now, uid), nil)
+
+			_, err := usecase.Download(context.Background(), uid, id)
+
+			require.ErrorIs(t, err, apperror.ErrNotReady)
+		})
+	})
+
+	t.Run("Process", func(t *testing.T) {
+		// Claim で取り出された直後の(実行中・試行回数を数えた)エクスポートを作る。
+		newClaimedExport := func(attempts uint) *entity.UserExport {
+			export := entity.NewUserExport(id, now, uid)
+			export.Status = entity.UserExportStatusRunning
+			export.Attempts = attempts
+			return export
+		}
+
+		t.Run("正常系_全データを集めてアーカイブを置き完了にする", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			m, usecase := setup4UserExportUsecase(t)
+
+			export := newClaimedExport(1)
+			calendar := entity.NewCalendar(
+				[]*entity.CalendarRecord{{Record: &entity.Record{ID: "01HD7Y3K8D6FDHMHTZ2GT41TN1"}}},
+				[]*entity.CalendarDeck{{Deck: &entity.Deck{ID: "01HD7Y3K8D6FDHMHTZ2GT41TD1"}}},
+				nil, nil, nil,
+			)
+			notifications := []*entity.Notification{
+				{ID: "01HD7Y3K8D6FDHMHTZ2GT41TX1", Category: NotificationCategoryBadge},
+				{ID: "01HD7Y3K8D6FDHMHTZ2GT41TX2", Category: NotificationCategoryDesignation},
+				{ID: "01HD7Y3K8D6FDHMHTZ2GT41TX3", Category: NotificationCategoryRank},
+			}
+
+			gomock.InOrder(
+				m.repository.EXPECT().Claim(context.Background(), now, now.Add(-userExportStaleTimeout), 1).Return([]*entity.UserExport{export}, nil),
+				m.calendarRepo.EXPECT().FindByUserId(context.Background(), uid).Return(calendar, nil),
+				m.tagRepo.EXPECT().FindByUserId(context.Background(), uid).Return(nil, nil),
+				m.unofficialEventRepo.EXPECT().FindByUserId(context.Background(), uid).Return(nil, nil),
+				m.userBadgeRepo.EXPECT().FindByUserId(context.Background(), uid).Return(nil, nil),
+				m.userEnvironmentBadgeRepo.EXPECT().FindByUserId(context.Background(), uid).Return(nil, nil),
+				m.notificationRepo.EXPECT().FindAllByUserId(context.Background(), uid).Return(notifications, nil),
+				m.archive.EXPECT().Put(context.Background(), export.ObjectKey(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, key string, data *entity.UserExportData) error {
+						require.Equal(t, uid, data.UserId)
+						require.Len(t, data.Records, 1)
+						require.Len(t, data.Decks, 1)
+						require.Len(t, data.Notifications, 3)
+						// 称号・ランクの通知だけを称号の履歴にする
+						require.Len(t, data.DesignationHistory, 2)
+						return nil
+					},
+				),
+				m.repository.EXPECT().Save(context.Background(), export).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 1)
+
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			require.Equal(t, entity.UserExportStatusSucceeded, export.Status)
+			require.Equal(t, now, export.CompletedAt)
+		})
+
+		t.Run("正常系_失敗したら記録して再実行を予約する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			m, usecase := setup4UserExportUsecase(t)
+
+			export := newClaimedExport(1)
+
+			gomock.InOrder(
+				m.repository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 1).Return([]*entity.UserExport{export}, nil),
+				m.calendarRepo.EXPECT().FindByUserId(context.Background(), uid).Return(nil, errors.New("connection refused")),
+				m.repository.EXPECT().Save(context.Background(), export).Return(nil),
+			)
+
+			n, err := usecase.Process(context.Background(), 1)
+
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			require.Equal(t, entity.UserExportStatusPending, export.Status)
+			require.Equal(t, "connection refused", export.LastError)
+			require.True(t, export.NextRunAt.After(now))
+		})
+
+		t.Run("異常系_取り出しに失敗したらエラーを返す", func(t *testing.T) {
+			m, usecase := setup4UserExportUsecase(t)
+
+			m.repository.EXPECT().Claim(context.Background(), gomock.Any(), gomock.Any(), 1).Return(nil, errors.New(""))
+
+			n, err := usecase.Process(context.Background(), 1)
+
+			require.Error(t, err)
+			require.Equal(t, 0, n)
+		})
+	})
+}
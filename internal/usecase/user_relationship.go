@@ -0,0 +1,449 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/domain/repository"
+)
+
+const (
+	NotificationCategoryFriend = "friend"
+
+	// friendLinkUrl はフレンド関係の通知のリンク先(フレンドの画面)。
+	friendLinkUrl = "/friends"
+)
+
+type UserRelationshipInterface interface {
+	// Request は userId から targetUserId へフレンドを申請して通知する。相手から届いている
+	// 申請があれば、それを承認してフレンドになる。既にフレンドか申請中なら
+	// apperror.ErrUserRelationshipExists を、どちらかがブロックしていれば apperror.ErrUserBlocked を返す。
+	Request(
+		ctx context.Context,
+		userId string,
+		targetUserId string,
+	) (*entity.UserRelationship, error)
+
+	// FindRequests は userId に届いているフレンド申請を、申請した人とともに新しい順に返す。
+	FindRequests(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.UserRelationship, error)
+
+	// Accept は userId に届いたフレンド申請 id を承認して、申請した人に通知する。
+	// userId 宛ての回答待ちの申請でなければ apperror.ErrRecordNotFound を返す。
+	Accept(
+		ctx context.Context,
+		userId string,
+		id string,
+	) (*entity.UserRelationship, error)
+
+	// Decline は userId に届いたフレンド申請 id を断る。申請した人には通知しない。
+	Decline(
+		ctx context.Context,
+		userId string,
+		id string,
+	) error
+
+	// FindFriends は userId のフレンドを、フレンドになった新しい順に返す。退会したユーザは含めない。
+	FindFriends(
+		ctx context.Context,
+		userId string,
+		limit int,
+		offset int,
+	) ([]*entity.UserRelationship, error)
+
+	// Unfriend は userId と friendUserId のフレンド関係をやめる。フレンドでなければ
+	// apperror.ErrRecordNotFound を返す。
+	Unfriend(
+		ctx context.Context,
+		userId string,
+		friendUserId string,
+	) error
+
+	// Block は userId が targetUserId をブロックする。2人の間のフレンド関係・申請は消える。
+	Block(
+		ctx context.Context,
+		userId string,
+		targetUserId string,
+	) (*entity.UserRelationship, error)
+
+	// Unblock は userId による targetUserId のブロックを解く。ブロックしていなければ
+	// apperror.ErrRecordNotFound を返す。
+	Unblock(
+		ctx context.Context,
+		userId string,
+		targetUserId string,
+	) error
+}
+
+type UserRelationship struct {
+	repository         repository.UserRelationshipInterface
+	userRepository     repository.UserInterface
+	notificationRepo   repository.NotificationInterface
+	transactionManager repository.TransactionManager
+}
+
+func NewUserRelationship(
+	repository repository.UserRelationshipInterface,
+	userRepository repository.UserInterface,
+	notificationRepo repository.NotificationInterface,
+	transactionManager repository.TransactionManager,
+) UserRelationshipInterface {
+	return &UserRelationship{
+		repository:         repository,
+		userRepository:     userRepository,
+		notificationRepo:   notificationRepo,
+		transactionManager: transactionManager,
+	}
+}
+
+func (u *UserRelationship) notify(
+	ctx context.Context,
+	userId string,
+	title string,
+	body string,
+) error {
+	id, err := generateId()
+	if err != nil {
+		return err
+	}
+
+	notification := entity.NewNotification(
+		id,
+		timeNow(),
+		userId,
+		NotificationCategoryFriend,
+		title,
+		body,
+		friendLinkUrl,
+	)
+
+	return u.notificationRepo.Save(ctx, notification)
+}
+
+// userName は通知の本文に使うユーザ名を返す。取得できなければ空文字を返す。
+func (u *UserRelationship) userName(
+	ctx context.Context,
+	userId string,
+) string {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		logWarn(ctx, err)
+		return ""
+	}
+
+	return user.Name
+}
+
+// findPending は userId 宛ての回答待ちのフレンド申請を返す。
+func (u *UserRelationship) findPending(
+	ctx context.Context,
+	userId string,
+	id string,
+) (*entity.UserRelationship, error) {
+	relationship, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 他人宛ての申請やブロックの行は、存在しないものとして扱う。
+	if relationship.TargetUserId != userId || relationship.Status != entity.UserRelationshipStatusPending {
+		return nil, apperror.ErrRecordNotFound
+	}
+
+	return relationship, nil
+}
+
+// withUsers は relationships に userId から見た相手のユーザを詰めて返す。
+// 退会したユーザとの関係は除く。
+func (u *UserRelationship) withUsers(
+	ctx context.Context,
+	userId string,
+	relationships []*entity.UserRelationship,
+) ([]*entity.UserRelationship, error) {
+	ret := make([]*entity.UserRelationship, 0, len(relationships))
+	for _, relationship := range relationships {
+		user, err := u.userRepository.FindById(ctx, relationship.OtherUserId(userId))
+		if errors.Is(err, apperror.ErrRecordNotFound) {
+			continue
+		} else if err != nil {
+			logError(ctx, err)
+			return nil, err
+		}
+
+		relationship.User = user
+		ret = append(ret, relationship)
+	}
+
+	return ret, nil
+}
+
+func (u *UserRelationship) Request(
+	ctx context.Context,
+	userId string,
+	targetUserId string,
+) (*entity.UserRelationship, error) {
+	target, err := u.userRepository.FindById(ctx, targetUserId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	relationships, err := u.repository.FindBetween(ctx, userId, targetUserId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	// 相手からの申請が届いていれば、申請し合ったものとしてフレンドにする。
+	var incoming *entity.UserRelationship
+	for _, relationship := range relationships {
+		switch {
+		case relationship.Status == entity.UserRelationshipStatusBlocked:
+			return nil, apperror.ErrUserBlocked
+		case relationship.Status == entity.UserRelationshipStatusAccepted:
+			return nil, apperror.ErrUserRelationshipExists
+		case relationship.UserId == userId:
+			return nil, apperror.ErrUserRelationshipExists
+		default:
+			incoming = relationship
+		}
+	}
+
+	if incoming != nil {
+		return u.accept(ctx, incoming, target)
+	}
+
+	id, err := generateId()
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	relationship := entity.NewUserRelationship(id, timeNow(), userId, targetUserId, entity.UserRelationshipStatusPending)
+
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.repository.Create(ctx, relationship); err != nil {
+			return err
+		}
+
+		return u.notify(
+			ctx,
+			targetUserId,
+			"フレンド申請が届きました",
+			u.userName(ctx, userId)+"さんからフレンド申請が届きました",
+		)
+	}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	relationship.User = target
+
+	return relationship, nil
+}
+
+// accept は申請 relationship を承認して、申請した人に通知する。user は承認した側から見た相手。
+func (u *UserRelationship) accept(
+	ctx context.Context,
+	relationship *entity.UserRelationship,
+	user *entity.User,
+) (*entity.UserRelationship, error) {
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		relationship.Accept(timeNow())
+		if err := u.repository.Save(ctx, relationship); err != nil {
+			return err
+		}
+
+		return u.notify(
+			ctx,
+			relationship.UserId,
+			"フレンド申請が承認されました",
+			u.userName(ctx, relationship.TargetUserId)+"さんとフレンドになりました",
+		)
+	}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	relationship.User = user
+
+	return relationship, nil
+}
+
+func (u *UserRelationship) FindRequests(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.UserRelationship, error) {
+	relationships, err := u.repository.FindPendingByTargetUserId(ctx, userId, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return u.withUsers(ctx, userId, relationships)
+}
+
+func (u *UserRelationship) Accept(
+	ctx context.Context,
+	userId string,
+	id string,
+) (*entity.UserRelationship, error) {
+	relationship, err := u.findPending(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	requester, err := u.userRepository.FindById(ctx, relationship.UserId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return u.accept(ctx, relationship, requester)
+}
+
+func (u *UserRelationship) Decline(
+	ctx context.Context,
+	userId string,
+	id string,
+) error {
+	relationship, err := u.findPending(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repository.Delete(ctx, relationship.ID); err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *UserRelationship) FindFriends(
+	ctx context.Context,
+	userId string,
+	limit int,
+	offset int,
+) ([]*entity.UserRelationship, error) {
+	relationships, err := u.repository.FindAcceptedByUserId(ctx, userId, limit, offset)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	return u.withUsers(ctx, userId, relationships)
+}
+
+func (u *UserRelationship) Unfriend(
+	ctx context.Context,
+	userId string,
+	friendUserId string,
+) error {
+	relationships, err := u.repository.FindBetween(ctx, userId, friendUserId)
+	if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	for _, relationship := range relationships {
+		if relationship.Status == entity.UserRelationshipStatusAccepted {
+			if err := u.repository.Delete(ctx, relationship.ID); err != nil {
+				logError(ctx, err)
+				return err
+			}
+
+			return nil
+		}
+	}
+
+	return apperror.ErrRecordNotFound
+}
+
+func (u *UserRelationship) Block(
+	ctx context.Context,
+	userId string,
+	targetUserId string,
+) (*entity.UserRelationship, error) {
+	target, err := u.userRepository.FindById(ctx, targetUserId)
+	if err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	var blocked *entity.UserRelationship
+	if err := u.transactionManager.Do(ctx, func(ctx context.Context) error {
+		relationships, err := u.repository.FindBetween(ctx, userId, targetUserId)
+		if err != nil {
+			return err
+		}
+
+		// 相手からのブロックは相手のものなので残し、フレンド関係・申請だけを消す。
+		for _, relationship := range relationships {
+			if relationship.Status == entity.UserRelationshipStatusBlocked {
+				if relationship.UserId == userId {
+					blocked = relationship
+				}
+				continue
+			}
+
+			if err := u.repository.Delete(ctx, relationship.ID); err != nil {
+				return err
+			}
+		}
+
+		if blocked != nil {
+			return nil
+		}
+
+		id, err := generateId()
+		if err != nil {
+			return err
+		}
+
+		blocked = entity.NewUserRelationship(id, timeNow(), userId, targetUserId, entity.UserRelationshipStatusBlocked)
+
+		return u.repository.Create(ctx, blocked)
+	}); err != nil {
+		logError(ctx, err)
+		return nil, err
+	}
+
+	blocked.User = target
+
+	return blocked, nil
+}
+
+func (u *UserRelationship) Unblock(
+	ctx context.Context,
+	userId string,
+	targetUserId string,
+) error {
+	relationships, err := u.repository.FindBetween(ctx, userId, targetUserId)
+	if err != nil {
+		logError(ctx, err)
+		return err
+	}
+
+	for _, relationship := range relationships {
+		if relationship.UserId == userId && relationship.Status == entity.UserRelationshipStatusBlocked {
+			if err := u.repository.Delete(ctx, relationship.ID); err != nil {
+				logError(ctx, err)
+				return err
+			}
+
+			return nil
+		}
+	}
+
+	return apperror.ErrRecordNotFound
+}
@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vsrecorder/core-apiserver/internal/domain/apperror"
+	"github.com/vsrecorder/core-apiserver/internal/domain/entity"
+	"github.com/vsrecorder/core-apiserver/internal/mock/mock_repository"
+)
+
+type userRelationshipUsecaseMocks struct {
+	relationship *mock_repository.MockUserRelationshipInterface
+	user         *mock_repository.MockUserInterface
+	notification *mock_repository.MockNotificationInterface
+}
+
+func setup4UserRelationshipUsecase(t *testing.T) (
+	userRelationshipUsecaseMocks,
+	UserRelationshipInterface,
+) {
+	mockCtrl := gomock.NewController(t)
+	mocks := userRelationshipUsecaseMocks{
+		relationship: mock_repository.NewMockUserRelationshipInterface(mockCtrl),
+		user:         mock_repository.NewMockUserInterface(mockCtrl),
+		notification: mock_repository.NewMockNotificationInterface(mockCtrl),
+	}
+	mockTransactionManager := mock_repository.NewMockTransactionManager(mockCtrl)
+	mockTransactionManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+
+	usecase := NewUserRelationship(
+		mocks.relationship,
+		mocks.user,
+		mocks.notification,
+		mockTransactionManager,
+	)
+
+	return mocks, usecase
+}
+
+func TestUserRelationshipUsecase(t *testing.T) {
+	userId := "zor5SLfEfwfZ90yRVXzlxBEFARy2"
+	targetUserId := "KBp7roRDZobZg1t0OPzFR1kvLeO2"
+	id := "01JTESTRELATIONSHIP0000000"
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+
+	newRelationship := func(userId string, targetUserId string, status entity.UserRelationshipStatus) *entity.UserRelationship {
+		return entity.NewUserRelationship(id, now.Add(-time.Hour), userId, targetUserId, status)
+	}
+
+	t.Run("Request", func(t *testing.T) {
+		t.Run("正常系_フレンドを申請して相手に通知する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return([]*entity.UserRelationship{}, nil)
+			mocks.relationship.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), userId).Return(&entity.User{ID: userId, Name: "テスト"}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, targetUserId, notification.UserId)
+					require.Equal(t, NotificationCategoryFriend, notification.Category)
+					return nil
+				},
+			)
+
+			ret, err := usecase.Request(context.Background(), userId, targetUserId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.UserRelationshipStatusPending, ret.Status)
+			require.Equal(t, userId, ret.UserId)
+			require.Equal(t, targetUserId, ret.TargetUserId)
+			require.Equal(t, now, ret.CreatedAt)
+			require.Equal(t, targetUserId, ret.User.ID)
+		})
+
+		t.Run("正常系_相手から申請が届いていれば承認してフレンドになる", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			incoming := newRelationship(targetUserId, userId, entity.UserRelationshipStatusPending)
+
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return([]*entity.UserRelationship{incoming}, nil)
+			mocks.relationship.EXPECT().Save(gomock.Any(), incoming).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), userId).Return(&entity.User{ID: userId}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, targetUserId, notification.UserId)
+					return nil
+				},
+			)
+
+			ret, err := usecase.Request(context.Background(), userId, targetUserId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.UserRelationshipStatusAccepted, ret.Status)
+			require.Equal(t, now, ret.UpdatedAt)
+		})
+
+		for name, tc := range map[string]struct {
+			relationship *entity.UserRelationship
+			expected     error
+		}{
+			"異常系_既にフレンドならErrUserRelationshipExistsを返す": {
+				newRelationship(targetUserId, userId, entity.UserRelationshipStatusAccepted),
+				apperror.ErrUserRelationshipExists,
+			},
+			"異常系_申請中ならErrUserRelationshipExistsを返す": {
+				newRelationship(userId, targetUserId, entity.UserRelationshipStatusPending),
+				apperror.ErrUserRelationshipExists,
+			},
+			"異常系_相手からブロックされていればErrUserBlockedを返す": {
+				newRelationship(targetUserId, userId, entity.UserRelationshipStatusBlocked),
+				apperror.ErrUserBlocked,
+			},
+			"異常系_相手をブロックしていればErrUserBlockedを返す": {
+				newRelationship(userId, targetUserId, entity.UserRelationshipStatusBlocked),
+				apperror.ErrUserBlocked,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				mocks, usecase := setup4UserRelationshipUsecase(t)
+
+				mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+				mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return([]*entity.UserRelationship{tc.relationship}, nil)
+
+				ret, err := usecase.Request(context.Background(), userId, targetUserId)
+
+				require.ErrorIs(t, err, tc.expected)
+				require.Nil(t, ret)
+			})
+		}
+
+		t.Run("異常系_相手がいなければErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.Request(context.Background(), userId, targetUserId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		t.Run("正常系_届いた申請を承認して申請した人に通知する", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			relationship := newRelationship(userId, targetUserId, entity.UserRelationshipStatusPending)
+
+			mocks.relationship.EXPECT().FindById(gomock.Any(), id).Return(relationship, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), userId).Return(&entity.User{ID: userId}, nil)
+			mocks.relationship.EXPECT().Save(gomock.Any(), relationship).Return(nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId, Name: "テスト"}, nil)
+			mocks.notification.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, notification *entity.Notification) error {
+					require.Equal(t, userId, notification.UserId)
+					return nil
+				},
+			)
+
+			ret, err := usecase.Accept(context.Background(), targetUserId, id)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.UserRelationshipStatusAccepted, ret.Status)
+			require.Equal(t, userId, ret.User.ID)
+		})
+
+		t.Run("異常系_自分が申請した側ならErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindById(gomock.Any(), id).Return(
+				newRelationship(userId, targetUserId, entity.UserRelationshipStatusPending), nil,
+			)
+
+			ret, err := usecase.Accept(context.Background(), userId, id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+
+		t.Run("異常系_ブロックの行ならErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindById(gomock.Any(), id).Return(
+				newRelationship(userId, targetUserId, entity.UserRelationshipStatusBlocked), nil,
+			)
+
+			ret, err := usecase.Accept(context.Background(), targetUserId, id)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+			require.Nil(t, ret)
+		})
+	})
+
+	t.Run("Decline", func(t *testing.T) {
+		t.Run("正常系_届いた申請を消す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindById(gomock.Any(), id).Return(
+				newRelationship(userId, targetUserId, entity.UserRelationshipStatusPending), nil,
+			)
+			mocks.relationship.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+			require.NoError(t, usecase.Decline(context.Background(), targetUserId, id))
+		})
+	})
+
+	t.Run("FindFriends", func(t *testing.T) {
+		t.Run("正常系_退会したユーザを除いて相手のユーザを詰める", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			withdrawnUserId := "Q8qU2m0aBcXyZ1234567890abcd"
+			friend := newRelationship(targetUserId, userId, entity.UserRelationshipStatusAccepted)
+			withdrawn := newRelationship(userId, withdrawnUserId, entity.UserRelationshipStatusAccepted)
+
+			mocks.relationship.EXPECT().FindAcceptedByUserId(gomock.Any(), userId, 10, 0).Return(
+				[]*entity.UserRelationship{friend, withdrawn}, nil,
+			)
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+			mocks.user.EXPECT().FindById(gomock.Any(), withdrawnUserId).Return(nil, apperror.ErrRecordNotFound)
+
+			ret, err := usecase.FindFriends(context.Background(), userId, 10, 0)
+
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, targetUserId, ret[0].User.ID)
+		})
+	})
+
+	t.Run("Unfriend", func(t *testing.T) {
+		t.Run("異常系_フレンドでなければErrRecordNotFoundを返す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return(
+				[]*entity.UserRelationship{newRelationship(userId, targetUserId, entity.UserRelationshipStatusPending)}, nil,
+			)
+
+			err := usecase.Unfriend(context.Background(), userId, targetUserId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		t.Run("正常系_フレンド関係を消して相手のブロックは残す", func(t *testing.T) {
+			overrideTimeNow(t, now)
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			friend := newRelationship(userId, targetUserId, entity.UserRelationshipStatusAccepted)
+			blockedByTarget := newRelationship(targetUserId, userId, entity.UserRelationshipStatusBlocked)
+			blockedByTarget.ID = "01JTESTRELATIONSHIP0000001"
+
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return(
+				[]*entity.UserRelationship{friend, blockedByTarget}, nil,
+			)
+			mocks.relationship.EXPECT().Delete(gomock.Any(), friend.ID).Return(nil)
+			mocks.relationship.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+			ret, err := usecase.Block(context.Background(), userId, targetUserId)
+
+			require.NoError(t, err)
+			require.Equal(t, entity.UserRelationshipStatusBlocked, ret.Status)
+			require.Equal(t, userId, ret.UserId)
+			require.Equal(t, targetUserId, ret.TargetUserId)
+			require.Equal(t, now, ret.CreatedAt)
+		})
+
+		t.Run("正常系_既にブロックしていればそのまま返す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			blocked := newRelationship(userId, targetUserId, entity.UserRelationshipStatusBlocked)
+
+			mocks.user.EXPECT().FindById(gomock.Any(), targetUserId).Return(&entity.User{ID: targetUserId}, nil)
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return(
+				[]*entity.UserRelationship{blocked}, nil,
+			)
+
+			ret, err := usecase.Block(context.Background(), userId, targetUserId)
+
+			require.NoError(t, err)
+			require.Equal(t, blocked, ret)
+		})
+	})
+
+	t.Run("Unblock", func(t *testing.T) {
+		t.Run("正常系_自分のブロックを消す", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return(
+				[]*entity.UserRelationship{newRelationship(userId, targetUserId, entity.UserRelationshipStatusBlocked)}, nil,
+			)
+			mocks.relationship.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+			require.NoError(t, usecase.Unblock(context.Background(), userId, targetUserId))
+		})
+
+		t.Run("異常系_相手からのブロックは解けない", func(t *testing.T) {
+			mocks, usecase := setup4UserRelationshipUsecase(t)
+
+			mocks.relationship.EXPECT().FindBetween(gomock.Any(), userId, targetUserId).Return(
+				[]*entity.UserRelationship{newRelationship(targetUserId, userId, entity.UserRelationshipStatusBlocked)}, nil,
+			)
+
+			err := usecase.Unblock(context.Background(), userId, targetUserId)
+
+			require.ErrorIs(t, err, apperror.ErrRecordNotFound)
+		})
+	})
+}
@@ -17,6 +17,7 @@ type UserStatInterface interface {
 		season string,
 		standardRegulationId string,
 		regulationId uint,
+		recordTagId string,
 	) (*entity.UserStat, error)
 }
 
@@ -49,31 +50,62 @@ func (u *UserStat) GetUserStat(
 	season string,
 	standardRegulationId string,
 	regulationId uint,
+	recordTagId string,
 ) (*entity.UserStat, error) {
+	fromDate, toDate, err := userStatDateRange(
+		ctx,
+		u.environmentRepo,
+		u.standardRegulationRepo,
+		u.championshipSeriesRepo,
+		yearMonth,
+		environmentId,
+		season,
+		standardRegulationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.userStatRepo.FindUserStat(ctx, userId, fromDate, toDate, regulationId, recordTagId)
+}
+
+// userStatDateRange は /users/:id/stats 系の期間指定(year_month・environment_id・season・
+// standard_regulation_id)を集計期間に変換する。複数指定された場合は期間の交差を取り、
+// いずれも未指定なら当月とする(toDate は exclusive 上限)。
+func userStatDateRange(
+	ctx context.Context,
+	environmentRepo repository.EnvironmentInterface,
+	standardRegulationRepo repository.StandardRegulationInterface,
+	championshipSeriesRepo repository.ChampionshipSeriesInterface,
+	yearMonth string,
+	environmentId string,
+	season string,
+	standardRegulationId string,
+) (time.Time, time.Time, error) {
 	var fromDate, toDate time.Time
 
 	if yearMonth != "" {
 		t, err := time.Parse("2006-01", yearMonth)
 		if err != nil {
 			logError(ctx, err)
-			return nil, err
+			return time.Time{}, time.Time{}, err
 		}
 		fromDate = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
 		toDate = fromDate.AddDate(0, 1, 0)
 	} else if season != "" {
 		var err error
-		fromDate, toDate, err = seasonRange(ctx, u.championshipSeriesRepo, season, timeNow().Local())
+		fromDate, toDate, err = seasonRange(ctx, championshipSeriesRepo, season, timeNow().Local())
 		if err != nil {
 			logError(ctx, err)
-			return nil, err
+			return time.Time{}, time.Time{}, err
 		}
 	}
 
 	if environmentId != "" {
-		env, err := u.environmentRepo.FindById(ctx, environmentId)
+		env, err := environmentRepo.FindById(ctx, environmentId)
 		if err != nil {
 			logError(ctx, err)
-			return nil, err
+			return time.Time{}, time.Time{}, err
 		}
 
 		// 環境の期間（to_dateは含む日付なので翌日0時をexclusive上限とする）
@@ -90,10 +122,10 @@ func (u *UserStat) GetUserStat(
 	}
 
 	if standardRegulationId != "" {
-		reg, err := u.standardRegulationRepo.FindById(ctx, standardRegulationId)
+		reg, err := standardRegulationRepo.FindById(ctx, standardRegulationId)
 		if err != nil {
 			logError(ctx, err)
-			return nil, err
+			return time.Time{}, time.Time{}, err
 		}
 
 		// レギュレーションの期間（to_dateは含む日付なので翌日0時をexclusive上限とする）
@@ -116,5 +148,5 @@ func (u *UserStat) GetUserStat(
 		toDate = fromDate.AddDate(0, 1, 0)
 	}
 
-	return u.userStatRepo.FindUserStat(ctx, userId, fromDate, toDate, regulationId)
+	return fromDate, toDate, nil
 }